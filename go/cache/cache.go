@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"time"
+)
+
+// Cache is the interface implemented by LRUCache and LFUCache.
+type Cache interface {
+	Get(key string) (v Value, ok bool)
+	Peek(key string) (v Value, ok bool)
+	Set(key string, value Value)
+	Delete(key string) bool
+	Clear()
+	SetCapacity(capacity int64)
+
+	Length() int64
+	Size() int64
+	Capacity() int64
+	Evictions() int64
+	Oldest() time.Time
+
+	Keys() []string
+	Items() []Item
+}
+
+// SizedValue is a Value that can estimate its memory usage.
+// A LFUCache weighs these values by their memory usage,
+// instead of their Size.
+type SizedValue interface {
+	Value
+
+	// CachedSize returns the estimated memory usage
+	// of the value, in bytes.
+	CachedSize() int64
+}
+
+// Config is the configuration of a Cache.
+type Config struct {
+	// MaxEntries is the capacity of a LRUCache, which
+	// is the total Size of its values.
+	MaxEntries int64
+
+	// MaxMemoryUsage is the capacity of a LFUCache, in bytes.
+	MaxMemoryUsage int64
+
+	// LFU selects a LFUCache instead of a LRUCache.
+	LFU bool
+}
+
+// NewCache returns the Cache described by the configuration.
+func NewCache(config *Config) Cache {
+	if config.LFU {
+		return NewLFUCache(config.MaxMemoryUsage)
+	}
+	return NewLRUCache(config.MaxEntries)
+}
@@ -0,0 +1,388 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+var _ Cache = (*LFUCache)(nil)
+
+const (
+	// lfuAverageEntrySize is the expected average size of the
+	// entries of a LFUCache. It determines how many keys the
+	// frequency sketch should be able to tell apart.
+	lfuAverageEntrySize = 1024
+
+	// lfuSketchDepth is the number of counters of every key.
+	lfuSketchDepth = 4
+)
+
+// LFUCache is a cache bounded by the memory usage of its values,
+// which uses the access frequency of the keys to decide which
+// entries it keeps. The frequencies are estimated by a count-min
+// sketch of the recent lookups, including the ones that miss.
+//
+// When space is needed, the least recently used entries are the
+// candidates for eviction, like in a LRUCache. However, they're only
+// evicted if the new entry was looked up more frequently than all of
+// them. Otherwise, the new entry isn't admitted. This way, a burst of
+// lookups of keys that are seen only once doesn't evict the entries
+// that are looked up all the time.
+type LFUCache struct {
+	mu sync.Mutex
+
+	// list & table contain *entry objects.
+	list  *list.List
+	table map[string]*list.Element
+
+	sketch *frequencySketch
+
+	size       int64
+	capacity   int64
+	evictions  int64
+	rejections int64
+}
+
+// NewLFUCache creates a new empty cache with the given capacity, in bytes.
+func NewLFUCache(capacity int64) *LFUCache {
+	return &LFUCache{
+		list:     list.New(),
+		table:    make(map[string]*list.Element),
+		sketch:   newFrequencySketch(capacity / lfuAverageEntrySize),
+		capacity: capacity,
+	}
+}
+
+// Get returns a value from the cache, and records the lookup.
+func (lfu *LFUCache) Get(key string) (v Value, ok bool) {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	lfu.sketch.increment(key)
+	element := lfu.table[key]
+	if element == nil {
+		return nil, false
+	}
+	lfu.moveToFront(element)
+	return element.Value.(*entry).value, true
+}
+
+// Peek returns a value from the cache without recording the lookup.
+func (lfu *LFUCache) Peek(key string) (v Value, ok bool) {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	element := lfu.table[key]
+	if element == nil {
+		return nil, false
+	}
+	return element.Value.(*entry).value, true
+}
+
+// Set sets a value in the cache. A new entry may not
+// be admitted if there isn't enough space for it.
+func (lfu *LFUCache) Set(key string, value Value) {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	if element := lfu.table[key]; element != nil {
+		lfu.updateInplace(element, value)
+	} else {
+		lfu.addNew(key, value)
+	}
+}
+
+// Delete removes an entry from the cache, and returns if the entry existed.
+func (lfu *LFUCache) Delete(key string) bool {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	element := lfu.table[key]
+	if element == nil {
+		return false
+	}
+	lfu.remove(element)
+	return true
+}
+
+// Clear will clear the entire cache. The recorded
+// frequencies are kept.
+func (lfu *LFUCache) Clear() {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	lfu.list.Init()
+	lfu.table = make(map[string]*list.Element)
+	lfu.size = 0
+}
+
+// SetCapacity will set the capacity of the cache. If the capacity is
+// smaller, and the current cache size exceed that capacity, the cache
+// will be shrank.
+func (lfu *LFUCache) SetCapacity(capacity int64) {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	lfu.capacity = capacity
+	for lfu.size > lfu.capacity {
+		lfu.remove(lfu.list.Back())
+		lfu.evictions++
+	}
+}
+
+// Stats returns a few stats on the cache.
+func (lfu *LFUCache) Stats() (length, size, capacity, evictions, rejections int64, oldest time.Time) {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	if lastElem := lfu.list.Back(); lastElem != nil {
+		oldest = lastElem.Value.(*entry).timeAccessed
+	}
+	return int64(lfu.list.Len()), lfu.size, lfu.capacity, lfu.evictions, lfu.rejections, oldest
+}
+
+// StatsJSON returns stats as a JSON object in a string.
+func (lfu *LFUCache) StatsJSON() string {
+	if lfu == nil {
+		return "{}"
+	}
+	l, s, c, e, r, o := lfu.Stats()
+	return fmt.Sprintf("{\"Length\": %v, \"Size\": %v, \"Capacity\": %v, \"Evictions\": %v, \"Rejections\": %v, \"OldestAccess\": \"%v\"}", l, s, c, e, r, o)
+}
+
+// Length returns how many elements are in the cache
+func (lfu *LFUCache) Length() int64 {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	return int64(lfu.list.Len())
+}
+
+// Size returns the estimated memory usage of the values, in bytes.
+func (lfu *LFUCache) Size() int64 {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	return lfu.size
+}
+
+// Capacity returns the cache maximum capacity, in bytes.
+func (lfu *LFUCache) Capacity() int64 {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	return lfu.capacity
+}
+
+// Evictions returns the eviction count.
+func (lfu *LFUCache) Evictions() int64 {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	return lfu.evictions
+}
+
+// Rejections returns the number of new entries
+// that weren't admitted into the cache.
+func (lfu *LFUCache) Rejections() int64 {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	return lfu.rejections
+}
+
+// Oldest returns the access time of the least recently used element
+// in the cache, or a IsZero() time if cache is empty.
+func (lfu *LFUCache) Oldest() (oldest time.Time) {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+	if lastElem := lfu.list.Back(); lastElem != nil {
+		oldest = lastElem.Value.(*entry).timeAccessed
+	}
+	return
+}
+
+// Keys returns all the keys for the cache, ordered from most recently
+// used to least recently used.
+func (lfu *LFUCache) Keys() []string {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	keys := make([]string, 0, lfu.list.Len())
+	for e := lfu.list.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.(*entry).key)
+	}
+	return keys
+}
+
+// Items returns all the values for the cache, ordered from most recently
+// used to least recently used.
+func (lfu *LFUCache) Items() []Item {
+	lfu.mu.Lock()
+	defer lfu.mu.Unlock()
+
+	items := make([]Item, 0, lfu.list.Len())
+	for e := lfu.list.Front(); e != nil; e = e.Next() {
+		v := e.Value.(*entry)
+		items = append(items, Item{Key: v.key, Value: v.value})
+	}
+	return items
+}
+
+func (lfu *LFUCache) updateInplace(element *list.Element, value Value) {
+	valueSize := valueMemoryUsage(value)
+	lfu.size += valueSize - element.Value.(*entry).size
+	element.Value.(*entry).value = value
+	element.Value.(*entry).size = valueSize
+	lfu.moveToFront(element)
+	for lfu.size > lfu.capacity {
+		lfu.remove(lfu.list.Back())
+		lfu.evictions++
+	}
+}
+
+func (lfu *LFUCache) moveToFront(element *list.Element) {
+	lfu.list.MoveToFront(element)
+	element.Value.(*entry).timeAccessed = time.Now()
+}
+
+func (lfu *LFUCache) addNew(key string, value Value) {
+	valueSize := valueMemoryUsage(value)
+	victims, ok := lfu.victims(key, valueSize)
+	if !ok {
+		lfu.rejections++
+		return
+	}
+	for _, victim := range victims {
+		lfu.remove(victim)
+		lfu.evictions++
+	}
+	newEntry := &entry{key, value, valueSize, time.Now()}
+	lfu.table[key] = lfu.list.PushFront(newEntry)
+	lfu.size += valueSize
+}
+
+// victims returns the entries that must be evicted to make space
+// for a new entry. It returns false if the new entry shouldn't be
+// admitted, because it doesn't fit, or because one of the victims
+// was looked up at least as frequently.
+func (lfu *LFUCache) victims(key string, valueSize int64) ([]*list.Element, bool) {
+	if valueSize > lfu.capacity {
+		return nil, false
+	}
+	freed := lfu.capacity - lfu.size
+	if freed >= valueSize {
+		return nil, true
+	}
+	var victims []*list.Element
+	frequency := lfu.sketch.estimate(key)
+	for e := lfu.list.Back(); e != nil && freed < valueSize; e = e.Prev() {
+		victim := e.Value.(*entry)
+		if lfu.sketch.estimate(victim.key) >= frequency {
+			return nil, false
+		}
+		victims = append(victims, e)
+		freed += victim.size
+	}
+	return victims, true
+}
+
+func (lfu *LFUCache) remove(element *list.Element) {
+	removed := element.Value.(*entry)
+	lfu.list.Remove(element)
+	delete(lfu.table, removed.key)
+	lfu.size -= removed.size
+}
+
+// valueMemoryUsage returns the memory usage of a value.
+// Values that can't estimate it are weighed by their Size.
+func valueMemoryUsage(value Value) int64 {
+	if sized, ok := value.(SizedValue); ok {
+		return sized.CachedSize()
+	}
+	return int64(value.Size())
+}
+
+// frequencySketch is a count-min sketch that estimates how often
+// keys were recorded. To forget about old lookups, all the counters
+// are halved after a number of increments that's proportional to
+// the number of keys the sketch can tell apart.
+type frequencySketch struct {
+	counters   [lfuSketchDepth][]uint8
+	mask       uint64
+	increments int
+	resetAt    int
+}
+
+func newFrequencySketch(keys int64) *frequencySketch {
+	width := uint64(64)
+	for int64(width) < keys && width < 1<<24 {
+		width <<= 1
+	}
+	fs := &frequencySketch{
+		mask:    width - 1,
+		resetAt: 10 * int(width),
+	}
+	for i := range fs.counters {
+		fs.counters[i] = make([]uint8, width)
+	}
+	return fs
+}
+
+// indexes returns the position of the key's counter in every row.
+// The positions are derived from a single hash by double hashing.
+func (fs *frequencySketch) indexes(key string) [lfuSketchDepth]uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	sum := h.Sum64()
+	h1, h2 := sum&0xffffffff, sum>>32|1
+	var indexes [lfuSketchDepth]uint64
+	for i := range indexes {
+		indexes[i] = (h1 + uint64(i)*h2) & fs.mask
+	}
+	return indexes
+}
+
+func (fs *frequencySketch) increment(key string) {
+	for i, index := range fs.indexes(key) {
+		if fs.counters[i][index] < 255 {
+			fs.counters[i][index]++
+		}
+	}
+	fs.increments++
+	if fs.increments >= fs.resetAt {
+		fs.reset()
+	}
+}
+
+func (fs *frequencySketch) estimate(key string) uint8 {
+	min := uint8(255)
+	for i, index := range fs.indexes(key) {
+		if fs.counters[i][index] < min {
+			min = fs.counters[i][index]
+		}
+	}
+	return min
+}
+
+func (fs *frequencySketch) reset() {
+	for i := range fs.counters {
+		for j := range fs.counters[i] {
+			fs.counters[i][j] /= 2
+		}
+	}
+	fs.increments /= 2
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sizedCacheValue struct {
+	bytes int64
+}
+
+func (sv *sizedCacheValue) Size() int {
+	return 1
+}
+
+func (sv *sizedCacheValue) CachedSize() int64 {
+	return sv.bytes
+}
+
+func TestLFUSetAndGet(t *testing.T) {
+	cache := NewLFUCache(100)
+	data := &CacheValue{10}
+	cache.Set("key", data)
+
+	v, ok := cache.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, data, v)
+	_, ok = cache.Get("other")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"key"}, cache.Keys())
+	assert.Equal(t, []Item{{Key: "key", Value: data}}, cache.Items())
+	assert.EqualValues(t, 1, cache.Length())
+	assert.EqualValues(t, 10, cache.Size())
+
+	// Updates change the size.
+	cache.Set("key", &CacheValue{20})
+	assert.EqualValues(t, 20, cache.Size())
+
+	assert.True(t, cache.Delete("key"))
+	assert.False(t, cache.Delete("key"))
+	assert.EqualValues(t, 0, cache.Size())
+}
+
+func TestLFUMemoryUsage(t *testing.T) {
+	cache := NewLFUCache(100)
+	// Values that can estimate their memory usage
+	// are weighed by it, instead of their Size.
+	cache.Set("key1", &sizedCacheValue{60})
+	assert.EqualValues(t, 60, cache.Size())
+
+	// Values larger than the cache are never admitted.
+	cache.Get("key2")
+	cache.Get("key2")
+	cache.Set("key2", &sizedCacheValue{101})
+	_, ok := cache.Peek("key2")
+	assert.False(t, ok)
+	assert.EqualValues(t, 1, cache.Rejections())
+}
+
+func TestLFUAdmission(t *testing.T) {
+	cache := NewLFUCache(3)
+	for i := 0; i < 3; i++ {
+		key := fmt.Sprintf("hot%d", i)
+		for j := 0; j < 5; j++ {
+			cache.Get(key)
+		}
+		cache.Set(key, &CacheValue{1})
+	}
+
+	// A burst of keys that are looked up only once
+	// doesn't evict the frequently used entries.
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("cold%d", i)
+		if _, ok := cache.Get(key); !ok {
+			cache.Set(key, &CacheValue{1})
+		}
+	}
+	assert.ElementsMatch(t, []string{"hot0", "hot1", "hot2"}, cache.Keys())
+	assert.EqualValues(t, 0, cache.Evictions())
+	assert.EqualValues(t, 100, cache.Rejections())
+
+	// A key that's looked up more frequently than the
+	// least recently used entry replaces it.
+	cache.Get("hot1")
+	cache.Get("hot2")
+	for i := 0; i < 10; i++ {
+		cache.Get("new")
+	}
+	cache.Set("new", &CacheValue{1})
+	assert.Equal(t, []string{"new", "hot2", "hot1"}, cache.Keys())
+	assert.EqualValues(t, 1, cache.Evictions())
+
+	// All the victims must be less frequently used.
+	cache.Set("large", &CacheValue{2})
+	assert.Equal(t, []string{"new", "hot2", "hot1"}, cache.Keys())
+	for i := 0; i < 20; i++ {
+		cache.Get("large")
+	}
+	cache.Set("large", &CacheValue{2})
+	assert.Equal(t, []string{"large", "new"}, cache.Keys())
+	assert.EqualValues(t, 3, cache.Evictions())
+
+	data := cache.StatsJSON()
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Errorf("cache.StatsJSON() returned bad json data: %v %v", data, err)
+	}
+	assert.EqualValues(t, 3, m["Size"])
+	assert.EqualValues(t, 3, m["Evictions"])
+}
+
+func TestLFUSetCapacity(t *testing.T) {
+	cache := NewLFUCache(3)
+	cache.Set("key1", &CacheValue{1})
+	cache.Set("key2", &CacheValue{1})
+	cache.Set("key3", &CacheValue{1})
+	cache.SetCapacity(2)
+	assert.Equal(t, []string{"key3", "key2"}, cache.Keys())
+	assert.EqualValues(t, 2, cache.Capacity())
+	assert.EqualValues(t, 1, cache.Evictions())
+
+	cache.Clear()
+	assert.EqualValues(t, 0, cache.Length())
+	assert.EqualValues(t, 0, cache.Size())
+	assert.True(t, cache.Oldest().IsZero())
+}
+
+func TestFrequencySketch(t *testing.T) {
+	fs := newFrequencySketch(0)
+	for i := 0; i < 10; i++ {
+		fs.increment("key1")
+	}
+	fs.increment("key2")
+	assert.EqualValues(t, 10, fs.estimate("key1"))
+	assert.EqualValues(t, 1, fs.estimate("key2"))
+	assert.EqualValues(t, 0, fs.estimate("key3"))
+
+	// The counters are halved periodically.
+	for i := fs.increments; i < fs.resetAt; i++ {
+		fs.increment("key3")
+	}
+	assert.EqualValues(t, 5, fs.estimate("key1"))
+}
+
+func TestNewCache(t *testing.T) {
+	_, ok := NewCache(&Config{MaxEntries: 10}).(*LRUCache)
+	assert.True(t, ok)
+	cache := NewCache(&Config{MaxEntries: 10, MaxMemoryUsage: 1000, LFU: true})
+	assert.EqualValues(t, 1000, cache.Capacity())
+}
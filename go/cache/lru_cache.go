@@ -14,9 +14,9 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package cache implements a LRU cache.
+// Package cache implements a LRU cache, and a LFU cache.
 //
-// The implementation borrows heavily from SmallLRUCache
+// The LRU implementation borrows heavily from SmallLRUCache
 // (originally by Nathan Schrenk). The object maintains a doubly-linked list of
 // elements. When an element is accessed, it is promoted to the head of the
 // list. When space is needed, the element at the tail of the list
@@ -30,6 +30,8 @@ import (
 	"time"
 )
 
+var _ Cache = (*LRUCache)(nil)
+
 // LRUCache is a typical LRU cache implementation.  If the cache
 // reaches the capacity, the least recently used item is deleted from
 // the cache. Note the capacity is not the number of items, but the
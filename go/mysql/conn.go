@@ -186,6 +186,12 @@ type PrepareData struct {
 	ParamsType  []int32
 	ColumnNames []string
 	BindVars    map[string]*querypb.BindVariable
+
+	// ClientData is a place where the handler can store data bound
+	// to the prepared statement, like Conn.ClientData for the
+	// connection. It's dropped with the statement, when it's closed
+	// or when the connection is reset.
+	ClientData interface{}
 }
 
 // bufPool is used to allocate and free buffers in an efficient way.
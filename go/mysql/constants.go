@@ -288,6 +288,7 @@ const (
 	ERServerShutdown = 1053
 
 	// not found
+	ERDbDropExists          = 1008
 	ERFormNotFound          = 1029
 	ERKeyNotFound           = 1032
 	ERBadFieldError         = 1054
@@ -336,10 +337,11 @@ const (
 	ErNoReferencedRow2              = 1452
 
 	// already exists
-	ERTableExists = 1050
-	ERDupEntry    = 1062
-	ERFileExists  = 1086
-	ERUDFExists   = 1125
+	ERDbCreateExists = 1007
+	ERTableExists    = 1050
+	ERDupEntry       = 1062
+	ERFileExists     = 1086
+	ERUDFExists      = 1125
 
 	// aborted
 	ERGotSignal          = 1078
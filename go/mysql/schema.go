@@ -35,6 +35,14 @@ const (
 
 	// BaseShowPrimary is the base query for fetching primary key info.
 	BaseShowPrimary = "SELECT table_name, column_name FROM information_schema.key_column_usage WHERE table_schema=database() AND constraint_name='PRIMARY' ORDER BY table_name, ordinal_position"
+
+	// BaseShowTableRows is the base query for fetching the estimated
+	// row counts of the tables.
+	BaseShowTableRows = "SELECT table_name, table_rows FROM information_schema.tables WHERE table_schema=database() AND table_type='BASE TABLE'"
+
+	// BaseShowIndexCardinality is the base query for fetching the estimated
+	// cardinality of the columns that lead an index.
+	BaseShowIndexCardinality = "SELECT table_name, column_name, max(cardinality) FROM information_schema.statistics WHERE table_schema=database() AND seq_in_index=1 GROUP BY table_name, column_name"
 )
 
 // BaseShowTablesFields contains the fields returned by a BaseShowTables or a BaseShowTablesForTable command.
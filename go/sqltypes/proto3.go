@@ -86,10 +86,11 @@ func ResultToProto3(qr *Result) *querypb.QueryResult {
 		return nil
 	}
 	return &querypb.QueryResult{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertId:     qr.InsertID,
-		Rows:         RowsToProto3(qr.Rows),
+		Fields:           qr.Fields,
+		RowsAffected:     qr.RowsAffected,
+		InsertId:         qr.InsertID,
+		Rows:             RowsToProto3(qr.Rows),
+		ExecutedPosition: qr.ExecutedPosition,
 	}
 }
 
@@ -100,10 +101,11 @@ func Proto3ToResult(qr *querypb.QueryResult) *Result {
 		return nil
 	}
 	return &Result{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertID:     qr.InsertId,
-		Rows:         proto3ToRows(qr.Fields, qr.Rows),
+		Fields:           qr.Fields,
+		RowsAffected:     qr.RowsAffected,
+		InsertID:         qr.InsertId,
+		Rows:             proto3ToRows(qr.Fields, qr.Rows),
+		ExecutedPosition: qr.ExecutedPosition,
 	}
 }
 
@@ -115,10 +117,11 @@ func CustomProto3ToResult(fields []*querypb.Field, qr *querypb.QueryResult) *Res
 		return nil
 	}
 	return &Result{
-		Fields:       qr.Fields,
-		RowsAffected: qr.RowsAffected,
-		InsertID:     qr.InsertId,
-		Rows:         proto3ToRows(fields, qr.Rows),
+		Fields:           qr.Fields,
+		RowsAffected:     qr.RowsAffected,
+		InsertID:         qr.InsertId,
+		Rows:             proto3ToRows(fields, qr.Rows),
+		ExecutedPosition: qr.ExecutedPosition,
 	}
 }
 
@@ -37,9 +37,10 @@ func TestResult(t *testing.T) {
 		Type: Float64,
 	}}
 	sqlResult := &Result{
-		Fields:       fields,
-		InsertID:     1,
-		RowsAffected: 2,
+		Fields:           fields,
+		InsertID:         1,
+		RowsAffected:     2,
+		ExecutedPosition: "MySQL56/33333333-3333-3333-3333-333333333333:1-5",
 		Rows: [][]Value{{
 			TestValue(VarChar, "aa"),
 			TestValue(Int64, "1"),
@@ -51,9 +52,10 @@ func TestResult(t *testing.T) {
 		}},
 	}
 	p3Result := &querypb.QueryResult{
-		Fields:       fields,
-		InsertId:     1,
-		RowsAffected: 2,
+		Fields:           fields,
+		InsertId:         1,
+		RowsAffected:     2,
+		ExecutedPosition: "MySQL56/33333333-3333-3333-3333-333333333333:1-5",
 		Rows: []*querypb.Row{{
 			Lengths: []int64{2, 1, 1},
 			Values:  []byte("aa12"),
@@ -68,7 +70,7 @@ func TestResult(t *testing.T) {
 	}
 
 	reverse := Proto3ToResult(p3Result)
-	if !reverse.Equal(sqlResult) {
+	if !reverse.Equal(sqlResult) || reverse.ExecutedPosition != sqlResult.ExecutedPosition {
 		t.Errorf("reverse:\n%#v, want\n%#v", reverse, sqlResult)
 	}
 
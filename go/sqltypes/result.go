@@ -29,6 +29,11 @@ type Result struct {
 	RowsAffected uint64           `json:"rows_affected"`
 	InsertID     uint64           `json:"insert_id"`
 	Rows         [][]Value        `json:"rows"`
+
+	// ExecutedPosition is the replication position of the tablet
+	// after the query. It's only set if it was requested in the
+	// ExecuteOptions.
+	ExecutedPosition string `json:"executed_position,omitempty"`
 }
 
 // ResultStream is an interface for receiving Result. It is used for
@@ -55,8 +60,9 @@ func (result *Result) Repair(fields []*querypb.Field) {
 // Copy creates a deep copy of Result.
 func (result *Result) Copy() *Result {
 	out := &Result{
-		InsertID:     result.InsertID,
-		RowsAffected: result.RowsAffected,
+		InsertID:         result.InsertID,
+		RowsAffected:     result.RowsAffected,
+		ExecutedPosition: result.ExecutedPosition,
 	}
 	if result.Fields != nil {
 		fieldsp := make([]*querypb.Field, len(result.Fields))
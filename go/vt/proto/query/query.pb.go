@@ -857,10 +857,20 @@ type ExecuteOptions struct {
 	TransactionIsolation ExecuteOptions_TransactionIsolation `protobuf:"varint,9,opt,name=transaction_isolation,json=transactionIsolation,proto3,enum=query.ExecuteOptions_TransactionIsolation" json:"transaction_isolation,omitempty"`
 	// skip_query_plan_cache specifies if the query plan should be cached by vitess.
 	// By default all query plans are cached.
-	SkipQueryPlanCache   bool     `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SkipQueryPlanCache bool `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
+	// wait_for_position is a replication position that the tablet
+	// waits for before executing the query. It's only used outside
+	// of transactions and reserved connections.
+	WaitForPosition string `protobuf:"bytes,11,opt,name=wait_for_position,json=waitForPosition,proto3" json:"wait_for_position,omitempty"`
+	// wait_for_position_timeout_ms is the time the tablet waits for
+	// wait_for_position, in milliseconds.
+	WaitForPositionTimeoutMs int64 `protobuf:"varint,12,opt,name=wait_for_position_timeout_ms,json=waitForPositionTimeoutMs,proto3" json:"wait_for_position_timeout_ms,omitempty"`
+	// include_executed_position asks the tablet to return its
+	// executed replication position with the result of the query.
+	IncludeExecutedPosition bool     `protobuf:"varint,13,opt,name=include_executed_position,json=includeExecutedPosition,proto3" json:"include_executed_position,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *ExecuteOptions) Reset()         { *m = ExecuteOptions{} }
@@ -930,6 +940,27 @@ func (m *ExecuteOptions) GetSkipQueryPlanCache() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetWaitForPosition() string {
+	if m != nil {
+		return m.WaitForPosition
+	}
+	return ""
+}
+
+func (m *ExecuteOptions) GetWaitForPositionTimeoutMs() int64 {
+	if m != nil {
+		return m.WaitForPositionTimeoutMs
+	}
+	return 0
+}
+
+func (m *ExecuteOptions) GetIncludeExecutedPosition() bool {
+	if m != nil {
+		return m.IncludeExecutedPosition
+	}
+	return false
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -1114,10 +1145,13 @@ func (m *Row) GetValues() []byte {
 // len(QueryResult[0].fields) is always equal to len(row) (for each
 // row in rows for each QueryResult in QueryResult[1:]).
 type QueryResult struct {
-	Fields               []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	RowsAffected         uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
-	InsertId             uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
-	Rows                 []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Fields       []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	RowsAffected uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	InsertId     uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
+	Rows         []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	// executed_position is the replication position of the tablet
+	// after the query, if include_executed_position was set.
+	ExecutedPosition     string   `protobuf:"bytes,6,opt,name=executed_position,json=executedPosition,proto3" json:"executed_position,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1176,6 +1210,13 @@ func (m *QueryResult) GetRows() []*Row {
 	return nil
 }
 
+func (m *QueryResult) GetExecutedPosition() string {
+	if m != nil {
+		return m.ExecutedPosition
+	}
+	return ""
+}
+
 // QueryWarning is used to convey out of band query execution warnings
 // by storing in the vtgate.Session
 type QueryWarning struct {
@@ -3915,10 +3956,13 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// table_statistics are coarse statistics about the tables of the
+	// keyspace, used by vtgate to order the joins of a query.
+	TableStatistics      []*TableStatistics `protobuf:"bytes,7,rep,name=table_statistics,json=tableStatistics,proto3" json:"table_statistics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *RealtimeStats) Reset()         { *m = RealtimeStats{} }
@@ -3988,6 +4032,75 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTableStatistics() []*TableStatistics {
+	if m != nil {
+		return m.TableStatistics
+	}
+	return nil
+}
+
+// TableStatistics contains the statistics of a table, as estimated
+// by MySQL. They are only used for query planning.
+type TableStatistics struct {
+	// name is the name of the table.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// row_count is the estimated number of rows of the table,
+	// from information_schema.tables.
+	RowCount uint64 `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// column_cardinality is the estimated number of distinct values of
+	// the leading columns of the indexes, from information_schema.statistics.
+	ColumnCardinality    map[string]uint64 `protobuf:"bytes,3,rep,name=column_cardinality,json=columnCardinality,proto3" json:"column_cardinality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TableStatistics) Reset()         { *m = TableStatistics{} }
+func (m *TableStatistics) String() string { return proto.CompactTextString(m) }
+func (*TableStatistics) ProtoMessage()    {}
+func (*TableStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{57}
+}
+
+func (m *TableStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableStatistics.Unmarshal(m, b)
+}
+func (m *TableStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableStatistics.Marshal(b, m, deterministic)
+}
+func (m *TableStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableStatistics.Merge(m, src)
+}
+func (m *TableStatistics) XXX_Size() int {
+	return xxx_messageInfo_TableStatistics.Size(m)
+}
+func (m *TableStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableStatistics proto.InternalMessageInfo
+
+func (m *TableStatistics) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TableStatistics) GetRowCount() uint64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *TableStatistics) GetColumnCardinality() map[string]uint64 {
+	if m != nil {
+		return m.ColumnCardinality
+	}
+	return nil
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4014,7 +4127,7 @@ func (m *AggregateStats) Reset()         { *m = AggregateStats{} }
 func (m *AggregateStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateStats) ProtoMessage()    {}
 func (*AggregateStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{57}
+	return fileDescriptor_5c6ac9b241082464, []int{58}
 }
 
 func (m *AggregateStats) XXX_Unmarshal(b []byte) error {
@@ -4120,7 +4233,7 @@ func (m *StreamHealthResponse) Reset()         { *m = StreamHealthResponse{} }
 func (m *StreamHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamHealthResponse) ProtoMessage()    {}
 func (*StreamHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{58}
+	return fileDescriptor_5c6ac9b241082464, []int{59}
 }
 
 func (m *StreamHealthResponse) XXX_Unmarshal(b []byte) error {
@@ -4191,7 +4304,7 @@ func (m *TransactionMetadata) Reset()         { *m = TransactionMetadata{} }
 func (m *TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*TransactionMetadata) ProtoMessage()    {}
 func (*TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c6ac9b241082464, []int{59}
+	return fileDescriptor_5c6ac9b241082464, []int{60}
 }
 
 func (m *TransactionMetadata) XXX_Unmarshal(b []byte) error {
@@ -4308,6 +4421,8 @@ func init() {
 	proto.RegisterType((*ReleaseResponse)(nil), "query.ReleaseResponse")
 	proto.RegisterType((*StreamHealthRequest)(nil), "query.StreamHealthRequest")
 	proto.RegisterType((*RealtimeStats)(nil), "query.RealtimeStats")
+	proto.RegisterType((*TableStatistics)(nil), "query.TableStatistics")
+	proto.RegisterMapType((map[string]uint64)(nil), "query.TableStatistics.ColumnCardinalityEntry")
 	proto.RegisterType((*AggregateStats)(nil), "query.AggregateStats")
 	proto.RegisterType((*StreamHealthResponse)(nil), "query.StreamHealthResponse")
 	proto.RegisterType((*TransactionMetadata)(nil), "query.TransactionMetadata")
@@ -4316,202 +4431,213 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x70, 0x1b, 0x47,
-	0x7a, 0xd6, 0xe0, 0x45, 0xe0, 0x07, 0x01, 0x36, 0x9b, 0xa4, 0x04, 0x51, 0x7e, 0xd0, 0x63, 0xcb,
-	0x66, 0x98, 0x84, 0x92, 0x28, 0x59, 0x51, 0x6c, 0x27, 0xd1, 0x10, 0x1c, 0xca, 0x90, 0x80, 0x01,
-	0xd4, 0x18, 0x48, 0x96, 0x2a, 0x55, 0x53, 0x43, 0xa0, 0x05, 0x4e, 0x71, 0x80, 0x81, 0x66, 0x86,
-	0x94, 0x78, 0x53, 0xe2, 0x38, 0xce, 0x3b, 0xce, 0xd3, 0x71, 0x5c, 0x71, 0xa5, 0x2a, 0x87, 0x54,
-	0x2e, 0x39, 0xef, 0x79, 0x0f, 0x3e, 0xec, 0x61, 0xab, 0xf6, 0xb8, 0xbb, 0x87, 0xdd, 0x3d, 0x6c,
-	0xed, 0x9e, 0x5c, 0x5b, 0x7b, 0xd8, 0xc3, 0x1e, 0xb6, 0xb6, 0xfa, 0x31, 0x03, 0x80, 0x84, 0x25,
-	0x5a, 0x5e, 0xd7, 0x96, 0x64, 0xdd, 0xfa, 0x7f, 0xf4, 0xe3, 0xfb, 0xfa, 0x9f, 0xbf, 0x1f, 0xd3,
-	0x90, 0xbf, 0xbb, 0x4b, 0xfd, 0xfd, 0xd5, 0x81, 0xef, 0x85, 0x1e, 0x4e, 0x73, 0x61, 0xb1, 0x18,
-	0x7a, 0x03, 0xaf, 0x63, 0x87, 0xb6, 0x50, 0x2f, 0xe6, 0xf7, 0x42, 0x7f, 0xd0, 0x16, 0x82, 0xfa,
-	0x9e, 0x02, 0x19, 0xd3, 0xf6, 0xbb, 0x34, 0xc4, 0x8b, 0x90, 0xdd, 0xa1, 0xfb, 0xc1, 0xc0, 0x6e,
-	0xd3, 0x92, 0xb2, 0xa4, 0x2c, 0xe7, 0x48, 0x2c, 0xe3, 0x79, 0x48, 0x07, 0xdb, 0xb6, 0xdf, 0x29,
-	0x25, 0xb8, 0x41, 0x08, 0xf8, 0x75, 0xc8, 0x87, 0xf6, 0x96, 0x4b, 0x43, 0x2b, 0xdc, 0x1f, 0xd0,
-	0x52, 0x72, 0x49, 0x59, 0x2e, 0xae, 0xcd, 0xaf, 0xc6, 0xfd, 0x99, 0xdc, 0x68, 0xee, 0x0f, 0x28,
-	0x81, 0x30, 0x2e, 0x63, 0x0c, 0xa9, 0x36, 0x75, 0xdd, 0x52, 0x8a, 0xb7, 0xc5, 0xcb, 0xea, 0x06,
-	0x14, 0x6f, 0x98, 0x57, 0xec, 0x90, 0x96, 0x6d, 0xd7, 0xa5, 0x7e, 0x65, 0x83, 0x0d, 0x67, 0x37,
-	0xa0, 0x7e, 0xdf, 0xee, 0xc5, 0xc3, 0x89, 0x64, 0x7c, 0x1c, 0x32, 0x5d, 0xdf, 0xdb, 0x1d, 0x04,
-	0xa5, 0xc4, 0x52, 0x72, 0x39, 0x47, 0xa4, 0xa4, 0xfe, 0x29, 0x80, 0xbe, 0x47, 0xfb, 0xa1, 0xe9,
-	0xed, 0xd0, 0x3e, 0x7e, 0x0e, 0x72, 0xa1, 0xd3, 0xa3, 0x41, 0x68, 0xf7, 0x06, 0xbc, 0x89, 0x24,
-	0x19, 0x2a, 0x3e, 0x07, 0xd2, 0x22, 0x64, 0x07, 0x5e, 0xe0, 0x84, 0x8e, 0xd7, 0xe7, 0x78, 0x72,
-	0x24, 0x96, 0xd5, 0x3f, 0x86, 0xf4, 0x0d, 0xdb, 0xdd, 0xa5, 0xf8, 0x45, 0x48, 0x71, 0xc0, 0x0a,
-	0x07, 0x9c, 0x5f, 0x15, 0xa4, 0x73, 0x9c, 0xdc, 0xc0, 0xda, 0xde, 0x63, 0x9e, 0xbc, 0xed, 0x69,
-	0x22, 0x04, 0x75, 0x07, 0xa6, 0xd7, 0x9d, 0x7e, 0xe7, 0x86, 0xed, 0x3b, 0x8c, 0x8c, 0xc7, 0x6c,
-	0x06, 0xbf, 0x02, 0x19, 0x5e, 0x08, 0x4a, 0xc9, 0xa5, 0xe4, 0x72, 0x7e, 0x6d, 0x5a, 0x56, 0xe4,
-	0x63, 0x23, 0xd2, 0xa6, 0x7e, 0x53, 0x01, 0x58, 0xf7, 0x76, 0xfb, 0x9d, 0xeb, 0xcc, 0x88, 0x11,
-	0x24, 0x83, 0xbb, 0xae, 0x24, 0x92, 0x15, 0xf1, 0x35, 0x28, 0x6e, 0x39, 0xfd, 0x8e, 0xb5, 0x27,
-	0x87, 0x23, 0xb8, 0xcc, 0xaf, 0xbd, 0x22, 0x9b, 0x1b, 0x56, 0x5e, 0x1d, 0x1d, 0x75, 0xa0, 0xf7,
-	0x43, 0x7f, 0x9f, 0x14, 0xb6, 0x46, 0x75, 0x8b, 0x2d, 0xc0, 0x87, 0x9d, 0x58, 0xa7, 0x3b, 0x74,
-	0x3f, 0xea, 0x74, 0x87, 0xee, 0xe3, 0xdf, 0x19, 0x45, 0x94, 0x5f, 0x9b, 0x8b, 0xfa, 0x1a, 0xa9,
-	0x2b, 0x61, 0xbe, 0x91, 0xb8, 0xa4, 0xa8, 0xdf, 0x48, 0x43, 0x51, 0xbf, 0x4f, 0xdb, 0xbb, 0x21,
-	0xad, 0x0f, 0xd8, 0x1c, 0x04, 0xb8, 0x06, 0x33, 0x4e, 0xbf, 0xed, 0xee, 0x76, 0x68, 0xc7, 0xba,
-	0xe3, 0x50, 0xb7, 0x13, 0xf0, 0x38, 0x2a, 0xc6, 0xe3, 0x1e, 0xf7, 0x5f, 0xad, 0x48, 0xe7, 0x4d,
-	0xee, 0x4b, 0x8a, 0xce, 0x98, 0x8c, 0x57, 0x60, 0xb6, 0xed, 0x3a, 0xb4, 0x1f, 0x5a, 0x77, 0x18,
-	0x5e, 0xcb, 0xf7, 0xee, 0x05, 0xa5, 0xf4, 0x92, 0xb2, 0x9c, 0x25, 0x33, 0xc2, 0xb0, 0xc9, 0xf4,
-	0xc4, 0xbb, 0x17, 0xe0, 0x37, 0x20, 0x7b, 0xcf, 0xf3, 0x77, 0x5c, 0xcf, 0xee, 0x94, 0x32, 0xbc,
-	0xcf, 0x17, 0x26, 0xf7, 0x79, 0x53, 0x7a, 0x91, 0xd8, 0x1f, 0x2f, 0x03, 0x0a, 0xee, 0xba, 0x56,
-	0x40, 0x5d, 0xda, 0x0e, 0x2d, 0xd7, 0xe9, 0x39, 0x61, 0x29, 0xcb, 0x43, 0xb2, 0x18, 0xdc, 0x75,
-	0x9b, 0x5c, 0x5d, 0x65, 0x5a, 0x6c, 0xc1, 0x42, 0xe8, 0xdb, 0xfd, 0xc0, 0x6e, 0xb3, 0xc6, 0x2c,
-	0x27, 0xf0, 0x5c, 0x9b, 0x87, 0x63, 0x8e, 0x77, 0xb9, 0x32, 0xb9, 0x4b, 0x73, 0x58, 0xa5, 0x12,
-	0xd5, 0x20, 0xf3, 0xe1, 0x04, 0x2d, 0x3e, 0x07, 0x0b, 0xc1, 0x8e, 0x33, 0xb0, 0x78, 0x3b, 0xd6,
-	0xc0, 0xb5, 0xfb, 0x56, 0xdb, 0x6e, 0x6f, 0xd3, 0x12, 0x70, 0xd8, 0x98, 0x19, 0xf9, 0xbc, 0x37,
-	0x5c, 0xbb, 0x5f, 0x66, 0x16, 0xf5, 0x4d, 0x28, 0x8e, 0xf3, 0x88, 0x67, 0xa1, 0x60, 0xde, 0x6a,
-	0xe8, 0x96, 0x66, 0x6c, 0x58, 0x86, 0x56, 0xd3, 0xd1, 0x31, 0x5c, 0x80, 0x1c, 0x57, 0xd5, 0x8d,
-	0xea, 0x2d, 0xa4, 0xe0, 0x29, 0x48, 0x6a, 0xd5, 0x2a, 0x4a, 0xa8, 0x97, 0x20, 0x1b, 0x11, 0x82,
-	0x67, 0x20, 0xdf, 0x32, 0x9a, 0x0d, 0xbd, 0x5c, 0xd9, 0xac, 0xe8, 0x1b, 0xe8, 0x18, 0xce, 0x42,
-	0xaa, 0x5e, 0x35, 0x1b, 0x48, 0x11, 0x25, 0xad, 0x81, 0x12, 0xac, 0xe6, 0xc6, 0xba, 0x86, 0x92,
-	0xea, 0xff, 0x2a, 0x30, 0x3f, 0x09, 0x18, 0xce, 0xc3, 0xd4, 0x86, 0xbe, 0xa9, 0xb5, 0xaa, 0x26,
-	0x3a, 0x86, 0xe7, 0x60, 0x86, 0xe8, 0x0d, 0x5d, 0x33, 0xb5, 0xf5, 0xaa, 0x6e, 0x11, 0x5d, 0xdb,
-	0x40, 0x0a, 0xc6, 0x50, 0x64, 0x25, 0xab, 0x5c, 0xaf, 0xd5, 0x2a, 0xa6, 0xa9, 0x6f, 0xa0, 0x04,
-	0x9e, 0x07, 0xc4, 0x75, 0x2d, 0x63, 0xa8, 0x4d, 0x62, 0x04, 0xd3, 0x4d, 0x9d, 0x54, 0xb4, 0x6a,
-	0xe5, 0x36, 0x6b, 0x00, 0xa5, 0xf0, 0x4b, 0xf0, 0x7c, 0xb9, 0x6e, 0x34, 0x2b, 0x4d, 0x53, 0x37,
-	0x4c, 0xab, 0x69, 0x68, 0x8d, 0xe6, 0xdb, 0x75, 0x93, 0xb7, 0x2c, 0xc0, 0xa5, 0x71, 0x11, 0x40,
-	0x6b, 0x99, 0x75, 0xd1, 0x0e, 0xca, 0x5c, 0x4d, 0x65, 0x15, 0x94, 0xb8, 0x9a, 0xca, 0x26, 0x50,
-	0xf2, 0x6a, 0x2a, 0x9b, 0x44, 0x29, 0xf5, 0xc3, 0x04, 0xa4, 0x39, 0x57, 0x2c, 0xdd, 0x8d, 0x24,
-	0x31, 0x5e, 0x8e, 0x3f, 0xfd, 0xc4, 0x43, 0x3e, 0x7d, 0x9e, 0x31, 0x65, 0x12, 0x12, 0x02, 0x3e,
-	0x05, 0x39, 0xcf, 0xef, 0x5a, 0xc2, 0x22, 0xd2, 0x67, 0xd6, 0xf3, 0xbb, 0x3c, 0xcf, 0xb2, 0xd4,
-	0xc5, 0xb2, 0xee, 0x96, 0x1d, 0x50, 0x1e, 0xc1, 0x39, 0x12, 0xcb, 0xf8, 0x24, 0x30, 0x3f, 0x8b,
-	0x8f, 0x23, 0xc3, 0x6d, 0x53, 0x9e, 0xdf, 0x35, 0xd8, 0x50, 0x5e, 0x86, 0x42, 0xdb, 0x73, 0x77,
-	0x7b, 0x7d, 0xcb, 0xa5, 0xfd, 0x6e, 0xb8, 0x5d, 0x9a, 0x5a, 0x52, 0x96, 0x0b, 0x64, 0x5a, 0x28,
-	0xab, 0x5c, 0x87, 0x4b, 0x30, 0xd5, 0xde, 0xb6, 0xfd, 0x80, 0x8a, 0xa8, 0x2d, 0x90, 0x48, 0xe4,
-	0xbd, 0xd2, 0xb6, 0xd3, 0xb3, 0xdd, 0x80, 0x47, 0x68, 0x81, 0xc4, 0x32, 0x03, 0x71, 0xc7, 0xb5,
-	0xbb, 0x01, 0x8f, 0xac, 0x02, 0x11, 0x82, 0xfa, 0x07, 0x90, 0x24, 0xde, 0x3d, 0xd6, 0xa4, 0xe8,
-	0x30, 0x28, 0x29, 0x4b, 0xc9, 0x65, 0x4c, 0x22, 0x91, 0x65, 0x77, 0x99, 0xe0, 0x44, 0xde, 0x8b,
-	0x52, 0xda, 0xc7, 0x0a, 0xe4, 0x79, 0x60, 0x12, 0x1a, 0xec, 0xba, 0x21, 0x4b, 0x84, 0x32, 0x03,
-	0x28, 0x63, 0x89, 0x90, 0xd3, 0x4e, 0xa4, 0x8d, 0xe1, 0x63, 0x1f, 0xb5, 0x65, 0xdf, 0xb9, 0x43,
-	0xdb, 0x21, 0x15, 0xf9, 0x3e, 0x45, 0xa6, 0x99, 0x52, 0x93, 0x3a, 0x46, 0xac, 0xd3, 0x0f, 0xa8,
-	0x1f, 0x5a, 0x4e, 0x87, 0x53, 0x9e, 0x22, 0x59, 0xa1, 0xa8, 0x74, 0xf0, 0x0b, 0x90, 0xe2, 0x69,
-	0x21, 0xc5, 0x7b, 0x01, 0xd9, 0x0b, 0xf1, 0xee, 0x11, 0xae, 0xbf, 0x9a, 0xca, 0xa6, 0x51, 0x46,
-	0x7d, 0x0b, 0xa6, 0xf9, 0xe0, 0x6e, 0xda, 0x7e, 0xdf, 0xe9, 0x77, 0xf9, 0x2a, 0xe7, 0x75, 0xc4,
-	0xb4, 0x17, 0x08, 0x2f, 0x33, 0xcc, 0x3d, 0x1a, 0x04, 0x76, 0x97, 0xca, 0x55, 0x27, 0x12, 0xd5,
-	0xff, 0x4e, 0x42, 0xbe, 0x19, 0xfa, 0xd4, 0xee, 0xf1, 0x05, 0x0c, 0xbf, 0x05, 0x10, 0x84, 0x76,
-	0x48, 0x7b, 0xb4, 0x1f, 0x46, 0xf8, 0x9e, 0x93, 0x3d, 0x8f, 0xf8, 0xad, 0x36, 0x23, 0x27, 0x32,
-	0xe2, 0x8f, 0xd7, 0x20, 0x4f, 0x99, 0xd9, 0x0a, 0xd9, 0x42, 0x28, 0x93, 0xed, 0x6c, 0x94, 0x39,
-	0xe2, 0x15, 0x92, 0x00, 0x8d, 0xcb, 0x8b, 0x9f, 0x24, 0x20, 0x17, 0xb7, 0x86, 0x35, 0xc8, 0xb6,
-	0xed, 0x90, 0x76, 0x3d, 0x7f, 0x5f, 0xae, 0x4f, 0xa7, 0x1f, 0xd6, 0xfb, 0x6a, 0x59, 0x3a, 0x93,
-	0xb8, 0x1a, 0x7e, 0x1e, 0xc4, 0xa2, 0x2f, 0xa2, 0x4e, 0xe0, 0xcd, 0x71, 0x0d, 0x8f, 0xbb, 0x37,
-	0x00, 0x0f, 0x7c, 0xa7, 0x67, 0xfb, 0xfb, 0xd6, 0x0e, 0xdd, 0x8f, 0x72, 0x79, 0x72, 0xc2, 0x4c,
-	0x22, 0xe9, 0x77, 0x8d, 0xee, 0xcb, 0xec, 0x73, 0x69, 0xbc, 0xae, 0x8c, 0x96, 0xc3, 0xf3, 0x33,
-	0x52, 0x93, 0xaf, 0x8e, 0x41, 0xb4, 0x0e, 0xa6, 0x79, 0x60, 0xb1, 0xa2, 0xfa, 0x1a, 0x64, 0xa3,
-	0xc1, 0xe3, 0x1c, 0xa4, 0x75, 0xdf, 0xf7, 0x7c, 0x74, 0x8c, 0x27, 0xa1, 0x5a, 0x55, 0xe4, 0xb1,
-	0x8d, 0x0d, 0x96, 0xc7, 0x7e, 0x94, 0x88, 0x17, 0x23, 0x42, 0xef, 0xee, 0xd2, 0x20, 0xc4, 0x7f,
-	0x02, 0x73, 0x94, 0x87, 0x90, 0xb3, 0x47, 0xad, 0x36, 0xdf, 0xb9, 0xb0, 0x00, 0x52, 0x38, 0xdf,
-	0x33, 0xab, 0x62, 0xa3, 0x15, 0xed, 0x68, 0xc8, 0x6c, 0xec, 0x2b, 0x55, 0x1d, 0xac, 0xc3, 0x9c,
-	0xd3, 0xeb, 0xd1, 0x8e, 0x63, 0x87, 0xa3, 0x0d, 0x88, 0x09, 0x5b, 0x88, 0x16, 0xf6, 0xb1, 0x8d,
-	0x11, 0x99, 0x8d, 0x6b, 0xc4, 0xcd, 0x9c, 0x86, 0x4c, 0xc8, 0x37, 0x71, 0x3c, 0x76, 0xf3, 0x6b,
-	0x85, 0x28, 0xa1, 0x70, 0x25, 0x91, 0x46, 0xfc, 0x1a, 0x88, 0x2d, 0x21, 0x4f, 0x1d, 0xc3, 0x80,
-	0x18, 0xae, 0xf4, 0x44, 0xd8, 0xf1, 0x69, 0x28, 0x8e, 0xad, 0x41, 0x1d, 0x4e, 0x58, 0x92, 0x14,
-	0x46, 0x17, 0x94, 0x0e, 0x3e, 0x03, 0x53, 0x9e, 0x58, 0x7f, 0x78, 0x52, 0x19, 0x8e, 0x78, 0x7c,
-	0x71, 0x22, 0x91, 0x17, 0x7e, 0x11, 0xf2, 0x3e, 0x0d, 0xa8, 0xbf, 0x47, 0x3b, 0xac, 0xd1, 0x29,
-	0xde, 0x28, 0x44, 0xaa, 0x4a, 0x47, 0xfd, 0x23, 0x98, 0x89, 0x29, 0x0e, 0x06, 0x5e, 0x3f, 0xa0,
-	0x78, 0x05, 0x32, 0x3e, 0xff, 0xde, 0x25, 0xad, 0x58, 0xf6, 0x31, 0x92, 0x09, 0x88, 0xf4, 0x50,
-	0x3b, 0x30, 0x23, 0x34, 0x37, 0x9d, 0x70, 0x9b, 0xcf, 0x24, 0x3e, 0x0d, 0x69, 0xca, 0x0a, 0x07,
-	0x26, 0x85, 0x34, 0xca, 0xdc, 0x4e, 0x84, 0x75, 0xa4, 0x97, 0xc4, 0x23, 0x7b, 0xf9, 0x59, 0x02,
-	0xe6, 0xe4, 0x28, 0xd7, 0xed, 0xb0, 0xbd, 0xfd, 0x84, 0x46, 0xc3, 0xef, 0xc2, 0x14, 0xd3, 0x3b,
-	0xf1, 0x97, 0x33, 0x21, 0x1e, 0x22, 0x0f, 0x16, 0x11, 0x76, 0x60, 0x8d, 0x4c, 0xbf, 0xdc, 0x24,
-	0x15, 0xec, 0x60, 0x64, 0x85, 0x9e, 0x10, 0x38, 0x99, 0x47, 0x04, 0xce, 0xd4, 0x51, 0x02, 0x47,
-	0xdd, 0x80, 0xf9, 0x71, 0xc6, 0x65, 0x70, 0xfc, 0x1e, 0x4c, 0x89, 0x49, 0x89, 0x72, 0xe4, 0xa4,
-	0x79, 0x8b, 0x5c, 0xd4, 0x4f, 0x13, 0x30, 0x2f, 0xd3, 0xd7, 0xd7, 0xe3, 0x3b, 0x1e, 0xe1, 0x39,
-	0x7d, 0xa4, 0x0f, 0xf4, 0x68, 0xf3, 0xa7, 0x96, 0x61, 0xe1, 0x00, 0x8f, 0x8f, 0xf1, 0xb1, 0x7e,
-	0xa6, 0xc0, 0xf4, 0x3a, 0xed, 0x3a, 0xfd, 0x27, 0x74, 0x16, 0x46, 0xc8, 0x4d, 0x1d, 0x29, 0x88,
-	0x07, 0x50, 0x90, 0x78, 0x25, 0x5b, 0x87, 0xd9, 0x56, 0x26, 0x7d, 0x2d, 0x97, 0x60, 0x5a, 0x1e,
-	0xb3, 0x6d, 0xd7, 0xb1, 0x83, 0x18, 0xcf, 0x81, 0x73, 0xb6, 0xc6, 0x8c, 0x44, 0x9e, 0xc8, 0xb9,
-	0xa0, 0xfe, 0x58, 0x81, 0x42, 0xd9, 0xeb, 0xf5, 0x9c, 0xf0, 0x09, 0xe5, 0xf8, 0x30, 0x43, 0xa9,
-	0x49, 0xf1, 0x78, 0x0e, 0x8a, 0x11, 0x4c, 0x49, 0xed, 0x81, 0x95, 0x46, 0x39, 0xb4, 0xd2, 0xfc,
-	0x44, 0x81, 0x19, 0xe2, 0xb9, 0xee, 0x96, 0xdd, 0xde, 0x79, 0xba, 0xc9, 0x39, 0x0f, 0x68, 0x08,
-	0xf4, 0xa8, 0xf4, 0xfc, 0x52, 0x81, 0x62, 0xc3, 0xa7, 0x03, 0xdb, 0xa7, 0x4f, 0x35, 0x3b, 0x6c,
-	0x9b, 0xde, 0x09, 0xe5, 0x06, 0x27, 0x47, 0x78, 0x59, 0x9d, 0x85, 0x99, 0x18, 0xbb, 0x20, 0x4c,
-	0xfd, 0x9e, 0x02, 0x0b, 0x22, 0xc4, 0xa4, 0xa5, 0xf3, 0x84, 0xd2, 0x12, 0xe1, 0x4d, 0x8d, 0xe0,
-	0x2d, 0xc1, 0xf1, 0x83, 0xd8, 0x24, 0xec, 0x77, 0x13, 0x70, 0x22, 0x0a, 0x9e, 0x27, 0x1c, 0xf8,
-	0x97, 0x88, 0x87, 0x45, 0x28, 0x1d, 0x26, 0x41, 0x32, 0xf4, 0x41, 0x02, 0x4a, 0x65, 0x9f, 0xda,
-	0x21, 0x1d, 0xd9, 0x07, 0x3d, 0x3d, 0xb1, 0x81, 0xcf, 0xc1, 0xf4, 0xc0, 0xf6, 0x43, 0xa7, 0xed,
-	0x0c, 0x6c, 0x76, 0x14, 0x4d, 0xf3, 0x6d, 0xd6, 0x81, 0x06, 0xc6, 0x5c, 0xd4, 0x53, 0x70, 0x72,
-	0x02, 0x23, 0x92, 0xaf, 0x5f, 0x29, 0x80, 0x9b, 0xa1, 0xed, 0x87, 0x5f, 0x83, 0x75, 0x69, 0x62,
-	0x30, 0x2d, 0xc0, 0xdc, 0x18, 0xfe, 0x51, 0x5e, 0x68, 0xf8, 0xb5, 0x58, 0x92, 0x3e, 0x97, 0x97,
-	0x51, 0xfc, 0x92, 0x97, 0x1f, 0x28, 0xb0, 0x58, 0xf6, 0xc4, 0xe5, 0xe3, 0x53, 0xf9, 0x85, 0xa9,
-	0xcf, 0xc3, 0xa9, 0x89, 0x00, 0x25, 0x01, 0xdf, 0x57, 0xe0, 0x38, 0xa1, 0x76, 0xe7, 0xe9, 0x04,
-	0x7f, 0x1d, 0x4e, 0x1c, 0x02, 0x27, 0xf7, 0x28, 0x17, 0x21, 0xdb, 0xa3, 0xa1, 0xcd, 0x76, 0xb8,
-	0x12, 0xd2, 0x62, 0xd4, 0xee, 0xd0, 0xbb, 0x26, 0x3d, 0x48, 0xec, 0xab, 0xfe, 0x30, 0x01, 0x73,
-	0x7c, 0x9f, 0xfd, 0xec, 0x90, 0x77, 0xa4, 0x5b, 0x98, 0xcc, 0xc1, 0xcd, 0x1f, 0x73, 0x18, 0xf8,
-	0xd4, 0x8a, 0x6e, 0x07, 0xa6, 0xf8, 0x3f, 0x36, 0x18, 0xf8, 0xf4, 0xba, 0xd0, 0xa8, 0xdf, 0x52,
-	0x60, 0x7e, 0x9c, 0xe2, 0xf8, 0x44, 0xf3, 0x9b, 0xbe, 0x6d, 0x99, 0x90, 0x52, 0x92, 0x47, 0x39,
-	0x24, 0xa5, 0x8e, 0x7c, 0x48, 0xfa, 0x76, 0x02, 0x4a, 0xa3, 0x60, 0x9e, 0xdd, 0xe9, 0x8c, 0xdf,
-	0xe9, 0x7c, 0xd1, 0x5b, 0x3e, 0xf5, 0x3b, 0x0a, 0x9c, 0x9c, 0x40, 0xe8, 0x17, 0x0b, 0x91, 0x91,
-	0x9b, 0x9d, 0xc4, 0x23, 0x6f, 0x76, 0xbe, 0xfa, 0x20, 0xf9, 0xae, 0x02, 0xf3, 0x35, 0x71, 0x57,
-	0x2f, 0x6e, 0x3e, 0x9e, 0xdc, 0x1c, 0xcc, 0xaf, 0xe3, 0x53, 0xc3, 0x9f, 0x51, 0x6a, 0x19, 0x16,
-	0x0e, 0x40, 0x7b, 0x8c, 0xdb, 0x9c, 0x5f, 0x28, 0x30, 0x2b, 0x5b, 0xd1, 0x9e, 0xd8, 0xed, 0xcb,
-	0x04, 0x76, 0xf0, 0x0b, 0x90, 0x74, 0x3a, 0xd1, 0xbe, 0x77, 0xfc, 0x5f, 0x3b, 0x33, 0xa8, 0x97,
-	0x01, 0x8f, 0xe2, 0x7e, 0x0c, 0xea, 0x7e, 0x9a, 0x80, 0x05, 0x22, 0xb2, 0xef, 0xb3, 0xff, 0x0b,
-	0x5f, 0xf6, 0xff, 0xc2, 0xc3, 0x17, 0xae, 0x4f, 0xf9, 0x66, 0x6a, 0x9c, 0xea, 0xaf, 0x6e, 0xe9,
-	0x3a, 0xb0, 0xd0, 0x26, 0x0f, 0x2d, 0xb4, 0x8f, 0x9f, 0x8f, 0x3e, 0x4d, 0xc0, 0xa2, 0x04, 0xf2,
-	0x6c, 0xaf, 0x73, 0xf4, 0x88, 0xc8, 0x1c, 0x8a, 0x88, 0x9f, 0x2b, 0x70, 0x6a, 0x22, 0x91, 0xbf,
-	0xf5, 0x1d, 0xcd, 0x81, 0xe8, 0x49, 0x3d, 0x32, 0x7a, 0xd2, 0x47, 0x8e, 0x9e, 0xf7, 0x13, 0x50,
-	0x24, 0xd4, 0xa5, 0x76, 0xf0, 0x94, 0xdf, 0xee, 0x1d, 0xe0, 0x30, 0x7d, 0xe8, 0x9e, 0x73, 0x16,
-	0x66, 0x62, 0x22, 0xe4, 0x81, 0x8b, 0x1f, 0xd0, 0xd9, 0x3a, 0xf8, 0x36, 0xb5, 0xdd, 0x30, 0xda,
-	0x09, 0xaa, 0xff, 0x93, 0x80, 0x02, 0x61, 0x1a, 0xa7, 0x47, 0x9b, 0xa1, 0x1d, 0x06, 0xf8, 0x25,
-	0x98, 0xde, 0xe6, 0x2e, 0xd6, 0x30, 0x42, 0x72, 0x24, 0x2f, 0x74, 0xe2, 0xef, 0xe3, 0x1a, 0x2c,
-	0x04, 0xb4, 0xed, 0xf5, 0x3b, 0x81, 0xb5, 0x45, 0xb7, 0x9d, 0x7e, 0xc7, 0xea, 0xd9, 0x41, 0x48,
-	0x7d, 0x4e, 0x4b, 0x81, 0xcc, 0x49, 0xe3, 0x3a, 0xb7, 0xd5, 0xb8, 0x09, 0x9f, 0x85, 0xf9, 0x2d,
-	0xa7, 0xef, 0x7a, 0x5d, 0x6b, 0xe0, 0xda, 0xfb, 0xd4, 0x0f, 0xac, 0xb6, 0xb7, 0xdb, 0x17, 0x7c,
-	0xa4, 0x09, 0x16, 0xb6, 0x86, 0x30, 0x95, 0x99, 0x05, 0xdf, 0x86, 0x95, 0x89, 0xbd, 0x58, 0x77,
-	0x1c, 0x37, 0xa4, 0x3e, 0xed, 0x58, 0x3e, 0x1d, 0xb8, 0x4e, 0x5b, 0xbc, 0x23, 0x12, 0x44, 0xbd,
-	0x3a, 0xa1, 0xeb, 0x4d, 0xe9, 0x4e, 0x86, 0xde, 0xf8, 0x14, 0xe4, 0xda, 0x83, 0x5d, 0x6b, 0x97,
-	0x3f, 0x5a, 0x60, 0xfc, 0x29, 0x24, 0xdb, 0x1e, 0xec, 0xb6, 0x98, 0x8c, 0x11, 0x24, 0xef, 0x0e,
-	0x44, 0x72, 0x56, 0x08, 0x2b, 0xaa, 0x9f, 0x29, 0x50, 0xd4, 0xba, 0x5d, 0x9f, 0x76, 0xed, 0x50,
-	0xd2, 0x74, 0x16, 0xe6, 0x05, 0x25, 0xfb, 0x96, 0x0c, 0x57, 0x81, 0x47, 0x11, 0x78, 0xa4, 0x4d,
-	0xc4, 0xaa, 0xc0, 0x73, 0x01, 0x8e, 0xef, 0xf6, 0x27, 0xd6, 0x49, 0xf0, 0x3a, 0xf3, 0xb1, 0x75,
-	0xb4, 0xd6, 0x1f, 0xc2, 0xc9, 0xc9, 0x2c, 0xf4, 0x1c, 0xf1, 0x96, 0xaf, 0x40, 0x8e, 0x4f, 0x00,
-	0x5d, 0x73, 0xfa, 0x0f, 0xa9, 0x6a, 0xdf, 0xe7, 0x7c, 0x7d, 0x4e, 0x55, 0xfb, 0xbe, 0xfa, 0x7f,
-	0xf1, 0x3f, 0xc5, 0x28, 0x5c, 0xe2, 0xc4, 0x11, 0x05, 0xb2, 0xf2, 0xb0, 0x40, 0x2e, 0xc1, 0x14,
-	0x0b, 0x46, 0xa7, 0xdf, 0xe5, 0xe0, 0xb2, 0x24, 0x12, 0x71, 0x13, 0x5e, 0x95, 0xd8, 0xe9, 0xfd,
-	0x90, 0xfa, 0x7d, 0xdb, 0x75, 0xf7, 0x2d, 0x71, 0xfd, 0xd8, 0x0f, 0x69, 0xc7, 0x1a, 0xbe, 0x6d,
-	0x14, 0xe9, 0xe3, 0x65, 0xe1, 0xad, 0xc7, 0xce, 0x24, 0xf6, 0x35, 0xe3, 0x57, 0x8f, 0x6f, 0x42,
-	0xd1, 0x97, 0x41, 0x6c, 0x05, 0x6c, 0x7a, 0x64, 0xca, 0x9d, 0x8f, 0x5e, 0x4d, 0x8c, 0x46, 0x38,
-	0x29, 0xf8, 0x63, 0x01, 0xff, 0xd8, 0x09, 0xe7, 0x6a, 0x2a, 0x9b, 0x41, 0x53, 0xea, 0xff, 0x2b,
-	0x30, 0x37, 0xe1, 0xec, 0x1e, 0x5f, 0x0c, 0x28, 0x23, 0xf7, 0x8e, 0xbf, 0x0f, 0x69, 0xfe, 0xa0,
-	0x45, 0x3e, 0x91, 0x3a, 0x71, 0xf8, 0xe8, 0xcf, 0x1f, 0x9f, 0x10, 0xe1, 0xc5, 0xbe, 0x45, 0x8e,
-	0xa9, 0xcd, 0x2f, 0x1e, 0xa3, 0x8c, 0x9a, 0x67, 0x3a, 0x71, 0x17, 0x79, 0xf8, 0x26, 0x33, 0xf5,
-	0xc8, 0x9b, 0xcc, 0x95, 0x7f, 0x4e, 0x42, 0xae, 0xb6, 0xdf, 0xbc, 0xeb, 0x6e, 0xba, 0x76, 0x97,
-	0xbf, 0x0e, 0xa9, 0x35, 0xcc, 0x5b, 0xe8, 0x18, 0x9e, 0x85, 0x82, 0x51, 0x37, 0x2d, 0xa3, 0x55,
-	0xad, 0x5a, 0x9b, 0x55, 0xed, 0x0a, 0x52, 0x30, 0x82, 0xe9, 0x06, 0xa9, 0x58, 0xd7, 0xf4, 0x5b,
-	0x42, 0x93, 0xc0, 0x73, 0x30, 0xd3, 0x32, 0x2a, 0xd7, 0x5b, 0xfa, 0x50, 0x99, 0xc2, 0x0b, 0x30,
-	0x5b, 0x6b, 0x55, 0xcd, 0x4a, 0xa3, 0x3a, 0xa2, 0xce, 0xe2, 0x02, 0xe4, 0xd6, 0xab, 0xf5, 0x75,
-	0x21, 0x22, 0xd6, 0x7e, 0xcb, 0x68, 0x56, 0xae, 0x18, 0xfa, 0x86, 0x50, 0x2d, 0x31, 0xd5, 0x6d,
-	0x9d, 0xd4, 0x37, 0x2b, 0x51, 0x97, 0x97, 0x31, 0x82, 0xfc, 0x7a, 0xc5, 0xd0, 0x88, 0x6c, 0xe5,
-	0x81, 0x82, 0x8b, 0x90, 0xd3, 0x8d, 0x56, 0x4d, 0xca, 0x09, 0x5c, 0x82, 0x39, 0xad, 0x65, 0xd6,
-	0xad, 0x8a, 0x51, 0x26, 0x7a, 0x4d, 0x37, 0x4c, 0x69, 0x49, 0xe1, 0x39, 0x28, 0x9a, 0x95, 0x9a,
-	0xde, 0x34, 0xb5, 0x5a, 0x43, 0x2a, 0xd9, 0x28, 0xb2, 0x4d, 0x3d, 0xf2, 0x41, 0x78, 0x11, 0x16,
-	0x8c, 0xba, 0x25, 0x5f, 0xda, 0x59, 0x37, 0xb4, 0x6a, 0x4b, 0x97, 0xb6, 0x25, 0x7c, 0x02, 0x70,
-	0xdd, 0xb0, 0x5a, 0x8d, 0x0d, 0xcd, 0xd4, 0x2d, 0xa3, 0x7e, 0x53, 0x1a, 0x2e, 0xe3, 0x22, 0x64,
-	0x87, 0x23, 0x78, 0xc0, 0x58, 0x28, 0x34, 0x34, 0x62, 0x0e, 0xc1, 0x3e, 0x78, 0xc0, 0xc8, 0x82,
-	0x2b, 0xa4, 0xde, 0x6a, 0x0c, 0xdd, 0x66, 0x21, 0x2f, 0xc9, 0x92, 0xaa, 0x14, 0x53, 0xad, 0x57,
-	0x8c, 0x72, 0x3c, 0xbe, 0x07, 0xd9, 0xc5, 0x04, 0x52, 0x56, 0x76, 0x20, 0xc5, 0xa7, 0x23, 0x0b,
-	0x29, 0xa3, 0x6e, 0xe8, 0xe8, 0x18, 0x9e, 0x01, 0xa8, 0x34, 0x2b, 0x86, 0xa9, 0x5f, 0x21, 0x5a,
-	0x95, 0xc1, 0xe6, 0x8a, 0x88, 0x40, 0x86, 0x76, 0x1a, 0xa6, 0x2a, 0xcd, 0xcd, 0x6a, 0x5d, 0x33,
-	0x25, 0xcc, 0x4a, 0xf3, 0x7a, 0xab, 0x6e, 0x32, 0x23, 0xc2, 0x79, 0xc8, 0x54, 0x9a, 0xa6, 0xfe,
-	0x8e, 0xc9, 0x70, 0x71, 0x9b, 0x60, 0x15, 0x3d, 0xb8, 0xbc, 0xf2, 0x51, 0x12, 0x52, 0xfc, 0xd1,
-	0x72, 0x01, 0x72, 0x7c, 0xb6, 0xcd, 0x5b, 0x0d, 0xd6, 0x65, 0x0e, 0x52, 0x15, 0xc3, 0xbc, 0x84,
-	0xfe, 0x2c, 0x81, 0x01, 0xd2, 0x2d, 0x5e, 0xfe, 0xf3, 0x0c, 0x2b, 0x57, 0x0c, 0xf3, 0xdc, 0x45,
-	0xf4, 0x6e, 0x82, 0x35, 0xdb, 0x12, 0xc2, 0x5f, 0x44, 0x86, 0xb5, 0x0b, 0xe8, 0xbd, 0xd8, 0xb0,
-	0x76, 0x01, 0xfd, 0x65, 0x64, 0x38, 0xbf, 0x86, 0xde, 0x8f, 0x0d, 0xe7, 0xd7, 0xd0, 0x5f, 0x45,
-	0x86, 0x8b, 0x17, 0xd0, 0x5f, 0xc7, 0x86, 0x8b, 0x17, 0xd0, 0xdf, 0x64, 0x18, 0x16, 0x8e, 0xe4,
-	0xfc, 0x1a, 0xfa, 0xdb, 0x6c, 0x2c, 0x5d, 0xbc, 0x80, 0xfe, 0x2e, 0xcb, 0xe6, 0x3f, 0x9e, 0x55,
-	0xf4, 0xf7, 0x88, 0x0d, 0x93, 0x4d, 0x10, 0xfa, 0x07, 0x5e, 0x64, 0x26, 0xf4, 0x8f, 0x88, 0x61,
-	0x64, 0x5a, 0x2e, 0x7e, 0xc0, 0x2d, 0xb7, 0x74, 0x8d, 0xa0, 0x7f, 0xca, 0x88, 0x87, 0x95, 0xe5,
-	0x4a, 0x4d, 0xab, 0x22, 0xcc, 0x6b, 0x30, 0x56, 0xfe, 0xe5, 0x2c, 0x2b, 0xb2, 0xf0, 0x44, 0xff,
-	0xda, 0x60, 0x1d, 0xde, 0xd0, 0x48, 0xf9, 0x6d, 0x8d, 0xa0, 0x7f, 0x3b, 0xcb, 0x3a, 0xbc, 0xa1,
-	0x11, 0xc9, 0xd7, 0xbf, 0x37, 0x98, 0x23, 0x37, 0x7d, 0x78, 0x96, 0x0d, 0x5a, 0xea, 0xff, 0xa3,
-	0x81, 0xb3, 0x90, 0x5c, 0xaf, 0x98, 0xe8, 0x23, 0xde, 0x1b, 0x0b, 0x51, 0xf4, 0x9f, 0x88, 0x29,
-	0x9b, 0xba, 0x89, 0x3e, 0x66, 0xca, 0xb4, 0xd9, 0x6a, 0x54, 0x75, 0xf4, 0x1c, 0x1b, 0xdc, 0x15,
-	0xbd, 0x5e, 0xd3, 0x4d, 0x72, 0x0b, 0xfd, 0x17, 0x77, 0xbf, 0xda, 0xac, 0x1b, 0xe8, 0x13, 0x84,
-	0x8b, 0x00, 0xfa, 0x3b, 0x0d, 0xa2, 0x37, 0x9b, 0x95, 0xba, 0x81, 0x5e, 0x5c, 0xd9, 0x04, 0x74,
-	0x30, 0x1d, 0x30, 0x00, 0x2d, 0xe3, 0x9a, 0x51, 0xbf, 0x69, 0xa0, 0x63, 0x4c, 0x68, 0x10, 0xbd,
-	0xa1, 0x11, 0x1d, 0x29, 0x18, 0x20, 0x23, 0x9f, 0x6b, 0x26, 0xf0, 0x34, 0x64, 0x49, 0xbd, 0x5a,
-	0x5d, 0xd7, 0xca, 0xd7, 0x50, 0x72, 0xfd, 0x75, 0x98, 0x71, 0xbc, 0xd5, 0x3d, 0x27, 0xa4, 0x41,
-	0x20, 0x9e, 0xc5, 0xdf, 0x56, 0xa5, 0xe4, 0x78, 0x67, 0x44, 0xe9, 0x4c, 0xd7, 0x3b, 0xb3, 0x17,
-	0x9e, 0xe1, 0xd6, 0x33, 0x3c, 0x63, 0x6c, 0x65, 0xb8, 0x70, 0xfe, 0xd7, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc2, 0xa3, 0x7f, 0x77, 0x74, 0x2f, 0x00, 0x00,
+	// 3317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x73, 0x1b, 0x47,
+	0x73, 0xd7, 0xe2, 0x45, 0xa0, 0x41, 0x80, 0xc3, 0x21, 0x29, 0x41, 0x94, 0x6c, 0xd3, 0x6b, 0xcb,
+	0x66, 0xe4, 0x98, 0x92, 0x28, 0x59, 0x51, 0x6c, 0xc7, 0xd1, 0x12, 0x5c, 0xca, 0x90, 0xf0, 0xd2,
+	0x60, 0x21, 0x59, 0x2a, 0x57, 0x6d, 0x2d, 0x81, 0x11, 0xb8, 0xc5, 0x05, 0x16, 0xda, 0x5d, 0x90,
+	0xc2, 0x4d, 0x89, 0xe3, 0x38, 0xef, 0x38, 0x4f, 0xc7, 0x49, 0xc5, 0x95, 0x5b, 0x2a, 0x97, 0xfc,
+	0x11, 0x39, 0xb8, 0x52, 0x39, 0xa4, 0x2a, 0xc7, 0x24, 0x87, 0x38, 0x87, 0x38, 0x39, 0xb9, 0x52,
+	0x39, 0xe4, 0x90, 0x43, 0xea, 0xab, 0x79, 0xec, 0x02, 0x20, 0x61, 0x89, 0x96, 0x3f, 0xd7, 0x57,
+	0x92, 0x75, 0x9b, 0xe9, 0xee, 0x79, 0xf4, 0x6f, 0x7a, 0xba, 0x07, 0xbd, 0x0d, 0xc8, 0xde, 0x1f,
+	0x50, 0x6f, 0xb8, 0xd6, 0xf7, 0xdc, 0xc0, 0xc5, 0x49, 0xde, 0x59, 0xce, 0x07, 0x6e, 0xdf, 0x6d,
+	0x5b, 0x81, 0x25, 0xc8, 0xcb, 0xd9, 0xbd, 0xc0, 0xeb, 0xb7, 0x44, 0x47, 0xfd, 0x58, 0x81, 0x94,
+	0x61, 0x79, 0x1d, 0x1a, 0xe0, 0x65, 0x48, 0xef, 0xd2, 0xa1, 0xdf, 0xb7, 0x5a, 0xb4, 0xa0, 0xac,
+	0x28, 0xab, 0x19, 0x12, 0xf5, 0xf1, 0x22, 0x24, 0xfd, 0x1d, 0xcb, 0x6b, 0x17, 0x62, 0x9c, 0x21,
+	0x3a, 0xf8, 0x2d, 0xc8, 0x06, 0xd6, 0xb6, 0x43, 0x03, 0x33, 0x18, 0xf6, 0x69, 0x21, 0xbe, 0xa2,
+	0xac, 0xe6, 0xd7, 0x17, 0xd7, 0xa2, 0xf5, 0x0c, 0xce, 0x34, 0x86, 0x7d, 0x4a, 0x20, 0x88, 0xda,
+	0x18, 0x43, 0xa2, 0x45, 0x1d, 0xa7, 0x90, 0xe0, 0x73, 0xf1, 0xb6, 0xba, 0x09, 0xf9, 0x5b, 0xc6,
+	0x35, 0x2b, 0xa0, 0x45, 0xcb, 0x71, 0xa8, 0x57, 0xda, 0x64, 0xdb, 0x19, 0xf8, 0xd4, 0xeb, 0x59,
+	0xdd, 0x68, 0x3b, 0x61, 0x1f, 0x1f, 0x87, 0x54, 0xc7, 0x73, 0x07, 0x7d, 0xbf, 0x10, 0x5b, 0x89,
+	0xaf, 0x66, 0x88, 0xec, 0xa9, 0x1f, 0x02, 0xe8, 0x7b, 0xb4, 0x17, 0x18, 0xee, 0x2e, 0xed, 0xe1,
+	0xd3, 0x90, 0x09, 0xec, 0x2e, 0xf5, 0x03, 0xab, 0xdb, 0xe7, 0x53, 0xc4, 0xc9, 0x88, 0xf0, 0x2d,
+	0x2a, 0x2d, 0x43, 0xba, 0xef, 0xfa, 0x76, 0x60, 0xbb, 0x3d, 0xae, 0x4f, 0x86, 0x44, 0x7d, 0xf5,
+	0x3d, 0x48, 0xde, 0xb2, 0x9c, 0x01, 0xc5, 0x2f, 0x41, 0x82, 0x2b, 0xac, 0x70, 0x85, 0xb3, 0x6b,
+	0x02, 0x74, 0xae, 0x27, 0x67, 0xb0, 0xb9, 0xf7, 0x98, 0x24, 0x9f, 0x7b, 0x96, 0x88, 0x8e, 0xba,
+	0x0b, 0xb3, 0x1b, 0x76, 0xaf, 0x7d, 0xcb, 0xf2, 0x6c, 0x06, 0xc6, 0x13, 0x4e, 0x83, 0x5f, 0x85,
+	0x14, 0x6f, 0xf8, 0x85, 0xf8, 0x4a, 0x7c, 0x35, 0xbb, 0x3e, 0x2b, 0x07, 0xf2, 0xbd, 0x11, 0xc9,
+	0x53, 0xff, 0x4e, 0x01, 0xd8, 0x70, 0x07, 0xbd, 0xf6, 0x4d, 0xc6, 0xc4, 0x08, 0xe2, 0xfe, 0x7d,
+	0x47, 0x02, 0xc9, 0x9a, 0xf8, 0x06, 0xe4, 0xb7, 0xed, 0x5e, 0xdb, 0xdc, 0x93, 0xdb, 0x11, 0x58,
+	0x66, 0xd7, 0x5f, 0x95, 0xd3, 0x8d, 0x06, 0xaf, 0x8d, 0xef, 0xda, 0xd7, 0x7b, 0x81, 0x37, 0x24,
+	0xb9, 0xed, 0x71, 0xda, 0x72, 0x13, 0xf0, 0x61, 0x21, 0xb6, 0xe8, 0x2e, 0x1d, 0x86, 0x8b, 0xee,
+	0xd2, 0x21, 0xfe, 0xb9, 0x71, 0x8d, 0xb2, 0xeb, 0x0b, 0xe1, 0x5a, 0x63, 0x63, 0xa5, 0x9a, 0x6f,
+	0xc7, 0xae, 0x28, 0xea, 0x57, 0x29, 0xc8, 0xeb, 0x0f, 0x68, 0x6b, 0x10, 0xd0, 0x5a, 0x9f, 0x9d,
+	0x81, 0x8f, 0x2b, 0x30, 0x67, 0xf7, 0x5a, 0xce, 0xa0, 0x4d, 0xdb, 0xe6, 0x3d, 0x9b, 0x3a, 0x6d,
+	0x9f, 0xdb, 0x51, 0x3e, 0xda, 0xf7, 0xa4, 0xfc, 0x5a, 0x49, 0x0a, 0x6f, 0x71, 0x59, 0x92, 0xb7,
+	0x27, 0xfa, 0xf8, 0x2c, 0xcc, 0xb7, 0x1c, 0x9b, 0xf6, 0x02, 0xf3, 0x1e, 0xd3, 0xd7, 0xf4, 0xdc,
+	0x7d, 0xbf, 0x90, 0x5c, 0x51, 0x56, 0xd3, 0x64, 0x4e, 0x30, 0xb6, 0x18, 0x9d, 0xb8, 0xfb, 0x3e,
+	0x7e, 0x1b, 0xd2, 0xfb, 0xae, 0xb7, 0xeb, 0xb8, 0x56, 0xbb, 0x90, 0xe2, 0x6b, 0xbe, 0x38, 0x7d,
+	0xcd, 0xdb, 0x52, 0x8a, 0x44, 0xf2, 0x78, 0x15, 0x90, 0x7f, 0xdf, 0x31, 0x7d, 0xea, 0xd0, 0x56,
+	0x60, 0x3a, 0x76, 0xd7, 0x0e, 0x0a, 0x69, 0x6e, 0x92, 0x79, 0xff, 0xbe, 0xd3, 0xe0, 0xe4, 0x32,
+	0xa3, 0x62, 0x13, 0x96, 0x02, 0xcf, 0xea, 0xf9, 0x56, 0x8b, 0x4d, 0x66, 0xda, 0xbe, 0xeb, 0x58,
+	0xac, 0x55, 0xc8, 0xf0, 0x25, 0xcf, 0x4e, 0x5f, 0xd2, 0x18, 0x0d, 0x29, 0x85, 0x23, 0xc8, 0x62,
+	0x30, 0x85, 0x8a, 0x2f, 0xc0, 0x92, 0xbf, 0x6b, 0xf7, 0x4d, 0x3e, 0x8f, 0xd9, 0x77, 0xac, 0x9e,
+	0xd9, 0xb2, 0x5a, 0x3b, 0xb4, 0x00, 0x5c, 0x6d, 0xcc, 0x98, 0xfc, 0xdc, 0xeb, 0x8e, 0xd5, 0x2b,
+	0x32, 0x0e, 0x43, 0x69, 0xdf, 0xb2, 0x19, 0x46, 0x9e, 0x19, 0x5d, 0x8f, 0x2c, 0x3f, 0xd6, 0x39,
+	0xc6, 0xd8, 0x72, 0xbd, 0xba, 0x24, 0xe3, 0xf7, 0xe0, 0xf4, 0x21, 0x59, 0x93, 0x5d, 0x3b, 0x77,
+	0x10, 0x98, 0x5d, 0xbf, 0x30, 0xcb, 0xb5, 0x2e, 0x1c, 0x18, 0x66, 0x08, 0x81, 0x0a, 0x43, 0xf9,
+	0xa4, 0x3c, 0x23, 0x93, 0x0a, 0x1d, 0xdb, 0xa3, 0x35, 0x73, 0x7c, 0x8b, 0x27, 0xa4, 0x80, 0xc4,
+	0xa0, 0x1d, 0x4e, 0xa2, 0xbe, 0x03, 0xf9, 0xc9, 0xf3, 0xc6, 0xf3, 0x90, 0x33, 0xee, 0xd4, 0x75,
+	0x53, 0xab, 0x6e, 0x9a, 0x55, 0xad, 0xa2, 0xa3, 0x63, 0x38, 0x07, 0x19, 0x4e, 0xaa, 0x55, 0xcb,
+	0x77, 0x90, 0x82, 0x67, 0x20, 0xae, 0x95, 0xcb, 0x28, 0xa6, 0x5e, 0x81, 0x74, 0x78, 0x70, 0x78,
+	0x0e, 0xb2, 0xcd, 0x6a, 0xa3, 0xae, 0x17, 0x4b, 0x5b, 0x25, 0x7d, 0x13, 0x1d, 0xc3, 0x69, 0x48,
+	0xd4, 0xca, 0x46, 0x1d, 0x29, 0xa2, 0xa5, 0xd5, 0x51, 0x8c, 0x8d, 0xdc, 0xdc, 0xd0, 0x50, 0x5c,
+	0xfd, 0x6b, 0x05, 0x16, 0xa7, 0x1d, 0x00, 0xce, 0xc2, 0xcc, 0xa6, 0xbe, 0xa5, 0x35, 0xcb, 0x06,
+	0x3a, 0x86, 0x17, 0x60, 0x8e, 0xe8, 0x75, 0x5d, 0x33, 0xb4, 0x8d, 0xb2, 0x6e, 0x12, 0x5d, 0xdb,
+	0x44, 0x0a, 0xc6, 0x90, 0x67, 0x2d, 0xb3, 0x58, 0xab, 0x54, 0x4a, 0x86, 0xa1, 0x6f, 0xa2, 0x18,
+	0x5e, 0x04, 0xc4, 0x69, 0xcd, 0xea, 0x88, 0x1a, 0xc7, 0x08, 0x66, 0x1b, 0x3a, 0x29, 0x69, 0xe5,
+	0xd2, 0x5d, 0x36, 0x01, 0x4a, 0xe0, 0x97, 0xe1, 0x85, 0x62, 0xad, 0xda, 0x28, 0x35, 0x0c, 0xbd,
+	0x6a, 0x98, 0x8d, 0xaa, 0x56, 0x6f, 0xbc, 0x5f, 0x33, 0xf8, 0xcc, 0x42, 0xb9, 0x24, 0xce, 0x03,
+	0x68, 0x4d, 0xa3, 0x26, 0xe6, 0x41, 0xa9, 0xeb, 0x89, 0xb4, 0x82, 0x62, 0xd7, 0x13, 0xe9, 0x18,
+	0x8a, 0x5f, 0x4f, 0xa4, 0xe3, 0x28, 0xa1, 0x7e, 0x16, 0x83, 0x24, 0xc7, 0x8a, 0xb9, 0xe5, 0x31,
+	0x67, 0xcb, 0xdb, 0x91, 0x8b, 0x8a, 0x3d, 0xc2, 0x45, 0x71, 0xcf, 0x2e, 0x9d, 0xa5, 0xe8, 0xe0,
+	0x53, 0x90, 0x71, 0xbd, 0x8e, 0x29, 0x38, 0xc2, 0xcd, 0xa7, 0x5d, 0xaf, 0xc3, 0xe3, 0x01, 0x73,
+	0xb1, 0x2c, 0x3a, 0x6c, 0x5b, 0x3e, 0xe5, 0x37, 0x2d, 0x43, 0xa2, 0x3e, 0x3e, 0x09, 0x4c, 0xce,
+	0xe4, 0xfb, 0x48, 0x71, 0xde, 0x8c, 0xeb, 0x75, 0xaa, 0x6c, 0x2b, 0xaf, 0x40, 0xae, 0xe5, 0x3a,
+	0x83, 0x6e, 0xcf, 0x74, 0x68, 0xaf, 0x13, 0xec, 0x14, 0x66, 0x56, 0x94, 0xd5, 0x1c, 0x99, 0x15,
+	0xc4, 0x32, 0xa7, 0xe1, 0x02, 0xcc, 0xb4, 0x76, 0x2c, 0xcf, 0xa7, 0xe2, 0x76, 0xe5, 0x48, 0xd8,
+	0xe5, 0xab, 0xd2, 0x96, 0xdd, 0xb5, 0x1c, 0x9f, 0xdf, 0xa4, 0x1c, 0x89, 0xfa, 0x4c, 0x89, 0x7b,
+	0x8e, 0xd5, 0xf1, 0xf9, 0x0d, 0xc8, 0x11, 0xd1, 0x51, 0x7f, 0x01, 0xe2, 0xc4, 0xdd, 0x67, 0x53,
+	0x8a, 0x05, 0xfd, 0x82, 0xb2, 0x12, 0x5f, 0xc5, 0x24, 0xec, 0xb2, 0x28, 0x24, 0x1d, 0xb1, 0xf0,
+	0xcf, 0xa1, 0xeb, 0xfd, 0x7b, 0x05, 0xb2, 0xfc, 0x02, 0x11, 0xea, 0x0f, 0x9c, 0x80, 0x39, 0x6c,
+	0xe9, 0xa9, 0x94, 0x09, 0x87, 0xcd, 0x61, 0x27, 0x92, 0xc7, 0xf4, 0x63, 0xce, 0xc7, 0xb4, 0xee,
+	0xdd, 0xa3, 0xad, 0x80, 0x8a, 0xb8, 0x94, 0x20, 0xb3, 0x8c, 0xa8, 0x49, 0x1a, 0x03, 0xd6, 0xee,
+	0xf9, 0xd4, 0x0b, 0x4c, 0xbb, 0xcd, 0x21, 0x4f, 0x90, 0xb4, 0x20, 0x94, 0xda, 0xf8, 0x45, 0x48,
+	0x70, 0xf7, 0x95, 0xe0, 0xab, 0x80, 0x5c, 0x85, 0xb8, 0xfb, 0x84, 0xd3, 0xf1, 0x1b, 0x30, 0x7f,
+	0xf8, 0x46, 0x09, 0x94, 0x11, 0x3d, 0x70, 0x95, 0xae, 0x27, 0xd2, 0x49, 0x94, 0x52, 0xdf, 0x85,
+	0x59, 0xae, 0xc9, 0x6d, 0xcb, 0xeb, 0xd9, 0xbd, 0x0e, 0x0f, 0xdd, 0x6e, 0x5b, 0xd8, 0x48, 0x8e,
+	0xf0, 0x36, 0x03, 0xa8, 0x4b, 0x7d, 0xdf, 0xea, 0x50, 0x19, 0x4a, 0xc3, 0xae, 0xfa, 0x57, 0x71,
+	0xc8, 0x36, 0x02, 0x8f, 0x5a, 0x5d, 0x1e, 0x95, 0xf1, 0xbb, 0x00, 0x7e, 0x60, 0x05, 0xb4, 0x4b,
+	0x7b, 0x41, 0x08, 0xc6, 0x69, 0xb9, 0xcd, 0x31, 0xb9, 0xb5, 0x46, 0x28, 0x44, 0xc6, 0xe4, 0xf1,
+	0x3a, 0x64, 0x29, 0x63, 0x9b, 0x01, 0x8b, 0xee, 0x32, 0x82, 0xcc, 0x87, 0xee, 0x30, 0x0a, 0xfb,
+	0x04, 0x68, 0xd4, 0x5e, 0xfe, 0x22, 0x06, 0x99, 0x68, 0x36, 0xac, 0x41, 0xba, 0x65, 0x05, 0xb4,
+	0xe3, 0x7a, 0x43, 0x19, 0x74, 0xcf, 0x3c, 0x6a, 0xf5, 0xb5, 0xa2, 0x14, 0x26, 0xd1, 0x30, 0xfc,
+	0x02, 0x88, 0x97, 0x8c, 0x30, 0x51, 0xa1, 0x6f, 0x86, 0x53, 0xb8, 0x91, 0xbe, 0x0d, 0xb8, 0xef,
+	0xd9, 0x5d, 0xcb, 0x1b, 0x9a, 0xbb, 0x74, 0x18, 0x06, 0xa8, 0xf8, 0x94, 0x63, 0x47, 0x52, 0xee,
+	0x06, 0x1d, 0x4a, 0x57, 0x75, 0x65, 0x72, 0xac, 0x34, 0xad, 0xc3, 0x87, 0x39, 0x36, 0x92, 0x87,
+	0x7c, 0x3f, 0x0c, 0xee, 0x49, 0x6e, 0x85, 0xac, 0xa9, 0xbe, 0x0e, 0xe9, 0x70, 0xf3, 0x38, 0x03,
+	0x49, 0xdd, 0xf3, 0x5c, 0x0f, 0x1d, 0xe3, 0x1e, 0xab, 0x52, 0x16, 0x4e, 0x6f, 0x73, 0x93, 0x39,
+	0xbd, 0x7f, 0x8f, 0x45, 0x11, 0x96, 0xd0, 0xfb, 0x03, 0xea, 0x07, 0xf8, 0x97, 0x61, 0x81, 0x72,
+	0x7b, 0xb3, 0xf7, 0xa8, 0xd9, 0xe2, 0xcf, 0x31, 0x66, 0x6d, 0x0a, 0xc7, 0x7b, 0x6e, 0x4d, 0xbc,
+	0x1e, 0xc3, 0x67, 0x1a, 0x99, 0x8f, 0x64, 0x25, 0xa9, 0x8d, 0x75, 0x58, 0xb0, 0xbb, 0x5d, 0xda,
+	0xb6, 0xad, 0x60, 0x7c, 0x02, 0x71, 0x60, 0x4b, 0xe1, 0x6b, 0x65, 0xe2, 0xb5, 0x47, 0xe6, 0xa3,
+	0x11, 0xd1, 0x34, 0x67, 0x20, 0x15, 0xf0, 0x97, 0x29, 0x37, 0xf4, 0xec, 0x7a, 0x2e, 0xf4, 0x3e,
+	0x9c, 0x48, 0x24, 0x13, 0xbf, 0x0e, 0xe2, 0x9d, 0xcb, 0xfd, 0xcc, 0xc8, 0x20, 0x46, 0xcf, 0x17,
+	0x22, 0xf8, 0xf8, 0x0c, 0xe4, 0x27, 0x02, 0x6b, 0x9b, 0x03, 0x16, 0x27, 0xb9, 0x31, 0x6a, 0xa9,
+	0x8d, 0xcf, 0xc1, 0x8c, 0x2b, 0x82, 0x6a, 0x21, 0x35, 0xb1, 0xe3, 0xc9, 0x88, 0x4b, 0x42, 0x29,
+	0xfc, 0x12, 0x64, 0x3d, 0xea, 0x53, 0x6f, 0x8f, 0xb6, 0xd9, 0xa4, 0x33, 0x7c, 0x52, 0x08, 0x49,
+	0xa5, 0xb6, 0xfa, 0x4b, 0x30, 0x17, 0x41, 0xec, 0xf7, 0xdd, 0x9e, 0xcf, 0x02, 0x6a, 0xca, 0xe3,
+	0xce, 0x41, 0xc2, 0x8a, 0xe5, 0x1a, 0x63, 0x6e, 0x83, 0x48, 0x09, 0xb5, 0x0d, 0x73, 0x82, 0x72,
+	0xdb, 0x0e, 0x76, 0xf8, 0x49, 0xe2, 0x33, 0x90, 0xa4, 0xac, 0x71, 0xe0, 0x50, 0x48, 0xbd, 0xc8,
+	0xf9, 0x44, 0x70, 0xc7, 0x56, 0x89, 0x3d, 0x76, 0x95, 0xff, 0x8e, 0xc1, 0x82, 0xdc, 0xe5, 0x86,
+	0x15, 0xb4, 0x76, 0x9e, 0x52, 0x6b, 0x78, 0x03, 0x66, 0x18, 0xdd, 0x8e, 0x6e, 0xce, 0x14, 0x7b,
+	0x08, 0x25, 0x98, 0x45, 0x58, 0xbe, 0x39, 0x76, 0xfc, 0xf2, 0xe5, 0x97, 0xb3, 0xfc, 0xb1, 0x70,
+	0x3e, 0xc5, 0x70, 0x52, 0x8f, 0x31, 0x9c, 0x99, 0xa3, 0x18, 0x8e, 0xba, 0x09, 0x8b, 0x93, 0x88,
+	0x4b, 0xe3, 0xf8, 0x79, 0x98, 0x11, 0x87, 0x12, 0xfa, 0xc8, 0x69, 0xe7, 0x16, 0x8a, 0xa8, 0x5f,
+	0xc6, 0x60, 0x51, 0xba, 0xaf, 0x1f, 0xc7, 0x3d, 0x1e, 0xc3, 0x39, 0x79, 0xa4, 0x0b, 0x7a, 0xb4,
+	0xf3, 0x53, 0x8b, 0xb0, 0x74, 0x00, 0xc7, 0x27, 0xb8, 0xac, 0xdf, 0x28, 0x30, 0xbb, 0x41, 0x3b,
+	0x76, 0xef, 0x29, 0x3d, 0x85, 0x31, 0x70, 0x13, 0x47, 0x32, 0xe2, 0x3e, 0xe4, 0xa4, 0xbe, 0x12,
+	0xad, 0xc3, 0x68, 0x2b, 0xd3, 0x6e, 0xcb, 0x15, 0x98, 0x95, 0xb9, 0x03, 0xcb, 0xb1, 0x2d, 0x3f,
+	0xd2, 0xe7, 0x40, 0xf2, 0x40, 0x63, 0x4c, 0x92, 0x0d, 0x46, 0x1d, 0xf5, 0x3f, 0x14, 0xc8, 0x15,
+	0xdd, 0x6e, 0xd7, 0x0e, 0x9e, 0x52, 0x8c, 0x0f, 0x23, 0x94, 0x98, 0x66, 0x8f, 0x17, 0x20, 0x1f,
+	0xaa, 0x29, 0xa1, 0x3d, 0x10, 0x69, 0x94, 0x43, 0x91, 0xe6, 0x3f, 0x15, 0x98, 0x23, 0xae, 0xe3,
+	0x6c, 0x5b, 0xad, 0xdd, 0x67, 0x1b, 0x9c, 0x8b, 0x80, 0x46, 0x8a, 0x1e, 0x15, 0x9e, 0xff, 0x53,
+	0x20, 0x5f, 0xf7, 0x68, 0xdf, 0xf2, 0xe8, 0x33, 0x8d, 0x0e, 0x7b, 0xa6, 0xb7, 0x03, 0xf9, 0xc0,
+	0xc9, 0x10, 0xde, 0x56, 0xe7, 0x61, 0x2e, 0xd2, 0x5d, 0x00, 0xa6, 0xfe, 0x8b, 0x02, 0x4b, 0xc2,
+	0xc4, 0x24, 0xa7, 0xfd, 0x94, 0xc2, 0x12, 0xea, 0x9b, 0x18, 0xd3, 0xb7, 0x00, 0xc7, 0x0f, 0xea,
+	0x26, 0xd5, 0xfe, 0x28, 0x06, 0x27, 0x42, 0xe3, 0x79, 0xca, 0x15, 0xff, 0x1e, 0xf6, 0xb0, 0x0c,
+	0x85, 0xc3, 0x20, 0x48, 0x84, 0x3e, 0x8d, 0x41, 0xa1, 0xe8, 0x51, 0x2b, 0xa0, 0x63, 0xef, 0xa0,
+	0x67, 0xc7, 0x36, 0xf0, 0x05, 0x98, 0xed, 0x5b, 0x5e, 0x60, 0xb7, 0xec, 0xbe, 0xc5, 0x7e, 0x8a,
+	0x26, 0x57, 0xe2, 0x87, 0x27, 0x98, 0x10, 0x51, 0x4f, 0xc1, 0xc9, 0x29, 0x88, 0x48, 0xbc, 0xfe,
+	0x5f, 0x01, 0xdc, 0x08, 0x2c, 0x2f, 0xf8, 0x11, 0xc4, 0xa5, 0xa9, 0xc6, 0xb4, 0x04, 0x0b, 0x13,
+	0xfa, 0x8f, 0xe3, 0x42, 0x83, 0x1f, 0x45, 0x48, 0xfa, 0x56, 0x5c, 0xc6, 0xf5, 0x97, 0xb8, 0xfc,
+	0x9b, 0x02, 0xcb, 0x45, 0x57, 0x64, 0x2a, 0x9f, 0xc9, 0x1b, 0xa6, 0xbe, 0x00, 0xa7, 0xa6, 0x2a,
+	0x28, 0x01, 0xf8, 0x57, 0x05, 0x8e, 0x13, 0x6a, 0xb5, 0x9f, 0x4d, 0xe5, 0x6f, 0xc2, 0x89, 0x43,
+	0xca, 0xc9, 0x37, 0xca, 0x65, 0x48, 0x77, 0x69, 0x60, 0xb5, 0xad, 0xc0, 0x92, 0x2a, 0x2d, 0x87,
+	0xf3, 0x8e, 0xa4, 0x2b, 0x52, 0x82, 0x44, 0xb2, 0xea, 0x57, 0x31, 0x58, 0xe0, 0xef, 0xec, 0xe7,
+	0x3f, 0xf2, 0x8e, 0x94, 0x85, 0x49, 0x1d, 0x7c, 0xfc, 0x31, 0x81, 0xbe, 0x47, 0xcd, 0x30, 0x3b,
+	0x30, 0xc3, 0x3f, 0x1c, 0x42, 0xdf, 0xa3, 0x37, 0x05, 0x45, 0xfd, 0x07, 0x05, 0x16, 0x27, 0x21,
+	0x8e, 0x7e, 0xd1, 0xfc, 0xb4, 0xb3, 0x2d, 0x53, 0x5c, 0x4a, 0xfc, 0x28, 0x3f, 0x92, 0x12, 0x47,
+	0xfe, 0x91, 0xf4, 0x8f, 0x31, 0x28, 0x8c, 0x2b, 0xf3, 0x3c, 0xa7, 0x33, 0x99, 0xd3, 0xf9, 0xae,
+	0x59, 0x3e, 0xf5, 0x9f, 0x14, 0x38, 0x39, 0x05, 0xd0, 0xef, 0x66, 0x22, 0x63, 0x99, 0x9d, 0xd8,
+	0x63, 0x33, 0x3b, 0x3f, 0xbc, 0x91, 0xfc, 0xb3, 0x02, 0x8b, 0x15, 0x91, 0xab, 0x17, 0x99, 0x8f,
+	0xa7, 0xd7, 0x07, 0xf3, 0x74, 0x7c, 0x62, 0xf4, 0xe5, 0x8a, 0x65, 0x73, 0x0e, 0xa8, 0xf6, 0x04,
+	0xd9, 0x9c, 0xff, 0x55, 0x60, 0x5e, 0xce, 0xa2, 0xb5, 0x76, 0x9f, 0x1d, 0x74, 0xf0, 0x8b, 0x10,
+	0xb7, 0xdb, 0xe1, 0xbb, 0x77, 0xb2, 0x80, 0x80, 0x31, 0xd4, 0xab, 0x80, 0xc7, 0xf5, 0x7e, 0x02,
+	0xe8, 0xfe, 0x2b, 0x06, 0x4b, 0x44, 0x78, 0xdf, 0xe7, 0xdf, 0x17, 0xbe, 0xef, 0xf7, 0x85, 0x47,
+	0x07, 0xae, 0x2f, 0xf9, 0x63, 0x6a, 0x12, 0xea, 0x1f, 0x2e, 0x74, 0x1d, 0x08, 0xb4, 0xf1, 0x43,
+	0x81, 0xf6, 0xc9, 0xfd, 0xd1, 0x97, 0x31, 0x58, 0x96, 0x8a, 0x3c, 0x7f, 0xeb, 0x1c, 0xdd, 0x22,
+	0x52, 0x87, 0x2c, 0xe2, 0x7f, 0x14, 0x38, 0x35, 0x15, 0xc8, 0x9f, 0xf9, 0x8b, 0xe6, 0x80, 0xf5,
+	0x24, 0x1e, 0x6b, 0x3d, 0xc9, 0x23, 0x5b, 0xcf, 0x27, 0x31, 0xc8, 0x13, 0xea, 0x50, 0xcb, 0x7f,
+	0xc6, 0xb3, 0x7b, 0x07, 0x30, 0x4c, 0x1e, 0xca, 0x73, 0xce, 0xc3, 0x5c, 0x04, 0x84, 0xfc, 0xc1,
+	0xc5, 0x7f, 0xa0, 0xb3, 0x38, 0xf8, 0x3e, 0xb5, 0x9c, 0x20, 0x7c, 0x09, 0xaa, 0x5f, 0xc7, 0x20,
+	0x47, 0x18, 0xc5, 0xee, 0x52, 0xf6, 0xdd, 0xdb, 0xc7, 0x2f, 0xc3, 0xec, 0x0e, 0x17, 0x31, 0x47,
+	0x16, 0x92, 0x21, 0x59, 0x41, 0x13, 0x5f, 0x1f, 0xd7, 0x61, 0xc9, 0xa7, 0x2d, 0xb7, 0xd7, 0xf6,
+	0xcd, 0x6d, 0xba, 0xc3, 0x6a, 0xc8, 0xba, 0x96, 0x1f, 0x50, 0x8f, 0xc3, 0x92, 0x23, 0x0b, 0x92,
+	0xb9, 0xc1, 0x79, 0x15, 0xce, 0xc2, 0xe7, 0x61, 0x71, 0xdb, 0xee, 0x39, 0x6e, 0x87, 0x15, 0x1c,
+	0x0d, 0xa9, 0xe7, 0x9b, 0x2d, 0x77, 0xd0, 0x13, 0x78, 0x24, 0x09, 0x16, 0xbc, 0xba, 0x60, 0x15,
+	0x19, 0x07, 0xdf, 0x85, 0xb3, 0x53, 0x57, 0x31, 0xef, 0xd9, 0x4e, 0x40, 0x3d, 0xda, 0x36, 0x3d,
+	0xda, 0x77, 0xec, 0x96, 0x28, 0x8e, 0x12, 0x40, 0xbd, 0x36, 0x65, 0xe9, 0x2d, 0x29, 0x4e, 0x46,
+	0xd2, 0xac, 0x8c, 0xa2, 0xd5, 0x1f, 0x98, 0x03, 0x5e, 0xb4, 0xc0, 0xf0, 0x53, 0x48, 0xba, 0xd5,
+	0x1f, 0x34, 0x59, 0x9f, 0x7d, 0x4d, 0xbf, 0xdf, 0x17, 0xce, 0x59, 0x21, 0xac, 0x89, 0x35, 0x40,
+	0xe2, 0xa3, 0xbf, 0x1f, 0x58, 0x81, 0xed, 0x07, 0x76, 0x4b, 0xb8, 0xe1, 0xec, 0xfa, 0xf1, 0xe8,
+	0x20, 0xb7, 0x1d, 0xda, 0x88, 0xb8, 0x64, 0x2e, 0x98, 0x24, 0xa8, 0x5f, 0x2b, 0x30, 0x77, 0x40,
+	0x68, 0x6a, 0xc1, 0xcd, 0x29, 0xc8, 0x78, 0xee, 0xbe, 0x04, 0x47, 0x54, 0x80, 0xa4, 0x3d, 0x77,
+	0x5f, 0x40, 0xf2, 0x21, 0x60, 0x59, 0x02, 0xd3, 0xb2, 0xbc, 0xb6, 0xdd, 0xb3, 0x1c, 0x3b, 0x18,
+	0xca, 0xea, 0x82, 0x37, 0xa7, 0xef, 0x64, 0xad, 0xc8, 0x07, 0x14, 0x47, 0xf2, 0xa2, 0x7e, 0x6f,
+	0xbe, 0x75, 0x90, 0xbe, 0xbc, 0xc9, 0x12, 0xa6, 0xd3, 0x84, 0xa7, 0xd4, 0xf1, 0x4d, 0x54, 0x26,
+	0x26, 0xc6, 0x4b, 0xf6, 0xbe, 0x51, 0x20, 0xaf, 0x75, 0x3a, 0x1e, 0xed, 0x58, 0x81, 0x34, 0xa9,
+	0xf3, 0xb0, 0x28, 0xcc, 0x67, 0x68, 0xca, 0xab, 0x2d, 0xd4, 0x53, 0xc4, 0xd9, 0x4b, 0x9e, 0xb8,
+	0xd7, 0x42, 0xd1, 0x4b, 0x70, 0x7c, 0xd0, 0x9b, 0x3a, 0x26, 0xc6, 0xc7, 0x2c, 0x0e, 0x7a, 0x53,
+	0x46, 0xfd, 0x22, 0x9c, 0x9c, 0x6e, 0x31, 0x5d, 0x5b, 0x14, 0x73, 0xe6, 0xc8, 0xf1, 0x29, 0x06,
+	0x52, 0xb1, 0x7b, 0x8f, 0x18, 0x6a, 0x3d, 0x28, 0x24, 0xbe, 0x7d, 0xa8, 0xf5, 0x40, 0xfd, 0x9b,
+	0xe8, 0xfb, 0x6b, 0x78, 0xb5, 0x22, 0x27, 0x1b, 0x5e, 0x7a, 0xe5, 0x51, 0x97, 0xbe, 0x00, 0x33,
+	0xec, 0xe2, 0xda, 0xbd, 0x0e, 0x57, 0x2e, 0x4d, 0xc2, 0x2e, 0x6e, 0xc0, 0x6b, 0x52, 0x77, 0xfa,
+	0x20, 0xa0, 0x5e, 0xcf, 0x72, 0x9c, 0xa1, 0x29, 0x52, 0xb5, 0xbd, 0x80, 0xb6, 0xcd, 0x51, 0x71,
+	0xab, 0x70, 0xb5, 0xaf, 0x08, 0x69, 0x3d, 0x12, 0x26, 0x91, 0xac, 0x11, 0x8a, 0xe2, 0x77, 0x20,
+	0xef, 0xc9, 0x0b, 0xcf, 0xcd, 0x39, 0x8c, 0xcf, 0x8b, 0x72, 0x77, 0x13, 0xde, 0x80, 0xe4, 0xbc,
+	0xf1, 0xee, 0x93, 0x3b, 0xe7, 0xeb, 0x89, 0x74, 0x0a, 0xcd, 0xa8, 0x7f, 0xab, 0xc0, 0xc2, 0x94,
+	0x3c, 0x47, 0x94, 0x44, 0x51, 0xc6, 0x72, 0xb4, 0x6f, 0x42, 0x92, 0xed, 0x2f, 0xac, 0x3d, 0x3b,
+	0x71, 0x38, 0x4d, 0xc2, 0xf6, 0x44, 0x89, 0x90, 0x62, 0x7e, 0x8b, 0xeb, 0xd4, 0xe2, 0x49, 0xda,
+	0x30, 0xfa, 0x64, 0x19, 0x4d, 0xe4, 0x6d, 0x0f, 0x67, 0x7d, 0x13, 0x8f, 0xcd, 0xfa, 0x9e, 0xfd,
+	0xc3, 0x38, 0x64, 0x2a, 0xc3, 0xc6, 0x7d, 0x67, 0xcb, 0xb1, 0x3a, 0xbc, 0x92, 0xa6, 0x52, 0x37,
+	0xee, 0xa0, 0x63, 0xac, 0xae, 0xb0, 0x5a, 0x33, 0xcc, 0x6a, 0xb3, 0x5c, 0x36, 0xb7, 0xca, 0xda,
+	0x35, 0xa4, 0xb0, 0x02, 0xbd, 0x3a, 0x29, 0x99, 0x37, 0xf4, 0x3b, 0x82, 0x12, 0x63, 0x15, 0x7f,
+	0xcd, 0x6a, 0xe9, 0x66, 0x53, 0x1f, 0x11, 0x13, 0x78, 0x09, 0xe6, 0x2b, 0xcd, 0xb2, 0x51, 0xaa,
+	0x97, 0xc7, 0xc8, 0x69, 0x56, 0x95, 0xb8, 0x51, 0xae, 0x6d, 0x88, 0x2e, 0x62, 0xf3, 0x37, 0xab,
+	0x8d, 0xd2, 0xb5, 0xaa, 0xbe, 0x29, 0x48, 0x2b, 0x8c, 0x74, 0x57, 0x27, 0xb5, 0xad, 0x52, 0xb8,
+	0xe4, 0x55, 0x8c, 0x20, 0xbb, 0x51, 0xaa, 0x6a, 0x44, 0xce, 0xf2, 0x50, 0xc1, 0x79, 0xc8, 0xe8,
+	0xd5, 0x66, 0x45, 0xf6, 0x63, 0xb8, 0x00, 0x0b, 0xac, 0x00, 0xd0, 0x2c, 0x55, 0x8b, 0x44, 0xaf,
+	0xb0, 0x3a, 0x41, 0xc1, 0x49, 0xe0, 0x05, 0xc8, 0x1b, 0xa5, 0x8a, 0xde, 0x30, 0xb4, 0x4a, 0x5d,
+	0x12, 0xd9, 0x2e, 0xd2, 0x0d, 0x3d, 0x94, 0x41, 0x78, 0x19, 0x96, 0xaa, 0x35, 0x53, 0x96, 0x30,
+	0x9a, 0xb7, 0xb4, 0x72, 0x53, 0x97, 0xbc, 0x15, 0x7c, 0x02, 0x70, 0xad, 0x6a, 0x36, 0xeb, 0x9b,
+	0x9a, 0xa1, 0x9b, 0xd5, 0xda, 0x6d, 0xc9, 0xb8, 0x8a, 0xf3, 0x90, 0x1e, 0xed, 0xe0, 0x21, 0x43,
+	0x21, 0x57, 0xd7, 0x88, 0x31, 0x52, 0xf6, 0xe1, 0x43, 0x06, 0x16, 0x5c, 0x23, 0xb5, 0x66, 0x7d,
+	0x24, 0x36, 0x0f, 0x59, 0x09, 0x96, 0x24, 0x25, 0x18, 0x69, 0xa3, 0x54, 0x2d, 0x46, 0xfb, 0x7b,
+	0x98, 0x5e, 0x8e, 0x21, 0xe5, 0xec, 0x2e, 0x24, 0xf8, 0x71, 0xa4, 0x21, 0x51, 0xad, 0x55, 0x59,
+	0x49, 0xe7, 0x1c, 0x40, 0xa9, 0x51, 0xaa, 0x1a, 0xfa, 0x35, 0xa2, 0x95, 0x99, 0xda, 0x9c, 0x10,
+	0x02, 0xc8, 0xb4, 0x9d, 0x85, 0x99, 0x52, 0x63, 0xab, 0x5c, 0xd3, 0x0c, 0xa9, 0x66, 0xa9, 0x71,
+	0xb3, 0x59, 0x63, 0x95, 0x95, 0x0f, 0x11, 0xce, 0x42, 0x8a, 0x15, 0x51, 0x7e, 0x60, 0x30, 0xbd,
+	0x38, 0x4f, 0xa0, 0x8a, 0x1e, 0x5e, 0x3d, 0xfb, 0x79, 0x1c, 0x12, 0xbc, 0x6a, 0x3d, 0x07, 0x19,
+	0x7e, 0xda, 0xac, 0x76, 0x14, 0x1d, 0xc3, 0x19, 0x48, 0x94, 0xaa, 0xc6, 0x15, 0xf4, 0x2b, 0x31,
+	0x0c, 0x90, 0x6c, 0xf2, 0xf6, 0xaf, 0xa6, 0x58, 0xbb, 0x54, 0x35, 0x2e, 0x5c, 0x46, 0x1f, 0xc5,
+	0xd8, 0xb4, 0x4d, 0xd1, 0xf9, 0xb5, 0x90, 0xb1, 0x7e, 0x09, 0x7d, 0x1c, 0x31, 0xd6, 0x2f, 0xa1,
+	0x5f, 0x0f, 0x19, 0x17, 0xd7, 0xd1, 0x27, 0x11, 0xe3, 0xe2, 0x3a, 0xfa, 0x8d, 0x90, 0x71, 0xf9,
+	0x12, 0xfa, 0xcd, 0x88, 0x71, 0xf9, 0x12, 0xfa, 0xad, 0x14, 0xd3, 0x85, 0x6b, 0x72, 0x71, 0x1d,
+	0xfd, 0x76, 0x3a, 0xea, 0x5d, 0xbe, 0x84, 0x7e, 0x27, 0xcd, 0xce, 0x3f, 0x3a, 0x55, 0xf4, 0xbb,
+	0x88, 0x6d, 0x93, 0x1d, 0x10, 0xfa, 0x3d, 0xde, 0x64, 0x2c, 0xf4, 0xfb, 0x88, 0xe9, 0xc8, 0xa8,
+	0xbc, 0xfb, 0x29, 0xe7, 0xdc, 0xd1, 0x35, 0x82, 0xfe, 0x20, 0x25, 0x2a, 0x56, 0x8b, 0xa5, 0x8a,
+	0x56, 0x46, 0x98, 0x8f, 0x60, 0xa8, 0xfc, 0xd1, 0x79, 0xd6, 0x64, 0xe6, 0x89, 0xfe, 0xb8, 0xce,
+	0x16, 0xbc, 0xa5, 0x91, 0xe2, 0xfb, 0x1a, 0x41, 0x7f, 0x72, 0x9e, 0x2d, 0x78, 0x4b, 0x23, 0x12,
+	0xaf, 0x3f, 0xad, 0x33, 0x41, 0xce, 0xfa, 0xec, 0x3c, 0xdb, 0xb4, 0xa4, 0xff, 0x59, 0x1d, 0xa7,
+	0x21, 0xbe, 0x51, 0x32, 0xd0, 0xe7, 0x7c, 0x35, 0x66, 0xa2, 0xe8, 0xcf, 0x11, 0x23, 0x36, 0x74,
+	0x03, 0xfd, 0x05, 0x23, 0x26, 0x8d, 0x66, 0xbd, 0xac, 0xa3, 0xd3, 0x6c, 0x73, 0xd7, 0xf4, 0x5a,
+	0x45, 0x37, 0xc8, 0x1d, 0xf4, 0x97, 0x5c, 0xfc, 0x7a, 0xa3, 0x56, 0x45, 0x5f, 0x20, 0x56, 0xcd,
+	0xaa, 0x7f, 0x50, 0x27, 0x7a, 0xa3, 0x51, 0xaa, 0x55, 0xd1, 0x4b, 0x67, 0xb7, 0x00, 0x1d, 0x74,
+	0x07, 0x4c, 0x81, 0x66, 0xf5, 0x46, 0xb5, 0x76, 0xbb, 0x8a, 0x8e, 0xb1, 0x4e, 0x9d, 0xe8, 0x75,
+	0x8d, 0xe8, 0x48, 0xc1, 0x00, 0x29, 0x59, 0x07, 0x1b, 0xc3, 0xb3, 0x90, 0x26, 0xb5, 0x72, 0x79,
+	0x43, 0x2b, 0xde, 0x40, 0xf1, 0x8d, 0xb7, 0x60, 0xce, 0x76, 0xd7, 0xf6, 0xec, 0x80, 0xfa, 0xbe,
+	0xf8, 0x5f, 0xc4, 0x5d, 0x55, 0xf6, 0x6c, 0xf7, 0x9c, 0x68, 0x9d, 0xeb, 0xb8, 0xe7, 0xf6, 0x82,
+	0x73, 0x9c, 0x7b, 0x8e, 0x7b, 0x8c, 0xed, 0x14, 0xef, 0x5c, 0xfc, 0xc9, 0x00, 0x53, 0x82, 0xb8,
+	0x72, 0x75, 0x31, 0x00, 0x00,
 }
@@ -265,10 +265,14 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// primary_key lists the columns of the primary key of the table.
+	// It's required by the DMLs that change rows selected by vtgate,
+	// like multi-table updates and deletes.
+	PrimaryKey           []string `protobuf:"bytes,7,rep,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -338,6 +342,13 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetPrimaryKey() []string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -562,48 +573,48 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0x56, 0x5a, 0x9a, 0xb6, 0x27, 0xb4, 0x6c, 0x16, 0xb0, 0xac, 0x08, 0x51, 0x45, 0x6c, 0xeb,
+	0x76, 0xd1, 0x4a, 0x45, 0x93, 0x58, 0x27, 0xa6, 0x31, 0xc4, 0x05, 0x02, 0x69, 0x53, 0x40, 0x5c,
+	0xec, 0x26, 0x0a, 0xa9, 0x07, 0x16, 0xcd, 0x0f, 0xb6, 0x93, 0x91, 0xd7, 0xd9, 0x63, 0xec, 0x55,
+	0xf6, 0x08, 0x7b, 0x89, 0x29, 0xb6, 0x13, 0x1c, 0xe8, 0xee, 0x7c, 0x7c, 0xce, 0xf7, 0xf9, 0xf3,
+	0x67, 0x9f, 0x03, 0xbd, 0x8c, 0x05, 0x37, 0x38, 0xf4, 0xc7, 0x09, 0x8d, 0x79, 0x8c, 0xda, 0x2a,
+	0x1c, 0x58, 0x77, 0x29, 0xa6, 0xb9, 0xdc, 0x75, 0x66, 0xb0, 0xea, 0xc6, 0x29, 0x27, 0xd1, 0xb5,
+	0x9b, 0x2e, 0x30, 0x43, 0xef, 0xa0, 0x45, 0x8b, 0x85, 0x6d, 0x0c, 0x9b, 0x23, 0x6b, 0xba, 0x3e,
+	0x2e, 0x49, 0xb4, 0x2a, 0x57, 0x96, 0x38, 0x27, 0x60, 0x69, 0xbb, 0x68, 0x1b, 0xe0, 0x07, 0x8d,
+	0x43, 0x8f, 0xfb, 0x57, 0x0b, 0x6c, 0x1b, 0x43, 0x63, 0xd4, 0x75, 0xbb, 0xc5, 0xce, 0x45, 0xb1,
+	0x81, 0xb6, 0xa0, 0xcb, 0x63, 0x99, 0x64, 0x76, 0x63, 0xd8, 0x1c, 0x75, 0xdd, 0x0e, 0x8f, 0x45,
+	0x8e, 0x39, 0x7f, 0x1b, 0xd0, 0x39, 0xc5, 0x39, 0x4b, 0xfc, 0x00, 0x23, 0x1b, 0xda, 0xec, 0xc6,
+	0xa7, 0x73, 0x3c, 0x17, 0x2c, 0x1d, 0xb7, 0x0c, 0xd1, 0x47, 0xe8, 0x64, 0x24, 0x9a, 0xe3, 0x7b,
+	0x45, 0x61, 0x4d, 0x77, 0x2a, 0x81, 0x25, 0x7c, 0x7c, 0xa9, 0x2a, 0x8e, 0x23, 0x4e, 0x73, 0xb7,
+	0x02, 0xa0, 0xf7, 0x60, 0xaa, 0xd3, 0x9b, 0x02, 0xba, 0xfd, 0x14, 0x2a, 0xd5, 0x48, 0xa0, 0x2a,
+	0x46, 0xfb, 0x60, 0x53, 0x7c, 0x97, 0x12, 0x8a, 0x3d, 0x7c, 0x9f, 0x2c, 0x48, 0x40, 0xb8, 0x47,
+	0xe5, 0xb5, 0xed, 0x15, 0x21, 0x6f, 0x53, 0xe5, 0x8f, 0x55, 0x5a, 0x99, 0x32, 0x38, 0x83, 0x5e,
+	0x4d, 0x0b, 0x7a, 0x06, 0xcd, 0x5b, 0x9c, 0x2b, 0x6b, 0x8a, 0x25, 0x7a, 0x05, 0xad, 0xcc, 0x5f,
+	0xa4, 0xd8, 0x6e, 0x0c, 0x8d, 0x91, 0x35, 0x5d, 0xab, 0x24, 0x49, 0xa0, 0x2b, 0xb3, 0xb3, 0xc6,
+	0xbe, 0x31, 0x38, 0x01, 0x4b, 0x93, 0xb7, 0x84, 0x6b, 0xb7, 0xce, 0xd5, 0xaf, 0xb8, 0x04, 0x4c,
+	0xa3, 0x72, 0x7e, 0x19, 0x60, 0xca, 0x03, 0x10, 0x82, 0x15, 0x9e, 0x27, 0xe5, 0x73, 0x89, 0x35,
+	0xda, 0x03, 0x33, 0xf1, 0xa9, 0x1f, 0x96, 0x1e, 0x6f, 0x3d, 0x52, 0x35, 0xfe, 0x26, 0xb2, 0xca,
+	0x26, 0x59, 0x8a, 0xd6, 0xa1, 0x15, 0xff, 0x8c, 0x30, 0xb5, 0x9b, 0x82, 0x49, 0x06, 0x83, 0x0f,
+	0x60, 0x69, 0xc5, 0x4b, 0x44, 0xaf, 0xeb, 0xa2, 0xbb, 0xba, 0xc8, 0xdf, 0x0d, 0x68, 0xc9, 0x9f,
+	0xb3, 0x4c, 0xe3, 0x27, 0x58, 0x0b, 0xe2, 0x45, 0x1a, 0x46, 0xde, 0xa3, 0x0f, 0xb1, 0x51, 0x89,
+	0x3d, 0x12, 0x79, 0x65, 0x64, 0x3f, 0xd0, 0x22, 0xcc, 0xd0, 0x01, 0xf4, 0xfd, 0x94, 0xc7, 0x1e,
+	0x89, 0x02, 0x8a, 0x43, 0x1c, 0x71, 0xa1, 0xdb, 0x9a, 0x6e, 0x56, 0xf0, 0xc3, 0x94, 0xc7, 0x27,
+	0x65, 0xd6, 0xed, 0xf9, 0x7a, 0x88, 0xde, 0x42, 0x5b, 0x12, 0x32, 0x7b, 0x65, 0xd8, 0xac, 0xbd,
+	0x9c, 0x3c, 0xd6, 0x2d, 0xf3, 0x68, 0x13, 0xcc, 0x84, 0x44, 0x11, 0x9e, 0xdb, 0x2d, 0xa1, 0x5f,
+	0x45, 0x68, 0x06, 0x2f, 0xd5, 0x0d, 0x16, 0x84, 0x71, 0xcf, 0x4f, 0xf9, 0x4d, 0x4c, 0x09, 0xf7,
+	0x39, 0xc9, 0xb0, 0x6d, 0x8a, 0x8f, 0xf5, 0x42, 0x16, 0x9c, 0x11, 0xc6, 0x0f, 0xf5, 0x34, 0xda,
+	0x01, 0x2b, 0xa1, 0x24, 0xf4, 0x69, 0xee, 0x15, 0x7e, 0xb6, 0x45, 0x37, 0x81, 0xda, 0x3a, 0xc5,
+	0xb9, 0x73, 0x01, 0xab, 0xfa, 0xf5, 0x0b, 0x11, 0x92, 0x4b, 0x99, 0xa8, 0xa2, 0xc2, 0xda, 0xc8,
+	0x0f, 0x4b, 0xf7, 0xc5, 0xba, 0x68, 0xbf, 0xf2, 0x6e, 0x4d, 0x41, 0x5c, 0x86, 0xce, 0x11, 0xf4,
+	0x6a, 0xae, 0xfc, 0x97, 0x76, 0x00, 0x1d, 0x86, 0xef, 0x52, 0x1c, 0x05, 0x25, 0x75, 0x15, 0x3b,
+	0x07, 0x60, 0x1e, 0xd5, 0x0f, 0x37, 0xb4, 0xc3, 0x77, 0xd4, 0x5b, 0x17, 0xa8, 0xfe, 0xd4, 0x1a,
+	0xcb, 0x59, 0x75, 0x91, 0x27, 0x58, 0x3e, 0xbc, 0xf3, 0xc7, 0x00, 0x38, 0xa7, 0xd9, 0xe5, 0xb9,
+	0x70, 0x1b, 0x7d, 0x86, 0xee, 0xad, 0xea, 0xde, 0x72, 0x66, 0x39, 0xd5, 0x53, 0x3c, 0xd4, 0x55,
+	0x2d, 0xae, 0x7e, 0xed, 0x03, 0x08, 0xcd, 0xa0, 0xa7, 0xda, 0xd9, 0x93, 0x93, 0x4f, 0xb6, 0xcf,
+	0xc6, 0xb2, 0xc9, 0xc7, 0xdc, 0x55, 0xaa, 0x45, 0x83, 0xaf, 0xd0, 0xaf, 0x13, 0x2f, 0xf9, 0xe1,
+	0x6f, 0xea, 0x6d, 0xf9, 0xfc, 0xc9, 0xd4, 0xd1, 0x3e, 0xfd, 0x97, 0xd7, 0xdf, 0x77, 0x33, 0xc2,
+	0x31, 0x63, 0x63, 0x12, 0x4f, 0xe4, 0x6a, 0x72, 0x1d, 0x4f, 0x32, 0x3e, 0x11, 0xe3, 0x7a, 0xa2,
+	0xb0, 0x57, 0xa6, 0x08, 0xf7, 0xfe, 0x0d, 0x00, 0x02, 0x2e, 0x17, 0xd7, 0xe4, 0x05, 0x00, 0x00,
 }
@@ -146,7 +146,19 @@ type Session struct {
 	// and is reset once transaction is committed or rolled back.
 	Savepoints []string `protobuf:"bytes,16,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
 	// in_reserved_conn is set to true if the session should be using reserved connections.
-	InReservedConn       bool     `protobuf:"varint,17,opt,name=in_reserved_conn,json=inReservedConn,proto3" json:"in_reserved_conn,omitempty"`
+	InReservedConn bool `protobuf:"varint,17,opt,name=in_reserved_conn,json=inReservedConn,proto3" json:"in_reserved_conn,omitempty"`
+	// lock_sessions keep track of the reserved connections on which the
+	// named lock functions of the session are executed, one per keyspace.
+	LockSessions []*Session_ShardSession `protobuf:"bytes,18,rep,name=lock_sessions,json=lockSessions,proto3" json:"lock_sessions,omitempty"`
+	// read_after_write_consistency is set to true if the reads of the
+	// session from replicas have to see the writes of the session.
+	ReadAfterWriteConsistency bool `protobuf:"varint,19,opt,name=read_after_write_consistency,json=readAfterWriteConsistency,proto3" json:"read_after_write_consistency,omitempty"`
+	// read_after_write_positions keeps the replication positions of the
+	// masters after the writes of the session, by keyspace/shard.
+	ReadAfterWritePositions map[string]string `protobuf:"bytes,20,rep,name=read_after_write_positions,json=readAfterWritePositions,proto3" json:"read_after_write_positions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// temporary_tables are the names of the temporary tables that the
+	// session created on its reserved connections.
+	TemporaryTables      []string `protobuf:"bytes,21,rep,name=temporary_tables,json=temporaryTables,proto3" json:"temporary_tables,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -289,6 +301,34 @@ func (m *Session) GetInReservedConn() bool {
 	return false
 }
 
+func (m *Session) GetLockSessions() []*Session_ShardSession {
+	if m != nil {
+		return m.LockSessions
+	}
+	return nil
+}
+
+func (m *Session) GetReadAfterWriteConsistency() bool {
+	if m != nil {
+		return m.ReadAfterWriteConsistency
+	}
+	return false
+}
+
+func (m *Session) GetReadAfterWritePositions() map[string]string {
+	if m != nil {
+		return m.ReadAfterWritePositions
+	}
+	return nil
+}
+
+func (m *Session) GetTemporaryTables() []string {
+	if m != nil {
+		return m.TemporaryTables
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -980,6 +1020,7 @@ func init() {
 	proto.RegisterEnum("vtgate.TransactionMode", TransactionMode_name, TransactionMode_value)
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.ReadAfterWritePositionsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
 	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
@@ -998,79 +1039,86 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x8e, 0x1b, 0xb5,
-	0x17, 0xef, 0x64, 0xf2, 0x35, 0x27, 0x5f, 0x53, 0x37, 0xed, 0x7f, 0x9a, 0x7f, 0x81, 0x28, 0x6d,
-	0xd5, 0xb4, 0xa0, 0x04, 0x2d, 0x02, 0x55, 0x08, 0x84, 0x76, 0xb3, 0x69, 0x95, 0xaa, 0xdb, 0x2c,
-	0x4e, 0x76, 0x2b, 0x21, 0xd0, 0x68, 0x9a, 0x71, 0x53, 0xab, 0xd9, 0xf1, 0xd4, 0x76, 0xb2, 0xe4,
-	0x29, 0xb8, 0xe7, 0x1a, 0x89, 0x47, 0xe0, 0x1d, 0xb8, 0xe3, 0x8d, 0x90, 0xed, 0x49, 0x32, 0x1b,
-	0x16, 0xba, 0x6d, 0xb5, 0x37, 0x23, 0x9f, 0x0f, 0x9f, 0x39, 0xe7, 0xf7, 0x3b, 0xc7, 0x36, 0x94,
-	0x17, 0x72, 0x1a, 0x48, 0xd2, 0x89, 0x39, 0x93, 0x0c, 0xe5, 0x8d, 0xd4, 0x70, 0x5f, 0xd0, 0x68,
-	0xc6, 0xa6, 0x61, 0x20, 0x03, 0x63, 0x69, 0x94, 0xde, 0xcc, 0x09, 0x5f, 0x26, 0x42, 0x55, 0xb2,
-	0x98, 0xa5, 0x8d, 0x0b, 0xc9, 0xe3, 0x89, 0x11, 0x5a, 0xbf, 0x39, 0x50, 0x18, 0x11, 0x21, 0x28,
-	0x8b, 0xd0, 0x5d, 0xa8, 0xd2, 0xc8, 0x97, 0x3c, 0x88, 0x44, 0x30, 0x91, 0x94, 0x45, 0x9e, 0xd5,
-	0xb4, 0xda, 0x45, 0x5c, 0xa1, 0xd1, 0x78, 0xa3, 0x44, 0x3d, 0xa8, 0x8a, 0x57, 0x01, 0x0f, 0x7d,
-	0x61, 0xf6, 0x09, 0x2f, 0xd3, 0xb4, 0xdb, 0xa5, 0x9d, 0x5b, 0x9d, 0x24, 0xbb, 0x24, 0x5e, 0x67,
-	0xa4, 0xbc, 0x12, 0x01, 0x57, 0x44, 0x4a, 0x12, 0xe8, 0x63, 0x80, 0x60, 0x2e, 0xd9, 0x84, 0x9d,
-	0x9c, 0x50, 0xe9, 0x65, 0xf5, 0x7f, 0x52, 0x1a, 0x74, 0x1b, 0x2a, 0x32, 0xe0, 0x53, 0x22, 0x7d,
-	0x21, 0x39, 0x8d, 0xa6, 0x5e, 0xae, 0x69, 0xb5, 0x1d, 0x5c, 0x36, 0xca, 0x91, 0xd6, 0xa1, 0x2e,
-	0x14, 0x58, 0x2c, 0x75, 0x0a, 0xf9, 0xa6, 0xd5, 0x2e, 0xed, 0x5c, 0xef, 0x98, 0xc2, 0xfb, 0x3f,
-	0x93, 0xc9, 0x5c, 0x92, 0xa1, 0x31, 0xe2, 0x95, 0x17, 0xda, 0x03, 0x37, 0x55, 0x9e, 0x7f, 0xc2,
-	0x42, 0xe2, 0x15, 0x9a, 0x56, 0xbb, 0xba, 0xf3, 0xbf, 0x55, 0xf2, 0xa9, 0x4a, 0x0f, 0x58, 0x48,
-	0x70, 0x4d, 0x9e, 0x55, 0xa0, 0x2e, 0x14, 0x4f, 0x03, 0x1e, 0xd1, 0x68, 0x2a, 0xbc, 0xa2, 0x2e,
-	0xfc, 0x5a, 0xf2, 0xd7, 0xef, 0xd5, 0xf7, 0xb9, 0xb1, 0xe1, 0xb5, 0x13, 0xfa, 0x0e, 0xca, 0x31,
-	0x27, 0x1b, 0xb4, 0x9c, 0x0b, 0xa0, 0x55, 0x8a, 0x39, 0x59, 0x63, 0xb5, 0x0b, 0x95, 0x98, 0x09,
-	0xb9, 0x89, 0x00, 0x17, 0x88, 0x50, 0x56, 0x5b, 0xd6, 0x21, 0xee, 0x40, 0x75, 0x16, 0x08, 0xe9,
-	0xd3, 0x48, 0x10, 0x2e, 0x7d, 0x1a, 0x7a, 0xa5, 0xa6, 0xd5, 0xce, 0xe2, 0xb2, 0xd2, 0x0e, 0xb4,
-	0x72, 0x10, 0xa2, 0x8f, 0x00, 0x5e, 0xb2, 0x79, 0x14, 0xfa, 0x9c, 0x9d, 0x0a, 0xaf, 0xac, 0x3d,
-	0x1c, 0xad, 0xc1, 0xec, 0x54, 0x20, 0x1f, 0x6e, 0xcc, 0x05, 0xe1, 0x7e, 0x48, 0x5e, 0xd2, 0x88,
-	0x84, 0xfe, 0x22, 0xe0, 0x34, 0x78, 0x31, 0x23, 0xc2, 0xab, 0xe8, 0x84, 0xee, 0x6f, 0x27, 0x74,
-	0x24, 0x08, 0xdf, 0x37, 0xce, 0xc7, 0x2b, 0xdf, 0x7e, 0x24, 0xf9, 0x12, 0xd7, 0xe7, 0xe7, 0x98,
-	0xd0, 0x10, 0x5c, 0xb1, 0x14, 0x92, 0x9c, 0xa4, 0x42, 0x57, 0x75, 0xe8, 0x3b, 0xff, 0xa8, 0x55,
-	0xfb, 0x6d, 0x45, 0xad, 0x89, 0xb3, 0x5a, 0xf4, 0x7f, 0x70, 0x38, 0x3b, 0xf5, 0x27, 0x6c, 0x1e,
-	0x49, 0xaf, 0xd6, 0xb4, 0xda, 0x36, 0x2e, 0x72, 0x76, 0xda, 0x53, 0xb2, 0x6a, 0x41, 0x11, 0x2c,
-	0x48, 0xcc, 0x68, 0x24, 0x85, 0xe7, 0x36, 0xed, 0xb6, 0x83, 0x53, 0x1a, 0xd4, 0x06, 0x97, 0x46,
-	0x3e, 0x27, 0x82, 0xf0, 0x05, 0x09, 0xfd, 0x09, 0x8b, 0x22, 0xef, 0xaa, 0x6e, 0xd4, 0x2a, 0x8d,
-	0x70, 0xa2, 0xee, 0xb1, 0x28, 0x6a, 0xfc, 0x61, 0x41, 0x39, 0x0d, 0x3e, 0xba, 0x0b, 0x79, 0xd3,
-	0xa8, 0x7a, 0x82, 0x4a, 0x3b, 0x95, 0xa4, 0x43, 0xc6, 0x5a, 0x89, 0x13, 0xa3, 0x1a, 0xb8, 0x74,
-	0x3b, 0xd2, 0xd0, 0xcb, 0xe8, 0x1c, 0x2b, 0x29, 0xed, 0x20, 0x44, 0x0f, 0xa1, 0x2c, 0x55, 0x3d,
-	0xd2, 0x0f, 0x66, 0x34, 0x10, 0x9e, 0x9d, 0xf4, 0xfa, 0x7a, 0xae, 0xc7, 0xda, 0xba, 0xab, 0x8c,
-	0xb8, 0x24, 0x37, 0x02, 0xfa, 0x04, 0x4a, 0xeb, 0xfc, 0x69, 0xa8, 0xc7, 0xcc, 0xc6, 0xb0, 0x52,
-	0x0d, 0xc2, 0xc6, 0x8f, 0x70, 0xf3, 0x5f, 0x49, 0x42, 0x2e, 0xd8, 0xaf, 0xc9, 0x52, 0x97, 0xe0,
-	0x60, 0xb5, 0x44, 0xf7, 0x21, 0xb7, 0x08, 0x66, 0x73, 0xa2, 0xf3, 0xdc, 0x34, 0xfe, 0x1e, 0x8d,
-	0xd6, 0x7b, 0xb1, 0xf1, 0xf8, 0x3a, 0xf3, 0xd0, 0x6a, 0xec, 0x41, 0xfd, 0x3c, 0x9e, 0xce, 0x09,
-	0x5c, 0x4f, 0x07, 0x76, 0x52, 0x31, 0x9e, 0x64, 0x8b, 0xb6, 0x9b, 0x6d, 0xfd, 0x9e, 0x81, 0x6a,
-	0x32, 0xd4, 0x98, 0xbc, 0x99, 0x13, 0x21, 0xd1, 0x67, 0xe0, 0x4c, 0x82, 0xd9, 0x8c, 0x70, 0x55,
-	0x99, 0x81, 0xb9, 0xd6, 0x31, 0x47, 0x5b, 0x4f, 0xeb, 0x07, 0xfb, 0xb8, 0x68, 0x3c, 0x06, 0x21,
-	0xba, 0x0f, 0x85, 0x64, 0x7c, 0x92, 0xdc, 0x6b, 0x5b, 0x1d, 0x85, 0x57, 0x76, 0x74, 0x0f, 0x72,
-	0xba, 0xac, 0x04, 0xe7, 0xab, 0xab, 0x22, 0xd5, 0x1c, 0xe8, 0x11, 0xc7, 0xc6, 0x8e, 0xbe, 0x84,
-	0x04, 0x6c, 0x5f, 0x2e, 0x63, 0xa2, 0xd1, 0xad, 0xee, 0xd4, 0xb7, 0x69, 0x19, 0x2f, 0x63, 0x82,
-	0x41, 0xae, 0xd7, 0x8a, 0xf5, 0xd7, 0x64, 0x29, 0xe2, 0x60, 0x42, 0x7c, 0x7d, 0x28, 0xea, 0xc3,
-	0xcb, 0xc1, 0x95, 0x95, 0x56, 0xb7, 0x52, 0xfa, 0x70, 0x2b, 0x5c, 0xe4, 0x70, 0x7b, 0x92, 0x2d,
-	0xe6, 0xdc, 0x7c, 0xeb, 0x17, 0x0b, 0x6a, 0x6b, 0xa4, 0x44, 0xcc, 0x22, 0xa1, 0xfe, 0x98, 0x23,
-	0x9c, 0x33, 0xbe, 0x05, 0x13, 0x3e, 0xec, 0xf5, 0x95, 0x1a, 0x1b, 0xeb, 0xbb, 0x60, 0xf4, 0x00,
-	0xf2, 0x9c, 0x88, 0xf9, 0x4c, 0x26, 0x20, 0xa1, 0xf4, 0x11, 0x88, 0xb5, 0x05, 0x27, 0x1e, 0xad,
-	0xbf, 0x32, 0x70, 0x2d, 0xc9, 0x68, 0x2f, 0x90, 0x93, 0x57, 0x97, 0x4e, 0xe0, 0xa7, 0x50, 0x50,
-	0xd9, 0x50, 0xa2, 0x46, 0xc5, 0x3e, 0x9f, 0xc2, 0x95, 0xc7, 0x07, 0x90, 0x18, 0x88, 0x33, 0x77,
-	0x65, 0xce, 0xdc, 0x95, 0x81, 0x48, 0xdf, 0x95, 0x97, 0xc4, 0x75, 0xeb, 0x57, 0x0b, 0xea, 0x67,
-	0x31, 0xbd, 0x34, 0xaa, 0x3f, 0x87, 0x82, 0x21, 0x72, 0x85, 0xe6, 0x8d, 0x24, 0x37, 0x43, 0xf3,
-	0x73, 0x2a, 0x5f, 0x99, 0xd0, 0x2b, 0x37, 0x35, 0xac, 0xf5, 0x91, 0xe4, 0x24, 0x38, 0xf9, 0xa0,
-	0x91, 0x5d, 0xcf, 0x61, 0xe6, 0xdd, 0xe6, 0xd0, 0x7e, 0xef, 0x39, 0xcc, 0xbe, 0x85, 0x9b, 0xdc,
-	0x85, 0x1e, 0x19, 0x29, 0x6c, 0xf3, 0xff, 0x8d, 0x6d, 0xab, 0x07, 0xd7, 0xb7, 0x80, 0x4a, 0x68,
-	0xdc, 0xcc, 0x97, 0xf5, 0xd6, 0xf9, 0xfa, 0x09, 0x6e, 0x62, 0x22, 0xd8, 0x6c, 0x41, 0x52, 0x9d,
-	0xf7, 0x7e, 0x90, 0x23, 0xc8, 0x86, 0x32, 0xb9, 0x86, 0x1c, 0xac, 0xd7, 0xad, 0x5b, 0xd0, 0x38,
-	0x2f, 0xbc, 0x49, 0xb4, 0xf5, 0xa7, 0x05, 0xd5, 0x63, 0x53, 0xc3, 0xfb, 0xfd, 0x72, 0x8b, 0xbc,
-	0xcc, 0x05, 0xc9, 0xbb, 0x07, 0xb9, 0xc5, 0x54, 0xa5, 0xba, 0x3a, 0xa4, 0x53, 0x6f, 0xe0, 0xe3,
-	0xc7, 0x92, 0x86, 0xd8, 0xd8, 0x15, 0x92, 0x2f, 0xe9, 0x4c, 0x12, 0xae, 0xd9, 0x55, 0x48, 0xa6,
-	0x3c, 0x1f, 0x69, 0x0b, 0x4e, 0x3c, 0x5a, 0xdf, 0x42, 0x6d, 0x5d, 0xcb, 0x86, 0x08, 0xb2, 0x20,
-	0xea, 0x81, 0x60, 0xe9, 0xe6, 0x3f, 0xb3, 0xfd, 0xb8, 0xaf, 0x4c, 0x38, 0xf1, 0x78, 0xb0, 0x0f,
-	0xb5, 0xad, 0xd7, 0x23, 0xaa, 0x41, 0xe9, 0xe8, 0xd9, 0xe8, 0xb0, 0xdf, 0x1b, 0x3c, 0x1a, 0xf4,
-	0xf7, 0xdd, 0x2b, 0x08, 0x20, 0x3f, 0x1a, 0x3c, 0x7b, 0xfc, 0xb4, 0xef, 0x5a, 0xc8, 0x81, 0xdc,
-	0xc1, 0xd1, 0xd3, 0xf1, 0xc0, 0xcd, 0xa8, 0xe5, 0xf8, 0xf9, 0xf0, 0xb0, 0xe7, 0xda, 0x0f, 0xbe,
-	0x81, 0x52, 0x4f, 0xbf, 0x81, 0x87, 0x3c, 0x24, 0x5c, 0x6d, 0x78, 0x36, 0xc4, 0x07, 0xbb, 0x4f,
-	0xdd, 0x2b, 0xa8, 0x00, 0xf6, 0x21, 0x56, 0x3b, 0x8b, 0x90, 0x3d, 0x1c, 0x8e, 0xc6, 0x6e, 0x06,
-	0x55, 0x01, 0x76, 0x8f, 0xc6, 0xc3, 0xde, 0xf0, 0xe0, 0x60, 0x30, 0x76, 0xed, 0xbd, 0xaf, 0xa0,
-	0x46, 0x59, 0x67, 0x41, 0x25, 0x11, 0xc2, 0x3c, 0xf1, 0x7f, 0xb8, 0x9d, 0x48, 0x94, 0x75, 0xcd,
-	0xaa, 0x3b, 0x65, 0xdd, 0x85, 0xec, 0x6a, 0x6b, 0xd7, 0xb4, 0xe6, 0x8b, 0xbc, 0x96, 0xbe, 0xf8,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x94, 0x91, 0x45, 0x62, 0x0c, 0x00, 0x00,
+	// 1288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x13, 0x47,
+	0x14, 0x66, 0xfd, 0xef, 0xe3, 0xbf, 0x65, 0x30, 0xb0, 0xb8, 0x69, 0x6b, 0x19, 0x10, 0x0e, 0x45,
+	0x71, 0x95, 0xaa, 0x15, 0xaa, 0x5a, 0xa1, 0xc4, 0x31, 0xc8, 0x88, 0xe0, 0x74, 0xec, 0x24, 0x52,
+	0xd5, 0x6a, 0xb5, 0x78, 0x27, 0x66, 0x84, 0xb3, 0xb3, 0xcc, 0x8c, 0x9d, 0xfa, 0x15, 0x7a, 0xd3,
+	0xfb, 0xbe, 0x40, 0x1f, 0xa1, 0xef, 0xd0, 0xbb, 0xbe, 0x51, 0x35, 0x33, 0xeb, 0xf5, 0xc6, 0xa4,
+	0x10, 0x40, 0xdc, 0x58, 0x3b, 0xe7, 0x7c, 0x73, 0xe6, 0xcc, 0xf7, 0xcd, 0x39, 0x33, 0x86, 0xf2,
+	0x5c, 0x4e, 0x3c, 0x49, 0xb6, 0x42, 0xce, 0x24, 0x43, 0x39, 0x33, 0x6a, 0xd8, 0x2f, 0x68, 0x30,
+	0x65, 0x13, 0xdf, 0x93, 0x9e, 0xf1, 0x34, 0x4a, 0xaf, 0x67, 0x84, 0x2f, 0xa2, 0x41, 0x55, 0xb2,
+	0x90, 0x25, 0x9d, 0x73, 0xc9, 0xc3, 0xb1, 0x19, 0xb4, 0x7e, 0x2f, 0x43, 0x7e, 0x48, 0x84, 0xa0,
+	0x2c, 0x40, 0x77, 0xa1, 0x4a, 0x03, 0x57, 0x72, 0x2f, 0x10, 0xde, 0x58, 0x52, 0x16, 0x38, 0x56,
+	0xd3, 0x6a, 0x17, 0x70, 0x85, 0x06, 0xa3, 0x95, 0x11, 0x75, 0xa1, 0x2a, 0x5e, 0x7a, 0xdc, 0x77,
+	0x85, 0x99, 0x27, 0x9c, 0x54, 0x33, 0xdd, 0x2e, 0x6d, 0x6f, 0x6c, 0x45, 0xd9, 0x45, 0xf1, 0xb6,
+	0x86, 0x0a, 0x15, 0x0d, 0x70, 0x45, 0x24, 0x46, 0x02, 0x7d, 0x01, 0xe0, 0xcd, 0x24, 0x1b, 0xb3,
+	0xd3, 0x53, 0x2a, 0x9d, 0x8c, 0x5e, 0x27, 0x61, 0x41, 0xb7, 0xa1, 0x22, 0x3d, 0x3e, 0x21, 0xd2,
+	0x15, 0x92, 0xd3, 0x60, 0xe2, 0x64, 0x9b, 0x56, 0xbb, 0x88, 0xcb, 0xc6, 0x38, 0xd4, 0x36, 0xd4,
+	0x81, 0x3c, 0x0b, 0xa5, 0x4e, 0x21, 0xd7, 0xb4, 0xda, 0xa5, 0xed, 0xeb, 0x5b, 0x66, 0xe3, 0xbd,
+	0xdf, 0xc8, 0x78, 0x26, 0xc9, 0xc0, 0x38, 0xf1, 0x12, 0x85, 0x76, 0xc1, 0x4e, 0x6c, 0xcf, 0x3d,
+	0x65, 0x3e, 0x71, 0xf2, 0x4d, 0xab, 0x5d, 0xdd, 0xbe, 0xb9, 0x4c, 0x3e, 0xb1, 0xd3, 0x7d, 0xe6,
+	0x13, 0x5c, 0x93, 0xe7, 0x0d, 0xa8, 0x03, 0x85, 0x33, 0x8f, 0x07, 0x34, 0x98, 0x08, 0xa7, 0xa0,
+	0x37, 0x7e, 0x2d, 0x5a, 0xf5, 0x27, 0xf5, 0x7b, 0x6c, 0x7c, 0x38, 0x06, 0xa1, 0x47, 0x50, 0x0e,
+	0x39, 0x59, 0xb1, 0x55, 0xbc, 0x04, 0x5b, 0xa5, 0x90, 0x93, 0x98, 0xab, 0x1d, 0xa8, 0x84, 0x4c,
+	0xc8, 0x55, 0x04, 0xb8, 0x44, 0x84, 0xb2, 0x9a, 0x12, 0x87, 0xb8, 0x03, 0xd5, 0xa9, 0x27, 0xa4,
+	0x4b, 0x03, 0x41, 0xb8, 0x74, 0xa9, 0xef, 0x94, 0x9a, 0x56, 0x3b, 0x83, 0xcb, 0xca, 0xda, 0xd7,
+	0xc6, 0xbe, 0x8f, 0x3e, 0x07, 0x38, 0x61, 0xb3, 0xc0, 0x77, 0x39, 0x3b, 0x13, 0x4e, 0x59, 0x23,
+	0x8a, 0xda, 0x82, 0xd9, 0x99, 0x40, 0x2e, 0xdc, 0x98, 0x09, 0xc2, 0x5d, 0x9f, 0x9c, 0xd0, 0x80,
+	0xf8, 0xee, 0xdc, 0xe3, 0xd4, 0x7b, 0x31, 0x25, 0xc2, 0xa9, 0xe8, 0x84, 0x36, 0xd7, 0x13, 0x3a,
+	0x14, 0x84, 0xef, 0x19, 0xf0, 0xd1, 0x12, 0xdb, 0x0b, 0x24, 0x5f, 0xe0, 0xfa, 0xec, 0x02, 0x17,
+	0x1a, 0x80, 0x2d, 0x16, 0x42, 0x92, 0xd3, 0x44, 0xe8, 0xaa, 0x0e, 0x7d, 0xe7, 0x8d, 0xbd, 0x6a,
+	0xdc, 0x5a, 0xd4, 0x9a, 0x38, 0x6f, 0x45, 0x9f, 0x41, 0x91, 0xb3, 0x33, 0x77, 0xcc, 0x66, 0x81,
+	0x74, 0x6a, 0x4d, 0xab, 0x9d, 0xc6, 0x05, 0xce, 0xce, 0xba, 0x6a, 0xac, 0x8e, 0xa0, 0xf0, 0xe6,
+	0x24, 0x64, 0x34, 0x90, 0xc2, 0xb1, 0x9b, 0xe9, 0x76, 0x11, 0x27, 0x2c, 0xa8, 0x0d, 0x36, 0x0d,
+	0x5c, 0x4e, 0x04, 0xe1, 0x73, 0xe2, 0xbb, 0x63, 0x16, 0x04, 0xce, 0x55, 0x7d, 0x50, 0xab, 0x34,
+	0xc0, 0x91, 0xb9, 0xcb, 0x82, 0x40, 0x09, 0x34, 0x65, 0xe3, 0x57, 0x2b, 0x81, 0xd0, 0x65, 0x04,
+	0x52, 0x53, 0x62, 0x81, 0x1e, 0xc1, 0x06, 0x27, 0x9e, 0xef, 0x7a, 0x27, 0x92, 0x70, 0xf7, 0x8c,
+	0x53, 0x49, 0xd4, 0x8a, 0x82, 0x0a, 0x49, 0x82, 0xf1, 0xc2, 0xb9, 0xa6, 0x17, 0xbe, 0xa5, 0x30,
+	0x3b, 0x0a, 0x72, 0xac, 0x10, 0xdd, 0x15, 0x00, 0x51, 0x68, 0xbc, 0x11, 0x20, 0x64, 0x82, 0x9a,
+	0xf2, 0xa8, 0xeb, 0x84, 0x1e, 0xac, 0x27, 0x84, 0xcf, 0x85, 0x3b, 0x58, 0xc2, 0x0d, 0x9b, 0x37,
+	0xf9, 0xc5, 0x5e, 0xb4, 0x09, 0xb6, 0x24, 0xa7, 0x21, 0xe3, 0x1e, 0x5f, 0xb8, 0xd2, 0xc8, 0x74,
+	0x5d, 0xd3, 0x57, 0x8b, 0xed, 0x23, 0x6d, 0x6e, 0xfc, 0x6d, 0x41, 0x39, 0xb9, 0x6b, 0x74, 0x17,
+	0x72, 0xa6, 0x84, 0x75, 0x6f, 0x29, 0x6d, 0x57, 0xa2, 0xda, 0x19, 0x69, 0x23, 0x8e, 0x9c, 0xaa,
+	0x15, 0x25, 0x0b, 0x95, 0xfa, 0x4e, 0x4a, 0xab, 0x57, 0x49, 0x58, 0xfb, 0x3e, 0x7a, 0x08, 0x65,
+	0xbd, 0xbe, 0x74, 0xbd, 0x29, 0xf5, 0x84, 0x93, 0x8e, 0xba, 0x40, 0xdc, 0xf1, 0x74, 0x1a, 0x72,
+	0x47, 0x39, 0x71, 0x49, 0xae, 0x06, 0xe8, 0x4b, 0x28, 0xc5, 0xca, 0x52, 0x5f, 0x37, 0xa0, 0x34,
+	0x86, 0xa5, 0xa9, 0xef, 0x37, 0x7e, 0x81, 0x5b, 0xff, 0x7b, 0x7c, 0x91, 0x0d, 0xe9, 0x57, 0x64,
+	0xa1, 0xb7, 0x50, 0xc4, 0xea, 0x13, 0x6d, 0x42, 0x76, 0xee, 0x4d, 0x67, 0x44, 0xe7, 0xb9, 0x6a,
+	0x09, 0xbb, 0x34, 0x88, 0xe7, 0x62, 0x83, 0xf8, 0x3e, 0xf5, 0xd0, 0x6a, 0xec, 0x42, 0xfd, 0xa2,
+	0x13, 0x7c, 0x41, 0xe0, 0x7a, 0x32, 0x70, 0x31, 0x19, 0xe3, 0x29, 0x6c, 0xbc, 0x4d, 0xbf, 0xf7,
+	0x89, 0xf5, 0x34, 0x53, 0x48, 0xdb, 0x99, 0xd6, 0x5f, 0x29, 0xa8, 0x46, 0xad, 0x13, 0x93, 0xd7,
+	0x33, 0x22, 0x24, 0x7a, 0x00, 0xc5, 0xb1, 0x37, 0x9d, 0x12, 0xae, 0x58, 0x32, 0x92, 0xd5, 0xb6,
+	0xcc, 0x05, 0xd2, 0xd5, 0xf6, 0xfe, 0x1e, 0x2e, 0x18, 0x44, 0xdf, 0x47, 0x9b, 0x90, 0x8f, 0x6a,
+	0xc0, 0x49, 0xc5, 0xd8, 0xe4, 0x89, 0xc3, 0x4b, 0x3f, 0xba, 0x07, 0x59, 0x4d, 0x51, 0xa4, 0xd9,
+	0xd5, 0x25, 0x61, 0xaa, 0xdb, 0xe8, 0x46, 0x8a, 0x8d, 0x1f, 0x7d, 0x0b, 0x91, 0x70, 0xae, 0x5c,
+	0x84, 0x44, 0x2b, 0x55, 0xdd, 0xae, 0xaf, 0x4b, 0x3c, 0x5a, 0x84, 0x04, 0x83, 0x8c, 0xbf, 0xd5,
+	0x09, 0x7a, 0x45, 0x16, 0x22, 0xf4, 0xc6, 0xc4, 0xd5, 0x57, 0x8f, 0xbe, 0x22, 0x8a, 0xb8, 0xb2,
+	0xb4, 0xea, 0x63, 0x99, 0xbc, 0x42, 0xf2, 0x97, 0xb9, 0x42, 0x9e, 0x66, 0x0a, 0x59, 0x3b, 0xd7,
+	0xfa, 0xc3, 0x82, 0x5a, 0xcc, 0x94, 0x08, 0x59, 0x20, 0xd4, 0x8a, 0x59, 0xc2, 0x39, 0xe3, 0x6b,
+	0x34, 0xe1, 0x83, 0x6e, 0x4f, 0x99, 0xb1, 0xf1, 0xbe, 0x0f, 0x47, 0xf7, 0x21, 0xc7, 0x89, 0x98,
+	0x4d, 0x65, 0x44, 0x12, 0x4a, 0x5e, 0x34, 0x58, 0x7b, 0x70, 0x84, 0x68, 0xfd, 0x9b, 0x82, 0x6b,
+	0x51, 0x46, 0xbb, 0x9e, 0x1c, 0xbf, 0xfc, 0xe4, 0x02, 0x7e, 0x05, 0x79, 0x95, 0x0d, 0x25, 0xaa,
+	0xec, 0xd2, 0x17, 0x4b, 0xb8, 0x44, 0x7c, 0x84, 0x88, 0x9e, 0x38, 0xf7, 0x22, 0xc9, 0x9a, 0x17,
+	0x89, 0x27, 0x92, 0x2f, 0x92, 0x4f, 0xa4, 0x75, 0xeb, 0x4f, 0x0b, 0xea, 0xe7, 0x39, 0xfd, 0x64,
+	0x52, 0x7f, 0x0d, 0x79, 0x23, 0xe4, 0x92, 0xcd, 0x1b, 0x51, 0x6e, 0x46, 0xe6, 0x63, 0x2a, 0x5f,
+	0x9a, 0xd0, 0x4b, 0x98, 0x2a, 0xd6, 0xfa, 0x50, 0x72, 0xe2, 0x9d, 0x7e, 0x54, 0xc9, 0xc6, 0x75,
+	0x98, 0x7a, 0xbf, 0x3a, 0x4c, 0x7f, 0x70, 0x1d, 0x66, 0xde, 0xa1, 0x4d, 0xf6, 0x52, 0x4f, 0xb9,
+	0x04, 0xb7, 0xb9, 0xb7, 0x73, 0xdb, 0xea, 0xc2, 0xf5, 0x35, 0xa2, 0x22, 0x19, 0x57, 0xf5, 0x65,
+	0xbd, 0xb3, 0xbe, 0x7e, 0x85, 0x5b, 0x98, 0x08, 0x36, 0x9d, 0x93, 0xc4, 0xc9, 0xfb, 0x30, 0xca,
+	0x11, 0x64, 0x7c, 0x19, 0x5d, 0x69, 0x45, 0xac, 0xbf, 0x5b, 0x1b, 0xd0, 0xb8, 0x28, 0xbc, 0x49,
+	0xb4, 0xf5, 0x8f, 0x05, 0xd5, 0x23, 0xb3, 0x87, 0x0f, 0x5b, 0x72, 0x4d, 0xbc, 0xd4, 0x25, 0xc5,
+	0xbb, 0x07, 0xd9, 0xf9, 0x44, 0xa5, 0xba, 0x6c, 0xd2, 0x89, 0x7f, 0x1a, 0x47, 0x4f, 0x24, 0xf5,
+	0xb1, 0xf1, 0x2b, 0x26, 0x4f, 0xe8, 0x54, 0x12, 0xee, 0x64, 0x22, 0x26, 0x13, 0xc8, 0xc7, 0xda,
+	0x83, 0x23, 0x44, 0xeb, 0x47, 0xa8, 0xc5, 0x7b, 0x59, 0x09, 0x41, 0xe6, 0x44, 0x3d, 0xc3, 0xac,
+	0x66, 0x7a, 0x7d, 0xfa, 0x51, 0x4f, 0xb9, 0x70, 0x84, 0xb8, 0xbf, 0x07, 0xb5, 0xb5, 0x37, 0x3a,
+	0xaa, 0x41, 0xe9, 0xf0, 0xf9, 0xf0, 0xa0, 0xd7, 0xed, 0x3f, 0xee, 0xf7, 0xf6, 0xec, 0x2b, 0x08,
+	0x20, 0x37, 0xec, 0x3f, 0x7f, 0xf2, 0xac, 0x67, 0x5b, 0xa8, 0x08, 0xd9, 0xfd, 0xc3, 0x67, 0xa3,
+	0xbe, 0x9d, 0x52, 0x9f, 0xa3, 0xe3, 0xc1, 0x41, 0xd7, 0x4e, 0xdf, 0xff, 0x01, 0x4a, 0x5d, 0xfd,
+	0x4f, 0x63, 0xc0, 0x7d, 0xc2, 0xd5, 0x84, 0xe7, 0x03, 0xbc, 0xbf, 0xf3, 0xcc, 0xbe, 0x82, 0xf2,
+	0x90, 0x3e, 0xc0, 0x6a, 0x66, 0x01, 0x32, 0x07, 0x83, 0xe1, 0xc8, 0x4e, 0xa1, 0x2a, 0xc0, 0xce,
+	0xe1, 0x68, 0xd0, 0x1d, 0xec, 0xef, 0xf7, 0x47, 0x76, 0x7a, 0xf7, 0x3b, 0xa8, 0x51, 0xb6, 0x35,
+	0xa7, 0x92, 0x08, 0x61, 0xfe, 0x48, 0xfd, 0x7c, 0x3b, 0x1a, 0x51, 0xd6, 0x31, 0x5f, 0x9d, 0x09,
+	0xeb, 0xcc, 0x65, 0x47, 0x7b, 0x3b, 0xe6, 0x68, 0xbe, 0xc8, 0xe9, 0xd1, 0x37, 0xff, 0x0d, 0x00,
+	0xa0, 0x10, 0xe0, 0xa0, 0xc8, 0x0d, 0x00, 0x00,
 }
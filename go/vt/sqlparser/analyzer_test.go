@@ -35,6 +35,7 @@ func TestPreview(t *testing.T) {
 		{"    select ...", StmtSelect},
 		{"(select ...", StmtSelect},
 		{"( select ...", StmtSelect},
+		{"with t as (select ...) select ...", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
 		{"   update ...", StmtUpdate},
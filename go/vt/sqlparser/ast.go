@@ -56,6 +56,7 @@ type (
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With             *With
 		Cache            *bool // a reference here so it can be nil
 		Distinct         bool
 		StraightJoinHint bool
@@ -66,6 +67,7 @@ type (
 		Where            *Where
 		GroupBy          GroupBy
 		Having           *Where
+		Windows          NamedWindows
 		OrderBy          OrderBy
 		Limit            *Limit
 		Lock             string
@@ -79,6 +81,7 @@ type (
 
 	// Union represents a UNION statement.
 	Union struct {
+		With           *With
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
 		OrderBy        OrderBy
@@ -86,6 +89,20 @@ type (
 		Lock           string
 	}
 
+	// With represents a WITH clause with non-recursive
+	// common table expressions.
+	With struct {
+		CTEs []*CommonTableExpr
+	}
+
+	// CommonTableExpr represents a common table expression
+	// of a WITH clause: name [(col, ...)] AS (subquery).
+	CommonTableExpr struct {
+		Name     TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
+
 	// Stream represents a SELECT statement.
 	Stream struct {
 		Comments   Comments
@@ -98,8 +115,8 @@ type (
 	// Replace is the counterpart to `INSERT IGNORE`, and works exactly like a
 	// normal INSERT except if the row exists. In that case it first deletes
 	// the row and re-inserts with new values. For that reason we keep it as an Insert struct.
-	// Replaces are currently disallowed in sharded schemas because
-	// of the implications the deletion part may have on vindexes.
+	// In sharded schemas, the rows that a Replace deletes are looked up
+	// first, so that their vindex entries can be deleted too.
 	// If you add fields here, consider adding them to calls to validateUnshardedRoute.
 	Insert struct {
 		Action     string
@@ -168,11 +185,12 @@ type (
 
 	// DBDDL represents a CREATE, DROP, or ALTER database statement.
 	DBDDL struct {
-		Action   string
-		DBName   string
-		IfExists bool
-		Collate  string
-		Charset  string
+		Action      string
+		DBName      string
+		IfExists    bool
+		IfNotExists bool
+		Collate     string
+		Charset     string
 	}
 
 	// DDL represents a CREATE, ALTER, DROP, RENAME, TRUNCATE or ANALYZE statement.
@@ -188,6 +206,9 @@ type (
 		// Table is set if Action is other than RenameStr or DropStr.
 		Table TableName
 
+		// Temporary is set for CREATE TEMPORARY TABLE and DROP TEMPORARY TABLE.
+		Temporary bool
+
 		// The following fields are set if a DDL was fully analyzed.
 		IfExists      bool
 		TableSpec     *TableSpec
@@ -688,6 +709,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -695,6 +717,8 @@ type (
 		Distinct  string
 		Exprs     SelectExprs
 		OrderBy   OrderBy
+		// Separator is the string between the concatenated
+		// values, which is ',' if it's not specified.
 		Separator string
 		Limit     *Limit
 	}
@@ -823,6 +847,46 @@ type Order struct {
 	Direction string
 }
 
+// OverClause represents the OVER clause of a window function.
+// It either references a named window, or specifies the window.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents a window specification:
+// [window_name] [PARTITION BY ...] [ORDER BY ...] [frame_clause]
+type WindowSpecification struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil if the frame only specifies its start.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FramePoint represents the start or the end of a frame.
+// Expr is only set for the offset based types.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// NamedWindows represents a WINDOW clause.
+type NamedWindows []*NamedWindow
+
+// NamedWindow represents a window defined in the WINDOW clause.
+type NamedWindow struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
@@ -891,10 +955,10 @@ func (node *Select) Format(buf *TrackedBuffer) {
 	addIf(node.StraightJoinHint, StraightJoinHint)
 	addIf(node.SQLCalcFoundRows, SQLCalcFoundRowsStr)
 
-	buf.astPrintf(node, "select %v%s%v from %v%v%v%v%v%v%s",
-		node.Comments, options, node.SelectExprs,
+	buf.astPrintf(node, "%vselect %v%s%v from %v%v%v%v%v%v%v%s",
+		node.With, node.Comments, options, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
@@ -905,13 +969,31 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v", node.FirstStatement)
+	buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 	for _, us := range node.UnionSelects {
 		buf.astPrintf(node, "%v", us)
 	}
 	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock)
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	prefix := "with "
+	for _, cte := range node.CTEs {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *UnionSelect) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, " %s %v", node.Type, node.Statement)
@@ -971,7 +1053,13 @@ func (node *SetTransaction) Format(buf *TrackedBuffer) {
 // Format formats the node.
 func (node *DBDDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
-	case CreateStr, AlterStr:
+	case CreateStr:
+		notExists := ""
+		if node.IfNotExists {
+			notExists = " if not exists"
+		}
+		buf.WriteString(fmt.Sprintf("%s database%s %s", node.Action, notExists, node.DBName))
+	case AlterStr:
 		buf.WriteString(fmt.Sprintf("%s database %s", node.Action, node.DBName))
 	case DropStr:
 		exists := ""
@@ -986,19 +1074,27 @@ func (node *DBDDL) Format(buf *TrackedBuffer) {
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
+		temp := ""
+		if node.Temporary {
+			temp = " temporary"
+		}
 		if node.OptLike != nil {
-			buf.astPrintf(node, "%s table %v %v", node.Action, node.Table, node.OptLike)
+			buf.astPrintf(node, "%s%s table %v %v", node.Action, temp, node.Table, node.OptLike)
 		} else if node.TableSpec != nil {
-			buf.astPrintf(node, "%s table %v %v", node.Action, node.Table, node.TableSpec)
+			buf.astPrintf(node, "%s%s table %v %v", node.Action, temp, node.Table, node.TableSpec)
 		} else {
-			buf.astPrintf(node, "%s table %v", node.Action, node.Table)
+			buf.astPrintf(node, "%s%s table %v", node.Action, temp, node.Table)
 		}
 	case DropStr:
+		temp := ""
+		if node.Temporary {
+			temp = " temporary"
+		}
 		exists := ""
 		if node.IfExists {
 			exists = " if exists"
 		}
-		buf.astPrintf(node, "%s table%s %v", node.Action, exists, node.FromTables)
+		buf.astPrintf(node, "%s%s table%s %v", node.Action, temp, exists, node.FromTables)
 	case RenameStr:
 		buf.astPrintf(node, "%s table %v to %v", node.Action, node.FromTables[0], node.ToTables[0])
 		for i := 1; i < len(node.FromTables); i++ {
@@ -1267,7 +1363,7 @@ func (f *ForeignKeyDefinition) Format(buf *TrackedBuffer) {
 // Format formats the node.
 func (node *Show) Format(buf *TrackedBuffer) {
 	nodeType := strings.ToLower(node.Type)
-	if (nodeType == "tables" || nodeType == "columns" || nodeType == "fields" || nodeType == "index" || nodeType == "keys" || nodeType == "indexes") && node.ShowTablesOpt != nil {
+	if (nodeType == "tables" || nodeType == "columns" || nodeType == "fields" || nodeType == "index" || nodeType == "keys" || nodeType == "indexes" || nodeType == "table status") && node.ShowTablesOpt != nil {
 		opt := node.ShowTablesOpt
 		if node.Extended != "" {
 			buf.astPrintf(node, "show %s%s", node.Extended, nodeType)
@@ -1686,12 +1782,17 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	} else {
 		buf.WriteString(funcName)
 	}
-	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	buf.astPrintf(node, "(%s%v)%v", distinct, node.Exprs, node.Over)
 }
 
 // Format formats the node
 func (node *GroupConcatExpr) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "group_concat(%s%v%v%s%v)", node.Distinct, node.Exprs, node.OrderBy, node.Separator, node.Limit)
+	buf.astPrintf(node, "group_concat(%s%v%v", node.Distinct, node.Exprs, node.OrderBy)
+	if node.Separator != "," {
+		buf.WriteString(" separator ")
+		sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(node.Separator)).EncodeSQL(buf)
+	}
+	buf.astPrintf(node, "%v)", node.Limit)
 }
 
 // Format formats the node.
@@ -1793,6 +1894,76 @@ func (node OrderBy) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.astPrintf(node, " over %v", node.WindowName)
+		return
+	}
+	buf.astPrintf(node, " over (%v)", node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionBy) != 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) != 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		for i, order := range node.OrderBy {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", order)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.Frame)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr == nil {
+		buf.astPrintf(node, "%s", node.Type)
+		return
+	}
+	buf.astPrintf(node, "%v %s", node.Expr, node.Type)
+}
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
 // Format formats the node.
 func (node *Order) Format(buf *TrackedBuffer) {
 	if node, ok := node.Expr.(*NullVal); ok {
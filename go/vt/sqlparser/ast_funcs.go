@@ -498,7 +498,8 @@ var Aggregates = map[string]bool{
 
 // IsAggregate returns true if the function is an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	// An aggregate function with an OVER clause is a window function.
+	return Aggregates[node.Name.Lowered()] && node.Over == nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -795,7 +796,12 @@ func Unionize(lhs, rhs SelectStatement, typ string, by OrderBy, limit *Limit, lo
 		return union
 	}
 
-	return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{{Type: typ, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
+	// A WITH clause that precedes the first select applies to the entire union.
+	var with *With
+	if sel, ok := lhs.(*Select); ok {
+		with, sel.With = sel.With, nil
+	}
+	return &Union{With: with, FirstStatement: lhs, UnionSelects: []*UnionSelect{{Type: typ, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
 }
 
 // AtCount represents the '@' count in ColIdent
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneSQLNode(t *testing.T) {
+	queries := []string{
+		"select a, b as c, count(*) from t as x join u on x.id = u.id where x.c in (1, 'a', :v) and exists (select 1 from dual) group by a having count(*) > 1 order by b desc limit 10 for update",
+		"with x (a) as (select 1 from dual) select * from x union all (select a from y order by a limit 2)",
+		"select group_concat(distinct a order by a asc separator ';'), case a when 1 then 'x' else null end from t",
+		"insert into t(a, b) values (1, 0x12), (2, 'b') on duplicate key update b = values(b)",
+		"update t set a = a + 1 where b between 1 and 3",
+	}
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			stmt, err := Parse(query)
+			require.NoError(t, err)
+			want := String(stmt)
+			clone := CloneSQLNode(stmt)
+			assert.Equal(t, stmt, clone)
+			assert.Equal(t, String(stmt), String(clone))
+
+			// Changing the clone leaves the original unchanged.
+			_ = Rewrite(clone, func(cursor *Cursor) bool {
+				switch node := cursor.Node().(type) {
+				case *ColName:
+					node.Name = NewColIdent("changed")
+				case *SQLVal:
+					node.Val[0] = 'z'
+				}
+				return true
+			}, nil)
+			assert.Equal(t, want, String(stmt))
+		})
+	}
+}
+
+func TestCloneSQLNodeMetadata(t *testing.T) {
+	col := &ColName{Name: NewColIdent("a"), Metadata: 1}
+	clone := CloneSQLNode(col).(*ColName)
+	assert.Nil(t, clone.Metadata)
+	assert.Equal(t, "a", clone.Name.String())
+	assert.Nil(t, CloneSQLNode(nil))
+}
@@ -34,6 +34,9 @@ const (
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
 	// DirectiveIgnoreMaxPayloadSize skips payload size validation when set.
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
+	// DirectiveAllowHashJoin lets vtgate perform the cross-shard joins
+	// of a select as hash joins, which load a side in memory.
+	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
 )
 
 func isNonSpace(r rune) bool {
@@ -86,6 +86,17 @@ const (
 	WhereStr  = "where"
 	HavingStr = "having"
 
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+
 	// ComparisonExpr.Operator
 	EqualStr             = "="
 	LessThanStr          = "<"
@@ -24,9 +24,23 @@ import (
 
 var ExprNotSupported = fmt.Errorf("Expr Not Supported")
 
-//Convert converts between AST expressions and executable expressions
-func Convert(e Expr) (evalengine.Expr, error) {
+// ColumnLookup translates a column reference into the offset of the
+// column in the rows that the converted expression will be evaluated on.
+type ColumnLookup func(col *ColName) (int, error)
+
+//Convert converts between AST expressions and executable expressions.
+//Column references can only be converted if a ColumnLookup is provided.
+func Convert(e Expr, lookup ColumnLookup) (evalengine.Expr, error) {
 	switch node := e.(type) {
+	case *ColName:
+		if lookup == nil {
+			return nil, ExprNotSupported
+		}
+		offset, err := lookup(node)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.Column{Offset: offset}, nil
 	case *SQLVal:
 		switch node.Type {
 		case IntVal:
@@ -38,6 +52,13 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		case StrVal:
 			return evalengine.NewLiteralString(node.Val)
 		}
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
+	case BoolVal:
+		if node {
+			return evalengine.NewLiteralInt([]byte("1"))
+		}
+		return evalengine.NewLiteralInt([]byte("0"))
 	case *BinaryExpr:
 		var op evalengine.BinaryExpr
 		switch node.Operator {
@@ -52,20 +73,182 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		default:
 			return nil, ExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		return convertBinaryOp(op, node.Left, node.Right, lookup)
+	case *ComparisonExpr:
+		var op evalengine.BinaryExpr
+		switch node.Operator {
+		case EqualStr:
+			op = &evalengine.Equals{}
+		case NotEqualStr:
+			op = &evalengine.NotEquals{}
+		case NullSafeEqualStr:
+			op = &evalengine.NullSafeEquals{}
+		case LessThanStr:
+			op = &evalengine.LessThan{}
+		case LessEqualStr:
+			op = &evalengine.LessEqualThan{}
+		case GreaterThanStr:
+			op = &evalengine.GreaterThan{}
+		case GreaterEqualStr:
+			op = &evalengine.GreaterEqualThan{}
+		case InStr, NotInStr:
+			return convertIn(node, lookup)
+		default:
+			return nil, ExprNotSupported
+		}
+		return convertBinaryOp(op, node.Left, node.Right, lookup)
+	case *AndExpr:
+		return convertBinaryOp(&evalengine.And{}, node.Left, node.Right, lookup)
+	case *OrExpr:
+		return convertBinaryOp(&evalengine.Or{}, node.Left, node.Right, lookup)
+	case *NotExpr:
+		inner, err := Convert(node.Expr, lookup)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		return &evalengine.Not{Inner: inner}, nil
+	case *IsExpr:
+		check, ok := isChecks[node.Operator]
+		if !ok {
+			return nil, ExprNotSupported
+		}
+		inner, err := Convert(node.Expr, lookup)
 		if err != nil {
 			return nil, err
 		}
-		return &evalengine.BinaryOp{
-			Expr:  op,
-			Left:  left,
-			Right: right,
-		}, nil
-
+		return &evalengine.Is{Inner: inner, Check: check}, nil
+	case *RangeCond:
+		return convertRange(node, lookup)
+	case *CaseExpr:
+		return convertCase(node, lookup)
+	case *FuncExpr:
+		return convertFunc(node, lookup)
 	}
 	return nil, ExprNotSupported
 }
+
+var isChecks = map[string]evalengine.IsCheck{
+	IsNullStr:     evalengine.IsNull,
+	IsNotNullStr:  evalengine.IsNotNull,
+	IsTrueStr:     evalengine.IsTrue,
+	IsNotTrueStr:  evalengine.IsNotTrue,
+	IsFalseStr:    evalengine.IsFalse,
+	IsNotFalseStr: evalengine.IsNotFalse,
+}
+
+// convertIn converts an IN or NOT IN comparison with a list of
+// values. Comparisons with subqueries or list arguments can't be
+// converted.
+func convertIn(node *ComparisonExpr, lookup ColumnLookup) (evalengine.Expr, error) {
+	tuple, ok := node.Right.(ValTuple)
+	if !ok {
+		return nil, ExprNotSupported
+	}
+	left, err := Convert(node.Left, lookup)
+	if err != nil {
+		return nil, err
+	}
+	list, err := convertExprs(Exprs(tuple), lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.In{
+		Left:   left,
+		List:   list,
+		Negate: node.Operator == NotInStr,
+	}, nil
+}
+
+// convertRange converts a BETWEEN into a conjunction of
+// comparisons, and a NOT BETWEEN into a disjunction.
+func convertRange(node *RangeCond, lookup ColumnLookup) (evalengine.Expr, error) {
+	var fromOp, toOp, combineOp evalengine.BinaryExpr = &evalengine.GreaterEqualThan{}, &evalengine.LessEqualThan{}, &evalengine.And{}
+	if node.Operator == NotBetweenStr {
+		fromOp, toOp, combineOp = &evalengine.LessThan{}, &evalengine.GreaterThan{}, &evalengine.Or{}
+	}
+	from, err := convertBinaryOp(fromOp, node.Left, node.From, lookup)
+	if err != nil {
+		return nil, err
+	}
+	to, err := convertBinaryOp(toOp, node.Left, node.To, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{Expr: combineOp, Left: from, Right: to}, nil
+}
+
+func convertCase(node *CaseExpr, lookup ColumnLookup) (evalengine.Expr, error) {
+	expr := &evalengine.Case{}
+	var err error
+	if node.Expr != nil {
+		if expr.Base, err = Convert(node.Expr, lookup); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, err := Convert(when.Cond, lookup)
+		if err != nil {
+			return nil, err
+		}
+		val, err := Convert(when.Val, lookup)
+		if err != nil {
+			return nil, err
+		}
+		expr.Whens = append(expr.Whens, evalengine.When{Cond: cond, Val: val})
+	}
+	if node.Else != nil {
+		if expr.Else, err = Convert(node.Else, lookup); err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
+
+// convertFunc converts a call to one of the functions
+// that are built into evalengine.
+func convertFunc(node *FuncExpr, lookup ColumnLookup) (evalengine.Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct || node.Over != nil || !evalengine.IsBuiltin(node.Name.String()) {
+		return nil, ExprNotSupported
+	}
+	var args Exprs
+	for _, selectExpr := range node.Exprs {
+		aliased, ok := selectExpr.(*AliasedExpr)
+		if !ok {
+			return nil, ExprNotSupported
+		}
+		args = append(args, aliased.Expr)
+	}
+	converted, err := convertExprs(args, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return evalengine.NewCall(node.Name.String(), converted)
+}
+
+func convertExprs(exprs Exprs, lookup ColumnLookup) ([]evalengine.Expr, error) {
+	converted := make([]evalengine.Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		c, err := Convert(expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, c)
+	}
+	return converted, nil
+}
+
+func convertBinaryOp(op evalengine.BinaryExpr, l, r Expr, lookup ColumnLookup) (evalengine.Expr, error) {
+	left, err := Convert(l, lookup)
+	if err != nil {
+		return nil, err
+	}
+	right, err := Convert(r, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{
+		Expr:  op,
+		Left:  left,
+		Right: right,
+	}, nil
+}
@@ -69,6 +69,180 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "42 = 42",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "42 != 42.0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":exp > 42",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "40+2 >= 42",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":uint64_bind_variable < 21",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":float_bind_variable <= 2.2",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":string_bind_variable = 'bar'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'10' > 9",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":null_bind_variable = 1",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: ":null_bind_variable <=> :null_bind_variable",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 < 2 and 2 < 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":null_bind_variable = 1 and 1 > 2",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":null_bind_variable = 1 or 1 < 2",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":null_bind_variable = 1 or 1 > 2",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "not 1 > 2",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "not :null_bind_variable",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: ":null_bind_variable is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is not null",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":null_bind_variable is not true",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "true is false",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":exp in (1, 66, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'2' in (1, 2.0)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":exp not in (1, 2)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":exp in (1, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: ":exp not in (66, null)",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":exp between 60 and 70",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":exp not between 60 and 70",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: ":exp not between :null_bind_variable and 60",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "case when 1 > 2 then 'a' when 2 > 1 then 'b' else 'c' end",
+		expected:   sqltypes.NewVarBinary("b"),
+	}, {
+		expression: "case :exp when 1 then 'a' end",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "case :null_bind_variable when null then 1 else 2 end",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "case when true then 1 else 'a' end",
+		expected:   sqltypes.NewVarBinary("1"),
+	}, {
+		expression: "case when true then 1 else 2.5 end",
+		expected:   sqltypes.NewFloat64(1),
+	}, {
+		expression: "concat('a', 1, 'b', 2.5)",
+		expected:   sqltypes.NewVarBinary("a1b2.5"),
+	}, {
+		expression: "concat('a', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "lower('AbC')",
+		expected:   sqltypes.NewVarBinary("abc"),
+	}, {
+		expression: "upper(:string_bind_variable)",
+		expected:   sqltypes.NewVarBinary("BAR"),
+	}, {
+		expression: "ifnull(:null_bind_variable, 42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "ifnull(1, 'a')",
+		expected:   sqltypes.NewVarBinary("1"),
+	}, {
+		expression: "coalesce(null, :null_bind_variable, :float_bind_variable, 1)",
+		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "round(2.5)",
+		expected:   sqltypes.NewFloat64(3),
+	}, {
+		expression: "round(2.5e0)",
+		expected:   sqltypes.NewFloat64(2),
+	}, {
+		expression: "round(3.5e0)",
+		expected:   sqltypes.NewFloat64(4),
+	}, {
+		expression: "round(1.005, 2)",
+		expected:   sqltypes.NewFloat64(1.01),
+	}, {
+		expression: "round(1.015, 2)",
+		expected:   sqltypes.NewFloat64(1.02),
+	}, {
+		expression: "round(0.285, 2)",
+		expected:   sqltypes.NewFloat64(0.29),
+	}, {
+		expression: "round(9.95, 1)",
+		expected:   sqltypes.NewFloat64(10),
+	}, {
+		expression: "round(1250.5, -2)",
+		expected:   sqltypes.NewFloat64(1300),
+	}, {
+		expression: "round(:decimal_bind_variable, 1)",
+		expected:   sqltypes.NewFloat64(-2.5),
+	}, {
+		expression: "round(3.14159, 2)",
+		expected:   sqltypes.NewFloat64(3.14),
+	}, {
+		expression: "round(1250, -2)",
+		expected:   sqltypes.NewInt64(1300),
+	}, {
+		expression: "round(:uint64_bind_variable, -1)",
+		expected:   sqltypes.NewUint64(20),
+	}, {
+		expression: "round('1.25', 1)",
+		expected:   sqltypes.NewFloat64(1.2),
+	}, {
+		expression: "date_format('2020-03-01 14:05:09.000012', '%W %M %D %Y %H:%i:%s.%f %p %j %%')",
+		expected:   sqltypes.NewVarBinary("Sunday March 1st 2020 14:05:09.000012 PM 061 %"),
+	}, {
+		expression: "date_format('2020-01-01', '%a %b %c %e %h %l %r %T %y')",
+		expected:   sqltypes.NewVarBinary("Wed Jan 1 1 12 12 12:00:00 AM 00:00:00 20"),
+	}, {
+		expression: "date_format('2021-01-02', '%U %u %V %X %v %x')",
+		expected:   sqltypes.NewVarBinary("00 00 52 2020 53 2020"),
+	}, {
+		expression: "date_format('2024-12-30', '%U %u %V %X %v %x')",
+		expected:   sqltypes.NewVarBinary("52 53 52 2024 01 2025"),
+	}, {
+		expression: "date_format('not a date', '%Y')",
+		expected:   sqltypes.NULL,
 	}}
 
 	for _, test := range tests {
@@ -77,15 +251,17 @@ func TestEvaluate(t *testing.T) {
 			stmt, err := Parse("select " + test.expression)
 			require.NoError(t, err)
 			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
-			sqltypesExpr, err := Convert(astExpr)
+			sqltypesExpr, err := Convert(astExpr, nil)
 			require.Nil(t, err)
 			require.NotNil(t, sqltypesExpr)
 			env := evalengine.ExpressionEnv{
 				BindVars: map[string]*querypb.BindVariable{
-					"exp":                  sqltypes.Int64BindVariable(66),
-					"string_bind_variable": sqltypes.StringBindVariable("bar"),
-					"uint64_bind_variable": sqltypes.Uint64BindVariable(22),
-					"float_bind_variable":  sqltypes.Float64BindVariable(2.2),
+					"exp":                   sqltypes.Int64BindVariable(66),
+					"string_bind_variable":  sqltypes.StringBindVariable("bar"),
+					"uint64_bind_variable":  sqltypes.Uint64BindVariable(22),
+					"float_bind_variable":   sqltypes.Float64BindVariable(2.2),
+					"decimal_bind_variable": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-2.45"))),
+					"null_bind_variable":    sqltypes.NullBindVariable,
 				},
 				Row: nil,
 			}
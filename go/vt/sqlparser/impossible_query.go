@@ -27,12 +27,15 @@ package sqlparser
 func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 	switch node := node.(type) {
 	case *Select:
-		buf.Myprintf("select %v from %v where 1 != 1", node.SelectExprs, node.From)
+		buf.Myprintf("%vselect %v from %v where 1 != 1", node.With, node.SelectExprs, node.From)
 		if node.GroupBy != nil {
 			node.GroupBy.Format(buf)
 		}
+		if node.Windows != nil {
+			node.Windows.Format(buf)
+		}
 	case *Union:
-		buf.astPrintf(node, "%v", node.FirstStatement)
+		buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 		for _, us := range node.UnionSelects {
 			buf.astPrintf(node, "%v", us)
 		}
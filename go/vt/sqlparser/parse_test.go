@@ -154,6 +154,47 @@ var (
 		input: "((select 1 from dual))",
 	}, {
 		input: "select 1 from (select 1 from dual) as t",
+	}, {
+		input: "with t as (select a from t1) select a from t",
+	}, {
+		input: "with t(b, c) as (select a, b from t1), s as (select b from t) select t.b, s.b from t join s on t.b = s.b",
+	}, {
+		input:  "with t as (select a from t1) select a from t order by a limit 1 for update",
+		output: "with t as (select a from t1) select a from t order by a asc limit 1 for update",
+	}, {
+		input: "with t as (select a from t1 union select b from t2) select a from t union select a from t",
+	}, {
+		input: "select * from t1 where a in (with t as (select b from t2) select b from t)",
+	}, {
+		input: "select a from (with t as (select a from t1) select a from t) as s",
+	}, {
+		input: "insert into t1(a) with t as (select b from t2) select b from t",
+	}, {
+		input:  "select with, t.with from t where with = 1 order by with",
+		output: "select `with`, t.`with` from t where `with` = 1 order by `with` asc",
+	}, {
+		input:  "insert into t(with) values (1)",
+		output: "insert into t(`with`) values (1)",
+	}, {
+		input:  "select a from ks.with",
+		output: "select a from ks.`with`",
+	}, {
+		input: "select row_number() over () from t",
+	}, {
+		input: "select a, rank() over (partition by b order by c desc) from t",
+	}, {
+		input:  "select sum(a) over (partition by b, c order by d rows between 1 preceding and current row) from t",
+		output: "select sum(a) over (partition by b, c order by d asc rows between 1 preceding and current row) from t",
+	}, {
+		input: "select sum(a) over (rows unbounded preceding) from t",
+	}, {
+		input: "select sum(a) over (range between unbounded preceding and unbounded following) from t",
+	}, {
+		input: "select sum(a) over (order by b asc range between interval 1 day preceding and :c following) from t",
+	}, {
+		input: "select sum(a) over w from t window w as (partition by b)",
+	}, {
+		input: "select sum(a) over (w order by c asc), count(*) over w2 from t group by b having count(*) > 1 window w as (partition by b), w2 as (w) order by b asc",
 	}, {
 		input: "select 1 from (select 1 from dual union select 2 from dual) as t",
 	}, {
@@ -1053,6 +1094,12 @@ var (
 	}, {
 		input:  "create table a ignore me this is garbage",
 		output: "create table a",
+	}, {
+		input:  "create temporary table a (\n\t`a` int\n)",
+		output: "create temporary table a (\n\ta int\n)",
+	}, {
+		input:  "create temporary table if not exists a like b",
+		output: "create temporary table a like b",
 	}, {
 		input:  "create table a (a int, b char, c garbage)",
 		output: "create table a",
@@ -1167,6 +1214,10 @@ var (
 	}, {
 		input:  "drop table if exists a",
 		output: "drop table if exists a",
+	}, {
+		input: "drop temporary table a, b",
+	}, {
+		input: "drop temporary table if exists a",
 	}, {
 		input:  "drop view if exists a",
 		output: "drop table if exists a",
@@ -1321,8 +1372,12 @@ var (
 		input:  "show session status",
 		output: "show session status",
 	}, {
-		input:  "show table status",
-		output: "show table",
+		input: "show table status",
+	}, {
+		input: "show table status from a like 't%'",
+	}, {
+		input:  "show table status in a where name = 't'",
+		output: "show table status from a where name = 't'",
 	}, {
 		input: "show tables",
 	}, {
@@ -1562,6 +1617,13 @@ var (
 		input: "select name, group_concat(distinct id, score order by id desc separator ':' limit 1) from t group by name",
 	}, {
 		input: "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
+	}, {
+		input:  "select name, group_concat(score separator ',') from t group by name",
+		output: "select name, group_concat(score) from t group by name",
+	}, {
+		input: "select name, group_concat(score separator '') from t group by name",
+	}, {
+		input: "select name, group_concat(score separator '\\'') from t group by name",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -1602,16 +1664,14 @@ var (
 		input:  "create schema test_db",
 		output: "create database test_db",
 	}, {
-		input:  "create database if not exists test_db",
-		output: "create database test_db",
+		input: "create database if not exists test_db",
 	}, {
 		input: "drop database test_db",
 	}, {
 		input:  "drop schema test_db",
 		output: "drop database test_db",
 	}, {
-		input:  "drop database if exists test_db",
-		output: "drop database test_db",
+		input: "drop database if exists test_db",
 	}, {
 		input:  "delete a.*, b.* from tbl_a a, tbl_b b where a.id = b.id and b.name = 'test'",
 		output: "delete a, b from tbl_a as a, tbl_b as b where a.id = b.id and b.name = 'test'",
@@ -144,6 +144,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -296,6 +308,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -304,6 +328,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -418,6 +446,24 @@ func replaceMatchExprExpr(newNode, parent SQLNode) {
 	parent.(*MatchExpr).Expr = newNode.(Expr)
 }
 
+func replaceNamedWindowName(newNode, parent SQLNode) {
+	parent.(*NamedWindow).Name = newNode.(ColIdent)
+}
+
+func replaceNamedWindowWindowSpec(newNode, parent SQLNode) {
+	parent.(*NamedWindow).WindowSpec = newNode.(*WindowSpecification)
+}
+
+type replaceNamedWindowsItems int
+
+func (r *replaceNamedWindowsItems) replace(newNode, container SQLNode) {
+	container.(NamedWindows)[int(*r)] = newNode.(*NamedWindow)
+}
+
+func (r *replaceNamedWindowsItems) inc() {
+	*r++
+}
+
 func replaceNextvalExpr(newNode, parent SQLNode) {
 	tmp := parent.(Nextval)
 	tmp.Expr = newNode.(Expr)
@@ -463,6 +509,14 @@ func (r *replaceOrderByItems) inc() {
 	*r++
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+}
+
 func replaceParenSelectSelect(newNode, parent SQLNode) {
 	parent.(*ParenSelect).Select = newNode.(SelectStatement)
 }
@@ -559,6 +613,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(NamedWindows)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -755,6 +817,10 @@ func (r *replaceUnionUnionSelects) inc() {
 	*r++
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUnionSelectStatement(newNode, parent SQLNode) {
 	parent.(*UnionSelect).Statement = newNode.(SelectStatement)
 }
@@ -864,6 +930,32 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowSpecificationFrame(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Frame = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecificationName(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecificationOrderBy(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).OrderBy = newNode.(OrderBy)
+}
+
+func replaceWindowSpecificationPartitionBy(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).PartitionBy = newNode.(Exprs)
+}
+
+type replaceWithCTEs int
+
+func (r *replaceWithCTEs) replace(newNode, container SQLNode) {
+	container.(*With).CTEs[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCTEs) inc() {
+	*r++
+}
+
 func replaceXorExprLeft(newNode, parent SQLNode) {
 	parent.(*XorExpr).Left = newNode.(Expr)
 }
@@ -967,6 +1059,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -1040,9 +1137,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1107,6 +1212,18 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Columns, replaceMatchExprColumns)
 		a.apply(node, n.Expr, replaceMatchExprExpr)
 
+	case *NamedWindow:
+		a.apply(node, n.Name, replaceNamedWindowName)
+		a.apply(node, n.WindowSpec, replaceNamedWindowWindowSpec)
+
+	case NamedWindows:
+		replacer := replaceNamedWindowsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
 	case Nextval:
 		a.apply(node, n.Expr, replaceNextvalExpr)
 
@@ -1145,6 +1262,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenSelect:
 		a.apply(node, n.Select, replaceParenSelectSelect)
 
@@ -1201,6 +1322,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1319,6 +1442,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			a.apply(node, item, replacerUnionSelectsB.replace)
 			replacerUnionSelectsB.inc()
 		}
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *UnionSelect:
 		a.apply(node, n.Statement, replaceUnionSelectStatement)
@@ -1385,6 +1509,20 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowSpecification:
+		a.apply(node, n.Frame, replaceWindowSpecificationFrame)
+		a.apply(node, n.Name, replaceWindowSpecificationName)
+		a.apply(node, n.OrderBy, replaceWindowSpecificationOrderBy)
+		a.apply(node, n.PartitionBy, replaceWindowSpecificationPartitionBy)
+
+	case *With:
+		replacerCTEs := replaceWithCTEs(0)
+		replacerCTEsB := &replacerCTEs
+		for _, item := range n.CTEs {
+			a.apply(node, item, replacerCTEsB.replace)
+			replacerCTEsB.inc()
+		}
+
 	case *XorExpr:
 		a.apply(node, n.Left, replaceXorExprLeft)
 		a.apply(node, n.Right, replaceXorExprRight)
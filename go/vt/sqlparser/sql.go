@@ -44,6 +44,15 @@ type yySymType struct {
 	empty                struct{}
 	statement            Statement
 	selStmt              SelectStatement
+	with                 *With
+	cte                  *CommonTableExpr
+	ctes                 []*CommonTableExpr
+	overClause           *OverClause
+	windowSpec           *WindowSpecification
+	frameClause          *FrameClause
+	framePoint           *FramePoint
+	namedWindow          *NamedWindow
+	namedWindows         NamedWindows
 	ddl                  *DDL
 	ins                  *Insert
 	byt                  byte
@@ -255,203 +264,208 @@ const LESS = 57488
 const THAN = 57489
 const PROCEDURE = 57490
 const TRIGGER = 57491
-const VINDEX = 57492
-const VINDEXES = 57493
-const STATUS = 57494
-const VARIABLES = 57495
-const WARNINGS = 57496
-const SEQUENCE = 57497
-const BEGIN = 57498
-const START = 57499
-const TRANSACTION = 57500
-const COMMIT = 57501
-const ROLLBACK = 57502
-const SAVEPOINT = 57503
-const RELEASE = 57504
-const WORK = 57505
-const BIT = 57506
-const TINYINT = 57507
-const SMALLINT = 57508
-const MEDIUMINT = 57509
-const INT = 57510
-const INTEGER = 57511
-const BIGINT = 57512
-const INTNUM = 57513
-const REAL = 57514
-const DOUBLE = 57515
-const FLOAT_TYPE = 57516
-const DECIMAL = 57517
-const NUMERIC = 57518
-const TIME = 57519
-const TIMESTAMP = 57520
-const DATETIME = 57521
-const YEAR = 57522
-const CHAR = 57523
-const VARCHAR = 57524
-const BOOL = 57525
-const CHARACTER = 57526
-const VARBINARY = 57527
-const NCHAR = 57528
-const TEXT = 57529
-const TINYTEXT = 57530
-const MEDIUMTEXT = 57531
-const LONGTEXT = 57532
-const BLOB = 57533
-const TINYBLOB = 57534
-const MEDIUMBLOB = 57535
-const LONGBLOB = 57536
-const JSON = 57537
-const ENUM = 57538
-const GEOMETRY = 57539
-const POINT = 57540
-const LINESTRING = 57541
-const POLYGON = 57542
-const GEOMETRYCOLLECTION = 57543
-const MULTIPOINT = 57544
-const MULTILINESTRING = 57545
-const MULTIPOLYGON = 57546
-const NULLX = 57547
-const AUTO_INCREMENT = 57548
-const APPROXNUM = 57549
-const SIGNED = 57550
-const UNSIGNED = 57551
-const ZEROFILL = 57552
-const COLLATION = 57553
-const DATABASES = 57554
-const TABLES = 57555
-const VITESS_METADATA = 57556
-const VSCHEMA = 57557
-const FULL = 57558
-const PROCESSLIST = 57559
-const COLUMNS = 57560
-const FIELDS = 57561
-const ENGINES = 57562
-const PLUGINS = 57563
-const EXTENDED = 57564
-const NAMES = 57565
-const CHARSET = 57566
-const GLOBAL = 57567
-const SESSION = 57568
-const ISOLATION = 57569
-const LEVEL = 57570
-const READ = 57571
-const WRITE = 57572
-const ONLY = 57573
-const REPEATABLE = 57574
-const COMMITTED = 57575
-const UNCOMMITTED = 57576
-const SERIALIZABLE = 57577
-const CURRENT_TIMESTAMP = 57578
-const DATABASE = 57579
-const CURRENT_DATE = 57580
-const CURRENT_TIME = 57581
-const LOCALTIME = 57582
-const LOCALTIMESTAMP = 57583
-const UTC_DATE = 57584
-const UTC_TIME = 57585
-const UTC_TIMESTAMP = 57586
-const REPLACE = 57587
-const CONVERT = 57588
-const CAST = 57589
-const SUBSTR = 57590
-const SUBSTRING = 57591
-const GROUP_CONCAT = 57592
-const SEPARATOR = 57593
-const TIMESTAMPADD = 57594
-const TIMESTAMPDIFF = 57595
-const MATCH = 57596
-const AGAINST = 57597
-const BOOLEAN = 57598
-const LANGUAGE = 57599
-const WITH = 57600
-const QUERY = 57601
-const EXPANSION = 57602
-const UNUSED = 57603
-const ARRAY = 57604
-const CUME_DIST = 57605
-const DESCRIPTION = 57606
-const DENSE_RANK = 57607
-const EMPTY = 57608
-const EXCEPT = 57609
-const FIRST_VALUE = 57610
-const GROUPING = 57611
-const GROUPS = 57612
-const JSON_TABLE = 57613
-const LAG = 57614
-const LAST_VALUE = 57615
-const LATERAL = 57616
-const LEAD = 57617
-const MEMBER = 57618
-const NTH_VALUE = 57619
-const NTILE = 57620
-const OF = 57621
-const OVER = 57622
+const TEMPORARY = 57492
+const VINDEX = 57493
+const VINDEXES = 57494
+const STATUS = 57495
+const VARIABLES = 57496
+const WARNINGS = 57497
+const SEQUENCE = 57498
+const BEGIN = 57499
+const START = 57500
+const TRANSACTION = 57501
+const COMMIT = 57502
+const ROLLBACK = 57503
+const SAVEPOINT = 57504
+const RELEASE = 57505
+const WORK = 57506
+const BIT = 57507
+const TINYINT = 57508
+const SMALLINT = 57509
+const MEDIUMINT = 57510
+const INT = 57511
+const INTEGER = 57512
+const BIGINT = 57513
+const INTNUM = 57514
+const REAL = 57515
+const DOUBLE = 57516
+const FLOAT_TYPE = 57517
+const DECIMAL = 57518
+const NUMERIC = 57519
+const TIME = 57520
+const TIMESTAMP = 57521
+const DATETIME = 57522
+const YEAR = 57523
+const CHAR = 57524
+const VARCHAR = 57525
+const BOOL = 57526
+const CHARACTER = 57527
+const VARBINARY = 57528
+const NCHAR = 57529
+const TEXT = 57530
+const TINYTEXT = 57531
+const MEDIUMTEXT = 57532
+const LONGTEXT = 57533
+const BLOB = 57534
+const TINYBLOB = 57535
+const MEDIUMBLOB = 57536
+const LONGBLOB = 57537
+const JSON = 57538
+const ENUM = 57539
+const GEOMETRY = 57540
+const POINT = 57541
+const LINESTRING = 57542
+const POLYGON = 57543
+const GEOMETRYCOLLECTION = 57544
+const MULTIPOINT = 57545
+const MULTILINESTRING = 57546
+const MULTIPOLYGON = 57547
+const NULLX = 57548
+const AUTO_INCREMENT = 57549
+const APPROXNUM = 57550
+const SIGNED = 57551
+const UNSIGNED = 57552
+const ZEROFILL = 57553
+const COLLATION = 57554
+const DATABASES = 57555
+const TABLES = 57556
+const VITESS_METADATA = 57557
+const VSCHEMA = 57558
+const FULL = 57559
+const PROCESSLIST = 57560
+const COLUMNS = 57561
+const FIELDS = 57562
+const ENGINES = 57563
+const PLUGINS = 57564
+const EXTENDED = 57565
+const NAMES = 57566
+const CHARSET = 57567
+const GLOBAL = 57568
+const SESSION = 57569
+const ISOLATION = 57570
+const LEVEL = 57571
+const READ = 57572
+const WRITE = 57573
+const ONLY = 57574
+const REPEATABLE = 57575
+const COMMITTED = 57576
+const UNCOMMITTED = 57577
+const SERIALIZABLE = 57578
+const CURRENT_TIMESTAMP = 57579
+const DATABASE = 57580
+const CURRENT_DATE = 57581
+const CURRENT_TIME = 57582
+const LOCALTIME = 57583
+const LOCALTIMESTAMP = 57584
+const UTC_DATE = 57585
+const UTC_TIME = 57586
+const UTC_TIMESTAMP = 57587
+const REPLACE = 57588
+const CONVERT = 57589
+const CAST = 57590
+const SUBSTR = 57591
+const SUBSTRING = 57592
+const GROUP_CONCAT = 57593
+const SEPARATOR = 57594
+const TIMESTAMPADD = 57595
+const TIMESTAMPDIFF = 57596
+const MATCH = 57597
+const AGAINST = 57598
+const BOOLEAN = 57599
+const LANGUAGE = 57600
+const WITH = 57601
+const QUERY = 57602
+const EXPANSION = 57603
+const UNUSED = 57604
+const ARRAY = 57605
+const CUME_DIST = 57606
+const DESCRIPTION = 57607
+const DENSE_RANK = 57608
+const EMPTY = 57609
+const EXCEPT = 57610
+const FIRST_VALUE = 57611
+const GROUPING = 57612
+const GROUPS = 57613
+const JSON_TABLE = 57614
+const LAG = 57615
+const LAST_VALUE = 57616
+const LATERAL = 57617
+const LEAD = 57618
+const MEMBER = 57619
+const NTH_VALUE = 57620
+const NTILE = 57621
+const OF = 57622
 const PERCENT_RANK = 57623
 const RANK = 57624
 const RECURSIVE = 57625
 const ROW_NUMBER = 57626
 const SYSTEM = 57627
-const WINDOW = 57628
-const ACTIVE = 57629
-const ADMIN = 57630
-const BUCKETS = 57631
-const CLONE = 57632
-const COMPONENT = 57633
-const DEFINITION = 57634
-const ENFORCED = 57635
-const EXCLUDE = 57636
-const FOLLOWING = 57637
-const GEOMCOLLECTION = 57638
-const GET_MASTER_PUBLIC_KEY = 57639
-const HISTOGRAM = 57640
-const HISTORY = 57641
-const INACTIVE = 57642
-const INVISIBLE = 57643
-const LOCKED = 57644
-const MASTER_COMPRESSION_ALGORITHMS = 57645
-const MASTER_PUBLIC_KEY_PATH = 57646
-const MASTER_TLS_CIPHERSUITES = 57647
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57648
-const NESTED = 57649
-const NETWORK_NAMESPACE = 57650
-const NOWAIT = 57651
-const NULLS = 57652
-const OJ = 57653
-const OLD = 57654
-const OPTIONAL = 57655
-const ORDINALITY = 57656
-const ORGANIZATION = 57657
-const OTHERS = 57658
-const PATH = 57659
-const PERSIST = 57660
-const PERSIST_ONLY = 57661
-const PRECEDING = 57662
-const PRIVILEGE_CHECKS_USER = 57663
-const PROCESS = 57664
-const RANDOM = 57665
-const REFERENCE = 57666
-const REQUIRE_ROW_FORMAT = 57667
-const RESOURCE = 57668
-const RESPECT = 57669
-const RESTART = 57670
-const RETAIN = 57671
-const REUSE = 57672
-const ROLE = 57673
-const SECONDARY = 57674
-const SECONDARY_ENGINE = 57675
-const SECONDARY_LOAD = 57676
-const SECONDARY_UNLOAD = 57677
-const SKIP = 57678
-const SRID = 57679
-const THREAD_PRIORITY = 57680
-const TIES = 57681
-const UNBOUNDED = 57682
-const VCPU = 57683
-const VISIBLE = 57684
-const FORMAT = 57685
-const TREE = 57686
-const VITESS = 57687
-const TRADITIONAL = 57688
+const ACTIVE = 57628
+const ADMIN = 57629
+const BUCKETS = 57630
+const CLONE = 57631
+const COMPONENT = 57632
+const DEFINITION = 57633
+const ENFORCED = 57634
+const EXCLUDE = 57635
+const GEOMCOLLECTION = 57636
+const GET_MASTER_PUBLIC_KEY = 57637
+const HISTOGRAM = 57638
+const HISTORY = 57639
+const INACTIVE = 57640
+const INVISIBLE = 57641
+const LOCKED = 57642
+const MASTER_COMPRESSION_ALGORITHMS = 57643
+const MASTER_PUBLIC_KEY_PATH = 57644
+const MASTER_TLS_CIPHERSUITES = 57645
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57646
+const NESTED = 57647
+const NETWORK_NAMESPACE = 57648
+const NOWAIT = 57649
+const NULLS = 57650
+const OJ = 57651
+const OLD = 57652
+const OPTIONAL = 57653
+const ORDINALITY = 57654
+const ORGANIZATION = 57655
+const OTHERS = 57656
+const PATH = 57657
+const PERSIST = 57658
+const PERSIST_ONLY = 57659
+const PRIVILEGE_CHECKS_USER = 57660
+const PROCESS = 57661
+const RANDOM = 57662
+const REFERENCE = 57663
+const REQUIRE_ROW_FORMAT = 57664
+const RESOURCE = 57665
+const RESPECT = 57666
+const RESTART = 57667
+const RETAIN = 57668
+const REUSE = 57669
+const ROLE = 57670
+const SECONDARY = 57671
+const SECONDARY_ENGINE = 57672
+const SECONDARY_LOAD = 57673
+const SECONDARY_UNLOAD = 57674
+const SKIP = 57675
+const SRID = 57676
+const THREAD_PRIORITY = 57677
+const TIES = 57678
+const VCPU = 57679
+const VISIBLE = 57680
+const OVER = 57681
+const WINDOW = 57682
+const ROWS = 57683
+const RANGE = 57684
+const ROW = 57685
+const CURRENT = 57686
+const UNBOUNDED = 57687
+const PRECEDING = 57688
+const FOLLOWING = 57689
+const FORMAT = 57690
+const TREE = 57691
+const VITESS = 57692
+const TRADITIONAL = 57693
 
 var yyToknames = [...]string{
 	"$end",
@@ -620,6 +634,7 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"TEMPORARY",
 	"VINDEX",
 	"VINDEXES",
 	"STATUS",
@@ -750,13 +765,11 @@ var yyToknames = [...]string{
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
 	"RANK",
 	"RECURSIVE",
 	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -765,7 +778,6 @@ var yyToknames = [...]string{
 	"DEFINITION",
 	"ENFORCED",
 	"EXCLUDE",
-	"FOLLOWING",
 	"GEOMCOLLECTION",
 	"GET_MASTER_PUBLIC_KEY",
 	"HISTOGRAM",
@@ -790,7 +802,6 @@ var yyToknames = [...]string{
 	"PATH",
 	"PERSIST",
 	"PERSIST_ONLY",
-	"PRECEDING",
 	"PRIVILEGE_CHECKS_USER",
 	"PROCESS",
 	"RANDOM",
@@ -810,9 +821,17 @@ var yyToknames = [...]string{
 	"SRID",
 	"THREAD_PRIORITY",
 	"TIES",
-	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"OVER",
+	"WINDOW",
+	"ROWS",
+	"RANGE",
+	"ROW",
+	"CURRENT",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -830,1845 +849,2055 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 42,
-	33, 305,
-	132, 305,
-	144, 305,
-	169, 319,
-	170, 319,
-	-2, 307,
-	-1, 47,
-	134, 329,
-	-2, 327,
-	-1, 70,
-	38, 365,
-	-2, 373,
-	-1, 388,
-	120, 696,
-	-2, 692,
-	-1, 389,
-	120, 697,
+	-1, 43,
+	33, 314,
+	132, 314,
+	144, 314,
+	170, 328,
+	171, 328,
+	-2, 316,
+	-1, 48,
+	134, 338,
+	-2, 336,
+	-1, 74,
+	38, 374,
+	-2, 382,
+	-1, 396,
+	120, 732,
+	-2, 727,
+	-1, 397,
+	120, 733,
+	-2, 728,
+	-1, 415,
+	38, 375,
+	-2, 387,
+	-1, 416,
+	38, 376,
+	-2, 388,
+	-1, 439,
+	88, 991,
+	-2, 79,
+	-1, 440,
+	88, 907,
+	-2, 80,
+	-1, 445,
+	88, 873,
 	-2, 693,
-	-1, 403,
-	38, 366,
-	-2, 378,
-	-1, 404,
-	38, 367,
-	-2, 379,
-	-1, 427,
-	88, 950,
-	-2, 72,
-	-1, 428,
-	88, 866,
-	-2, 73,
-	-1, 433,
-	88, 833,
-	-2, 658,
-	-1, 435,
-	88, 897,
-	-2, 660,
-	-1, 755,
-	56, 54,
-	58, 54,
-	-2, 58,
-	-1, 932,
-	120, 699,
+	-1, 447,
+	88, 938,
 	-2, 695,
-	-1, 1360,
-	5, 617,
-	17, 617,
-	19, 617,
-	31, 617,
-	59, 617,
-	-2, 404,
+	-1, 777,
+	56, 61,
+	58, 61,
+	-2, 65,
+	-1, 958,
+	120, 735,
+	-2, 731,
+	-1, 959,
+	120, 736,
+	-2, 729,
+	-1, 1405,
+	5, 652,
+	17, 652,
+	19, 652,
+	31, 652,
+	59, 652,
+	-2, 413,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17676
+const yyLast = 19722
 
 var yyAct = [...]int{
 
-	388, 1599, 1589, 1399, 1556, 1287, 332, 1192, 1472, 1505,
-	1037, 1212, 1459, 1340, 347, 1373, 1193, 1010, 318, 1337,
-	1060, 1080, 599, 722, 1341, 1238, 1033, 1036, 1346, 69,
-	3, 683, 361, 1046, 1306, 926, 1180, 89, 432, 597,
-	396, 283, 588, 303, 283, 1352, 854, 729, 1131, 89,
-	919, 283, 873, 1264, 1255, 1050, 768, 1007, 1012, 996,
-	405, 732, 896, 727, 952, 749, 334, 27, 748, 390,
-	1076, 319, 767, 421, 322, 67, 283, 89, 557, 739,
-	558, 283, 418, 283, 426, 65, 757, 330, 323, 1066,
-	989, 70, 696, 882, 64, 7, 6, 5, 1592, 429,
-	697, 1531, 645, 644, 654, 655, 647, 648, 649, 650,
-	651, 652, 653, 646, 577, 1576, 656, 1587, 1564, 1584,
-	1400, 1575, 72, 73, 74, 75, 76, 1323, 1429, 562,
-	91, 92, 93, 391, 411, 373, 1367, 379, 380, 377,
-	378, 376, 375, 374, 271, 1027, 1563, 269, 769, 273,
-	770, 381, 382, 29, 617, 58, 32, 33, 279, 275,
-	276, 277, 1226, 1368, 1369, 1225, 1028, 1029, 1227, 321,
-	320, 612, 1246, 1059, 929, 613, 610, 611, 1289, 1462,
-	1067, 91, 92, 93, 1420, 1418, 311, 881, 313, 843,
-	309, 605, 606, 615, 1291, 842, 801, 840, 1586, 1583,
-	1557, 594, 1286, 596, 57, 990, 1549, 1603, 1051, 1506,
-	1607, 1213, 1215, 578, 602, 564, 616, 273, 1292, 1307,
-	847, 619, 831, 1514, 1508, 1053, 1283, 1363, 1362, 1361,
-	844, 841, 1285, 560, 1290, 593, 595, 567, 286, 574,
-	274, 1034, 883, 884, 885, 1538, 272, 1442, 645, 644,
-	654, 655, 647, 648, 649, 650, 651, 652, 653, 646,
-	1309, 1111, 656, 1222, 1110, 668, 669, 1023, 270, 656,
-	283, 569, 570, 969, 1185, 283, 327, 579, 1532, 1160,
-	789, 283, 278, 1139, 763, 743, 1053, 283, 586, 1150,
-	681, 592, 89, 1214, 1147, 1507, 89, 1311, 89, 1315,
-	584, 1310, 874, 1308, 89, 1132, 878, 571, 1313, 572,
-	868, 633, 573, 601, 89, 89, 636, 1312, 91, 92,
-	93, 802, 1067, 1601, 591, 603, 1602, 636, 1600, 1052,
-	1314, 1316, 1515, 1513, 1547, 604, 1284, 607, 1282, 630,
-	631, 646, 1523, 618, 656, 625, 1325, 815, 818, 819,
-	820, 821, 822, 823, 1350, 824, 825, 826, 827, 828,
-	803, 804, 805, 806, 787, 788, 816, 1562, 790, 771,
-	791, 792, 793, 794, 795, 796, 797, 798, 799, 800,
-	807, 808, 809, 810, 811, 812, 813, 814, 629, 875,
-	1052, 953, 580, 581, 582, 833, 666, 869, 1244, 668,
-	669, 1274, 590, 1552, 668, 669, 634, 635, 633, 59,
-	563, 628, 626, 627, 89, 79, 283, 283, 283, 1053,
-	635, 633, 720, 736, 636, 89, 91, 92, 93, 903,
-	684, 89, 1567, 1270, 1271, 1272, 1468, 636, 719, 817,
-	1467, 1259, 1099, 901, 902, 900, 1258, 429, 974, 975,
-	1247, 91, 92, 93, 80, 1608, 1098, 649, 650, 651,
-	652, 653, 646, 1569, 1548, 656, 733, 699, 701, 703,
-	705, 707, 709, 710, 747, 700, 702, 1485, 706, 708,
-	1386, 711, 91, 92, 93, 721, 589, 91, 92, 93,
-	953, 400, 1157, 1124, 1125, 1126, 565, 566, 1097, 1609,
-	756, 634, 635, 633, 1465, 1273, 761, 1256, 1056, 766,
-	1278, 1275, 1266, 1276, 1269, 1057, 1265, 1121, 556, 636,
-	1267, 1268, 859, 1052, 57, 891, 893, 894, 1049, 1047,
-	268, 1048, 892, 1520, 1277, 1519, 899, 66, 1045, 1051,
-	647, 648, 649, 650, 651, 652, 653, 646, 1511, 1585,
-	656, 1094, 1091, 1092, 1349, 1090, 1382, 283, 91, 92,
-	93, 829, 89, 1054, 832, 1181, 834, 283, 283, 89,
-	89, 89, 634, 635, 633, 283, 1338, 731, 283, 1349,
-	1327, 283, 852, 853, 68, 283, 1438, 89, 1101, 1104,
-	636, 400, 89, 89, 89, 283, 89, 89, 1146, 1571,
-	400, 830, 1511, 1560, 415, 416, 89, 89, 837, 838,
-	839, 993, 670, 671, 672, 673, 674, 675, 676, 677,
-	678, 679, 632, 856, 971, 1522, 857, 982, 1096, 1390,
-	858, 861, 862, 863, 1288, 865, 866, 91, 92, 93,
-	1145, 921, 1144, 1511, 400, 870, 871, 91, 92, 93,
-	1095, 1229, 993, 634, 635, 633, 1230, 920, 1511, 1539,
-	848, 634, 635, 633, 970, 1026, 922, 1511, 1510, 1457,
-	1456, 636, 897, 1444, 400, 1441, 400, 1392, 1391, 636,
-	89, 1388, 1389, 634, 635, 633, 1388, 1387, 982, 400,
-	1100, 993, 400, 632, 400, 1163, 941, 944, 778, 777,
-	759, 636, 954, 1181, 930, 1102, 898, 350, 349, 352,
-	353, 354, 355, 89, 89, 992, 351, 356, 29, 1017,
-	932, 758, 1162, 998, 1001, 1002, 1003, 999, 931, 1000,
-	1004, 89, 936, 1353, 1354, 684, 57, 29, 283, 759,
-	982, 89, 1187, 993, 983, 760, 283, 762, 1188, 1349,
-	393, 962, 963, 758, 283, 283, 923, 924, 283, 283,
-	966, 972, 283, 283, 283, 89, 930, 1492, 933, 57,
-	976, 846, 764, 1577, 1474, 1061, 29, 1449, 89, 558,
-	1081, 1378, 932, 1008, 760, 1475, 758, 429, 57, 1233,
-	988, 982, 1353, 1354, 984, 1077, 1072, 1071, 1084, 1359,
-	1038, 57, 856, 1594, 1590, 1380, 1356, 1338, 1062, 1063,
-	1064, 1065, 1260, 879, 850, 1018, 1204, 1202, 1358, 1020,
-	986, 1205, 1203, 1201, 1073, 1074, 1075, 57, 1206, 1200,
-	1002, 1003, 283, 89, 1581, 89, 1021, 1103, 1025, 1574,
-	1170, 283, 283, 283, 283, 283, 1016, 283, 283, 1024,
-	1082, 283, 89, 1331, 730, 1579, 1179, 1041, 400, 1178,
-	937, 938, 1251, 949, 943, 946, 947, 723, 283, 776,
-	587, 1554, 1086, 283, 1088, 283, 283, 950, 1243, 724,
-	283, 89, 1068, 1069, 1070, 1553, 1490, 1241, 1235, 961,
-	1436, 1115, 964, 965, 1432, 1470, 1078, 1079, 645, 644,
-	654, 655, 647, 648, 649, 650, 651, 652, 653, 646,
-	1431, 1087, 656, 849, 1006, 895, 1118, 397, 904, 905,
-	906, 907, 908, 909, 910, 911, 912, 913, 914, 915,
-	916, 917, 918, 897, 1435, 645, 644, 654, 655, 647,
-	648, 649, 650, 651, 652, 653, 646, 394, 395, 656,
-	68, 645, 644, 654, 655, 647, 648, 649, 650, 651,
-	652, 653, 646, 1127, 1177, 656, 398, 898, 1434, 1334,
-	1181, 614, 1176, 1596, 1595, 958, 1151, 1141, 1148, 998,
-	1001, 1002, 1003, 999, 283, 1000, 1004, 872, 737, 1596,
-	1169, 1536, 1463, 968, 283, 283, 283, 283, 283, 1194,
-	1174, 391, 393, 1140, 66, 71, 283, 63, 1, 1588,
-	283, 1401, 1471, 1093, 283, 1555, 1156, 1504, 283, 1189,
-	645, 644, 654, 655, 647, 648, 649, 650, 651, 652,
-	653, 646, 1372, 1044, 656, 1228, 1173, 89, 1035, 1211,
-	78, 1183, 555, 389, 1182, 1184, 1234, 1231, 77, 1546,
-	1239, 1239, 867, 1218, 600, 1220, 406, 1221, 1043, 1038,
-	1196, 1197, 1195, 1199, 1207, 1198, 1042, 1512, 1461, 1055,
-	407, 1245, 1217, 1058, 1379, 1219, 1240, 734, 735, 409,
-	90, 408, 1242, 1223, 284, 89, 89, 284, 1551, 784,
-	782, 783, 90, 1250, 284, 1252, 1253, 1254, 781, 786,
-	785, 780, 1136, 1137, 1236, 1237, 296, 406, 1263, 424,
-	880, 310, 1005, 772, 1083, 89, 738, 81, 1281, 284,
-	90, 407, 1280, 1154, 284, 1262, 284, 1257, 403, 404,
-	409, 1089, 408, 877, 293, 957, 608, 609, 298, 664,
-	89, 1175, 1224, 430, 423, 1279, 920, 1344, 973, 726,
-	1433, 1333, 1248, 1249, 1293, 1155, 693, 951, 752, 333,
-	890, 348, 1303, 345, 346, 1305, 977, 1186, 638, 1294,
-	1295, 331, 325, 751, 1302, 744, 283, 1296, 1128, 1129,
-	1130, 1318, 997, 1328, 995, 994, 89, 419, 1304, 1355,
-	1351, 89, 89, 750, 1194, 1339, 1317, 981, 402, 932,
-	1428, 1530, 1324, 401, 948, 399, 50, 931, 1303, 621,
-	315, 31, 410, 22, 21, 20, 19, 89, 18, 24,
-	17, 1342, 16, 15, 575, 35, 26, 25, 14, 1348,
-	13, 89, 12, 89, 89, 11, 10, 1239, 1239, 9,
-	8, 4, 624, 1371, 1357, 1364, 23, 682, 2, 0,
-	0, 0, 1385, 1038, 0, 1038, 1366, 0, 1370, 0,
-	0, 283, 1376, 1377, 0, 0, 1365, 0, 1375, 0,
-	0, 0, 0, 0, 0, 1383, 1384, 0, 0, 0,
-	0, 283, 0, 1394, 0, 0, 0, 89, 0, 1402,
-	89, 89, 89, 283, 0, 0, 0, 0, 1395, 0,
-	1397, 644, 654, 655, 647, 648, 649, 650, 651, 652,
-	653, 646, 0, 284, 656, 0, 0, 0, 284, 0,
-	0, 0, 0, 0, 284, 0, 0, 0, 1413, 1414,
-	284, 1415, 0, 1416, 1417, 90, 1419, 1407, 1408, 90,
-	0, 90, 0, 0, 0, 1411, 0, 90, 0, 0,
-	0, 0, 0, 0, 0, 0, 1194, 90, 90, 1437,
-	362, 28, 0, 0, 0, 0, 1446, 0, 0, 0,
-	0, 89, 0, 0, 0, 0, 0, 0, 0, 89,
-	0, 1231, 0, 0, 0, 0, 0, 0, 0, 28,
-	0, 0, 0, 1038, 89, 1458, 1445, 0, 0, 0,
-	0, 89, 0, 0, 0, 0, 1455, 0, 0, 1298,
-	1299, 0, 0, 0, 0, 1478, 0, 0, 0, 0,
-	0, 0, 0, 1473, 1319, 1320, 392, 1321, 1322, 0,
-	0, 0, 0, 1469, 0, 1476, 0, 0, 0, 1329,
-	1330, 0, 89, 89, 0, 89, 0, 0, 0, 0,
-	89, 0, 89, 89, 89, 283, 0, 90, 89, 284,
-	284, 284, 1498, 1491, 1499, 1501, 1502, 1489, 90, 1484,
-	1493, 1342, 1503, 1509, 90, 89, 283, 1464, 1516, 1466,
-	0, 0, 0, 0, 0, 0, 1497, 1524, 0, 0,
-	0, 1517, 0, 1518, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1477, 0, 1537, 1545, 0, 0,
-	0, 0, 89, 0, 1544, 1543, 0, 0, 0, 0,
-	1381, 0, 0, 89, 89, 1342, 0, 0, 0, 0,
-	0, 1558, 0, 0, 1559, 0, 0, 0, 0, 89,
-	0, 0, 0, 1194, 1565, 1473, 1038, 0, 0, 0,
-	283, 0, 0, 0, 0, 0, 0, 0, 89, 0,
-	0, 0, 0, 0, 0, 0, 1573, 0, 0, 0,
-	0, 0, 0, 0, 1409, 0, 1578, 1580, 89, 0,
-	654, 655, 647, 648, 649, 650, 651, 652, 653, 646,
-	1582, 1593, 656, 0, 0, 0, 0, 0, 1604, 0,
-	284, 0, 0, 0, 0, 90, 0, 0, 0, 0,
-	284, 284, 90, 90, 90, 0, 0, 0, 284, 0,
-	0, 284, 0, 0, 284, 0, 0, 0, 284, 0,
-	90, 413, 0, 0, 0, 90, 90, 90, 284, 90,
-	90, 0, 0, 0, 0, 0, 0, 0, 0, 90,
-	90, 0, 598, 0, 0, 0, 598, 0, 598, 0,
-	0, 0, 0, 0, 598, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 28, 0, 1426, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 665,
-	667, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1479, 1480, 1481, 1482, 1483, 0, 0, 0, 1486, 1487,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	680, 0, 0, 90, 685, 686, 687, 688, 689, 690,
-	691, 692, 0, 695, 698, 698, 698, 704, 698, 698,
-	704, 698, 712, 713, 714, 715, 716, 717, 718, 0,
-	0, 0, 0, 28, 0, 0, 90, 90, 645, 644,
-	654, 655, 647, 648, 649, 650, 651, 652, 653, 646,
-	0, 0, 656, 0, 90, 0, 0, 753, 0, 0,
-	0, 284, 0, 0, 90, 0, 0, 0, 0, 284,
-	0, 91, 92, 93, 0, 0, 0, 284, 284, 0,
-	0, 284, 284, 0, 0, 284, 284, 284, 90, 0,
-	0, 0, 359, 0, 640, 0, 643, 0, 934, 935,
-	0, 90, 657, 658, 659, 660, 661, 662, 663, 0,
-	641, 642, 639, 645, 644, 654, 655, 647, 648, 649,
-	650, 651, 652, 653, 646, 287, 0, 656, 0, 88,
-	0, 0, 0, 0, 290, 1425, 967, 0, 0, 0,
-	0, 312, 297, 0, 0, 0, 0, 0, 1597, 0,
-	0, 0, 0, 0, 0, 284, 90, 0, 90, 0,
-	0, 0, 0, 0, 284, 284, 284, 284, 284, 431,
-	284, 284, 0, 0, 284, 90, 295, 1424, 0, 0,
-	0, 0, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 0, 0, 0, 284, 0, 284, 284,
-	0, 0, 598, 284, 90, 1423, 0, 0, 0, 598,
-	598, 598, 288, 0, 0, 645, 644, 654, 655, 647,
-	648, 649, 650, 651, 652, 653, 646, 598, 0, 656,
-	0, 0, 598, 598, 598, 0, 598, 598, 637, 299,
-	291, 0, 300, 301, 307, 0, 598, 598, 292, 294,
-	304, 0, 289, 306, 305, 0, 0, 645, 644, 654,
-	655, 647, 648, 649, 650, 651, 652, 653, 646, 0,
-	0, 656, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 694, 0, 645, 644, 654, 655, 647,
-	648, 649, 650, 651, 652, 653, 646, 0, 0, 656,
-	0, 0, 0, 0, 0, 0, 0, 284, 0, 725,
-	728, 0, 0, 0, 0, 0, 0, 284, 284, 284,
-	284, 284, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 0, 0, 284, 0, 0, 0, 284, 1134, 0,
-	0, 284, 1135, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1142, 1143, 0, 0, 0, 0, 1149,
-	90, 0, 1152, 1153, 0, 0, 0, 0, 0, 0,
-	1159, 0, 0, 0, 1161, 0, 0, 1164, 1165, 1166,
-	1167, 1168, 0, 0, 431, 0, 0, 0, 431, 0,
-	431, 0, 0, 0, 1009, 0, 431, 0, 753, 0,
-	0, 0, 753, 0, 0, 0, 620, 622, 90, 90,
-	0, 0, 0, 0, 0, 29, 30, 58, 32, 33,
-	0, 0, 0, 1209, 1210, 1297, 0, 0, 0, 0,
-	0, 0, 0, 0, 62, 0, 0, 0, 90, 34,
-	53, 54, 0, 56, 0, 645, 644, 654, 655, 647,
-	648, 649, 650, 651, 652, 653, 646, 1133, 0, 656,
-	0, 0, 43, 90, 0, 0, 57, 0, 0, 0,
-	0, 0, 0, 598, 0, 598, 0, 645, 644, 654,
-	655, 647, 648, 649, 650, 651, 652, 653, 646, 0,
-	0, 656, 598, 0, 0, 0, 0, 0, 0, 284,
-	0, 0, 860, 0, 0, 0, 741, 0, 0, 90,
-	0, 0, 0, 0, 90, 90, 0, 431, 0, 0,
-	0, 0, 0, 773, 0, 0, 876, 0, 0, 0,
-	0, 0, 36, 37, 39, 38, 41, 0, 55, 0,
-	90, 0, 0, 0, 886, 887, 888, 889, 0, 0,
-	0, 0, 1300, 1301, 90, 0, 90, 90, 0, 0,
-	0, 42, 61, 60, 0, 0, 51, 52, 40, 0,
-	0, 1138, 0, 0, 392, 0, 0, 0, 0, 0,
-	0, 0, 44, 45, 284, 46, 47, 48, 49, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 939,
-	940, 0, 0, 0, 284, 0, 0, 0, 0, 0,
-	90, 0, 0, 90, 90, 90, 284, 0, 0, 0,
-	0, 0, 0, 0, 753, 0, 0, 1360, 0, 0,
-	1190, 1191, 0, 0, 753, 753, 753, 753, 753, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1009, 0, 1216, 0, 431, 0, 754, 0, 753, 0,
-	0, 431, 431, 431, 0, 0, 0, 0, 0, 0,
-	0, 59, 0, 0, 0, 0, 0, 0, 0, 431,
-	0, 0, 0, 1032, 431, 431, 431, 0, 431, 431,
-	0, 0, 0, 0, 90, 0, 0, 281, 431, 431,
-	0, 0, 90, 0, 0, 0, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 90, 1410, 0,
-	0, 0, 1412, 0, 90, 0, 598, 0, 0, 0,
-	0, 0, 420, 1421, 1422, 0, 0, 559, 0, 561,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 598, 0, 0, 0, 1439,
-	1440, 0, 1443, 0, 0, 90, 90, 0, 90, 0,
-	0, 0, 925, 90, 431, 90, 90, 90, 284, 0,
-	1454, 90, 0, 0, 0, 0, 0, 0, 955, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 90, 284,
-	0, 0, 0, 0, 0, 959, 960, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 978, 0, 0, 0, 0, 0, 1343,
-	0, 28, 0, 741, 0, 90, 431, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 90, 90, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 431, 0, 0,
-	0, 0, 90, 0, 0, 1158, 0, 1500, 0, 0,
-	431, 0, 0, 284, 0, 0, 0, 0, 0, 360,
-	0, 90, 1171, 1172, 728, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1526, 1527, 1528, 1529, 0,
-	1533, 90, 1534, 1535, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1540, 0, 1541, 1542, 0,
-	282, 0, 0, 308, 0, 431, 568, 431, 0, 0,
-	282, 576, 0, 0, 0, 0, 0, 583, 0, 0,
-	0, 0, 0, 585, 431, 0, 1561, 0, 0, 0,
-	0, 0, 414, 0, 0, 422, 0, 0, 0, 0,
-	282, 0, 282, 0, 1427, 0, 0, 0, 0, 0,
-	0, 1570, 0, 1123, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1451, 1452, 1453, 0, 0, 0, 0, 0,
-	0, 0, 0, 1605, 1606, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 598, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1326,
-	0, 0, 746, 0, 755, 0, 0, 0, 0, 1343,
-	0, 28, 0, 0, 0, 955, 0, 0, 0, 0,
-	0, 0, 0, 1335, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1521, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 431,
-	0, 0, 0, 1343, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
-	0, 0, 0, 0, 282, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 0, 282, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1261, 431, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 431, 0, 0,
-	0, 0, 0, 779, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 835, 836, 0, 0, 1591, 0, 0,
-	0, 845, 431, 0, 420, 0, 0, 851, 0, 1430,
+	396, 1676, 1687, 1649, 1524, 1444, 1613, 997, 1592, 1327,
+	700, 340, 1232, 1558, 355, 1520, 1071, 1252, 1385, 1100,
+	1067, 1418, 1233, 369, 616, 1044, 1114, 1386, 1382, 753,
+	73, 3, 774, 1080, 326, 1070, 1391, 1278, 94, 1220,
+	1397, 1295, 290, 444, 311, 290, 878, 1168, 1349, 790,
+	94, 605, 290, 945, 1304, 1046, 417, 69, 952, 290,
+	1084, 406, 1030, 1041, 748, 770, 756, 980, 331, 430,
+	776, 1110, 401, 922, 789, 771, 438, 342, 572, 761,
+	290, 94, 399, 1023, 573, 290, 743, 290, 327, 67,
+	593, 330, 578, 433, 27, 779, 338, 715, 908, 66,
+	1662, 409, 1341, 897, 614, 441, 28, 716, 1665, 1666,
+	1654, 1540, 288, 1655, 1654, 1663, 1664, 1655, 1637, 1638,
+	7, 6, 322, 71, 5, 1680, 1642, 1650, 1674, 1623,
+	30, 72, 1668, 1134, 1445, 30, 1641, 60, 33, 34,
+	1622, 1366, 1477, 423, 577, 1266, 1412, 1133, 1265, 1061,
+	432, 1267, 30, 634, 1227, 574, 329, 576, 277, 1286,
+	1228, 275, 402, 279, 1656, 97, 98, 99, 1656, 1413,
+	1414, 1062, 1063, 791, 328, 792, 1093, 955, 97, 98,
+	99, 59, 580, 581, 30, 1094, 59, 1510, 1101, 1132,
+	1586, 662, 661, 671, 672, 664, 665, 666, 667, 668,
+	669, 670, 663, 59, 381, 673, 387, 388, 385, 386,
+	384, 383, 382, 629, 1545, 633, 1329, 630, 627, 628,
+	389, 390, 1468, 286, 281, 283, 284, 985, 1350, 97,
+	98, 99, 319, 1466, 907, 59, 611, 321, 613, 317,
+	866, 632, 1129, 1126, 1127, 624, 1125, 622, 623, 1331,
+	865, 909, 910, 911, 863, 1671, 1614, 1660, 1580, 1326,
+	282, 278, 1024, 1607, 1085, 1695, 594, 1691, 579, 1352,
+	610, 612, 1330, 619, 279, 1559, 290, 585, 586, 1136,
+	1139, 290, 867, 276, 596, 1566, 1332, 864, 290, 870,
+	1561, 1253, 1255, 636, 1087, 290, 603, 853, 1087, 609,
+	94, 411, 1323, 1408, 94, 1407, 1406, 1354, 1325, 1358,
+	595, 1353, 94, 1351, 575, 583, 582, 590, 1356, 294,
+	1131, 280, 94, 94, 1596, 1146, 1491, 1355, 1145, 1188,
+	97, 98, 99, 685, 686, 1262, 1185, 1225, 1198, 1068,
+	1357, 1359, 1130, 1176, 785, 765, 584, 1087, 285, 698,
+	601, 592, 673, 1057, 642, 97, 98, 99, 600, 1002,
+	608, 1560, 1621, 647, 648, 602, 663, 904, 1587, 673,
+	653, 1101, 618, 1254, 607, 597, 598, 599, 823, 97,
+	98, 99, 1135, 1689, 620, 587, 1690, 588, 1688, 898,
+	589, 893, 61, 1605, 1567, 1565, 83, 1137, 1086, 1575,
+	58, 58, 1086, 929, 1395, 58, 1651, 1652, 621, 1431,
+	1651, 1652, 1324, 1368, 1322, 683, 635, 927, 928, 926,
+	650, 793, 58, 651, 652, 650, 94, 981, 290, 290,
+	290, 741, 652, 650, 742, 84, 653, 94, 646, 685,
+	686, 653, 1672, 94, 645, 643, 685, 686, 644, 653,
+	981, 1086, 1195, 855, 58, 1284, 1083, 1081, 606, 1082,
+	1609, 441, 811, 758, 1630, 701, 1079, 1085, 917, 919,
+	920, 750, 1628, 1516, 757, 918, 899, 702, 894, 1526,
+	718, 720, 722, 724, 726, 728, 729, 1161, 1162, 1163,
+	719, 721, 769, 725, 727, 1090, 730, 1515, 768, 778,
+	777, 1299, 1091, 824, 666, 667, 668, 669, 670, 663,
+	1298, 1287, 673, 788, 662, 661, 671, 672, 664, 665,
+	666, 667, 668, 669, 670, 663, 1606, 783, 673, 1696,
+	837, 840, 841, 842, 843, 844, 845, 1536, 846, 847,
+	848, 849, 850, 825, 826, 827, 828, 809, 810, 838,
+	1513, 812, 1296, 813, 814, 815, 816, 817, 818, 819,
+	820, 821, 822, 829, 830, 831, 832, 833, 834, 835,
+	836, 1169, 290, 1697, 1158, 884, 851, 94, 59, 854,
+	1026, 856, 290, 1314, 290, 94, 94, 94, 412, 412,
+	925, 290, 755, 1572, 290, 1007, 1008, 68, 290, 876,
+	877, 1571, 290, 1184, 94, 1183, 274, 1182, 1027, 94,
+	94, 94, 290, 94, 94, 1310, 1311, 1312, 649, 97,
+	98, 99, 839, 94, 94, 1427, 651, 652, 650, 662,
+	661, 671, 672, 664, 665, 666, 667, 668, 669, 670,
+	663, 1088, 801, 673, 653, 880, 1208, 1670, 651, 652,
+	650, 412, 857, 1394, 859, 571, 883, 1487, 651, 652,
+	650, 868, 1383, 871, 432, 1394, 653, 1624, 875, 70,
+	651, 652, 650, 1221, 946, 858, 653, 1051, 1370, 780,
+	923, 852, 889, 948, 427, 428, 873, 1313, 653, 860,
+	861, 862, 1318, 1315, 1306, 1316, 1309, 94, 1305, 97,
+	98, 99, 1307, 1308, 290, 97, 98, 99, 882, 947,
+	1632, 412, 1016, 886, 887, 888, 1317, 890, 891, 1027,
+	969, 972, 1574, 956, 1208, 1617, 982, 895, 896, 1221,
+	901, 963, 94, 94, 924, 1435, 97, 98, 99, 290,
+	1269, 94, 958, 1208, 412, 957, 664, 665, 666, 667,
+	668, 669, 670, 663, 1027, 94, 673, 1208, 1597, 780,
+	290, 1208, 1563, 94, 1506, 1505, 1017, 1004, 290, 1493,
+	412, 1270, 995, 1490, 412, 1394, 290, 290, 1060, 701,
+	290, 290, 949, 950, 290, 290, 290, 94, 1201, 960,
+	956, 702, 1200, 990, 991, 1437, 1436, 964, 1433, 1434,
+	94, 573, 72, 999, 1433, 1432, 1042, 1003, 1016, 958,
+	1005, 441, 1022, 1016, 1018, 1010, 1016, 412, 1027, 412,
+	869, 994, 649, 412, 1072, 786, 651, 652, 650, 880,
+	1019, 800, 799, 738, 1102, 1103, 1104, 1009, 1025, 59,
+	1645, 1522, 1020, 1052, 653, 781, 739, 1054, 1095, 781,
+	1498, 1053, 1115, 59, 290, 94, 1423, 94, 1273, 290,
+	1138, 1050, 1059, 1058, 290, 290, 290, 290, 290, 1111,
+	290, 290, 1398, 1399, 290, 290, 94, 1116, 1055, 1075,
+	1106, 59, 965, 966, 1105, 1328, 971, 974, 975, 1523,
+	782, 1118, 784, 290, 782, 1682, 780, 1677, 1425, 290,
+	290, 290, 1401, 1383, 1300, 905, 290, 94, 874, 1404,
+	1403, 989, 1244, 1242, 992, 993, 1241, 1245, 1243, 1112,
+	1113, 1240, 1657, 1246, 1119, 1036, 1037, 961, 962, 1123,
+	1640, 1376, 1210, 418, 1140, 1141, 1142, 1143, 1144, 1647,
+	1147, 1148, 754, 1149, 432, 1150, 1219, 419, 977, 1153,
+	923, 1218, 1291, 1157, 751, 752, 421, 1283, 420, 1120,
+	744, 1122, 978, 1152, 358, 357, 360, 361, 362, 363,
+	1000, 1156, 745, 359, 364, 798, 1159, 604, 1611, 1610,
+	1151, 671, 672, 664, 665, 666, 667, 668, 669, 670,
+	663, 1543, 1281, 673, 1179, 1096, 1097, 1098, 1099, 1275,
+	1164, 1485, 1518, 1121, 924, 872, 1625, 1374, 1040, 404,
+	405, 1107, 1108, 1109, 1217, 407, 1619, 1484, 290, 70,
+	418, 408, 1216, 1483, 1379, 1178, 74, 1042, 290, 290,
+	290, 290, 290, 1177, 419, 1221, 631, 1189, 1234, 1186,
+	290, 415, 416, 421, 290, 420, 900, 1194, 290, 1684,
+	1683, 72, 290, 759, 1229, 402, 1684, 1594, 76, 77,
+	78, 79, 80, 1511, 1001, 68, 75, 65, 1, 1268,
+	1213, 94, 1675, 1446, 1251, 1224, 1519, 1128, 1612, 1557,
+	1274, 1223, 1417, 1222, 1279, 1279, 1209, 1271, 397, 1078,
+	1258, 1069, 1260, 1235, 1261, 1072, 1238, 1214, 82, 410,
+	570, 1247, 81, 1257, 1236, 1237, 1604, 1239, 892, 617,
+	1263, 1077, 1076, 1564, 1509, 1259, 1288, 1289, 1089, 94,
+	94, 1290, 1280, 1292, 1293, 1294, 95, 1285, 1092, 1424,
+	291, 1173, 1174, 291, 1282, 1608, 806, 804, 95, 805,
+	291, 803, 808, 1276, 1277, 807, 802, 291, 1297, 304,
+	436, 94, 906, 318, 1192, 1039, 794, 1117, 760, 1303,
+	1032, 1035, 1036, 1037, 1033, 85, 1034, 1038, 291, 95,
+	1398, 1399, 1321, 291, 1320, 291, 1171, 94, 1124, 903,
+	1172, 1319, 301, 625, 946, 1032, 1035, 1036, 1037, 1033,
+	626, 1034, 1038, 1180, 1181, 306, 681, 1215, 1264, 1187,
+	442, 1345, 1190, 1191, 435, 1389, 1006, 1346, 747, 94,
+	1197, 1482, 1378, 1336, 1199, 1193, 290, 1202, 1203, 1204,
+	1205, 1206, 1367, 1371, 1302, 1207, 94, 712, 979, 341,
+	916, 94, 94, 1361, 1344, 356, 353, 1360, 354, 1234,
+	1384, 1348, 958, 1011, 1226, 957, 655, 339, 333, 773,
+	1345, 766, 1387, 1031, 1029, 1333, 1334, 94, 1028, 431,
+	1335, 1400, 1396, 772, 1015, 1347, 414, 1476, 1585, 1249,
+	1250, 94, 1393, 94, 94, 413, 976, 1279, 1279, 1402,
+	51, 638, 323, 32, 422, 22, 1377, 21, 1410, 1416,
+	20, 19, 1430, 1409, 18, 1072, 24, 1072, 17, 1411,
+	16, 290, 15, 591, 36, 26, 1415, 25, 1420, 1428,
+	1429, 14, 13, 12, 1421, 1422, 11, 10, 9, 8,
+	4, 290, 641, 23, 1653, 1636, 1579, 94, 1525, 1447,
+	94, 94, 94, 290, 1591, 1539, 1635, 1340, 398, 1439,
+	699, 2, 94, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1440, 0, 1442, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 0, 0, 0, 291,
+	335, 1438, 0, 0, 0, 0, 291, 0, 0, 0,
+	0, 1464, 0, 291, 0, 0, 1452, 1453, 95, 0,
+	0, 1441, 95, 0, 0, 0, 0, 0, 0, 0,
+	95, 1342, 1343, 1451, 0, 0, 1234, 1481, 1458, 0,
+	95, 95, 0, 1486, 0, 0, 94, 1495, 0, 0,
+	0, 0, 0, 1494, 94, 0, 0, 0, 0, 0,
+	0, 1508, 1271, 0, 0, 1459, 0, 0, 0, 94,
+	1072, 0, 0, 0, 0, 0, 94, 0, 0, 0,
+	0, 0, 1512, 0, 1514, 0, 0, 0, 0, 0,
+	0, 0, 0, 1529, 0, 0, 0, 0, 0, 0,
+	1521, 0, 1504, 0, 0, 0, 0, 0, 0, 1405,
+	0, 0, 1528, 0, 0, 0, 0, 0, 0, 0,
+	0, 94, 94, 0, 94, 1542, 0, 0, 0, 94,
+	0, 94, 94, 94, 290, 0, 1551, 94, 1552, 1554,
+	1555, 1387, 0, 0, 95, 1544, 291, 291, 291, 0,
+	1546, 0, 0, 94, 290, 95, 1568, 0, 1562, 1556,
+	1576, 95, 0, 1569, 0, 1570, 1461, 1462, 1535, 1463,
+	0, 94, 1465, 1517, 1467, 1527, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1550, 0, 0, 0,
+	1603, 0, 0, 0, 1595, 0, 0, 0, 0, 1387,
+	0, 1602, 1457, 0, 1601, 94, 94, 1460, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1469, 1470,
+	1615, 0, 1616, 0, 1577, 0, 0, 94, 0, 1521,
+	1072, 0, 0, 0, 0, 1507, 1234, 1626, 290, 1618,
+	0, 0, 0, 0, 0, 94, 1488, 1489, 0, 1492,
+	0, 0, 0, 0, 0, 94, 0, 1634, 0, 0,
+	1639, 0, 0, 1643, 0, 0, 0, 1503, 0, 1646,
+	1648, 0, 0, 0, 0, 0, 94, 0, 0, 1658,
+	0, 0, 0, 1659, 1661, 0, 0, 0, 0, 0,
+	291, 0, 0, 0, 0, 95, 0, 0, 94, 0,
+	291, 0, 291, 95, 95, 95, 1679, 1681, 1629, 291,
+	0, 0, 291, 0, 0, 0, 291, 1692, 1480, 0,
+	291, 0, 95, 0, 0, 0, 0, 95, 95, 95,
+	291, 95, 95, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 95, 0, 687, 688, 689, 690, 691, 692,
+	693, 694, 695, 696, 0, 97, 98, 99, 1553, 662,
+	661, 671, 672, 664, 665, 666, 667, 668, 669, 670,
+	663, 0, 0, 673, 0, 0, 0, 1337, 0, 0,
+	0, 0, 1578, 0, 0, 0, 1479, 0, 1581, 1582,
+	1583, 1584, 0, 1588, 0, 1589, 1590, 662, 661, 671,
+	672, 664, 665, 666, 667, 668, 669, 670, 663, 295,
+	1598, 673, 1599, 1600, 0, 95, 0, 0, 298, 370,
+	29, 0, 291, 0, 0, 0, 305, 662, 661, 671,
+	672, 664, 665, 666, 667, 668, 669, 670, 663, 0,
+	0, 673, 0, 1620, 0, 0, 0, 0, 0, 29,
+	95, 95, 0, 0, 0, 0, 0, 291, 0, 95,
+	303, 0, 0, 0, 0, 0, 0, 310, 0, 0,
+	1631, 0, 0, 95, 0, 0, 0, 0, 291, 0,
+	0, 95, 0, 0, 0, 0, 291, 403, 0, 0,
+	0, 0, 0, 0, 291, 291, 0, 296, 291, 291,
+	0, 0, 291, 291, 291, 95, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1669, 367, 95, 1474,
+	0, 0, 0, 0, 307, 299, 0, 308, 309, 315,
+	0, 0, 0, 300, 302, 312, 0, 297, 314, 313,
+	0, 0, 0, 1693, 1694, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 661, 671, 672, 664,
+	665, 666, 667, 668, 669, 670, 663, 320, 0, 673,
+	0, 0, 291, 95, 0, 95, 0, 291, 0, 0,
+	0, 0, 291, 291, 291, 291, 291, 0, 291, 291,
+	0, 0, 291, 291, 95, 1473, 0, 0, 443, 662,
+	661, 671, 672, 664, 665, 666, 667, 668, 669, 670,
+	663, 291, 1472, 673, 0, 0, 0, 291, 291, 291,
+	657, 0, 660, 0, 291, 95, 0, 0, 674, 675,
+	676, 677, 678, 679, 680, 0, 658, 659, 656, 662,
+	661, 671, 672, 664, 665, 666, 667, 668, 669, 670,
+	663, 0, 0, 673, 0, 0, 921, 0, 0, 930,
+	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
+	941, 942, 943, 944, 0, 662, 661, 671, 672, 664,
+	665, 666, 667, 668, 669, 670, 663, 0, 0, 673,
+	0, 1471, 662, 661, 671, 672, 664, 665, 666, 667,
+	668, 669, 670, 663, 0, 425, 673, 0, 0, 0,
+	0, 0, 0, 30, 31, 60, 33, 34, 986, 615,
+	0, 0, 0, 615, 0, 0, 0, 0, 0, 0,
+	0, 615, 64, 0, 0, 0, 291, 35, 54, 55,
+	0, 57, 0, 29, 0, 0, 291, 291, 291, 291,
+	291, 0, 0, 0, 0, 0, 682, 684, 291, 0,
+	44, 0, 291, 332, 59, 0, 291, 0, 0, 0,
+	291, 662, 661, 671, 672, 664, 665, 666, 667, 668,
+	669, 670, 663, 0, 0, 673, 0, 697, 0, 95,
+	0, 704, 705, 706, 707, 708, 709, 710, 711, 0,
+	714, 717, 717, 717, 723, 717, 717, 723, 717, 731,
+	732, 733, 734, 735, 736, 737, 0, 443, 0, 0,
+	740, 443, 0, 29, 0, 0, 0, 0, 0, 443,
+	37, 38, 40, 39, 42, 0, 56, 95, 95, 637,
+	639, 0, 0, 0, 0, 0, 0, 0, 775, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 43,
+	63, 62, 0, 0, 52, 53, 41, 0, 0, 95,
+	0, 0, 0, 0, 0, 0, 1170, 0, 0, 0,
+	0, 45, 46, 0, 47, 48, 49, 50, 0, 0,
+	0, 0, 0, 0, 0, 95, 662, 661, 671, 672,
+	664, 665, 666, 667, 668, 669, 670, 663, 0, 0,
+	673, 662, 661, 671, 672, 664, 665, 666, 667, 668,
+	669, 670, 663, 0, 0, 673, 0, 95, 1165, 1166,
+	1167, 0, 0, 0, 291, 0, 0, 0, 0, 0,
+	0, 0, 0, 763, 95, 0, 0, 0, 0, 95,
+	95, 0, 0, 0, 443, 0, 0, 0, 0, 0,
+	795, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	61, 0, 0, 0, 0, 95, 0, 0, 0, 0,
+	0, 0, 0, 58, 0, 0, 0, 0, 0, 95,
+	0, 95, 95, 0, 0, 0, 615, 0, 0, 0,
+	0, 0, 0, 0, 615, 615, 615, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 615, 0, 0, 0, 0, 615, 615,
+	615, 0, 615, 615, 0, 0, 0, 0, 0, 291,
+	654, 0, 615, 615, 0, 95, 0, 0, 95, 95,
+	95, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	95, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 332, 0, 0, 0,
+	0, 0, 0, 0, 0, 713, 0, 0, 0, 0,
+	0, 0, 0, 0, 443, 0, 0, 0, 0, 0,
+	0, 0, 443, 443, 443, 0, 0, 0, 0, 0,
+	0, 0, 0, 746, 749, 0, 0, 0, 0, 0,
+	0, 443, 0, 0, 0, 0, 443, 443, 443, 0,
+	443, 443, 0, 0, 95, 0, 0, 0, 0, 0,
+	443, 443, 95, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 95, 0, 996,
+	0, 0, 0, 0, 95, 0, 0, 0, 0, 0,
+	1338, 1339, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1362, 1363, 0, 1364,
+	1365, 0, 0, 0, 0, 1043, 0, 0, 0, 775,
+	0, 1372, 1373, 775, 0, 0, 0, 0, 0, 95,
+	95, 0, 95, 0, 951, 0, 443, 95, 0, 95,
+	95, 95, 291, 0, 0, 95, 0, 0, 0, 0,
+	0, 0, 983, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 291, 0, 0, 0, 0, 0, 0, 987,
+	988, 0, 0, 0, 0, 0, 0, 0, 998, 95,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1012, 0, 615, 0, 615, 0, 0, 0,
+	763, 0, 0, 443, 1426, 0, 0, 0, 0, 0,
+	0, 0, 0, 95, 95, 615, 0, 0, 0, 0,
+	0, 0, 0, 0, 443, 0, 0, 0, 0, 0,
+	0, 0, 0, 885, 0, 95, 0, 443, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 0,
+	0, 0, 0, 95, 0, 0, 0, 902, 1454, 0,
+	0, 0, 0, 95, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 912, 913, 914, 915, 0,
+	0, 0, 0, 0, 95, 0, 0, 0, 0, 0,
+	0, 0, 443, 0, 443, 0, 1175, 0, 0, 0,
+	403, 0, 0, 0, 0, 0, 95, 0, 0, 0,
+	0, 0, 0, 443, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 967, 968, 0, 0, 29, 0, 0, 0,
+	0, 0, 0, 0, 1160, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 775, 0, 0,
+	0, 368, 0, 1230, 1231, 0, 0, 775, 775, 775,
+	775, 775, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1043, 0, 1256, 0, 0, 0, 0,
+	0, 775, 1530, 1531, 1532, 1533, 1534, 0, 0, 0,
+	1537, 1538, 0, 289, 0, 0, 316, 0, 0, 0,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 1066,
+	400, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
+	0, 434, 0, 0, 0, 0, 289, 0, 289, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 615,
+	0, 0, 0, 0, 983, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	615, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 443, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1301, 443, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1388, 0,
+	29, 0, 0, 0, 0, 0, 0, 1667, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 443, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1685,
+	0, 0, 0, 0, 0, 0, 0, 1196, 0, 0,
+	0, 0, 0, 0, 443, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1211,
+	1212, 749, 0, 0, 0, 0, 443, 289, 0, 0,
+	0, 0, 289, 0, 0, 0, 1375, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 443, 0, 983, 0, 0, 1390, 1392,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1456, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1392, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1475, 0, 443, 0,
+	443, 1419, 0, 0, 996, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1500, 1501, 1502, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1443, 0, 0, 1448, 1449, 1450,
+	0, 0, 0, 0, 0, 0, 426, 0, 615, 1455,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	289, 289, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1369, 0, 0, 0, 0, 0, 1388, 0, 29,
+	0, 0, 983, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1380, 0, 0,
+	0, 0, 0, 443, 0, 0, 0, 0, 1573, 0,
+	0, 998, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 443, 0, 0, 0,
+	0, 0, 0, 443, 0, 1388, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 864, 0, 431, 0, 324, 0, 0, 0, 0,
-	0, 0, 1447, 0, 0, 1448, 0, 0, 1450, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 431, 0,
-	955, 0, 414, 1345, 1347, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 282, 282, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1347,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 431, 0, 431, 1374, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1488, 324, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1547, 1548,
+	0, 1549, 0, 289, 0, 0, 998, 0, 998, 998,
+	998, 0, 0, 289, 1419, 289, 0, 0, 0, 0,
+	0, 0, 289, 0, 0, 289, 0, 0, 0, 289,
+	998, 0, 0, 879, 0, 1644, 0, 0, 0, 0,
+	0, 0, 0, 289, 0, 0, 0, 0, 1593, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1478, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1398,
-	0, 0, 1403, 1404, 1405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 985, 0, 0, 0, 0, 0,
-	0, 0, 991, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1019, 0, 0, 0, 0,
+	0, 1678, 443, 443, 332, 0, 0, 0, 0, 0,
+	0, 1496, 0, 0, 1497, 0, 0, 1499, 0, 0,
+	0, 0, 983, 0, 1627, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 282, 0, 0, 0,
-	0, 0, 955, 0, 0, 0, 282, 282, 0, 0,
-	0, 0, 0, 0, 282, 0, 0, 282, 0, 0,
-	282, 0, 0, 431, 855, 0, 0, 0, 0, 0,
-	0, 1460, 0, 0, 282, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 431, 0, 1085, 0,
-	0, 0, 0, 431, 0, 0, 0, 1105, 1106, 1107,
-	1108, 1109, 0, 1112, 1113, 0, 0, 1114, 0, 0,
+	0, 0, 1633, 0, 0, 0, 0, 0, 0, 426,
+	879, 0, 1593, 0, 0, 400, 426, 426, 0, 0,
+	426, 426, 426, 0, 0, 0, 984, 0, 0, 0,
+	0, 0, 0, 998, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 426, 426, 426, 426, 426,
+	400, 0, 0, 0, 0, 1673, 0, 0, 0, 1541,
+	332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 879, 0, 289,
+	0, 0, 0, 0, 0, 0, 0, 289, 1048, 0,
+	0, 289, 289, 0, 0, 289, 1056, 879, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1116, 0, 0, 0, 0, 1117,
-	0, 0, 0, 0, 1494, 1495, 1122, 1496, 0, 0,
-	0, 0, 1460, 0, 1460, 1460, 1460, 0, 0, 0,
-	1374, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	414, 855, 0, 0, 0, 414, 414, 1460, 0, 414,
-	414, 414, 0, 0, 0, 956, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 414, 414, 414, 414, 414, 0,
-	0, 0, 0, 0, 1550, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 431, 431, 282, 0, 0,
-	0, 0, 0, 855, 0, 282, 0, 0, 0, 955,
-	0, 1566, 0, 282, 1014, 0, 0, 282, 282, 0,
-	0, 282, 1022, 855, 0, 0, 0, 0, 0, 0,
-	1572, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1460, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 282, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 282, 282, 282, 282, 0, 282, 282, 0, 0,
-	282, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 282, 0, 0,
-	0, 0, 282, 0, 1119, 1120, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 289, 0, 0, 0, 0,
+	289, 0, 0, 0, 0, 289, 289, 289, 289, 289,
+	0, 289, 289, 0, 0, 289, 289, 0, 0, 0,
+	0, 0, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	1154, 1155, 289, 0, 0, 0, 0, 289, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 414, 414, 0,
+	0, 0, 0, 0, 0, 426, 426, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
-	0, 0, 1332, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 414, 282, 0, 0, 0, 0, 0, 0,
-	0, 0, 956, 282, 282, 282, 282, 282, 0, 0,
-	0, 0, 0, 0, 0, 1208, 0, 0, 0, 282,
-	0, 0, 0, 1014, 0, 0, 0, 282, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 426, 289,
+	0, 0, 0, 0, 0, 0, 0, 0, 984, 289,
+	289, 289, 289, 289, 0, 0, 0, 0, 0, 0,
+	0, 1248, 0, 0, 0, 289, 0, 0, 0, 1048,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1396, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1406,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	855, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 956, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 426, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	879, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 984,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1525, 0, 0, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 282, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1568, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 956,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1014, 0, 0, 0, 0, 0,
-	541, 529, 0, 486, 544, 459, 476, 552, 477, 480,
-	517, 444, 499, 180, 474, 282, 463, 439, 470, 440,
-	461, 488, 123, 492, 458, 531, 502, 543, 152, 0,
-	464, 550, 154, 508, 0, 227, 168, 0, 0, 0,
-	490, 533, 497, 526, 485, 518, 449, 507, 545, 475,
-	515, 546, 0, 0, 0, 91, 92, 93, 0, 1039,
-	1040, 0, 0, 0, 0, 0, 113, 0, 512, 540,
-	472, 514, 516, 554, 438, 509, 956, 442, 445, 551,
-	536, 467, 468, 1232, 0, 0, 0, 0, 0, 282,
-	489, 498, 523, 483, 0, 0, 0, 0, 0, 0,
-	0, 0, 465, 0, 506, 0, 0, 0, 446, 443,
-	0, 0, 0, 0, 487, 0, 0, 0, 448, 0,
-	466, 524, 0, 436, 132, 528, 535, 484, 285, 539,
-	482, 481, 542, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 532, 462, 471,
-	117, 469, 209, 187, 248, 505, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 441, 0,
-	228, 251, 267, 111, 457, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 453, 456,
-	451, 452, 500, 501, 547, 548, 549, 525, 447, 0,
-	454, 455, 0, 530, 537, 538, 504, 94, 103, 153,
-	264, 202, 128, 252, 437, 450, 121, 460, 0, 0,
-	473, 478, 479, 491, 493, 494, 495, 496, 503, 510,
-	511, 513, 519, 520, 521, 522, 527, 534, 553, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 541, 529,
-	0, 486, 544, 459, 476, 552, 477, 480, 517, 444,
-	499, 180, 474, 0, 463, 439, 470, 440, 461, 488,
-	123, 492, 458, 531, 502, 543, 152, 0, 464, 550,
-	154, 508, 0, 227, 168, 0, 0, 0, 490, 533,
-	497, 526, 485, 518, 449, 507, 545, 475, 515, 546,
-	0, 0, 0, 91, 92, 93, 0, 1039, 1040, 0,
-	0, 0, 0, 0, 113, 0, 512, 540, 472, 514,
-	516, 554, 438, 509, 0, 442, 445, 551, 536, 467,
-	468, 0, 0, 0, 0, 0, 0, 0, 489, 498,
-	523, 483, 0, 0, 0, 0, 0, 0, 0, 0,
-	465, 0, 506, 0, 0, 0, 446, 443, 0, 0,
-	0, 0, 487, 0, 0, 0, 448, 0, 466, 524,
-	0, 436, 132, 528, 535, 484, 285, 539, 482, 481,
-	542, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 532, 462, 471, 117, 469,
-	209, 187, 248, 505, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 441, 0, 228, 251,
-	267, 111, 457, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 453, 456, 451, 452,
-	500, 501, 547, 548, 549, 525, 447, 0, 454, 455,
-	0, 530, 537, 538, 504, 94, 103, 153, 264, 202,
-	128, 252, 437, 450, 121, 460, 0, 0, 473, 478,
-	479, 491, 493, 494, 495, 496, 503, 510, 511, 513,
-	519, 520, 521, 522, 527, 534, 553, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 541, 529, 0, 486,
-	544, 459, 476, 552, 477, 480, 517, 444, 499, 180,
-	474, 0, 463, 439, 470, 440, 461, 488, 123, 492,
-	458, 531, 502, 543, 152, 0, 464, 550, 154, 508,
-	0, 227, 168, 0, 0, 0, 490, 533, 497, 526,
-	485, 518, 449, 507, 545, 475, 515, 546, 57, 0,
-	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 512, 540, 472, 514, 516, 554,
-	438, 509, 0, 442, 445, 551, 536, 467, 468, 0,
-	0, 0, 0, 0, 0, 0, 489, 498, 523, 483,
-	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
-	506, 0, 0, 0, 446, 443, 0, 0, 0, 0,
-	487, 0, 0, 0, 448, 0, 466, 524, 0, 436,
-	132, 528, 535, 484, 285, 539, 482, 481, 542, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 532, 462, 471, 117, 469, 209, 187,
-	248, 505, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 441, 0, 228, 251, 267, 111,
-	457, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 453, 456, 451, 452, 500, 501,
-	547, 548, 549, 525, 447, 0, 454, 455, 0, 530,
-	537, 538, 504, 94, 103, 153, 264, 202, 128, 252,
-	437, 450, 121, 460, 0, 0, 473, 478, 479, 491,
-	493, 494, 495, 496, 503, 510, 511, 513, 519, 520,
-	521, 522, 527, 534, 553, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 541, 529, 0, 486, 544, 459,
-	476, 552, 477, 480, 517, 444, 499, 180, 474, 0,
-	463, 439, 470, 440, 461, 488, 123, 492, 458, 531,
-	502, 543, 152, 0, 464, 550, 154, 508, 0, 227,
-	168, 0, 0, 0, 490, 533, 497, 526, 485, 518,
-	449, 507, 545, 475, 515, 546, 0, 0, 0, 91,
-	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
-	113, 0, 512, 540, 472, 514, 516, 554, 438, 509,
-	0, 442, 445, 551, 536, 467, 468, 0, 0, 0,
-	0, 0, 0, 0, 489, 498, 523, 483, 0, 0,
-	0, 0, 0, 0, 1336, 0, 465, 0, 506, 0,
-	0, 0, 446, 443, 0, 0, 0, 0, 487, 0,
-	0, 0, 448, 0, 466, 524, 0, 436, 132, 528,
-	535, 484, 285, 539, 482, 481, 542, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 532, 462, 471, 117, 469, 209, 187, 248, 505,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 441, 0, 228, 251, 267, 111, 457, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 453, 456, 451, 452, 500, 501, 547, 548,
-	549, 525, 447, 0, 454, 455, 0, 530, 537, 538,
-	504, 94, 103, 153, 264, 202, 128, 252, 437, 450,
-	121, 460, 0, 0, 473, 478, 479, 491, 493, 494,
-	495, 496, 503, 510, 511, 513, 519, 520, 521, 522,
-	527, 534, 553, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 541, 529, 0, 486, 544, 459, 476, 552,
-	477, 480, 517, 444, 499, 180, 474, 0, 463, 439,
-	470, 440, 461, 488, 123, 492, 458, 531, 502, 543,
-	152, 0, 464, 550, 154, 508, 0, 227, 168, 0,
-	0, 0, 490, 533, 497, 526, 485, 518, 449, 507,
-	545, 475, 515, 546, 0, 0, 0, 91, 92, 93,
-	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
-	512, 540, 472, 514, 516, 554, 438, 509, 0, 442,
-	445, 551, 536, 467, 468, 0, 0, 0, 0, 0,
-	0, 0, 489, 498, 523, 483, 0, 0, 0, 0,
-	0, 0, 1023, 0, 465, 0, 506, 0, 0, 0,
-	446, 443, 0, 0, 0, 0, 487, 0, 0, 0,
-	448, 0, 466, 524, 0, 436, 132, 528, 535, 484,
-	285, 539, 482, 481, 542, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 532,
-	462, 471, 117, 469, 209, 187, 248, 505, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	441, 0, 228, 251, 267, 111, 457, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	453, 456, 451, 452, 500, 501, 547, 548, 549, 525,
-	447, 0, 454, 455, 0, 530, 537, 538, 504, 94,
-	103, 153, 264, 202, 128, 252, 437, 450, 121, 460,
-	0, 0, 473, 478, 479, 491, 493, 494, 495, 496,
-	503, 510, 511, 513, 519, 520, 521, 522, 527, 534,
-	553, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	541, 529, 0, 486, 544, 459, 476, 552, 477, 480,
-	517, 444, 499, 180, 474, 0, 463, 439, 470, 440,
-	461, 488, 123, 492, 458, 531, 502, 543, 152, 0,
-	464, 550, 154, 508, 0, 227, 168, 0, 0, 0,
-	490, 533, 497, 526, 485, 518, 449, 507, 545, 475,
-	515, 546, 0, 0, 0, 91, 92, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 512, 540,
-	472, 514, 516, 554, 438, 509, 0, 442, 445, 551,
-	536, 467, 468, 0, 0, 0, 0, 0, 0, 0,
-	489, 498, 523, 483, 0, 0, 0, 0, 0, 0,
-	987, 0, 465, 0, 506, 0, 0, 0, 446, 443,
-	0, 0, 0, 0, 487, 0, 0, 0, 448, 0,
-	466, 524, 0, 436, 132, 528, 535, 484, 285, 539,
-	482, 481, 542, 199, 0, 231, 136, 151, 109, 148,
-	95, 105, 0, 134, 177, 207, 211, 532, 462, 471,
-	117, 469, 209, 187, 248, 505, 189, 208, 155, 237,
-	200, 247, 257, 258, 234, 255, 263, 224, 98, 233,
-	245, 114, 219, 0, 0, 0, 100, 243, 230, 166,
-	145, 146, 99, 0, 205, 122, 130, 119, 179, 240,
-	241, 118, 266, 106, 254, 102, 107, 253, 173, 236,
-	244, 167, 160, 101, 242, 165, 159, 150, 126, 138,
-	197, 157, 198, 139, 170, 169, 171, 0, 441, 0,
-	228, 251, 267, 111, 457, 235, 261, 262, 0, 201,
-	112, 131, 125, 196, 129, 172, 108, 141, 225, 149,
-	156, 204, 265, 186, 210, 115, 250, 226, 453, 456,
-	451, 452, 500, 501, 547, 548, 549, 525, 447, 0,
-	454, 455, 0, 530, 537, 538, 504, 94, 103, 153,
-	264, 202, 128, 252, 437, 450, 121, 460, 0, 0,
-	473, 478, 479, 491, 493, 494, 495, 496, 503, 510,
-	511, 513, 519, 520, 521, 522, 527, 534, 553, 96,
-	97, 104, 110, 116, 120, 124, 127, 133, 137, 140,
-	142, 143, 144, 147, 158, 161, 162, 163, 164, 174,
-	175, 176, 178, 181, 182, 183, 184, 185, 188, 190,
-	191, 192, 193, 194, 195, 203, 206, 212, 213, 214,
-	215, 216, 217, 218, 220, 221, 222, 223, 229, 232,
-	238, 239, 249, 256, 259, 135, 246, 260, 541, 529,
-	0, 486, 544, 459, 476, 552, 477, 480, 517, 444,
-	499, 180, 474, 0, 463, 439, 470, 440, 461, 488,
-	123, 492, 458, 531, 502, 543, 152, 0, 464, 550,
-	154, 508, 0, 227, 168, 0, 0, 0, 490, 533,
-	497, 526, 485, 518, 449, 507, 545, 475, 515, 546,
-	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 512, 540, 472, 514,
-	516, 554, 438, 509, 0, 442, 445, 551, 536, 467,
-	468, 0, 0, 0, 0, 0, 0, 0, 489, 498,
-	523, 483, 0, 0, 0, 0, 0, 0, 0, 0,
-	465, 0, 506, 0, 0, 0, 446, 443, 0, 0,
-	0, 0, 487, 0, 0, 0, 448, 0, 466, 524,
-	0, 436, 132, 528, 535, 484, 285, 539, 482, 481,
-	542, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 532, 462, 471, 117, 469,
-	209, 187, 248, 505, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 441, 0, 228, 251,
-	267, 111, 457, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 453, 456, 451, 452,
-	500, 501, 547, 548, 549, 525, 447, 0, 454, 455,
-	0, 530, 537, 538, 504, 94, 103, 153, 264, 202,
-	128, 252, 437, 450, 121, 460, 0, 0, 473, 478,
-	479, 491, 493, 494, 495, 496, 503, 510, 511, 513,
-	519, 520, 521, 522, 527, 534, 553, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260, 541, 529, 0, 486,
-	544, 459, 476, 552, 477, 480, 517, 444, 499, 180,
-	474, 0, 463, 439, 470, 440, 461, 488, 123, 492,
-	458, 531, 502, 543, 152, 0, 464, 550, 154, 508,
-	0, 227, 168, 0, 0, 0, 490, 533, 497, 526,
-	485, 518, 449, 507, 545, 475, 515, 546, 0, 0,
-	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 512, 540, 472, 514, 516, 554,
-	438, 509, 0, 442, 445, 551, 536, 467, 468, 0,
-	0, 0, 0, 0, 0, 0, 489, 498, 523, 483,
-	0, 0, 0, 0, 0, 0, 0, 0, 465, 0,
-	506, 0, 0, 0, 446, 443, 0, 0, 0, 0,
-	487, 0, 0, 0, 448, 0, 466, 524, 0, 436,
-	132, 528, 535, 484, 285, 539, 482, 481, 542, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 532, 462, 471, 117, 469, 209, 187,
-	248, 505, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 434, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 441, 0, 228, 251, 267, 111,
-	457, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 435, 433, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 453, 456, 451, 452, 500, 501,
-	547, 548, 549, 525, 447, 0, 454, 455, 0, 530,
-	537, 538, 504, 94, 103, 153, 264, 202, 128, 252,
-	437, 450, 121, 460, 0, 0, 473, 478, 479, 491,
-	493, 494, 495, 496, 503, 510, 511, 513, 519, 520,
-	521, 522, 527, 534, 553, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 541, 529, 0, 486, 544, 459,
-	476, 552, 477, 480, 517, 444, 499, 180, 474, 0,
-	463, 439, 470, 440, 461, 488, 123, 492, 458, 531,
-	502, 543, 152, 0, 464, 550, 154, 508, 0, 227,
-	168, 0, 0, 0, 490, 533, 497, 526, 485, 518,
-	449, 507, 545, 475, 515, 546, 0, 0, 0, 91,
-	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
-	113, 0, 512, 540, 472, 514, 516, 554, 438, 509,
-	0, 442, 445, 551, 536, 467, 468, 0, 0, 0,
-	0, 0, 0, 0, 489, 498, 523, 483, 0, 0,
-	0, 0, 0, 0, 0, 0, 465, 0, 506, 0,
-	0, 0, 446, 443, 0, 0, 0, 0, 487, 0,
-	0, 0, 448, 0, 466, 524, 0, 436, 132, 528,
-	535, 484, 285, 539, 482, 481, 542, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 532, 462, 471, 117, 469, 209, 187, 248, 505,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 765, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	434, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 441, 0, 228, 251, 267, 111, 457, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 435,
-	433, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 453, 456, 451, 452, 500, 501, 547, 548,
-	549, 525, 447, 0, 454, 455, 0, 530, 537, 538,
-	504, 94, 103, 153, 264, 202, 128, 252, 437, 450,
-	121, 460, 0, 0, 473, 478, 479, 491, 493, 494,
-	495, 496, 503, 510, 511, 513, 519, 520, 521, 522,
-	527, 534, 553, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 541, 529, 0, 486, 544, 459, 476, 552,
-	477, 480, 517, 444, 499, 180, 474, 0, 463, 439,
-	470, 440, 461, 488, 123, 492, 458, 531, 502, 543,
-	152, 0, 464, 550, 154, 508, 0, 227, 168, 0,
-	0, 0, 490, 533, 497, 526, 485, 518, 449, 507,
-	545, 475, 515, 546, 0, 0, 0, 91, 92, 93,
-	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
-	512, 540, 472, 514, 516, 554, 438, 509, 0, 442,
-	445, 551, 536, 467, 468, 0, 0, 0, 0, 0,
-	0, 0, 489, 498, 523, 483, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 0, 506, 0, 0, 0,
-	446, 443, 0, 0, 0, 0, 487, 0, 0, 0,
-	448, 0, 466, 524, 0, 436, 132, 528, 535, 484,
-	285, 539, 482, 481, 542, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 532,
-	462, 471, 117, 469, 209, 187, 248, 505, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 425, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 434, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	441, 0, 228, 251, 267, 111, 457, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 435, 433, 428,
-	427, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	453, 456, 451, 452, 500, 501, 547, 548, 549, 525,
-	447, 0, 454, 455, 0, 530, 537, 538, 504, 94,
-	103, 153, 264, 202, 128, 252, 437, 450, 121, 460,
-	0, 0, 473, 478, 479, 491, 493, 494, 495, 496,
-	503, 510, 511, 513, 519, 520, 521, 522, 527, 534,
-	553, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	180, 0, 0, 927, 0, 329, 0, 0, 0, 123,
-	0, 328, 0, 0, 0, 152, 0, 928, 372, 154,
-	0, 0, 227, 168, 0, 0, 0, 0, 0, 363,
-	364, 0, 0, 0, 0, 0, 0, 0, 0, 57,
-	0, 0, 91, 92, 93, 350, 349, 352, 353, 354,
-	355, 0, 0, 113, 351, 356, 357, 358, 0, 0,
-	0, 0, 326, 343, 0, 371, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 340, 341, 412, 0, 0,
-	0, 386, 0, 342, 0, 0, 335, 336, 338, 337,
-	339, 344, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 132, 385, 0, 0, 285, 0, 0, 383, 0,
-	199, 0, 231, 136, 151, 109, 148, 95, 105, 0,
-	134, 177, 207, 211, 0, 0, 0, 117, 0, 209,
-	187, 248, 0, 189, 208, 155, 237, 200, 247, 257,
-	258, 234, 255, 263, 224, 98, 233, 245, 114, 219,
-	0, 0, 0, 100, 243, 230, 166, 145, 146, 99,
-	0, 205, 122, 130, 119, 179, 240, 241, 118, 266,
-	106, 254, 102, 107, 253, 173, 236, 244, 167, 160,
-	101, 242, 165, 159, 150, 126, 138, 197, 157, 198,
-	139, 170, 169, 171, 0, 0, 0, 228, 251, 267,
-	111, 0, 235, 261, 262, 0, 201, 112, 131, 125,
-	196, 129, 172, 108, 141, 225, 149, 156, 204, 265,
-	186, 210, 115, 250, 226, 373, 384, 379, 380, 377,
-	378, 376, 375, 374, 387, 365, 366, 367, 368, 370,
-	0, 381, 382, 369, 94, 103, 153, 264, 202, 128,
-	252, 0, 0, 121, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 97, 104, 110,
-	116, 120, 124, 127, 133, 137, 140, 142, 143, 144,
-	147, 158, 161, 162, 163, 164, 174, 175, 176, 178,
-	181, 182, 183, 184, 185, 188, 190, 191, 192, 193,
-	194, 195, 203, 206, 212, 213, 214, 215, 216, 217,
-	218, 220, 221, 222, 223, 229, 232, 238, 239, 249,
-	256, 259, 135, 246, 260, 180, 0, 0, 0, 0,
-	329, 0, 0, 0, 123, 0, 328, 0, 0, 0,
-	152, 0, 0, 372, 154, 0, 0, 227, 168, 0,
-	0, 0, 0, 0, 363, 364, 0, 0, 0, 0,
-	0, 0, 1030, 0, 57, 0, 0, 91, 92, 93,
-	350, 349, 352, 353, 354, 355, 0, 0, 113, 351,
-	356, 357, 358, 1031, 0, 0, 0, 326, 343, 0,
-	371, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	340, 341, 0, 0, 0, 0, 386, 0, 342, 0,
-	0, 335, 336, 338, 337, 339, 344, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 132, 385, 0, 0,
-	285, 0, 0, 383, 0, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 0,
-	0, 0, 117, 0, 209, 187, 248, 0, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	0, 0, 228, 251, 267, 111, 0, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	373, 384, 379, 380, 377, 378, 376, 375, 374, 387,
-	365, 366, 367, 368, 370, 0, 381, 382, 369, 94,
-	103, 153, 264, 202, 128, 252, 0, 0, 121, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	180, 0, 0, 0, 0, 329, 0, 0, 0, 123,
-	0, 328, 0, 0, 0, 152, 0, 0, 372, 154,
-	0, 0, 227, 168, 0, 0, 0, 0, 0, 363,
-	364, 0, 0, 0, 0, 0, 0, 0, 0, 57,
-	0, 400, 91, 92, 93, 350, 349, 352, 353, 354,
-	355, 0, 0, 113, 351, 356, 357, 358, 0, 0,
-	0, 0, 326, 343, 0, 371, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 340, 341, 0, 0, 0,
-	0, 386, 0, 342, 0, 0, 335, 336, 338, 337,
-	339, 344, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 132, 385, 0, 0, 285, 0, 0, 383, 0,
-	199, 0, 231, 136, 151, 109, 148, 95, 105, 0,
-	134, 177, 207, 211, 0, 0, 0, 117, 0, 209,
-	187, 248, 0, 189, 208, 155, 237, 200, 247, 257,
-	258, 234, 255, 263, 224, 98, 233, 245, 114, 219,
-	0, 0, 0, 100, 243, 230, 166, 145, 146, 99,
-	0, 205, 122, 130, 119, 179, 240, 241, 118, 266,
-	106, 254, 102, 107, 253, 173, 236, 244, 167, 160,
-	101, 242, 165, 159, 150, 126, 138, 197, 157, 198,
-	139, 170, 169, 171, 0, 0, 0, 228, 251, 267,
-	111, 0, 235, 261, 262, 0, 201, 112, 131, 125,
-	196, 129, 172, 108, 141, 225, 149, 156, 204, 265,
-	186, 210, 115, 250, 226, 373, 384, 379, 380, 377,
-	378, 376, 375, 374, 387, 365, 366, 367, 368, 370,
-	0, 381, 382, 369, 94, 103, 153, 264, 202, 128,
-	252, 0, 0, 121, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 97, 104, 110,
-	116, 120, 124, 127, 133, 137, 140, 142, 143, 144,
-	147, 158, 161, 162, 163, 164, 174, 175, 176, 178,
-	181, 182, 183, 184, 185, 188, 190, 191, 192, 193,
-	194, 195, 203, 206, 212, 213, 214, 215, 216, 217,
-	218, 220, 221, 222, 223, 229, 232, 238, 239, 249,
-	256, 259, 135, 246, 260, 180, 0, 0, 0, 0,
-	329, 0, 0, 0, 123, 0, 328, 0, 0, 0,
-	152, 0, 0, 372, 154, 0, 0, 227, 168, 0,
-	0, 0, 0, 0, 363, 364, 0, 0, 0, 0,
-	0, 0, 0, 0, 57, 0, 0, 91, 92, 93,
-	350, 349, 352, 353, 354, 355, 0, 0, 113, 351,
-	356, 357, 358, 0, 0, 0, 0, 326, 343, 0,
-	371, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	340, 341, 412, 0, 0, 0, 386, 0, 342, 0,
-	0, 335, 336, 338, 337, 339, 344, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 132, 385, 0, 0,
-	285, 0, 0, 383, 0, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 0,
-	0, 0, 117, 0, 209, 187, 248, 0, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	0, 0, 228, 251, 267, 111, 0, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	373, 384, 379, 380, 377, 378, 376, 375, 374, 387,
-	365, 366, 367, 368, 370, 0, 381, 382, 369, 94,
-	103, 153, 264, 202, 128, 252, 0, 0, 121, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	180, 0, 0, 0, 0, 329, 0, 0, 0, 123,
-	0, 328, 0, 0, 0, 152, 0, 0, 372, 154,
-	0, 0, 227, 168, 0, 0, 0, 0, 0, 363,
-	364, 0, 0, 0, 0, 0, 0, 0, 0, 57,
-	0, 0, 91, 92, 93, 350, 945, 352, 353, 354,
-	355, 0, 0, 113, 351, 356, 357, 358, 0, 0,
-	0, 0, 326, 343, 0, 371, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 340, 341, 412, 0, 0,
-	0, 386, 0, 342, 0, 0, 335, 336, 338, 337,
-	339, 344, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 132, 385, 0, 0, 285, 0, 0, 383, 0,
-	199, 0, 231, 136, 151, 109, 148, 95, 105, 0,
-	134, 177, 207, 211, 0, 0, 0, 117, 0, 209,
-	187, 248, 0, 189, 208, 155, 237, 200, 247, 257,
-	258, 234, 255, 263, 224, 98, 233, 245, 114, 219,
-	0, 0, 0, 100, 243, 230, 166, 145, 146, 99,
-	0, 205, 122, 130, 119, 179, 240, 241, 118, 266,
-	106, 254, 102, 107, 253, 173, 236, 244, 167, 160,
-	101, 242, 165, 159, 150, 126, 138, 197, 157, 198,
-	139, 170, 169, 171, 0, 0, 0, 228, 251, 267,
-	111, 0, 235, 261, 262, 0, 201, 112, 131, 125,
-	196, 129, 172, 108, 141, 225, 149, 156, 204, 265,
-	186, 210, 115, 250, 226, 373, 384, 379, 380, 377,
-	378, 376, 375, 374, 387, 365, 366, 367, 368, 370,
-	0, 381, 382, 369, 94, 103, 153, 264, 202, 128,
-	252, 0, 0, 121, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 97, 104, 110,
-	116, 120, 124, 127, 133, 137, 140, 142, 143, 144,
-	147, 158, 161, 162, 163, 164, 174, 175, 176, 178,
-	181, 182, 183, 184, 185, 188, 190, 191, 192, 193,
-	194, 195, 203, 206, 212, 213, 214, 215, 216, 217,
-	218, 220, 221, 222, 223, 229, 232, 238, 239, 249,
-	256, 259, 135, 246, 260, 180, 0, 0, 0, 0,
-	329, 0, 0, 0, 123, 0, 328, 0, 0, 0,
-	152, 0, 0, 372, 154, 0, 0, 227, 168, 0,
-	0, 0, 0, 0, 363, 364, 0, 0, 0, 0,
-	0, 0, 0, 0, 57, 0, 0, 91, 92, 93,
-	350, 942, 352, 353, 354, 355, 0, 0, 113, 351,
-	356, 357, 358, 0, 0, 0, 0, 326, 343, 0,
-	371, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	340, 341, 412, 0, 0, 0, 386, 0, 342, 0,
-	0, 335, 336, 338, 337, 339, 344, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 132, 385, 0, 0,
-	285, 0, 0, 383, 0, 199, 0, 231, 136, 151,
-	109, 148, 95, 105, 0, 134, 177, 207, 211, 0,
-	0, 0, 117, 0, 209, 187, 248, 0, 189, 208,
-	155, 237, 200, 247, 257, 258, 234, 255, 263, 224,
-	98, 233, 245, 114, 219, 0, 0, 0, 100, 243,
-	230, 166, 145, 146, 99, 0, 205, 122, 130, 119,
-	179, 240, 241, 118, 266, 106, 254, 102, 107, 253,
-	173, 236, 244, 167, 160, 101, 242, 165, 159, 150,
-	126, 138, 197, 157, 198, 139, 170, 169, 171, 0,
-	0, 0, 228, 251, 267, 111, 0, 235, 261, 262,
-	0, 201, 112, 131, 125, 196, 129, 172, 108, 141,
-	225, 149, 156, 204, 265, 186, 210, 115, 250, 226,
-	373, 384, 379, 380, 377, 378, 376, 375, 374, 387,
-	365, 366, 367, 368, 370, 0, 381, 382, 369, 94,
-	103, 153, 264, 202, 128, 252, 0, 0, 121, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 97, 104, 110, 116, 120, 124, 127, 133,
-	137, 140, 142, 143, 144, 147, 158, 161, 162, 163,
-	164, 174, 175, 176, 178, 181, 182, 183, 184, 185,
-	188, 190, 191, 192, 193, 194, 195, 203, 206, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 222, 223,
-	229, 232, 238, 239, 249, 256, 259, 135, 246, 260,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 0, 0, 0, 329, 0, 0,
-	0, 123, 0, 328, 0, 0, 0, 152, 0, 0,
-	372, 154, 0, 0, 227, 168, 0, 0, 0, 0,
-	0, 363, 364, 0, 0, 0, 0, 0, 0, 0,
-	0, 57, 0, 0, 91, 92, 93, 350, 349, 352,
-	353, 354, 355, 0, 0, 113, 351, 356, 357, 358,
-	0, 0, 0, 0, 326, 343, 0, 371, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 340, 341, 0,
-	0, 0, 0, 386, 0, 342, 0, 0, 335, 336,
-	338, 337, 339, 344, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 132, 385, 0, 0, 285, 0, 0,
-	383, 0, 199, 0, 231, 136, 151, 109, 148, 95,
-	105, 0, 134, 177, 207, 211, 0, 0, 0, 117,
-	0, 209, 187, 248, 0, 189, 208, 155, 237, 200,
-	247, 257, 258, 234, 255, 263, 224, 98, 233, 245,
-	114, 219, 0, 0, 0, 100, 243, 230, 166, 145,
-	146, 99, 0, 205, 122, 130, 119, 179, 240, 241,
-	118, 266, 106, 254, 102, 107, 253, 173, 236, 244,
-	167, 160, 101, 242, 165, 159, 150, 126, 138, 197,
-	157, 198, 139, 170, 169, 171, 0, 0, 0, 228,
-	251, 267, 111, 0, 235, 261, 262, 0, 201, 112,
-	131, 125, 196, 129, 172, 108, 141, 225, 149, 156,
-	204, 265, 186, 210, 115, 250, 226, 373, 384, 379,
-	380, 377, 378, 376, 375, 374, 387, 365, 366, 367,
-	368, 370, 0, 381, 382, 369, 94, 103, 153, 264,
-	202, 128, 252, 0, 0, 121, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	104, 110, 116, 120, 124, 127, 133, 137, 140, 142,
-	143, 144, 147, 158, 161, 162, 163, 164, 174, 175,
-	176, 178, 181, 182, 183, 184, 185, 188, 190, 191,
-	192, 193, 194, 195, 203, 206, 212, 213, 214, 215,
-	216, 217, 218, 220, 221, 222, 223, 229, 232, 238,
-	239, 249, 256, 259, 135, 246, 260, 180, 0, 0,
-	0, 0, 329, 0, 0, 0, 123, 0, 328, 0,
-	0, 0, 152, 0, 0, 372, 154, 0, 0, 227,
-	168, 0, 0, 0, 0, 0, 363, 364, 0, 0,
-	0, 0, 0, 0, 0, 0, 57, 0, 0, 91,
-	92, 93, 350, 349, 352, 353, 354, 355, 0, 0,
-	113, 351, 356, 357, 358, 0, 0, 0, 0, 326,
-	343, 0, 371, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 340, 341, 0, 0, 0, 0, 386, 0,
-	342, 0, 0, 335, 336, 338, 337, 339, 344, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 132, 385,
-	0, 0, 285, 0, 0, 383, 0, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 0, 0, 0, 117, 0, 209, 187, 248, 0,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 0, 0, 228, 251, 267, 111, 0, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 373, 384, 379, 380, 377, 378, 376, 375,
-	374, 387, 365, 366, 367, 368, 370, 0, 381, 382,
-	369, 94, 103, 153, 264, 202, 128, 252, 0, 0,
-	121, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 180, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 0, 0, 0, 0, 0, 152, 0, 0,
-	372, 154, 0, 0, 227, 168, 0, 0, 0, 0,
-	0, 363, 364, 0, 0, 0, 0, 0, 0, 0,
-	0, 57, 0, 0, 91, 92, 93, 350, 349, 352,
-	353, 354, 355, 0, 0, 113, 351, 356, 357, 358,
-	0, 0, 0, 0, 0, 343, 0, 371, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 340, 341, 0,
-	0, 0, 0, 386, 0, 342, 0, 0, 335, 336,
-	338, 337, 339, 344, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 132, 385, 0, 0, 285, 0, 0,
-	383, 0, 199, 0, 231, 136, 151, 109, 148, 95,
-	105, 0, 134, 177, 207, 211, 0, 0, 0, 117,
-	0, 209, 187, 248, 1598, 189, 208, 155, 237, 200,
-	247, 257, 258, 234, 255, 263, 224, 98, 233, 245,
-	114, 219, 0, 0, 0, 100, 243, 230, 166, 145,
-	146, 99, 0, 205, 122, 130, 119, 179, 240, 241,
-	118, 266, 106, 254, 102, 107, 253, 173, 236, 244,
-	167, 160, 101, 242, 165, 159, 150, 126, 138, 197,
-	157, 198, 139, 170, 169, 171, 0, 0, 0, 228,
-	251, 267, 111, 0, 235, 261, 262, 0, 201, 112,
-	131, 125, 196, 129, 172, 108, 141, 225, 149, 156,
-	204, 265, 186, 210, 115, 250, 226, 373, 384, 379,
-	380, 377, 378, 376, 375, 374, 387, 365, 366, 367,
-	368, 370, 0, 381, 382, 369, 94, 103, 153, 264,
-	202, 128, 252, 0, 0, 121, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	104, 110, 116, 120, 124, 127, 133, 137, 140, 142,
-	143, 144, 147, 158, 161, 162, 163, 164, 174, 175,
-	176, 178, 181, 182, 183, 184, 185, 188, 190, 191,
-	192, 193, 194, 195, 203, 206, 212, 213, 214, 215,
-	216, 217, 218, 220, 221, 222, 223, 229, 232, 238,
-	239, 249, 256, 259, 135, 246, 260, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 0, 0,
-	0, 0, 152, 0, 0, 372, 154, 0, 0, 227,
-	168, 0, 0, 0, 0, 0, 363, 364, 0, 0,
-	0, 0, 0, 0, 0, 0, 57, 0, 400, 91,
-	92, 93, 350, 349, 352, 353, 354, 355, 0, 0,
-	113, 351, 356, 357, 358, 0, 0, 0, 0, 0,
-	343, 0, 371, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 340, 341, 0, 0, 0, 0, 386, 0,
-	342, 0, 0, 335, 336, 338, 337, 339, 344, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 132, 385,
-	0, 0, 285, 0, 0, 383, 0, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 0, 0, 0, 117, 0, 209, 187, 248, 0,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 0, 0, 228, 251, 267, 111, 0, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 373, 384, 379, 380, 377, 378, 376, 375,
-	374, 387, 365, 366, 367, 368, 370, 0, 381, 382,
-	369, 94, 103, 153, 264, 202, 128, 252, 0, 0,
-	121, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 180, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 0, 0, 0, 0, 0, 152, 0, 0,
-	372, 154, 0, 0, 227, 168, 0, 0, 0, 0,
-	0, 363, 364, 0, 0, 0, 0, 0, 0, 0,
-	0, 57, 0, 0, 91, 92, 93, 350, 349, 352,
-	353, 354, 355, 0, 0, 113, 351, 356, 357, 358,
-	0, 0, 0, 0, 0, 343, 0, 371, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 340, 341, 0,
-	0, 0, 0, 386, 0, 342, 0, 0, 335, 336,
-	338, 337, 339, 344, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 132, 385, 0, 0, 285, 0, 0,
-	383, 0, 199, 0, 231, 136, 151, 109, 148, 95,
-	105, 0, 134, 177, 207, 211, 0, 0, 0, 117,
-	0, 209, 187, 248, 0, 189, 208, 155, 237, 200,
-	247, 257, 258, 234, 255, 263, 224, 98, 233, 245,
-	114, 219, 0, 0, 0, 100, 243, 230, 166, 145,
-	146, 99, 0, 205, 122, 130, 119, 179, 240, 241,
-	118, 266, 106, 254, 102, 107, 253, 173, 236, 244,
-	167, 160, 101, 242, 165, 159, 150, 126, 138, 197,
-	157, 198, 139, 170, 169, 171, 0, 0, 0, 228,
-	251, 267, 111, 0, 235, 261, 262, 0, 201, 112,
-	131, 125, 196, 129, 172, 108, 141, 225, 149, 156,
-	204, 265, 186, 210, 115, 250, 226, 373, 384, 379,
-	380, 377, 378, 376, 375, 374, 387, 365, 366, 367,
-	368, 370, 0, 381, 382, 369, 94, 103, 153, 264,
-	202, 128, 252, 0, 0, 121, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	104, 110, 116, 120, 124, 127, 133, 137, 140, 142,
-	143, 144, 147, 158, 161, 162, 163, 164, 174, 175,
-	176, 178, 181, 182, 183, 184, 185, 188, 190, 191,
-	192, 193, 194, 195, 203, 206, 212, 213, 214, 215,
-	216, 217, 218, 220, 221, 222, 223, 229, 232, 238,
-	239, 249, 256, 259, 135, 246, 260, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 0, 0,
-	0, 0, 152, 0, 0, 0, 154, 0, 0, 227,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
-	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
-	113, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 645, 644,
-	654, 655, 647, 648, 649, 650, 651, 652, 653, 646,
-	0, 0, 656, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 132, 0,
-	0, 0, 285, 0, 0, 0, 0, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 0, 0, 0, 117, 0, 209, 187, 248, 0,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 0, 0, 228, 251, 267, 111, 0, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 94, 103, 153, 264, 202, 128, 252, 0, 0,
-	121, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 180, 0, 0, 0, 740, 0, 0, 0,
-	0, 123, 0, 0, 0, 0, 0, 152, 0, 0,
-	0, 154, 0, 0, 227, 168, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 91, 92, 93, 0, 742, 0,
-	0, 0, 0, 0, 0, 113, 0, 0, 0, 0,
-	0, 634, 635, 633, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 636,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 132, 0, 0, 0, 285, 0, 0,
-	0, 0, 199, 0, 231, 136, 151, 109, 148, 95,
-	105, 0, 134, 177, 207, 211, 0, 0, 0, 117,
-	0, 209, 187, 248, 0, 189, 208, 155, 237, 200,
-	247, 257, 258, 234, 255, 263, 224, 98, 233, 245,
-	114, 219, 0, 0, 0, 100, 243, 230, 166, 145,
-	146, 99, 0, 205, 122, 130, 119, 179, 240, 241,
-	118, 266, 106, 254, 102, 107, 253, 173, 236, 244,
-	167, 160, 101, 242, 165, 159, 150, 126, 138, 197,
-	157, 198, 139, 170, 169, 171, 0, 0, 0, 228,
-	251, 267, 111, 0, 235, 261, 262, 0, 201, 112,
-	131, 125, 196, 129, 172, 108, 141, 225, 149, 156,
-	204, 265, 186, 210, 115, 250, 226, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 94, 103, 153, 264,
-	202, 128, 252, 0, 0, 121, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	104, 110, 116, 120, 124, 127, 133, 137, 140, 142,
-	143, 144, 147, 158, 161, 162, 163, 164, 174, 175,
-	176, 178, 181, 182, 183, 184, 185, 188, 190, 191,
-	192, 193, 194, 195, 203, 206, 212, 213, 214, 215,
-	216, 217, 218, 220, 221, 222, 223, 229, 232, 238,
-	239, 249, 256, 259, 135, 246, 260, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 0, 0,
-	0, 0, 152, 0, 0, 0, 154, 0, 0, 227,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
-	92, 93, 0, 0, 0, 0, 0, 0, 0, 0,
-	113, 0, 0, 0, 0, 0, 83, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 132, 85,
-	86, 0, 82, 0, 0, 0, 87, 199, 0, 231,
-	136, 151, 109, 148, 95, 105, 0, 134, 177, 207,
-	211, 0, 0, 0, 117, 0, 209, 187, 248, 0,
-	189, 208, 155, 237, 200, 247, 257, 258, 234, 255,
-	263, 224, 98, 233, 245, 114, 219, 0, 0, 0,
-	100, 243, 230, 166, 145, 146, 99, 0, 205, 122,
-	130, 119, 179, 240, 241, 118, 266, 106, 254, 102,
-	107, 253, 173, 236, 244, 167, 160, 101, 242, 165,
-	159, 150, 126, 138, 197, 157, 198, 139, 170, 169,
-	171, 0, 0, 0, 228, 251, 267, 111, 0, 235,
-	261, 262, 0, 201, 112, 131, 125, 196, 129, 172,
-	108, 141, 225, 149, 156, 204, 265, 186, 210, 115,
-	250, 226, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 94, 103, 153, 264, 202, 128, 252, 0, 0,
-	121, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 97, 104, 110, 116, 120, 124,
-	127, 133, 137, 140, 142, 143, 144, 147, 158, 161,
-	162, 163, 164, 174, 175, 176, 178, 181, 182, 183,
-	184, 185, 188, 190, 191, 192, 193, 194, 195, 203,
-	206, 212, 213, 214, 215, 216, 217, 218, 220, 221,
-	222, 223, 229, 232, 238, 239, 249, 256, 259, 135,
-	246, 260, 180, 0, 0, 0, 1013, 0, 0, 0,
-	0, 123, 0, 0, 0, 0, 0, 152, 0, 0,
-	0, 154, 0, 0, 227, 168, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 91, 92, 93, 0, 1015, 0,
-	0, 0, 0, 0, 0, 113, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 132, 0, 0, 0, 285, 0, 0,
-	0, 0, 199, 0, 231, 136, 151, 109, 148, 95,
-	105, 0, 134, 177, 207, 211, 0, 0, 0, 117,
-	0, 209, 187, 248, 0, 189, 208, 155, 237, 200,
-	247, 257, 258, 234, 255, 263, 224, 98, 233, 245,
-	114, 219, 0, 0, 0, 100, 243, 230, 166, 145,
-	146, 99, 0, 205, 122, 130, 119, 179, 240, 241,
-	118, 266, 106, 254, 102, 107, 253, 173, 236, 244,
-	167, 160, 101, 242, 165, 159, 150, 126, 138, 197,
-	157, 198, 139, 170, 169, 171, 0, 0, 0, 228,
-	251, 267, 111, 0, 235, 261, 262, 0, 201, 112,
-	131, 125, 196, 129, 172, 108, 141, 225, 149, 156,
-	204, 265, 186, 210, 115, 250, 226, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 94, 103, 153, 264,
-	202, 128, 252, 0, 0, 121, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	104, 110, 116, 120, 124, 127, 133, 137, 140, 142,
-	143, 144, 147, 158, 161, 162, 163, 164, 174, 175,
-	176, 178, 181, 182, 183, 184, 185, 188, 190, 191,
-	192, 193, 194, 195, 203, 206, 212, 213, 214, 215,
-	216, 217, 218, 220, 221, 222, 223, 229, 232, 238,
-	239, 249, 256, 259, 135, 246, 260, 29, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
-	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 0, 0, 0, 285, 0, 0, 0, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 1013, 0,
-	0, 0, 0, 123, 0, 0, 0, 0, 0, 152,
-	0, 0, 0, 154, 0, 0, 227, 168, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 91, 92, 93, 0,
-	1015, 0, 0, 0, 0, 0, 0, 113, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 0, 0, 0, 285,
-	0, 0, 0, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 1011, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 91, 92, 93, 0, 0, 979, 0, 0, 980,
-	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 0, 0, 0, 285, 0, 0, 0, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 775, 0, 0, 0, 152,
-	0, 0, 0, 154, 0, 0, 227, 168, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 91, 92, 93, 0,
-	774, 0, 0, 0, 0, 0, 0, 113, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 0, 0, 0, 285,
-	0, 0, 0, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	400, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 0, 0, 0, 285, 0, 0, 0, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 0, 0, 0, 0, 152,
-	0, 0, 0, 154, 0, 0, 227, 168, 0, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 57, 0, 0, 91, 92, 93, 0,
-	0, 0, 0, 0, 0, 0, 0, 113, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 0, 0, 0, 285,
-	0, 0, 0, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 91, 92, 93, 0, 1015, 0, 0, 0, 0,
-	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 0, 0, 0, 285, 0, 0, 0, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 0, 0, 0, 0, 152,
-	0, 0, 0, 154, 0, 0, 227, 168, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 91, 92, 93, 0,
-	742, 0, 0, 0, 0, 0, 0, 113, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 0, 0, 0, 285,
-	0, 0, 0, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 180,
-	0, 0, 0, 0, 0, 0, 0, 745, 123, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 154, 0,
-	0, 227, 168, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 984, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 91, 92, 93, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 0, 0, 0, 285, 0, 0, 0, 0, 199,
-	0, 231, 136, 151, 109, 148, 95, 105, 0, 134,
-	177, 207, 211, 0, 0, 0, 117, 0, 209, 187,
-	248, 0, 189, 208, 155, 237, 200, 247, 257, 258,
-	234, 255, 263, 224, 98, 233, 245, 114, 219, 0,
-	0, 0, 100, 243, 230, 166, 145, 146, 99, 0,
-	205, 122, 130, 119, 179, 240, 241, 118, 266, 106,
-	254, 102, 107, 253, 173, 236, 244, 167, 160, 101,
-	242, 165, 159, 150, 126, 138, 197, 157, 198, 139,
-	170, 169, 171, 0, 0, 0, 228, 251, 267, 111,
-	0, 235, 261, 262, 0, 201, 112, 131, 125, 196,
-	129, 172, 108, 141, 225, 149, 156, 204, 265, 186,
-	210, 115, 250, 226, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 94, 103, 153, 264, 202, 128, 252,
-	0, 0, 121, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 97, 104, 110, 116,
-	120, 124, 127, 133, 137, 140, 142, 143, 144, 147,
-	158, 161, 162, 163, 164, 174, 175, 176, 178, 181,
-	182, 183, 184, 185, 188, 190, 191, 192, 193, 194,
-	195, 203, 206, 212, 213, 214, 215, 216, 217, 218,
-	220, 221, 222, 223, 229, 232, 238, 239, 249, 256,
-	259, 135, 246, 260, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 0, 0, 0, 0, 152,
-	0, 0, 0, 154, 0, 0, 227, 168, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 91, 92, 93, 0,
-	623, 0, 0, 0, 0, 0, 0, 113, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 132, 0, 0, 0, 285,
-	0, 0, 0, 0, 199, 0, 231, 136, 151, 109,
-	148, 95, 105, 0, 134, 177, 207, 211, 0, 0,
-	0, 117, 0, 209, 187, 248, 0, 189, 208, 155,
-	237, 200, 247, 257, 258, 234, 255, 263, 224, 98,
-	233, 245, 114, 219, 0, 0, 0, 100, 243, 230,
-	166, 145, 146, 99, 0, 205, 122, 130, 119, 179,
-	240, 241, 118, 266, 106, 254, 102, 107, 253, 173,
-	236, 244, 167, 160, 101, 242, 165, 159, 150, 126,
-	138, 197, 157, 198, 139, 170, 169, 171, 0, 0,
-	0, 228, 251, 267, 111, 0, 235, 261, 262, 0,
-	201, 112, 131, 125, 196, 129, 172, 108, 141, 225,
-	149, 156, 204, 265, 186, 210, 115, 250, 226, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 94, 103,
-	153, 264, 202, 128, 252, 0, 0, 121, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 104, 110, 116, 120, 124, 127, 133, 137,
-	140, 142, 143, 144, 147, 158, 161, 162, 163, 164,
-	174, 175, 176, 178, 181, 182, 183, 184, 185, 188,
-	190, 191, 192, 193, 194, 195, 203, 206, 212, 213,
-	214, 215, 216, 217, 218, 220, 221, 222, 223, 229,
-	232, 238, 239, 249, 256, 259, 135, 246, 260, 417,
-	0, 0, 0, 0, 0, 0, 180, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 154, 0, 0, 227, 168,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 91, 92,
-	93, 0, 0, 0, 0, 0, 0, 0, 0, 113,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 0, 0,
-	0, 285, 0, 0, 0, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 0, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	154, 0, 0, 227, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 132, 0, 0, 0, 285, 0, 0, 0,
-	0, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 0, 0, 0, 117, 0,
-	209, 187, 248, 0, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 0, 0, 228, 251,
-	267, 111, 0, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 94, 103, 153, 264, 202,
-	128, 252, 0, 0, 121, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 316, 246, 260, 180, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 154, 0, 0, 227, 168,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 91, 92,
-	93, 0, 0, 0, 0, 0, 0, 0, 0, 113,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 132, 0, 280,
-	0, 285, 0, 0, 0, 0, 199, 0, 231, 136,
-	151, 109, 148, 95, 105, 0, 134, 177, 207, 211,
-	0, 0, 0, 117, 0, 209, 187, 248, 0, 189,
-	208, 155, 237, 200, 247, 257, 258, 234, 255, 263,
-	224, 98, 233, 245, 114, 219, 0, 0, 0, 100,
-	243, 230, 166, 145, 146, 99, 0, 205, 122, 130,
-	119, 179, 240, 241, 118, 266, 106, 254, 102, 107,
-	253, 173, 236, 244, 167, 160, 101, 242, 165, 159,
-	150, 126, 138, 197, 157, 198, 139, 170, 169, 171,
-	0, 0, 0, 228, 251, 267, 111, 0, 235, 261,
-	262, 0, 201, 112, 131, 125, 196, 129, 172, 108,
-	141, 225, 149, 156, 204, 265, 186, 210, 115, 250,
-	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	94, 103, 153, 264, 202, 128, 252, 0, 0, 121,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 104, 110, 116, 120, 124, 127,
-	133, 137, 140, 142, 143, 144, 147, 158, 161, 162,
-	163, 164, 174, 175, 176, 178, 181, 182, 183, 184,
-	185, 188, 190, 191, 192, 193, 194, 195, 203, 206,
-	212, 213, 214, 215, 216, 217, 218, 220, 221, 222,
-	223, 229, 232, 238, 239, 249, 256, 259, 135, 246,
-	260, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	154, 0, 0, 227, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 92, 93, 0, 0, 0, 0,
-	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 132, 0, 0, 0, 285, 0, 0, 0,
-	0, 199, 0, 231, 136, 151, 109, 148, 95, 105,
-	0, 134, 177, 207, 211, 0, 0, 0, 117, 0,
-	209, 187, 248, 0, 189, 208, 155, 237, 200, 247,
-	257, 258, 234, 255, 263, 224, 98, 233, 245, 114,
-	219, 0, 0, 0, 100, 243, 230, 166, 145, 146,
-	99, 0, 205, 122, 130, 119, 179, 240, 241, 118,
-	266, 106, 254, 102, 107, 253, 173, 236, 244, 167,
-	160, 101, 242, 165, 159, 150, 126, 138, 197, 157,
-	198, 139, 170, 169, 171, 0, 0, 0, 228, 251,
-	267, 111, 0, 235, 261, 262, 0, 201, 112, 131,
-	125, 196, 129, 172, 108, 141, 225, 149, 156, 204,
-	265, 186, 210, 115, 250, 226, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 94, 103, 153, 264, 202,
-	128, 252, 0, 0, 121, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 96, 97, 104,
-	110, 116, 120, 124, 127, 133, 137, 140, 142, 143,
-	144, 147, 158, 161, 162, 163, 164, 174, 175, 176,
-	178, 181, 182, 183, 184, 185, 188, 190, 191, 192,
-	193, 194, 195, 203, 206, 212, 213, 214, 215, 216,
-	217, 218, 220, 221, 222, 223, 229, 232, 238, 239,
-	249, 256, 259, 135, 246, 260,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1048, 0, 0, 0, 0,
+	556, 544, 0, 498, 559, 471, 488, 567, 489, 492,
+	529, 456, 511, 187, 486, 289, 475, 451, 482, 452,
+	473, 500, 130, 504, 470, 546, 514, 558, 159, 0,
+	476, 565, 161, 520, 0, 234, 175, 0, 0, 0,
+	502, 548, 509, 539, 497, 530, 461, 519, 560, 487,
+	527, 561, 0, 0, 0, 97, 98, 99, 0, 1073,
+	1074, 0, 0, 0, 0, 0, 119, 0, 524, 555,
+	484, 526, 528, 569, 450, 521, 0, 454, 457, 566,
+	551, 479, 480, 1272, 0, 0, 984, 0, 0, 0,
+	501, 510, 536, 495, 0, 0, 0, 0, 0, 289,
+	0, 0, 477, 0, 518, 0, 0, 0, 458, 455,
+	0, 0, 0, 0, 499, 0, 0, 0, 460, 0,
+	478, 537, 0, 448, 139, 543, 550, 496, 293, 554,
+	494, 493, 557, 206, 0, 238, 143, 158, 115, 155,
+	101, 111, 0, 141, 184, 214, 218, 547, 474, 483,
+	124, 481, 216, 194, 255, 517, 196, 215, 162, 244,
+	207, 254, 292, 264, 265, 241, 262, 270, 231, 104,
+	240, 252, 120, 226, 0, 0, 0, 106, 250, 237,
+	173, 152, 153, 105, 0, 212, 129, 137, 126, 186,
+	247, 248, 125, 272, 112, 261, 108, 113, 260, 180,
+	243, 251, 174, 167, 107, 249, 172, 166, 157, 133,
+	145, 204, 164, 205, 146, 177, 176, 178, 0, 453,
+	0, 235, 258, 273, 117, 469, 242, 268, 269, 0,
+	208, 118, 138, 132, 203, 136, 179, 114, 148, 232,
+	156, 163, 211, 271, 193, 217, 121, 257, 233, 465,
+	468, 463, 464, 512, 513, 562, 563, 564, 538, 459,
+	0, 466, 467, 0, 545, 552, 553, 516, 100, 109,
+	160, 96, 209, 135, 259, 449, 462, 128, 472, 0,
+	0, 485, 490, 491, 503, 505, 506, 507, 508, 515,
+	522, 523, 525, 532, 534, 535, 542, 549, 102, 103,
+	110, 116, 122, 127, 131, 134, 144, 147, 149, 150,
+	151, 154, 165, 168, 169, 170, 171, 181, 182, 183,
+	185, 188, 189, 190, 191, 192, 195, 197, 198, 199,
+	201, 202, 210, 213, 219, 220, 221, 222, 223, 224,
+	225, 227, 228, 229, 230, 236, 239, 245, 246, 263,
+	266, 531, 568, 541, 533, 540, 123, 256, 200, 140,
+	142, 253, 267, 556, 544, 0, 498, 559, 471, 488,
+	567, 489, 492, 529, 456, 511, 187, 486, 0, 475,
+	451, 482, 452, 473, 500, 130, 504, 470, 546, 514,
+	558, 159, 0, 476, 565, 161, 520, 0, 234, 175,
+	0, 0, 0, 502, 548, 509, 539, 497, 530, 461,
+	519, 560, 487, 527, 561, 0, 0, 0, 97, 98,
+	99, 0, 1073, 1074, 0, 0, 0, 0, 0, 119,
+	0, 524, 555, 484, 526, 528, 569, 450, 521, 0,
+	454, 457, 566, 551, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 501, 510, 536, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 477, 0, 518, 0, 0,
+	0, 458, 455, 0, 0, 0, 0, 499, 0, 0,
+	0, 460, 0, 478, 537, 0, 448, 139, 543, 550,
+	496, 293, 554, 494, 493, 557, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	547, 474, 483, 124, 481, 216, 194, 255, 517, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 453, 0, 235, 258, 273, 117, 469, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 465, 468, 463, 464, 512, 513, 562, 563,
+	564, 538, 459, 0, 466, 467, 0, 545, 552, 553,
+	516, 100, 109, 160, 96, 209, 135, 259, 449, 462,
+	128, 472, 0, 0, 485, 490, 491, 503, 505, 506,
+	507, 508, 515, 522, 523, 525, 532, 534, 535, 542,
+	549, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 531, 568, 541, 533, 540, 123,
+	256, 200, 140, 142, 253, 267, 556, 544, 0, 498,
+	559, 471, 488, 567, 489, 492, 529, 456, 511, 187,
+	486, 0, 475, 451, 482, 452, 473, 500, 130, 504,
+	470, 546, 514, 558, 159, 0, 476, 565, 161, 520,
+	0, 234, 175, 0, 0, 0, 502, 548, 509, 539,
+	497, 530, 461, 519, 560, 487, 527, 561, 59, 0,
+	0, 97, 98, 99, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 524, 555, 484, 526, 528, 569,
+	450, 521, 0, 454, 457, 566, 551, 479, 480, 0,
+	0, 0, 0, 0, 0, 0, 501, 510, 536, 495,
+	0, 0, 0, 0, 0, 0, 0, 0, 477, 0,
+	518, 0, 0, 0, 458, 455, 0, 0, 0, 0,
+	499, 0, 0, 0, 460, 0, 478, 537, 0, 448,
+	139, 543, 550, 496, 293, 554, 494, 493, 557, 206,
+	0, 238, 143, 158, 115, 155, 101, 111, 0, 141,
+	184, 214, 218, 547, 474, 483, 124, 481, 216, 194,
+	255, 517, 196, 215, 162, 244, 207, 254, 292, 264,
+	265, 241, 262, 270, 231, 104, 240, 252, 120, 226,
+	0, 0, 0, 106, 250, 237, 173, 152, 153, 105,
+	0, 212, 129, 137, 126, 186, 247, 248, 125, 272,
+	112, 261, 108, 113, 260, 180, 243, 251, 174, 167,
+	107, 249, 172, 166, 157, 133, 145, 204, 164, 205,
+	146, 177, 176, 178, 0, 453, 0, 235, 258, 273,
+	117, 469, 242, 268, 269, 0, 208, 118, 138, 132,
+	203, 136, 179, 114, 148, 232, 156, 163, 211, 271,
+	193, 217, 121, 257, 233, 465, 468, 463, 464, 512,
+	513, 562, 563, 564, 538, 459, 0, 466, 467, 0,
+	545, 552, 553, 516, 100, 109, 160, 96, 209, 135,
+	259, 449, 462, 128, 472, 0, 0, 485, 490, 491,
+	503, 505, 506, 507, 508, 515, 522, 523, 525, 532,
+	534, 535, 542, 549, 102, 103, 110, 116, 122, 127,
+	131, 134, 144, 147, 149, 150, 151, 154, 165, 168,
+	169, 170, 171, 181, 182, 183, 185, 188, 189, 190,
+	191, 192, 195, 197, 198, 199, 201, 202, 210, 213,
+	219, 220, 221, 222, 223, 224, 225, 227, 228, 229,
+	230, 236, 239, 245, 246, 263, 266, 531, 568, 541,
+	533, 540, 123, 256, 200, 140, 142, 253, 267, 556,
+	544, 0, 498, 559, 471, 488, 567, 489, 492, 529,
+	456, 511, 187, 486, 0, 475, 451, 482, 452, 473,
+	500, 130, 504, 470, 546, 514, 558, 159, 0, 476,
+	565, 161, 520, 0, 234, 175, 0, 0, 0, 502,
+	548, 509, 539, 497, 530, 461, 519, 560, 487, 527,
+	561, 0, 0, 0, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 524, 555, 484,
+	526, 528, 569, 450, 521, 0, 454, 457, 566, 551,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 501,
+	510, 536, 495, 0, 0, 0, 0, 0, 0, 1381,
+	0, 477, 0, 518, 0, 0, 0, 458, 455, 0,
+	0, 0, 0, 499, 0, 0, 0, 460, 0, 478,
+	537, 0, 448, 139, 543, 550, 496, 293, 554, 494,
+	493, 557, 206, 0, 238, 143, 158, 115, 155, 101,
+	111, 0, 141, 184, 214, 218, 547, 474, 483, 124,
+	481, 216, 194, 255, 517, 196, 215, 162, 244, 207,
+	254, 292, 264, 265, 241, 262, 270, 231, 104, 240,
+	252, 120, 226, 0, 0, 0, 106, 250, 237, 173,
+	152, 153, 105, 0, 212, 129, 137, 126, 186, 247,
+	248, 125, 272, 112, 261, 108, 113, 260, 180, 243,
+	251, 174, 167, 107, 249, 172, 166, 157, 133, 145,
+	204, 164, 205, 146, 177, 176, 178, 0, 453, 0,
+	235, 258, 273, 117, 469, 242, 268, 269, 0, 208,
+	118, 138, 132, 203, 136, 179, 114, 148, 232, 156,
+	163, 211, 271, 193, 217, 121, 257, 233, 465, 468,
+	463, 464, 512, 513, 562, 563, 564, 538, 459, 0,
+	466, 467, 0, 545, 552, 553, 516, 100, 109, 160,
+	96, 209, 135, 259, 449, 462, 128, 472, 0, 0,
+	485, 490, 491, 503, 505, 506, 507, 508, 515, 522,
+	523, 525, 532, 534, 535, 542, 549, 102, 103, 110,
+	116, 122, 127, 131, 134, 144, 147, 149, 150, 151,
+	154, 165, 168, 169, 170, 171, 181, 182, 183, 185,
+	188, 189, 190, 191, 192, 195, 197, 198, 199, 201,
+	202, 210, 213, 219, 220, 221, 222, 223, 224, 225,
+	227, 228, 229, 230, 236, 239, 245, 246, 263, 266,
+	531, 568, 541, 533, 540, 123, 256, 200, 140, 142,
+	253, 267, 556, 544, 0, 498, 559, 471, 488, 567,
+	489, 492, 529, 456, 511, 187, 486, 0, 475, 451,
+	482, 452, 473, 500, 130, 504, 470, 546, 514, 558,
+	159, 0, 476, 565, 161, 520, 0, 234, 175, 0,
+	0, 0, 502, 548, 509, 539, 497, 530, 461, 519,
+	560, 487, 527, 561, 0, 0, 0, 97, 98, 99,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	524, 555, 484, 526, 528, 569, 450, 521, 0, 454,
+	457, 566, 551, 479, 480, 0, 0, 0, 0, 0,
+	0, 0, 501, 510, 536, 495, 0, 0, 0, 0,
+	0, 0, 1057, 0, 477, 0, 518, 0, 0, 0,
+	458, 455, 0, 0, 0, 0, 499, 0, 0, 0,
+	460, 0, 478, 537, 0, 448, 139, 543, 550, 496,
+	293, 554, 494, 493, 557, 206, 0, 238, 143, 158,
+	115, 155, 101, 111, 0, 141, 184, 214, 218, 547,
+	474, 483, 124, 481, 216, 194, 255, 517, 196, 215,
+	162, 244, 207, 254, 292, 264, 265, 241, 262, 270,
+	231, 104, 240, 252, 120, 226, 0, 0, 0, 106,
+	250, 237, 173, 152, 153, 105, 0, 212, 129, 137,
+	126, 186, 247, 248, 125, 272, 112, 261, 108, 113,
+	260, 180, 243, 251, 174, 167, 107, 249, 172, 166,
+	157, 133, 145, 204, 164, 205, 146, 177, 176, 178,
+	0, 453, 0, 235, 258, 273, 117, 469, 242, 268,
+	269, 0, 208, 118, 138, 132, 203, 136, 179, 114,
+	148, 232, 156, 163, 211, 271, 193, 217, 121, 257,
+	233, 465, 468, 463, 464, 512, 513, 562, 563, 564,
+	538, 459, 0, 466, 467, 0, 545, 552, 553, 516,
+	100, 109, 160, 881, 209, 135, 259, 449, 462, 128,
+	472, 0, 0, 485, 490, 491, 503, 505, 506, 507,
+	508, 515, 522, 523, 525, 532, 534, 535, 542, 549,
+	102, 103, 110, 116, 122, 127, 131, 134, 144, 147,
+	149, 150, 151, 154, 165, 168, 169, 170, 171, 181,
+	182, 183, 185, 188, 189, 190, 191, 192, 195, 197,
+	198, 199, 201, 202, 210, 213, 219, 220, 221, 222,
+	223, 224, 225, 227, 228, 229, 230, 236, 239, 245,
+	246, 263, 266, 531, 568, 541, 533, 540, 123, 256,
+	200, 140, 142, 253, 267, 556, 544, 0, 498, 559,
+	471, 488, 567, 489, 492, 529, 456, 511, 187, 486,
+	0, 475, 451, 482, 452, 473, 500, 130, 504, 470,
+	546, 514, 558, 159, 0, 476, 565, 161, 520, 0,
+	234, 175, 0, 0, 0, 502, 548, 509, 539, 497,
+	530, 461, 519, 560, 487, 527, 561, 0, 0, 0,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 524, 555, 484, 526, 528, 569, 450,
+	521, 0, 454, 457, 566, 551, 479, 480, 0, 0,
+	0, 0, 0, 0, 0, 501, 510, 536, 495, 0,
+	0, 0, 0, 0, 0, 1021, 0, 477, 0, 518,
+	0, 0, 0, 458, 455, 0, 0, 0, 0, 499,
+	0, 0, 0, 460, 0, 478, 537, 0, 448, 139,
+	543, 550, 496, 293, 554, 494, 493, 557, 206, 0,
+	238, 143, 158, 115, 155, 101, 111, 0, 141, 184,
+	214, 218, 547, 474, 483, 124, 481, 216, 194, 255,
+	517, 196, 215, 162, 244, 207, 254, 292, 264, 265,
+	241, 262, 270, 231, 104, 240, 252, 120, 226, 0,
+	0, 0, 106, 250, 237, 173, 152, 153, 105, 0,
+	212, 129, 137, 126, 186, 247, 248, 125, 272, 112,
+	261, 108, 113, 260, 180, 243, 251, 174, 167, 107,
+	249, 172, 166, 157, 133, 145, 204, 164, 205, 146,
+	177, 176, 178, 0, 453, 0, 235, 258, 273, 117,
+	469, 242, 268, 269, 0, 208, 118, 138, 132, 203,
+	136, 179, 114, 148, 232, 156, 163, 211, 271, 193,
+	217, 121, 257, 233, 465, 468, 463, 464, 512, 513,
+	562, 563, 564, 538, 459, 0, 466, 467, 0, 545,
+	552, 553, 516, 100, 109, 160, 959, 209, 135, 259,
+	449, 462, 128, 472, 0, 0, 485, 490, 491, 503,
+	505, 506, 507, 508, 515, 522, 523, 525, 532, 534,
+	535, 542, 549, 102, 103, 110, 116, 122, 127, 131,
+	134, 144, 147, 149, 150, 151, 154, 165, 168, 169,
+	170, 171, 181, 182, 183, 185, 188, 189, 190, 191,
+	192, 195, 197, 198, 199, 201, 202, 210, 213, 219,
+	220, 221, 222, 223, 224, 225, 227, 228, 229, 230,
+	236, 239, 245, 246, 263, 266, 531, 568, 541, 533,
+	540, 123, 256, 200, 140, 142, 253, 267, 556, 544,
+	0, 498, 559, 471, 488, 567, 489, 492, 529, 456,
+	511, 187, 486, 0, 475, 451, 482, 452, 473, 500,
+	130, 504, 470, 546, 514, 558, 159, 0, 476, 565,
+	161, 520, 0, 234, 175, 0, 0, 0, 502, 548,
+	509, 539, 497, 530, 461, 519, 560, 487, 527, 561,
+	0, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 524, 555, 484, 526,
+	528, 569, 450, 521, 0, 454, 457, 566, 551, 479,
+	480, 0, 0, 0, 0, 0, 0, 0, 501, 510,
+	536, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	477, 0, 518, 0, 0, 0, 458, 455, 0, 0,
+	0, 0, 499, 0, 0, 0, 460, 0, 478, 537,
+	0, 448, 139, 543, 550, 496, 293, 554, 494, 493,
+	557, 206, 0, 238, 143, 158, 115, 155, 101, 111,
+	0, 141, 184, 214, 218, 547, 474, 483, 124, 481,
+	216, 194, 255, 517, 196, 215, 162, 244, 207, 254,
+	292, 264, 265, 241, 262, 270, 231, 104, 240, 252,
+	120, 226, 0, 0, 0, 106, 250, 237, 173, 152,
+	153, 105, 0, 212, 129, 137, 126, 186, 247, 248,
+	125, 272, 112, 261, 108, 113, 260, 180, 243, 251,
+	174, 167, 107, 249, 172, 166, 157, 133, 145, 204,
+	164, 205, 146, 177, 176, 178, 0, 453, 0, 235,
+	258, 273, 117, 469, 242, 268, 269, 0, 208, 118,
+	138, 132, 203, 136, 179, 114, 148, 232, 156, 163,
+	211, 271, 193, 217, 121, 257, 233, 465, 468, 463,
+	464, 512, 513, 562, 563, 564, 538, 459, 0, 466,
+	467, 0, 545, 552, 553, 516, 100, 109, 160, 96,
+	209, 135, 259, 449, 462, 128, 472, 0, 0, 485,
+	490, 491, 503, 505, 506, 507, 508, 515, 522, 523,
+	525, 532, 534, 535, 542, 549, 102, 103, 110, 116,
+	122, 127, 131, 134, 144, 147, 149, 150, 151, 154,
+	165, 168, 169, 170, 171, 181, 182, 183, 185, 188,
+	189, 190, 191, 192, 195, 197, 198, 199, 201, 202,
+	210, 213, 219, 220, 221, 222, 223, 224, 225, 227,
+	228, 229, 230, 236, 239, 245, 246, 263, 266, 531,
+	568, 541, 533, 540, 123, 256, 200, 140, 142, 253,
+	267, 556, 544, 0, 498, 559, 471, 488, 567, 489,
+	492, 529, 456, 511, 187, 486, 0, 475, 451, 482,
+	452, 473, 500, 130, 504, 470, 546, 514, 558, 159,
+	0, 476, 565, 161, 520, 0, 234, 175, 0, 0,
+	0, 502, 548, 509, 539, 497, 530, 461, 519, 560,
+	487, 527, 561, 0, 0, 0, 97, 98, 99, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 524,
+	555, 484, 526, 528, 569, 450, 521, 0, 454, 457,
+	566, 551, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 501, 510, 536, 495, 0, 0, 0, 0, 0,
+	0, 0, 0, 477, 0, 518, 0, 0, 0, 458,
+	455, 0, 0, 0, 0, 499, 0, 0, 0, 460,
+	0, 478, 537, 0, 448, 139, 543, 550, 496, 293,
+	554, 494, 493, 557, 206, 0, 238, 143, 158, 115,
+	155, 101, 111, 0, 141, 184, 214, 218, 547, 474,
+	483, 124, 481, 216, 194, 255, 517, 196, 215, 162,
+	244, 207, 254, 292, 264, 265, 241, 262, 270, 231,
+	104, 240, 252, 120, 226, 0, 0, 0, 106, 250,
+	237, 173, 152, 153, 105, 0, 212, 129, 137, 126,
+	186, 247, 248, 125, 272, 112, 261, 108, 113, 260,
+	180, 243, 251, 174, 167, 107, 249, 172, 166, 157,
+	133, 145, 204, 164, 205, 146, 177, 176, 178, 0,
+	453, 0, 235, 258, 273, 117, 469, 242, 268, 269,
+	0, 208, 118, 138, 132, 203, 136, 179, 114, 148,
+	232, 156, 163, 211, 271, 193, 217, 121, 257, 233,
+	465, 468, 463, 464, 512, 513, 562, 563, 564, 538,
+	459, 0, 466, 467, 0, 545, 552, 553, 516, 100,
+	109, 160, 959, 209, 135, 259, 449, 462, 128, 472,
+	0, 0, 485, 490, 491, 503, 505, 506, 507, 508,
+	515, 522, 523, 525, 532, 534, 535, 542, 549, 102,
+	103, 110, 116, 122, 127, 131, 134, 144, 147, 149,
+	150, 151, 154, 165, 168, 169, 170, 171, 181, 182,
+	183, 185, 188, 189, 190, 191, 192, 195, 197, 198,
+	199, 201, 202, 210, 213, 219, 220, 221, 222, 223,
+	224, 225, 227, 228, 229, 230, 236, 239, 245, 246,
+	263, 266, 531, 568, 541, 533, 540, 123, 256, 200,
+	140, 142, 253, 267, 556, 544, 0, 498, 559, 471,
+	488, 567, 489, 492, 529, 456, 511, 187, 486, 0,
+	475, 451, 482, 452, 473, 500, 130, 504, 470, 546,
+	514, 558, 159, 0, 476, 565, 161, 520, 0, 234,
+	175, 0, 0, 0, 502, 548, 509, 539, 497, 530,
+	461, 519, 560, 487, 527, 561, 0, 0, 0, 97,
+	98, 99, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 0, 524, 555, 484, 526, 528, 569, 450, 521,
+	0, 454, 457, 566, 551, 479, 480, 0, 0, 0,
+	0, 0, 0, 0, 501, 510, 536, 495, 0, 0,
+	0, 0, 0, 0, 0, 0, 477, 0, 518, 0,
+	0, 0, 458, 455, 0, 0, 0, 0, 499, 0,
+	0, 0, 460, 0, 478, 537, 0, 448, 139, 543,
+	550, 496, 293, 554, 494, 493, 557, 206, 0, 238,
+	143, 158, 115, 155, 101, 111, 0, 141, 184, 214,
+	218, 547, 474, 483, 124, 481, 216, 194, 255, 517,
+	196, 215, 162, 244, 207, 254, 292, 264, 265, 241,
+	262, 270, 231, 104, 240, 252, 120, 226, 0, 0,
+	0, 106, 250, 237, 173, 152, 153, 105, 0, 212,
+	129, 137, 126, 186, 247, 248, 125, 272, 112, 261,
+	108, 446, 260, 180, 243, 251, 174, 167, 107, 249,
+	172, 166, 157, 133, 145, 204, 164, 205, 146, 177,
+	176, 178, 0, 453, 0, 235, 258, 273, 117, 469,
+	242, 268, 269, 0, 208, 118, 138, 132, 203, 136,
+	447, 445, 148, 232, 156, 163, 211, 271, 193, 217,
+	121, 257, 233, 465, 468, 463, 464, 512, 513, 562,
+	563, 564, 538, 459, 0, 466, 467, 0, 545, 552,
+	553, 516, 100, 109, 160, 96, 209, 135, 259, 449,
+	462, 128, 472, 0, 0, 485, 490, 491, 503, 505,
+	506, 507, 508, 515, 522, 523, 525, 532, 534, 535,
+	542, 549, 102, 103, 110, 116, 122, 127, 131, 134,
+	144, 147, 149, 150, 151, 154, 165, 168, 169, 170,
+	171, 181, 182, 183, 185, 188, 189, 190, 191, 192,
+	195, 197, 198, 199, 201, 202, 210, 213, 219, 220,
+	221, 222, 223, 224, 225, 227, 228, 229, 230, 236,
+	239, 245, 246, 263, 266, 531, 568, 541, 533, 540,
+	123, 256, 200, 140, 142, 253, 267, 556, 544, 0,
+	498, 559, 471, 488, 567, 489, 492, 529, 456, 511,
+	187, 486, 0, 475, 451, 482, 452, 473, 500, 130,
+	504, 470, 546, 514, 558, 159, 0, 476, 565, 161,
+	520, 0, 234, 175, 0, 0, 0, 502, 548, 509,
+	539, 497, 530, 461, 519, 560, 487, 527, 561, 0,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 524, 555, 484, 526, 528,
+	569, 450, 521, 0, 454, 457, 566, 551, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 501, 510, 536,
+	495, 0, 0, 0, 0, 0, 0, 0, 0, 477,
+	0, 518, 0, 0, 0, 458, 455, 0, 0, 0,
+	0, 499, 0, 0, 0, 460, 0, 478, 537, 0,
+	448, 139, 543, 550, 496, 293, 554, 494, 493, 557,
+	206, 0, 238, 143, 158, 115, 155, 101, 111, 0,
+	141, 184, 214, 218, 547, 474, 483, 124, 481, 216,
+	194, 255, 517, 196, 215, 162, 244, 207, 254, 292,
+	264, 265, 241, 262, 270, 231, 104, 240, 252, 120,
+	226, 0, 0, 0, 106, 250, 237, 173, 152, 153,
+	105, 0, 212, 129, 137, 126, 186, 247, 248, 125,
+	272, 112, 261, 108, 113, 260, 180, 243, 251, 174,
+	167, 107, 249, 172, 166, 157, 133, 145, 204, 164,
+	205, 146, 177, 176, 178, 0, 453, 0, 235, 258,
+	273, 117, 469, 242, 268, 269, 0, 208, 118, 138,
+	132, 203, 136, 179, 114, 148, 232, 156, 163, 211,
+	271, 193, 217, 121, 257, 233, 465, 468, 463, 464,
+	512, 513, 562, 563, 564, 538, 459, 0, 466, 467,
+	0, 545, 552, 553, 516, 100, 109, 160, 881, 209,
+	135, 259, 449, 462, 128, 472, 0, 0, 485, 490,
+	491, 503, 505, 506, 507, 508, 515, 522, 523, 525,
+	532, 534, 535, 542, 549, 102, 103, 110, 116, 122,
+	127, 131, 134, 144, 147, 149, 150, 151, 154, 165,
+	168, 169, 170, 171, 181, 182, 183, 185, 188, 189,
+	190, 191, 192, 195, 197, 198, 199, 201, 202, 210,
+	213, 219, 220, 221, 222, 223, 224, 225, 227, 228,
+	229, 230, 236, 239, 245, 246, 263, 266, 531, 568,
+	541, 533, 540, 123, 256, 200, 140, 142, 253, 267,
+	556, 544, 0, 498, 559, 471, 488, 567, 489, 492,
+	529, 456, 511, 187, 486, 0, 475, 451, 482, 452,
+	473, 500, 130, 504, 470, 546, 514, 558, 159, 0,
+	476, 565, 161, 520, 0, 234, 175, 0, 0, 0,
+	502, 548, 509, 539, 497, 530, 461, 519, 560, 487,
+	527, 561, 0, 0, 0, 97, 98, 99, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 0, 524, 555,
+	484, 526, 528, 569, 450, 521, 0, 454, 457, 566,
+	551, 479, 480, 0, 0, 0, 0, 0, 0, 0,
+	501, 510, 536, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 477, 0, 518, 0, 0, 0, 458, 455,
+	0, 0, 0, 0, 499, 0, 0, 0, 460, 0,
+	478, 537, 0, 448, 139, 543, 550, 496, 293, 554,
+	494, 493, 557, 206, 0, 238, 143, 158, 115, 155,
+	101, 111, 0, 141, 184, 214, 218, 547, 474, 483,
+	124, 481, 216, 194, 255, 517, 196, 215, 162, 244,
+	207, 254, 292, 264, 265, 241, 262, 270, 231, 104,
+	240, 787, 120, 226, 0, 0, 0, 106, 250, 237,
+	173, 152, 153, 105, 0, 212, 129, 137, 126, 186,
+	247, 248, 125, 272, 112, 261, 108, 446, 260, 180,
+	243, 251, 174, 167, 107, 249, 172, 166, 157, 133,
+	145, 204, 164, 205, 146, 177, 176, 178, 0, 453,
+	0, 235, 258, 273, 117, 469, 242, 268, 269, 0,
+	208, 118, 138, 132, 203, 136, 447, 445, 148, 232,
+	156, 163, 211, 271, 193, 217, 121, 257, 233, 465,
+	468, 463, 464, 512, 513, 562, 563, 564, 538, 459,
+	0, 466, 467, 0, 545, 552, 553, 516, 100, 109,
+	160, 96, 209, 135, 259, 449, 462, 128, 472, 0,
+	0, 485, 490, 491, 503, 505, 506, 507, 508, 515,
+	522, 523, 525, 532, 534, 535, 542, 549, 102, 103,
+	110, 116, 122, 127, 131, 134, 144, 147, 149, 150,
+	151, 154, 165, 168, 169, 170, 171, 181, 182, 183,
+	185, 188, 189, 190, 191, 192, 195, 197, 198, 199,
+	201, 202, 210, 213, 219, 220, 221, 222, 223, 224,
+	225, 227, 228, 229, 230, 236, 239, 245, 246, 263,
+	266, 531, 568, 541, 533, 540, 123, 256, 200, 140,
+	142, 253, 267, 556, 544, 0, 498, 559, 471, 488,
+	567, 489, 492, 529, 456, 511, 187, 486, 0, 475,
+	451, 482, 452, 473, 500, 130, 504, 470, 546, 514,
+	558, 159, 0, 476, 565, 161, 520, 0, 234, 175,
+	0, 0, 0, 502, 548, 509, 539, 497, 530, 461,
+	519, 560, 487, 527, 561, 0, 0, 0, 97, 98,
+	99, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	0, 524, 555, 484, 526, 528, 569, 450, 521, 0,
+	454, 457, 566, 551, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 501, 510, 536, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 477, 0, 518, 0, 0,
+	0, 458, 455, 0, 0, 0, 0, 499, 0, 0,
+	0, 460, 0, 478, 537, 0, 448, 139, 543, 550,
+	496, 293, 554, 494, 493, 557, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	547, 474, 483, 124, 481, 216, 194, 255, 517, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 437, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	446, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 453, 0, 235, 258, 273, 117, 469, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 447,
+	445, 440, 439, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 465, 468, 463, 464, 512, 513, 562, 563,
+	564, 538, 459, 0, 466, 467, 0, 545, 552, 553,
+	516, 100, 109, 160, 96, 209, 135, 259, 449, 462,
+	128, 472, 0, 0, 485, 490, 491, 503, 505, 506,
+	507, 508, 515, 522, 523, 525, 532, 534, 535, 542,
+	549, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 531, 568, 541, 533, 540, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 953,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 954, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 0, 97, 98,
+	99, 358, 357, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 424, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 1064, 0, 59, 0, 0, 97, 98,
+	99, 358, 357, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 1065, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 0, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 412, 97, 98,
+	99, 358, 357, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 0, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 0, 97, 98,
+	99, 358, 357, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 424, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 0, 97, 98,
+	99, 358, 973, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 424, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 0, 97, 98,
+	99, 358, 970, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 424, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 72, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 0, 97, 98,
+	99, 358, 357, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 0, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 703, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 0, 0, 123,
+	256, 200, 140, 142, 253, 267, 187, 0, 0, 0,
+	0, 337, 0, 0, 0, 130, 0, 336, 0, 0,
+	0, 159, 0, 0, 380, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 371, 372, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 0, 0, 97, 98,
+	99, 358, 357, 360, 361, 362, 363, 0, 0, 119,
+	359, 364, 365, 366, 0, 0, 0, 0, 334, 351,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 349, 0, 0, 0, 0, 394, 0, 350,
+	0, 0, 343, 344, 346, 345, 347, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 393, 0,
+	0, 293, 0, 0, 391, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 381, 392, 387, 388, 385, 386, 384, 383,
+	382, 395, 373, 374, 375, 376, 378, 0, 389, 390,
+	377, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 187, 0, 123,
+	256, 200, 140, 142, 253, 267, 130, 0, 0, 0,
+	0, 0, 159, 0, 0, 380, 161, 0, 0, 234,
+	175, 0, 0, 0, 0, 0, 371, 372, 0, 0,
+	0, 0, 0, 0, 0, 0, 59, 0, 0, 97,
+	98, 99, 358, 357, 360, 361, 362, 363, 0, 0,
+	119, 359, 364, 365, 366, 0, 0, 0, 0, 0,
+	351, 0, 379, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 349, 0, 0, 0, 0, 394, 0,
+	350, 0, 0, 343, 344, 346, 345, 347, 352, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 393,
+	0, 0, 293, 0, 0, 391, 0, 206, 0, 238,
+	143, 158, 115, 155, 101, 111, 0, 141, 184, 214,
+	218, 0, 0, 0, 124, 0, 216, 194, 255, 1686,
+	196, 215, 162, 244, 207, 254, 292, 264, 265, 241,
+	262, 270, 231, 104, 240, 252, 120, 226, 0, 0,
+	0, 106, 250, 237, 173, 152, 153, 105, 0, 212,
+	129, 137, 126, 186, 247, 248, 125, 272, 112, 261,
+	108, 113, 260, 180, 243, 251, 174, 167, 107, 249,
+	172, 166, 157, 133, 145, 204, 164, 205, 146, 177,
+	176, 178, 0, 0, 0, 235, 258, 273, 117, 0,
+	242, 268, 269, 0, 208, 118, 138, 132, 203, 136,
+	179, 114, 148, 232, 156, 163, 211, 271, 193, 217,
+	121, 257, 233, 381, 392, 387, 388, 385, 386, 384,
+	383, 382, 395, 373, 374, 375, 376, 378, 0, 389,
+	390, 377, 100, 109, 160, 96, 209, 135, 259, 0,
+	0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 103, 110, 116, 122, 127, 131, 134,
+	144, 147, 149, 150, 151, 154, 165, 168, 169, 170,
+	171, 181, 182, 183, 185, 188, 189, 190, 191, 192,
+	195, 197, 198, 199, 201, 202, 210, 213, 219, 220,
+	221, 222, 223, 224, 225, 227, 228, 229, 230, 236,
+	239, 245, 246, 263, 266, 0, 0, 0, 187, 0,
+	123, 256, 200, 140, 142, 253, 267, 130, 0, 0,
+	0, 0, 0, 159, 0, 0, 380, 161, 0, 0,
+	234, 175, 0, 0, 0, 0, 0, 371, 372, 0,
+	0, 0, 0, 0, 0, 0, 0, 59, 0, 412,
+	97, 98, 99, 358, 357, 360, 361, 362, 363, 0,
+	0, 119, 359, 364, 365, 366, 0, 0, 0, 0,
+	0, 351, 0, 379, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 349, 0, 0, 0, 0, 394,
+	0, 350, 0, 0, 343, 344, 346, 345, 347, 352,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	393, 0, 0, 293, 0, 0, 391, 0, 206, 0,
+	238, 143, 158, 115, 155, 101, 111, 0, 141, 184,
+	214, 218, 0, 0, 0, 124, 0, 216, 194, 255,
+	0, 196, 215, 162, 244, 207, 254, 292, 264, 265,
+	241, 262, 270, 231, 104, 240, 252, 120, 226, 0,
+	0, 0, 106, 250, 237, 173, 152, 153, 105, 0,
+	212, 129, 137, 126, 186, 247, 248, 125, 272, 112,
+	261, 108, 113, 260, 180, 243, 251, 174, 167, 107,
+	249, 172, 166, 157, 133, 145, 204, 164, 205, 146,
+	177, 176, 178, 0, 0, 0, 235, 258, 273, 117,
+	0, 242, 268, 269, 0, 208, 118, 138, 132, 203,
+	136, 179, 114, 148, 232, 156, 163, 211, 271, 193,
+	217, 121, 257, 233, 381, 392, 387, 388, 385, 386,
+	384, 383, 382, 395, 373, 374, 375, 376, 378, 0,
+	389, 390, 377, 100, 109, 160, 96, 209, 135, 259,
+	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 103, 110, 116, 122, 127, 131,
+	134, 144, 147, 149, 150, 151, 154, 165, 168, 169,
+	170, 171, 181, 182, 183, 185, 188, 189, 190, 191,
+	192, 195, 197, 198, 199, 201, 202, 210, 213, 219,
+	220, 221, 222, 223, 224, 225, 227, 228, 229, 230,
+	236, 239, 245, 246, 263, 266, 0, 0, 0, 187,
+	0, 123, 256, 200, 140, 142, 253, 267, 130, 0,
+	0, 0, 0, 0, 159, 0, 0, 380, 161, 0,
+	0, 234, 175, 0, 0, 0, 0, 0, 371, 372,
+	0, 0, 0, 0, 0, 0, 0, 0, 59, 0,
+	0, 97, 98, 99, 358, 357, 360, 361, 362, 363,
+	0, 0, 119, 359, 364, 365, 366, 0, 0, 0,
+	0, 0, 351, 0, 379, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 349, 0, 0, 0, 0,
+	394, 0, 350, 0, 0, 343, 344, 346, 345, 347,
+	352, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 393, 0, 0, 293, 0, 0, 391, 0, 206,
+	0, 238, 143, 158, 115, 155, 101, 111, 0, 141,
+	184, 214, 218, 0, 0, 0, 124, 0, 216, 194,
+	255, 0, 196, 215, 162, 244, 207, 254, 292, 264,
+	265, 241, 262, 270, 231, 104, 240, 252, 120, 226,
+	0, 0, 0, 106, 250, 237, 173, 152, 153, 105,
+	0, 212, 129, 137, 126, 186, 247, 248, 125, 272,
+	112, 261, 108, 113, 260, 180, 243, 251, 174, 167,
+	107, 249, 172, 166, 157, 133, 145, 204, 164, 205,
+	146, 177, 176, 178, 0, 0, 0, 235, 258, 273,
+	117, 0, 242, 268, 269, 0, 208, 118, 138, 132,
+	203, 136, 179, 114, 148, 232, 156, 163, 211, 271,
+	193, 217, 121, 257, 233, 381, 392, 387, 388, 385,
+	386, 384, 383, 382, 395, 373, 374, 375, 376, 378,
+	0, 389, 390, 377, 100, 109, 160, 96, 209, 135,
+	259, 0, 0, 128, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 103, 110, 116, 122, 127,
+	131, 134, 144, 147, 149, 150, 151, 154, 165, 168,
+	169, 170, 171, 181, 182, 183, 185, 188, 189, 190,
+	191, 192, 195, 197, 198, 199, 201, 202, 210, 213,
+	219, 220, 221, 222, 223, 224, 225, 227, 228, 229,
+	230, 236, 239, 245, 246, 263, 266, 0, 0, 0,
+	187, 0, 123, 256, 200, 140, 142, 253, 267, 130,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 161,
+	0, 0, 234, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 662, 661, 671, 672, 664, 665, 666, 667, 668,
+	669, 670, 663, 0, 0, 673, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 0, 0, 0, 293, 0, 0, 0, 0,
+	206, 0, 238, 143, 158, 115, 155, 101, 111, 0,
+	141, 184, 214, 218, 0, 0, 0, 124, 0, 216,
+	194, 255, 0, 196, 215, 162, 244, 207, 254, 292,
+	264, 265, 241, 262, 270, 231, 104, 240, 252, 120,
+	226, 0, 0, 0, 106, 250, 237, 173, 152, 153,
+	105, 0, 212, 129, 137, 126, 186, 247, 248, 125,
+	272, 112, 261, 108, 113, 260, 180, 243, 251, 174,
+	167, 107, 249, 172, 166, 157, 133, 145, 204, 164,
+	205, 146, 177, 176, 178, 0, 0, 0, 235, 258,
+	273, 117, 0, 242, 268, 269, 0, 208, 118, 138,
+	132, 203, 136, 179, 114, 148, 232, 156, 163, 211,
+	271, 193, 217, 121, 257, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 109, 160, 96, 209,
+	135, 259, 0, 0, 128, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 110, 116, 122,
+	127, 131, 134, 144, 147, 149, 150, 151, 154, 165,
+	168, 169, 170, 171, 181, 182, 183, 185, 188, 189,
+	190, 191, 192, 195, 197, 198, 199, 201, 202, 210,
+	213, 219, 220, 221, 222, 223, 224, 225, 227, 228,
+	229, 230, 236, 239, 245, 246, 263, 266, 0, 0,
+	0, 0, 0, 123, 256, 200, 140, 142, 253, 267,
+	187, 0, 0, 0, 762, 0, 0, 0, 0, 130,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 161,
+	0, 0, 234, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 99, 0, 764, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 651,
+	652, 650, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 653, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 0, 0, 0, 293, 0, 0, 0, 0,
+	206, 0, 238, 143, 158, 115, 155, 101, 111, 0,
+	141, 184, 214, 218, 0, 0, 0, 124, 0, 216,
+	194, 255, 0, 196, 215, 162, 244, 207, 254, 292,
+	264, 265, 241, 262, 270, 231, 104, 240, 252, 120,
+	226, 0, 0, 0, 106, 250, 237, 173, 152, 153,
+	105, 0, 212, 129, 137, 126, 186, 247, 248, 125,
+	272, 112, 261, 108, 113, 260, 180, 243, 251, 174,
+	167, 107, 249, 172, 166, 157, 133, 145, 204, 164,
+	205, 146, 177, 176, 178, 0, 0, 0, 235, 258,
+	273, 117, 0, 242, 268, 269, 0, 208, 118, 138,
+	132, 203, 136, 179, 114, 148, 232, 156, 163, 211,
+	271, 193, 217, 121, 257, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 109, 160, 96, 209,
+	135, 259, 0, 0, 128, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 110, 116, 122,
+	127, 131, 134, 144, 147, 149, 150, 151, 154, 165,
+	168, 169, 170, 171, 181, 182, 183, 185, 188, 189,
+	190, 191, 192, 195, 197, 198, 199, 201, 202, 210,
+	213, 219, 220, 221, 222, 223, 224, 225, 227, 228,
+	229, 230, 236, 239, 245, 246, 263, 266, 0, 0,
+	0, 187, 0, 123, 256, 200, 140, 142, 253, 267,
+	130, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	161, 0, 0, 234, 175, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 0, 0,
+	87, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 89, 90, 0, 86, 0, 0, 0,
+	92, 206, 0, 238, 143, 158, 115, 155, 101, 111,
+	0, 141, 184, 214, 218, 0, 0, 0, 124, 0,
+	216, 194, 255, 0, 196, 215, 162, 244, 207, 254,
+	91, 264, 265, 241, 262, 270, 231, 104, 240, 252,
+	120, 226, 0, 0, 0, 106, 250, 237, 173, 152,
+	153, 105, 0, 212, 129, 137, 126, 186, 247, 248,
+	125, 272, 112, 261, 108, 113, 260, 180, 243, 251,
+	174, 167, 107, 249, 172, 166, 157, 133, 145, 204,
+	164, 205, 146, 177, 176, 178, 0, 0, 0, 235,
+	258, 273, 117, 0, 242, 268, 269, 0, 208, 118,
+	138, 132, 203, 136, 179, 114, 148, 232, 156, 163,
+	211, 271, 193, 217, 121, 257, 233, 0, 88, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 100, 109, 160, 96,
+	209, 135, 259, 0, 0, 128, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 103, 110, 116,
+	122, 127, 131, 134, 144, 147, 149, 150, 151, 154,
+	165, 168, 169, 170, 171, 181, 182, 183, 185, 188,
+	189, 190, 191, 192, 195, 197, 198, 199, 201, 202,
+	210, 213, 219, 220, 221, 222, 223, 224, 225, 227,
+	228, 229, 230, 236, 239, 245, 246, 263, 266, 30,
+	0, 0, 0, 0, 123, 256, 200, 140, 142, 253,
+	267, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	161, 0, 0, 234, 175, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	59, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 0, 0, 0, 293, 0, 0, 0,
+	0, 206, 0, 238, 143, 158, 115, 155, 101, 111,
+	0, 141, 184, 214, 218, 0, 0, 0, 124, 0,
+	216, 194, 255, 0, 196, 215, 162, 244, 207, 254,
+	292, 264, 265, 241, 262, 270, 231, 104, 240, 252,
+	120, 226, 0, 0, 0, 106, 250, 237, 173, 152,
+	153, 105, 0, 212, 129, 137, 126, 186, 247, 248,
+	125, 272, 112, 261, 108, 113, 260, 180, 243, 251,
+	174, 167, 107, 249, 172, 166, 157, 133, 145, 204,
+	164, 205, 146, 177, 176, 178, 0, 0, 0, 235,
+	258, 273, 117, 0, 242, 268, 269, 0, 208, 118,
+	138, 132, 203, 136, 179, 114, 148, 232, 156, 163,
+	211, 271, 193, 217, 121, 257, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 100, 109, 160, 703,
+	209, 135, 259, 0, 0, 128, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 103, 110, 116,
+	122, 127, 131, 134, 144, 147, 149, 150, 151, 154,
+	165, 168, 169, 170, 171, 181, 182, 183, 185, 188,
+	189, 190, 191, 192, 195, 197, 198, 199, 201, 202,
+	210, 213, 219, 220, 221, 222, 223, 224, 225, 227,
+	228, 229, 230, 236, 239, 245, 246, 263, 266, 30,
+	0, 0, 0, 0, 123, 256, 200, 140, 142, 253,
+	267, 187, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	161, 0, 0, 234, 175, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	59, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 0, 0, 0, 293, 0, 0, 0,
+	0, 206, 0, 238, 143, 158, 115, 155, 101, 111,
+	0, 141, 184, 214, 218, 0, 0, 0, 124, 0,
+	216, 194, 255, 0, 196, 215, 162, 244, 207, 254,
+	292, 264, 265, 241, 262, 270, 231, 104, 240, 252,
+	120, 226, 0, 0, 0, 106, 250, 237, 173, 152,
+	153, 105, 0, 212, 129, 137, 126, 186, 247, 248,
+	125, 272, 112, 261, 108, 113, 260, 180, 243, 251,
+	174, 167, 107, 249, 172, 166, 157, 133, 145, 204,
+	164, 205, 146, 177, 176, 178, 0, 0, 0, 235,
+	258, 273, 117, 0, 242, 268, 269, 0, 208, 118,
+	138, 132, 203, 136, 179, 114, 148, 232, 156, 163,
+	211, 271, 193, 217, 121, 257, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 100, 109, 160, 58,
+	209, 135, 259, 0, 0, 128, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 103, 110, 116,
+	122, 127, 131, 134, 144, 147, 149, 150, 151, 154,
+	165, 168, 169, 170, 171, 181, 182, 183, 185, 188,
+	189, 190, 191, 192, 195, 197, 198, 199, 201, 202,
+	210, 213, 219, 220, 221, 222, 223, 224, 225, 227,
+	228, 229, 230, 236, 239, 245, 246, 263, 266, 0,
+	0, 0, 187, 0, 123, 256, 200, 140, 142, 253,
+	267, 130, 0, 0, 0, 0, 0, 159, 0, 0,
+	0, 161, 0, 0, 234, 175, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 99, 0, 0, 1013,
+	0, 0, 1014, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 0, 0, 0, 293, 0, 0,
+	0, 0, 206, 0, 238, 143, 158, 115, 155, 101,
+	111, 0, 141, 184, 214, 218, 0, 0, 0, 124,
+	0, 216, 194, 255, 0, 196, 215, 162, 244, 207,
+	254, 292, 264, 265, 241, 262, 270, 231, 104, 240,
+	252, 120, 226, 0, 0, 0, 106, 250, 237, 173,
+	152, 153, 105, 0, 212, 129, 137, 126, 186, 247,
+	248, 125, 272, 112, 261, 108, 113, 260, 180, 243,
+	251, 174, 167, 107, 249, 172, 166, 157, 133, 145,
+	204, 164, 205, 146, 177, 176, 178, 0, 0, 0,
+	235, 258, 273, 117, 0, 242, 268, 269, 0, 208,
+	118, 138, 132, 203, 136, 179, 114, 148, 232, 156,
+	163, 211, 271, 193, 217, 121, 257, 233, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 109, 160,
+	96, 209, 135, 259, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 103, 110,
+	116, 122, 127, 131, 134, 144, 147, 149, 150, 151,
+	154, 165, 168, 169, 170, 171, 181, 182, 183, 185,
+	188, 189, 190, 191, 192, 195, 197, 198, 199, 201,
+	202, 210, 213, 219, 220, 221, 222, 223, 224, 225,
+	227, 228, 229, 230, 236, 239, 245, 246, 263, 266,
+	0, 0, 0, 187, 0, 123, 256, 200, 140, 142,
+	253, 267, 130, 0, 797, 0, 0, 0, 159, 0,
+	0, 0, 161, 0, 0, 234, 175, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 97, 98, 99, 0, 796,
+	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 0, 0, 0, 293, 0,
+	0, 0, 0, 206, 0, 238, 143, 158, 115, 155,
+	101, 111, 0, 141, 184, 214, 218, 0, 0, 0,
+	124, 0, 216, 194, 255, 0, 196, 215, 162, 244,
+	207, 254, 292, 264, 265, 241, 262, 270, 231, 104,
+	240, 252, 120, 226, 0, 0, 0, 106, 250, 237,
+	173, 152, 153, 105, 0, 212, 129, 137, 126, 186,
+	247, 248, 125, 272, 112, 261, 108, 113, 260, 180,
+	243, 251, 174, 167, 107, 249, 172, 166, 157, 133,
+	145, 204, 164, 205, 146, 177, 176, 178, 0, 0,
+	0, 235, 258, 273, 117, 0, 242, 268, 269, 0,
+	208, 118, 138, 132, 203, 136, 179, 114, 148, 232,
+	156, 163, 211, 271, 193, 217, 121, 257, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 100, 109,
+	160, 96, 209, 135, 259, 0, 0, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 102, 103,
+	110, 116, 122, 127, 131, 134, 144, 147, 149, 150,
+	151, 154, 165, 168, 169, 170, 171, 181, 182, 183,
+	185, 188, 189, 190, 191, 192, 195, 197, 198, 199,
+	201, 202, 210, 213, 219, 220, 221, 222, 223, 224,
+	225, 227, 228, 229, 230, 236, 239, 245, 246, 263,
+	266, 0, 0, 0, 0, 0, 123, 256, 200, 140,
+	142, 253, 267, 187, 0, 0, 0, 1047, 0, 0,
+	0, 0, 130, 0, 0, 0, 0, 0, 159, 0,
+	0, 0, 161, 0, 0, 234, 175, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 97, 98, 99, 0, 1049,
+	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 0, 0, 0, 293, 0,
+	0, 0, 0, 206, 0, 238, 143, 158, 115, 155,
+	101, 111, 0, 141, 184, 214, 218, 0, 0, 0,
+	124, 0, 216, 194, 255, 0, 196, 215, 162, 244,
+	207, 254, 292, 264, 265, 241, 262, 270, 231, 104,
+	240, 252, 120, 226, 0, 0, 0, 106, 250, 237,
+	173, 152, 153, 105, 0, 212, 129, 137, 126, 186,
+	247, 248, 125, 272, 112, 261, 108, 113, 260, 180,
+	243, 251, 174, 167, 107, 249, 172, 166, 157, 133,
+	145, 204, 164, 205, 146, 177, 176, 178, 0, 0,
+	0, 235, 258, 273, 117, 0, 242, 268, 269, 0,
+	208, 118, 138, 132, 203, 136, 179, 114, 148, 232,
+	156, 163, 211, 271, 193, 217, 121, 257, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 100, 109,
+	160, 0, 209, 135, 259, 0, 0, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 102, 103,
+	110, 116, 122, 127, 131, 134, 144, 147, 149, 150,
+	151, 154, 165, 168, 169, 170, 171, 181, 182, 183,
+	185, 188, 189, 190, 191, 192, 195, 197, 198, 199,
+	201, 202, 210, 213, 219, 220, 221, 222, 223, 224,
+	225, 227, 228, 229, 230, 236, 239, 245, 246, 263,
+	266, 0, 0, 0, 187, 0, 123, 256, 200, 140,
+	142, 253, 267, 130, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 161, 0, 0, 234, 175, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 412, 97, 98, 99, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 0, 0, 0, 293,
+	0, 0, 0, 0, 206, 0, 238, 143, 158, 115,
+	155, 101, 111, 0, 141, 184, 214, 218, 0, 0,
+	0, 124, 0, 216, 194, 255, 0, 196, 215, 162,
+	244, 207, 254, 292, 264, 265, 241, 262, 270, 231,
+	104, 240, 252, 120, 226, 0, 0, 0, 106, 250,
+	237, 173, 152, 153, 105, 0, 212, 129, 137, 126,
+	186, 247, 248, 125, 272, 112, 261, 108, 113, 260,
+	180, 243, 251, 174, 167, 107, 249, 172, 166, 157,
+	133, 145, 204, 164, 205, 146, 177, 176, 178, 0,
+	0, 0, 235, 258, 273, 117, 0, 242, 268, 269,
+	0, 208, 118, 138, 132, 203, 136, 179, 114, 148,
+	232, 156, 163, 211, 271, 193, 217, 121, 257, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	109, 160, 96, 209, 135, 259, 0, 0, 128, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	103, 110, 116, 122, 127, 131, 134, 144, 147, 149,
+	150, 151, 154, 165, 168, 169, 170, 171, 181, 182,
+	183, 185, 188, 189, 190, 191, 192, 195, 197, 198,
+	199, 201, 202, 210, 213, 219, 220, 221, 222, 223,
+	224, 225, 227, 228, 229, 230, 236, 239, 245, 246,
+	263, 266, 0, 0, 0, 187, 0, 123, 256, 200,
+	140, 142, 253, 267, 130, 0, 0, 0, 0, 0,
+	159, 0, 0, 0, 161, 0, 0, 234, 175, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 59, 0, 0, 97, 98, 99,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 0, 0, 0,
+	293, 0, 0, 0, 0, 206, 0, 238, 143, 158,
+	115, 155, 101, 111, 0, 141, 184, 214, 218, 0,
+	0, 0, 124, 0, 216, 194, 255, 0, 196, 215,
+	162, 244, 207, 254, 292, 264, 265, 241, 262, 270,
+	231, 104, 240, 252, 120, 226, 0, 0, 0, 106,
+	250, 237, 173, 152, 153, 105, 0, 212, 129, 137,
+	126, 186, 247, 248, 125, 272, 112, 261, 108, 113,
+	260, 180, 243, 251, 174, 167, 107, 249, 172, 166,
+	157, 133, 145, 204, 164, 205, 146, 177, 176, 178,
+	0, 0, 0, 235, 258, 273, 117, 0, 242, 268,
+	269, 0, 208, 118, 138, 132, 203, 136, 179, 114,
+	148, 232, 156, 163, 211, 271, 193, 217, 121, 257,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	100, 109, 160, 96, 209, 135, 259, 0, 0, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	102, 103, 110, 116, 122, 127, 131, 134, 144, 147,
+	149, 150, 151, 154, 165, 168, 169, 170, 171, 181,
+	182, 183, 185, 188, 189, 190, 191, 192, 195, 197,
+	198, 199, 201, 202, 210, 213, 219, 220, 221, 222,
+	223, 224, 225, 227, 228, 229, 230, 236, 239, 245,
+	246, 263, 266, 0, 0, 0, 0, 0, 123, 256,
+	200, 140, 142, 253, 267, 187, 0, 0, 0, 1047,
+	0, 0, 0, 0, 130, 0, 0, 0, 0, 0,
+	159, 0, 0, 0, 161, 0, 0, 234, 175, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 97, 98, 99,
+	0, 1049, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 0, 0, 0,
+	293, 0, 0, 0, 0, 206, 0, 238, 143, 158,
+	115, 155, 101, 111, 0, 141, 184, 214, 218, 0,
+	0, 0, 124, 0, 216, 194, 255, 0, 1045, 215,
+	162, 244, 207, 254, 292, 264, 265, 241, 262, 270,
+	231, 104, 240, 252, 120, 226, 0, 0, 0, 106,
+	250, 237, 173, 152, 153, 105, 0, 212, 129, 137,
+	126, 186, 247, 248, 125, 272, 112, 261, 108, 113,
+	260, 180, 243, 251, 174, 167, 107, 249, 172, 166,
+	157, 133, 145, 204, 164, 205, 146, 177, 176, 178,
+	0, 0, 0, 235, 258, 273, 117, 0, 242, 268,
+	269, 0, 208, 118, 138, 132, 203, 136, 179, 114,
+	148, 232, 156, 163, 211, 271, 193, 217, 121, 257,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	100, 109, 160, 0, 209, 135, 259, 0, 0, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	102, 103, 110, 116, 122, 127, 131, 134, 144, 147,
+	149, 150, 151, 154, 165, 168, 169, 170, 171, 181,
+	182, 183, 185, 188, 189, 190, 191, 192, 195, 197,
+	198, 199, 201, 202, 210, 213, 219, 220, 221, 222,
+	223, 224, 225, 227, 228, 229, 230, 236, 239, 245,
+	246, 263, 266, 0, 0, 0, 187, 0, 123, 256,
+	200, 140, 142, 253, 267, 130, 0, 0, 0, 0,
+	0, 159, 0, 0, 0, 161, 0, 0, 234, 175,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 97, 98,
+	99, 0, 764, 0, 0, 0, 0, 0, 0, 119,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 0, 0,
+	0, 293, 0, 0, 0, 0, 206, 0, 238, 143,
+	158, 115, 155, 101, 111, 0, 141, 184, 214, 218,
+	0, 0, 0, 124, 0, 216, 194, 255, 0, 196,
+	215, 162, 244, 207, 254, 292, 264, 265, 241, 262,
+	270, 231, 104, 240, 252, 120, 226, 0, 0, 0,
+	106, 250, 237, 173, 152, 153, 105, 0, 212, 129,
+	137, 126, 186, 247, 248, 125, 272, 112, 261, 108,
+	113, 260, 180, 243, 251, 174, 167, 107, 249, 172,
+	166, 157, 133, 145, 204, 164, 205, 146, 177, 176,
+	178, 0, 0, 0, 235, 258, 273, 117, 0, 242,
+	268, 269, 0, 208, 118, 138, 132, 203, 136, 179,
+	114, 148, 232, 156, 163, 211, 271, 193, 217, 121,
+	257, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 100, 109, 160, 96, 209, 135, 259, 0, 0,
+	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 103, 110, 116, 122, 127, 131, 134, 144,
+	147, 149, 150, 151, 154, 165, 168, 169, 170, 171,
+	181, 182, 183, 185, 188, 189, 190, 191, 192, 195,
+	197, 198, 199, 201, 202, 210, 213, 219, 220, 221,
+	222, 223, 224, 225, 227, 228, 229, 230, 236, 239,
+	245, 246, 263, 266, 0, 0, 0, 187, 0, 123,
+	256, 200, 140, 142, 253, 267, 130, 0, 0, 0,
+	0, 0, 159, 0, 0, 0, 161, 0, 0, 234,
+	175, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 97,
+	98, 99, 0, 640, 0, 0, 0, 0, 0, 0,
+	119, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 0,
+	0, 0, 293, 0, 0, 0, 0, 206, 0, 238,
+	143, 158, 115, 155, 101, 111, 0, 141, 184, 214,
+	218, 0, 0, 0, 124, 0, 216, 194, 255, 0,
+	196, 215, 162, 244, 207, 254, 292, 264, 265, 241,
+	262, 270, 231, 104, 240, 252, 120, 226, 0, 0,
+	0, 106, 250, 237, 173, 152, 153, 105, 0, 212,
+	129, 137, 126, 186, 247, 248, 125, 272, 112, 261,
+	108, 113, 260, 180, 243, 251, 174, 167, 107, 249,
+	172, 166, 157, 133, 145, 204, 164, 205, 146, 177,
+	176, 178, 0, 0, 0, 235, 258, 273, 117, 0,
+	242, 268, 269, 0, 208, 118, 138, 132, 203, 136,
+	179, 114, 148, 232, 156, 163, 211, 271, 193, 217,
+	121, 257, 233, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 100, 109, 160, 96, 209, 135, 259, 0,
+	0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 102, 103, 110, 116, 122, 127, 131, 134,
+	144, 147, 149, 150, 151, 154, 165, 168, 169, 170,
+	171, 181, 182, 183, 185, 188, 189, 190, 191, 192,
+	195, 197, 198, 199, 201, 202, 210, 213, 219, 220,
+	221, 222, 223, 224, 225, 227, 228, 229, 230, 236,
+	239, 245, 246, 263, 266, 0, 0, 0, 187, 0,
+	123, 256, 200, 140, 142, 253, 267, 130, 0, 0,
+	0, 0, 0, 159, 0, 0, 0, 161, 0, 0,
+	234, 175, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 99, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	0, 0, 0, 293, 0, 0, 0, 0, 206, 0,
+	238, 143, 158, 115, 155, 101, 111, 0, 141, 184,
+	214, 218, 0, 0, 0, 124, 0, 216, 194, 255,
+	0, 196, 215, 162, 244, 207, 254, 292, 264, 265,
+	241, 262, 270, 231, 104, 240, 252, 120, 226, 0,
+	0, 0, 106, 250, 237, 173, 152, 153, 105, 0,
+	212, 129, 137, 126, 186, 247, 248, 125, 272, 112,
+	261, 108, 113, 260, 180, 243, 251, 174, 167, 107,
+	249, 172, 166, 157, 133, 145, 204, 164, 205, 146,
+	177, 176, 178, 0, 0, 0, 235, 258, 273, 117,
+	0, 242, 268, 269, 0, 208, 118, 138, 132, 203,
+	136, 179, 114, 148, 232, 156, 163, 211, 271, 193,
+	217, 121, 257, 233, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 109, 160, 96, 209, 135, 259,
+	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 103, 110, 116, 122, 127, 131,
+	134, 144, 147, 149, 150, 151, 154, 165, 168, 169,
+	170, 171, 181, 182, 183, 185, 188, 189, 190, 191,
+	192, 195, 197, 198, 199, 201, 202, 210, 213, 219,
+	220, 221, 222, 223, 224, 225, 227, 228, 229, 230,
+	236, 239, 245, 246, 263, 266, 0, 0, 0, 187,
+	0, 123, 256, 200, 140, 142, 253, 267, 130, 0,
+	0, 0, 0, 0, 159, 0, 0, 0, 161, 0,
+	0, 234, 175, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 59, 0,
+	0, 97, 98, 99, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 0, 0, 0, 293, 0, 0, 0, 0, 206,
+	0, 238, 143, 158, 115, 155, 101, 111, 0, 141,
+	184, 214, 218, 0, 0, 0, 124, 0, 216, 194,
+	255, 0, 196, 215, 162, 244, 207, 254, 292, 264,
+	265, 241, 262, 270, 231, 104, 240, 252, 120, 226,
+	0, 0, 0, 106, 250, 237, 173, 152, 153, 105,
+	0, 212, 129, 137, 126, 186, 247, 248, 125, 272,
+	112, 261, 108, 113, 260, 180, 243, 251, 174, 167,
+	107, 249, 172, 166, 157, 133, 145, 204, 164, 205,
+	146, 177, 176, 178, 0, 0, 0, 235, 258, 273,
+	117, 0, 242, 268, 269, 0, 208, 118, 138, 132,
+	203, 136, 179, 114, 148, 232, 156, 163, 211, 271,
+	193, 217, 121, 257, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 100, 109, 160, 0, 209, 135,
+	259, 0, 0, 128, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 103, 110, 116, 122, 127,
+	131, 134, 144, 147, 149, 150, 151, 154, 165, 168,
+	169, 170, 171, 181, 182, 183, 185, 188, 189, 190,
+	191, 192, 195, 197, 198, 199, 201, 202, 210, 213,
+	219, 220, 221, 222, 223, 224, 225, 227, 228, 229,
+	230, 236, 239, 245, 246, 263, 266, 0, 0, 0,
+	187, 0, 123, 256, 200, 140, 142, 253, 267, 130,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 161,
+	0, 0, 234, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 99, 0, 1049, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 0, 0, 0, 293, 0, 0, 0, 0,
+	206, 0, 238, 143, 158, 115, 155, 101, 111, 0,
+	141, 184, 214, 218, 0, 0, 0, 124, 0, 216,
+	194, 255, 0, 196, 215, 162, 244, 207, 254, 292,
+	264, 265, 241, 262, 270, 231, 104, 240, 252, 120,
+	226, 0, 0, 0, 106, 250, 237, 173, 152, 153,
+	105, 0, 212, 129, 137, 126, 186, 247, 248, 125,
+	272, 112, 261, 108, 113, 260, 180, 243, 251, 174,
+	167, 107, 249, 172, 166, 157, 133, 145, 204, 164,
+	205, 146, 177, 176, 178, 0, 0, 0, 235, 258,
+	273, 117, 0, 242, 268, 269, 0, 208, 118, 138,
+	132, 203, 136, 179, 114, 148, 232, 156, 163, 211,
+	271, 193, 217, 121, 257, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 109, 160, 0, 209,
+	135, 259, 0, 0, 128, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 110, 116, 122,
+	127, 131, 134, 144, 147, 149, 150, 151, 154, 165,
+	168, 169, 170, 171, 181, 182, 183, 185, 188, 189,
+	190, 191, 192, 195, 197, 198, 199, 201, 202, 210,
+	213, 219, 220, 221, 222, 223, 224, 225, 227, 228,
+	229, 230, 236, 239, 245, 246, 263, 266, 0, 0,
+	0, 0, 187, 123, 256, 200, 140, 142, 253, 267,
+	767, 130, 0, 0, 0, 0, 0, 159, 0, 0,
+	0, 161, 0, 0, 234, 175, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 0, 0, 0, 293, 0, 0,
+	0, 0, 206, 0, 238, 143, 158, 115, 155, 101,
+	111, 0, 141, 184, 214, 218, 0, 0, 0, 124,
+	0, 216, 194, 255, 0, 196, 215, 162, 244, 207,
+	254, 292, 264, 265, 241, 262, 270, 231, 104, 240,
+	252, 120, 226, 0, 0, 0, 106, 250, 237, 173,
+	152, 153, 105, 0, 212, 129, 137, 126, 186, 247,
+	248, 125, 272, 112, 261, 108, 113, 260, 180, 243,
+	251, 174, 167, 107, 249, 172, 166, 157, 133, 145,
+	204, 164, 205, 146, 177, 176, 178, 0, 0, 0,
+	235, 258, 273, 117, 0, 242, 268, 269, 0, 208,
+	118, 138, 132, 203, 136, 179, 114, 148, 232, 156,
+	163, 211, 271, 193, 217, 121, 257, 233, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 109, 160,
+	0, 209, 135, 259, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 103, 110,
+	116, 122, 127, 131, 134, 144, 147, 149, 150, 151,
+	154, 165, 168, 169, 170, 171, 181, 182, 183, 185,
+	188, 189, 190, 191, 192, 195, 197, 198, 199, 201,
+	202, 210, 213, 219, 220, 221, 222, 223, 224, 225,
+	227, 228, 229, 230, 236, 239, 245, 246, 263, 266,
+	0, 0, 0, 0, 0, 123, 256, 200, 140, 142,
+	253, 267, 429, 0, 0, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 0, 0, 0, 0, 130, 0,
+	0, 0, 0, 0, 159, 0, 0, 0, 161, 0,
+	0, 234, 175, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 97, 98, 99, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 0, 0, 0, 293, 0, 0, 0, 0, 206,
+	0, 238, 143, 158, 115, 155, 101, 111, 0, 141,
+	184, 214, 218, 0, 0, 0, 124, 0, 216, 194,
+	255, 0, 196, 215, 162, 244, 207, 254, 292, 264,
+	265, 241, 262, 270, 231, 104, 240, 252, 120, 226,
+	0, 0, 0, 106, 250, 237, 173, 152, 153, 105,
+	0, 212, 129, 137, 126, 186, 247, 248, 125, 272,
+	112, 261, 108, 113, 260, 180, 243, 251, 174, 167,
+	107, 249, 172, 166, 157, 133, 145, 204, 164, 205,
+	146, 177, 176, 178, 0, 0, 0, 235, 258, 273,
+	117, 0, 242, 268, 269, 0, 208, 118, 138, 132,
+	203, 136, 179, 114, 148, 232, 156, 163, 211, 271,
+	193, 217, 121, 257, 233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 100, 109, 160, 0, 209, 135,
+	259, 0, 0, 128, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 102, 103, 110, 116, 122, 127,
+	131, 134, 144, 147, 149, 150, 151, 154, 165, 168,
+	169, 170, 171, 181, 182, 183, 185, 188, 189, 190,
+	191, 192, 195, 197, 198, 199, 201, 202, 210, 213,
+	219, 220, 221, 222, 223, 224, 225, 227, 228, 229,
+	230, 236, 239, 245, 246, 263, 266, 0, 0, 0,
+	187, 0, 123, 256, 200, 140, 142, 253, 267, 130,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 161,
+	0, 0, 234, 175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 97, 98, 99, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	0, 139, 0, 0, 0, 293, 0, 0, 0, 0,
+	206, 0, 238, 143, 158, 115, 155, 101, 111, 0,
+	141, 184, 214, 218, 0, 0, 0, 124, 0, 216,
+	194, 255, 0, 196, 215, 162, 244, 207, 254, 292,
+	264, 265, 241, 262, 270, 231, 104, 240, 252, 120,
+	226, 0, 0, 0, 106, 250, 237, 173, 152, 153,
+	105, 0, 212, 129, 137, 126, 186, 247, 248, 125,
+	272, 112, 261, 108, 113, 260, 180, 243, 251, 174,
+	167, 107, 249, 172, 166, 157, 133, 145, 204, 164,
+	205, 146, 177, 176, 178, 0, 0, 0, 235, 258,
+	273, 117, 0, 242, 268, 269, 0, 208, 118, 138,
+	132, 203, 136, 179, 114, 148, 232, 156, 163, 211,
+	271, 193, 217, 121, 257, 233, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 109, 160, 0, 209,
+	135, 259, 0, 0, 128, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 103, 110, 116, 122,
+	127, 131, 134, 144, 147, 149, 150, 151, 154, 165,
+	168, 169, 170, 171, 181, 182, 183, 185, 188, 189,
+	190, 191, 192, 195, 197, 198, 199, 201, 202, 210,
+	213, 219, 220, 221, 222, 223, 224, 225, 227, 228,
+	229, 230, 236, 239, 245, 246, 263, 266, 0, 0,
+	0, 187, 0, 123, 256, 200, 140, 324, 253, 267,
+	130, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	161, 0, 0, 234, 175, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 97, 98, 99, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 0, 287, 0, 293, 0, 0, 0,
+	0, 206, 0, 238, 143, 158, 115, 155, 101, 111,
+	0, 141, 184, 214, 218, 0, 0, 0, 124, 0,
+	216, 194, 255, 0, 196, 215, 162, 244, 207, 254,
+	292, 264, 265, 241, 262, 270, 231, 104, 240, 252,
+	120, 226, 0, 0, 0, 106, 250, 237, 173, 152,
+	153, 105, 0, 212, 129, 137, 126, 186, 247, 248,
+	125, 272, 112, 261, 108, 113, 260, 180, 243, 251,
+	174, 167, 107, 249, 172, 166, 157, 133, 145, 204,
+	164, 205, 146, 177, 176, 178, 0, 0, 0, 235,
+	258, 273, 117, 0, 242, 268, 269, 0, 208, 118,
+	138, 132, 203, 136, 179, 114, 148, 232, 156, 163,
+	211, 271, 193, 217, 121, 257, 233, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 100, 109, 160, 0,
+	209, 135, 259, 0, 0, 128, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 103, 110, 116,
+	122, 127, 131, 134, 144, 147, 149, 150, 151, 154,
+	165, 168, 169, 170, 171, 181, 182, 183, 185, 188,
+	189, 190, 191, 192, 195, 197, 198, 199, 201, 202,
+	210, 213, 219, 220, 221, 222, 223, 224, 225, 227,
+	228, 229, 230, 236, 239, 245, 246, 263, 266, 0,
+	0, 0, 187, 0, 123, 256, 200, 140, 142, 253,
+	267, 130, 0, 0, 0, 0, 0, 159, 0, 0,
+	0, 161, 0, 0, 234, 175, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 97, 98, 99, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 0, 0, 0, 293, 0, 0,
+	0, 0, 206, 0, 238, 143, 158, 115, 155, 101,
+	111, 0, 141, 184, 214, 218, 0, 0, 0, 124,
+	0, 216, 194, 255, 0, 196, 215, 162, 244, 207,
+	254, 292, 264, 265, 241, 262, 270, 231, 104, 240,
+	252, 120, 226, 0, 0, 0, 106, 250, 237, 173,
+	152, 153, 105, 0, 212, 129, 137, 126, 186, 247,
+	248, 125, 272, 112, 261, 108, 113, 260, 180, 243,
+	251, 174, 167, 107, 249, 172, 166, 157, 133, 145,
+	204, 164, 205, 146, 177, 176, 178, 0, 0, 0,
+	235, 258, 273, 117, 0, 242, 268, 269, 0, 208,
+	118, 138, 132, 203, 136, 179, 114, 148, 232, 156,
+	163, 211, 271, 193, 217, 121, 257, 233, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 109, 160,
+	0, 209, 135, 259, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 103, 110,
+	116, 122, 127, 131, 134, 144, 147, 149, 150, 151,
+	154, 165, 168, 169, 170, 171, 181, 182, 183, 185,
+	188, 189, 190, 191, 192, 195, 197, 198, 199, 201,
+	202, 210, 213, 219, 220, 221, 222, 223, 224, 225,
+	227, 228, 229, 230, 236, 239, 245, 246, 263, 266,
+	0, 0, 0, 0, 0, 123, 256, 200, 140, 142,
+	253, 267,
 }
 var yyPact = [...]int{
 
-	2129, -1000, -270, 999, -1000, -1000, -1000, -1000, -1000, -1000,
+	2077, -1000, -270, 1060, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1004, 1045, 146,
+	-1000, -1000, -1000, -1000, -1000, -1000, 339, 13163, 28, 190,
+	93, 19013, 188, 1665, 19354, -1000, 63, -1000, 51, 16950,
+	58, 18672, -1000, -1000, -57, -75, -1000, 11108, 19354, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 796, 989, 998,
+	1005, 1004, -1000, 592, 999, -1000, 9708, 139, 139, 18331,
+	8308, -1000, -1000, 559, 19354, 182, 19354, -119, 132, 132,
+	132, 185, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 935, 770, -1000,
-	-1000, -1000, -1000, -1000, -1000, 358, 12119, 14, 109, 28,
-	16968, 107, 1731, 17313, -1000, 15, -1000, 6, 17313, 10,
-	16623, -1000, -1000, -60, -61, -1000, 10049, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 744, 927, 900, 950, 532,
-	1086, -1000, 8657, 82, 82, 16278, 7277, -1000, -1000, 422,
-	17313, 101, 17313, -133, 79, 79, 79, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2685,25 +2914,25 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 184, 19354, 639, 639, 262, -1000,
+	19354, 130, 179, 639, 130, 130, 130, 19354, -1000, 230,
+	-1000, -1000, -1000, -1000, 19354, 639, 947, 362, 105, 5041,
+	-1000, 240, -1000, 5041, 77, 75, -18, 1024, 70, -16,
+	-1000, 5041, -1000, -1000, -1000, -1000, -1000, -1000, 159, -1000,
+	-1000, 16950, 16609, 129, 350, -1000, -1000, -1000, -1000, -1000,
+	-1000, 560, 346, -1000, 11108, 1910, 782, 782, -1000, -1000,
+	212, -1000, -1000, 12131, 12131, 12131, 12131, 12131, 12131, 12131,
+	12131, 12131, 12131, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 782, 229, -1000,
+	10758, 782, 782, 782, 782, 782, 782, 782, 782, 11108,
+	782, 782, 782, 782, 782, 782, 782, 782, 782, 782,
+	782, 782, 782, 782, 782, 782, -1000, -1000, 775, -1000,
+	824, 1004, -1000, 146, -1000, -1000, 941, 11108, 11108, 998,
+	912, 1004, -1000, 904, 9708, -1000, -1000, 912, -1000, -1000,
+	-1000, -1000, 393, 1042, -1000, 12822, 225, 17974, 17291, 19354,
+	838, 834, -1000, -1000, 224, 767, 7945, -72, -1000, -1000,
+	-1000, 333, 14545, -1000, -1000, -1000, 945, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 106, 17313,
-	498, 498, 184, -1000, 17313, 77, 498, 77, 77, 77,
-	17313, -1000, 180, -1000, -1000, -1000, 17313, 498, 840, 390,
-	70, 4771, -1000, 181, -1000, 4771, 22, 4771, -59, 959,
-	23, -14, -1000, 4771, -1000, -1000, -1000, -1000, -1000, -1000,
-	87, -1000, -1000, 17313, 15926, 147, 300, -1000, -1000, -1000,
-	-1000, -1000, -1000, 564, 329, -1000, 10049, 1734, 679, 679,
-	-1000, -1000, 144, -1000, -1000, 11084, 11084, 11084, 11084, 11084,
-	11084, 11084, 11084, 11084, 11084, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 679,
-	170, -1000, 9704, 679, 679, 679, 679, 679, 679, 679,
-	679, 10049, 679, 679, 679, 679, 679, 679, 679, 679,
-	679, 679, 679, 679, 679, 679, 679, 679, -1000, -1000,
-	935, -1000, 770, -1000, -1000, -1000, 848, 10049, 10049, 935,
-	-1000, 816, 8657, -1000, -1000, 1035, -1000, -1000, -1000, -1000,
-	353, 977, -1000, 11774, 165, 15581, 14546, 17313, 728, 689,
-	-1000, -1000, 164, 714, 6919, -96, -1000, -1000, -1000, 281,
-	13856, -1000, -1000, -1000, 839, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2715,297 +2944,313 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 640, 17313, -1000, 166, -1000,
-	498, 4771, 89, 498, 315, 498, 17313, 17313, 4771, 4771,
-	4771, 30, 64, 58, 17313, 713, 86, 17313, 890, 759,
-	17313, 498, 498, -1000, 6203, -1000, 4771, 390, -1000, 458,
-	10049, 4771, 4771, 4771, 17313, 4771, 4771, -1000, -1000, -1000,
-	299, -1000, -1000, -1000, -1000, 4771, 4771, -1000, 976, 291,
-	-1000, -1000, -1000, -1000, 10049, 210, -1000, 758, -1000, 9,
-	-1000, -1000, -1000, -1000, -1000, 999, -1000, -1000, -1000, -119,
-	-1000, -1000, 10049, 10049, 10049, 10049, 452, 221, 11084, 467,
-	347, 11084, 11084, 11084, 11084, 11084, 11084, 11084, 11084, 11084,
-	11084, 11084, 11084, 11084, 11084, 11084, 577, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 498, -1000, 996, 644, 644,
-	156, 156, 156, 156, 156, 156, 156, 156, 156, 11429,
-	7622, 6203, 532, 635, 935, 8657, 8657, 10049, 10049, 9347,
-	9002, 8657, 842, 307, 329, 17313, -1000, -1000, 10739, -1000,
-	-1000, -1000, -1000, -1000, 432, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 17313, 17313, 8657, 8657, 8657, 8657, 8657, 900,
-	532, 1035, -1000, 984, 175, 606, 703, -1000, 424, 900,
-	13511, 733, -1000, 1035, -1000, -1000, -1000, 17313, -1000, -1000,
-	15236, -1000, -1000, 5845, 44, 17313, -1000, 685, 934, -1000,
-	-1000, -1000, 892, 12821, 13166, 44, 663, 14546, 17313, -1000,
-	-1000, 14546, 17313, 5487, 6561, -96, -1000, 607, -1000, -100,
-	-81, 7967, 128, -1000, -1000, -1000, -1000, 4413, 391, 504,
-	435, -53, -1000, -1000, -1000, 718, -1000, 718, 718, 718,
-	718, -21, -21, -21, -21, -1000, -1000, -1000, -1000, -1000,
-	740, 739, -1000, 718, 718, 718, -1000, -1000, -1000, -1000,
+	773, 19354, -1000, 348, -1000, 639, 5041, 164, 639, 373,
+	639, 19354, 132, 19354, 5041, 5041, 5041, 86, 119, 109,
+	19354, 762, 155, 19354, 982, 130, 853, 19354, 639, 639,
+	-1000, 7582, -1000, 5041, 362, -1000, 511, 11108, 5041, 5041,
+	5041, 19354, 5041, 5041, -1000, -1000, -1000, 380, -1000, -1000,
+	-1000, -1000, 5041, 5041, 378, 1035, 378, -1000, -1000, -1000,
+	-1000, 11108, 271, -1000, 850, -1000, 55, -1000, -1000, -1000,
+	-1000, -1000, 1060, -1000, -1000, -1000, -115, -1000, -1000, 11108,
+	11108, 11108, 11108, 395, 275, 12131, 521, 321, 12131, 12131,
+	12131, 12131, 12131, 12131, 12131, 12131, 12131, 12131, 12131, 12131,
+	12131, 12131, 12131, 645, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 639, -1000, 125, 901, 901, 239, 239, 239,
+	239, 239, 239, 239, 239, 239, 12472, 8658, 6856, 592,
+	764, 1004, 1045, 19354, 9708, 9708, 11108, 11108, 10408, 10058,
+	9708, 927, 343, 346, 16950, -1000, -1000, 11790, -1000, -1000,
+	-1000, -1000, -1000, 529, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 16950, 16950, 9708, 9708, 9708, 9708, 9708, 19354, 782,
+	16950, 998, 592, -1000, 1055, 261, 749, 752, -1000, 571,
+	941, -1000, -1000, 998, 14204, 755, -1000, 912, -1000, 19354,
+	-1000, -1000, 16268, -1000, -1000, 6130, 101, 19354, -1000, 550,
+	1140, -1000, -1000, -1000, 986, 13863, 15927, 101, 621, 17291,
+	19354, -1000, -1000, 17291, 19354, 5767, 7219, -72, -1000, 720,
+	-1000, -97, -77, 9008, 226, -1000, -1000, -1000, -1000, 4678,
+	319, 582, 422, -51, -1000, -1000, -1000, 791, -1000, 791,
+	791, 791, 791, -14, -14, -14, -14, -1000, -1000, -1000,
+	-1000, -1000, 827, 823, -1000, 791, 791, 791, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 738, 738, 738, 723, 723, 742,
-	-1000, 17313, 4771, 888, 4771, -1000, 427, -1000, -1000, -1000,
-	17313, 17313, 17313, 17313, 17313, 136, 17313, 17313, 695, -1000,
-	17313, 4771, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	329, -1000, -1000, -1000, -1000, -1000, -1000, 17313, -1000, -1000,
-	-1000, -1000, 17313, 390, 17313, 17313, 329, -1000, 453, 17313,
-	17313, -1000, -1000, -1000, -1000, -1000, 329, 221, 342, 232,
-	-1000, -1000, 420, -1000, -1000, 921, -1000, -1000, -1000, -1000,
-	467, 11084, 11084, 11084, 149, 921, 2098, 1479, 1201, 156,
-	352, 352, 231, 231, 231, 231, 231, 437, 437, -1000,
-	-1000, -1000, 432, -1000, -1000, -1000, 432, 8657, 8657, 682,
-	679, 163, -1000, 744, -1000, -1000, 900, 630, 630, 584,
-	576, 283, 967, 630, 278, 965, 630, 630, 8657, -1000,
-	-1000, 406, -1000, 10049, 432, -1000, 159, -1000, 799, 664,
-	637, 630, 432, 432, 630, 630, 848, -1000, -1000, 800,
-	10049, 10049, 10049, -1000, -1000, -1000, 848, 953, -1000, 823,
-	820, 958, 8657, 14546, 1035, -1000, -1000, -1000, 154, 712,
-	679, -1000, 17313, 14546, 14546, 14546, 14546, 14546, -1000, 784,
-	778, -1000, 772, 771, 783, 17313, -1000, 633, 532, 12821,
-	158, 679, -1000, 14891, -1000, -1000, 958, 14546, 553, -1000,
-	553, -1000, 143, -1000, -1000, 607, -96, -84, -1000, -1000,
-	-1000, -1000, 329, -1000, 587, 598, 4055, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 732, 498, -1000, 860, 197, 258,
-	498, 859, -1000, -1000, -1000, 849, -1000, 325, -55, -1000,
-	-1000, 385, -21, -21, -1000, -1000, 128, 832, 128, 128,
-	128, 443, 443, -1000, -1000, -1000, -1000, 381, -1000, -1000,
-	-1000, 376, -1000, 757, 17313, 4771, -1000, -1000, -1000, -1000,
-	373, 373, 204, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 41, 578, -1000, -1000, -1000, -1000,
-	11, 27, 84, -1000, 4771, -1000, 291, 291, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 149, 921,
-	2066, -1000, 11084, 11084, -1000, -1000, 630, 630, 8657, 6203,
-	935, 848, -1000, -1000, 105, 577, 105, 11084, 11084, -1000,
-	11084, 11084, -1000, -145, 569, 259, -1000, 10049, 495, -1000,
-	6203, -1000, 11084, 11084, -1000, -1000, -1000, -1000, -1000, -1000,
-	812, 329, 329, -1000, -1000, 17313, -1000, -1000, -1000, -1000,
-	956, 10049, -1000, 594, -1000, 5129, 752, 17313, 679, 999,
-	12821, 17313, 691, -1000, 266, 934, 737, 751, 678, -1000,
-	-1000, -1000, -1000, 773, -1000, 754, -1000, -1000, -1000, -1000,
-	-1000, 532, -1000, 97, 96, 95, 17313, -1000, 935, 553,
-	-1000, -1000, 162, -1000, -1000, -110, -87, -1000, -1000, -1000,
-	4413, -1000, 4413, 17313, 60, -1000, 498, 498, -1000, -1000,
-	-1000, 724, 750, 11084, -1000, -1000, -1000, 497, 128, 128,
-	-1000, 366, -1000, -1000, -1000, 628, -1000, 623, 571, 619,
-	17313, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 812, 812, 812, 795,
+	795, 835, -1000, 19354, 5041, 980, 5041, -1000, 19354, 118,
+	-1000, -1000, -1000, 19354, 19354, 19354, 19354, 19354, 200, 19354,
+	19354, 701, -1000, 19354, 19354, 5041, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 346, -1000, -1000, -1000, -1000,
+	-1000, -1000, 19354, -1000, -1000, -1000, -1000, 362, 19354, 19354,
+	19354, 362, 346, -1000, 510, 19354, 16950, -1000, -1000, -1000,
+	-1000, -1000, 346, 275, 354, 341, -1000, -1000, 414, -1000,
+	-1000, 2182, -1000, -1000, -1000, -1000, 521, 12131, 12131, 12131,
+	415, 2182, 2167, 880, 1826, 239, 399, 399, 256, 256,
+	256, 256, 256, 643, 643, -1000, -1000, -1000, 529, -1000,
+	-1000, -1000, 529, 9708, 9708, 750, 782, 223, -1000, -1000,
+	796, -1000, -1000, 998, 1004, 758, 758, 549, 581, 325,
+	1028, 758, 318, 1026, 758, 758, 9708, -1000, -1000, 366,
+	-1000, 11108, 529, -1000, 218, -1000, 530, 734, 730, 758,
+	529, 529, 758, 758, -1000, -1000, 146, 685, -1000, 941,
+	-1000, -1000, 892, 11108, 11108, 11108, -1000, -1000, -1000, -1000,
+	941, 1003, -1000, 915, 910, 1023, 9708, 17291, 912, -1000,
+	-1000, -1000, 217, 124, 782, -1000, 16950, 17291, 17291, 17291,
+	17291, 17291, -1000, 876, 871, -1000, 868, 867, 878, 19354,
+	-1000, 760, 592, 13863, 238, 782, -1000, 17632, -1000, -1000,
+	1023, 17291, 661, -1000, 661, -1000, 215, -1000, -1000, 720,
+	-72, -102, -1000, -1000, -1000, -1000, 346, -1000, 676, 713,
+	4315, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 801, 639,
+	-1000, 971, 266, 270, 639, 964, -1000, -1000, -1000, 928,
+	-1000, 382, -69, -1000, -1000, 446, -14, -14, -1000, -1000,
+	226, 922, 226, 226, 226, 488, 488, -1000, -1000, -1000,
+	-1000, 445, -1000, -1000, -1000, 436, -1000, 849, 16950, 5041,
+	-1000, -1000, -1000, -1000, -1000, 555, 555, 280, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 98,
+	829, -1000, -1000, -1000, -1000, 48, 81, 152, -1000, 701,
+	5041, -1000, 378, -1000, -1000, -1000, 378, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 415, 2182, 1668, -1000, 12131,
+	12131, -1000, -254, 758, 758, 9708, 6493, 1004, 941, 998,
+	-1000, -1000, 114, 645, 114, 12131, 12131, -1000, 12131, 12131,
+	-1000, -132, 654, 326, -1000, 11108, 593, -1000, 6856, -1000,
+	12131, 12131, -1000, -1000, -1000, -1000, -1000, 985, 16950, -1000,
+	890, 346, 346, -1000, -1000, 19354, -1000, -1000, -1000, -1000,
+	1011, 11108, -1000, 696, -1000, 5404, 848, 16950, 782, 1060,
+	13513, 16950, 717, -1000, 316, 1140, 817, 847, 1115, -1000,
+	-1000, -1000, -1000, 865, -1000, 864, -1000, -1000, -1000, -1000,
+	-1000, 592, -1000, 174, 173, 171, 16950, -1000, 1004, 661,
+	-1000, -1000, 248, -1000, -1000, -101, -82, -1000, -1000, -1000,
+	4678, -1000, 4678, 16950, 116, -1000, 639, 639, -1000, -1000,
+	-1000, 799, 843, 12131, -1000, -1000, -1000, 566, 226, 226,
+	-1000, 295, -1000, -1000, -1000, 746, -1000, 740, 677, 737,
+	19354, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	17313, -1000, -1000, -1000, -1000, -1000, 17313, -155, 498, 17313,
-	17313, 17313, 17313, -1000, 390, 390, -1000, 11084, 921, 921,
-	-1000, -1000, 432, -1000, 900, -1000, 432, 718, 718, -1000,
-	718, 723, -1000, 718, -1, 718, -2, 432, 432, 1906,
-	1878, 1836, 1659, 679, -140, -1000, 329, 10049, -1000, 852,
-	836, -1000, -1000, 954, 918, 329, -1000, -1000, 863, 521,
-	528, -1000, -1000, 8312, 617, 127, 615, -1000, 935, 17313,
-	10049, -1000, -1000, 10049, 720, -1000, 10049, -1000, -1000, -1000,
-	935, 679, 679, 679, 615, 900, -1000, -1000, -1000, -1000,
-	4055, -1000, 611, -1000, 718, -1000, -1000, -1000, 17313, -44,
-	983, 921, -1000, -1000, -1000, -1000, -1000, -21, 440, -21,
-	375, -1000, 371, 4771, -1000, -1000, -1000, -1000, 869, -1000,
-	6203, -1000, -1000, 717, 729, -1000, -1000, -1000, -1000, 921,
-	-1000, 848, -1000, -1000, 121, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 11084, 11084, 11084, 11084, 11084, 900, 413,
-	329, 11084, 11084, -1000, 10049, 10049, 858, -1000, 679, -1000,
-	731, 17313, 17313, -1000, 17313, 900, -1000, 329, 329, 17313,
-	329, 14201, 17313, 17313, 12464, -1000, 153, 17313, -1000, 609,
-	-1000, 195, -1000, -118, 128, -1000, 128, 476, 474, -1000,
-	679, 567, -1000, 254, 17313, 17313, -1000, -1000, -1000, 799,
-	799, 799, 799, 3, 432, -1000, 799, 799, 329, 564,
-	982, -1000, 679, 999, 125, -1000, -1000, -1000, 600, 585,
-	-1000, 585, 585, 158, 153, -1000, 498, 246, 400, -1000,
-	56, 17313, 332, 857, -1000, 843, -1000, -1000, -1000, -1000,
-	-1000, 39, 6203, 4413, 544, -1000, -1000, -1000, -1000, -1000,
-	432, 94, -158, -1000, -1000, -1000, 17313, 528, 17313, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 367, -1000, -1000, 17313,
-	-1000, -1000, 399, -1000, -1000, 541, -1000, 17313, -1000, -1000,
-	578, -1000, 798, -153, -162, 496, -1000, -1000, 716, -1000,
-	-1000, 39, 819, -155, -1000, 793, -1000, 17313, -1000, 36,
-	-1000, -156, 490, 34, -159, 749, 679, -179, 748, -1000,
-	964, 10394, -1000, -1000, 980, 177, 177, 799, 432, -1000,
-	-1000, -1000, 65, 426, -1000, -1000, -1000, -1000, -1000, -1000,
+	19354, -1000, -1000, -1000, -1000, -1000, 16950, -142, 639, 16950,
+	16950, 16950, 19354, -1000, 362, 362, -1000, 12131, 2182, 2182,
+	-1000, 15577, -1000, -1000, 529, -1000, 998, -1000, 941, 529,
+	791, 791, -1000, 791, 795, -1000, 791, 46, 791, 35,
+	529, 529, 2042, 1963, 1946, 1870, 782, -127, -1000, 346,
+	11108, -1000, 1698, 1630, 782, -1000, -1000, -1000, 1009, 1001,
+	346, -1000, -1000, 974, 607, 599, -1000, -1000, 9358, 715,
+	206, 711, -1000, 1004, 16950, 11108, -1000, -1000, 11108, 793,
+	-1000, 11108, -1000, -1000, -1000, 1004, 782, 782, 782, 711,
+	998, -1000, -1000, -1000, -1000, 4315, -1000, 706, -1000, 791,
+	-1000, -1000, -1000, 16950, -37, 1054, 2182, -1000, -1000, -1000,
+	-1000, -1000, -14, 486, -14, 432, -1000, 408, 5041, -1000,
+	-1000, -1000, -1000, 976, -1000, 6493, -1000, -1000, 784, 833,
+	-1000, -1000, -1000, -1000, 2182, -1000, 419, -1000, 941, -1000,
+	-1000, -1000, 169, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 12131, 12131, 12131, 12131, 12131, 998, 473, 346, 12131,
+	12131, -1000, -246, 11108, 11108, 963, -1000, 782, -1000, 178,
+	16950, 16950, -1000, 16950, 998, -1000, 346, 346, 16950, 346,
+	15236, 16950, 16950, 14895, -1000, 219, 16950, -1000, 703, 257,
+	-1000, -50, 226, -1000, 226, 542, 534, -1000, 782, 664,
+	-1000, 311, 16950, 19354, 529, 97, -1000, -1000, -1000, -1000,
+	530, 530, 530, 530, 92, 529, -1000, 530, 530, -1000,
+	16950, 346, 560, 1048, -1000, 782, 1060, 204, -1000, -1000,
+	-1000, 699, 685, -1000, 685, 685, 238, 219, -1000, 639,
+	305, 462, -1000, 113, 389, 951, -1000, 950, -1000, -1000,
+	-1000, -1000, -1000, 95, 6493, 4678, 666, -1000, -1000, 1004,
+	1000, -1000, -1000, -1000, -1000, 529, 88, -148, -1000, -1000,
+	-1000, 609, -1000, 984, 16950, 599, 16950, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 407, -1000, -1000, 19354, -1000, 400,
+	-1000, -1000, 652, -1000, 16950, -1000, -1000, 829, -240, 11108,
+	-1000, 889, -139, -152, 16950, 782, 595, -1000, -1000, 783,
+	-1000, -1000, 95, 903, -142, -1000, 45, -1000, -1000, 560,
+	-1000, 881, -1000, -1000, 419, 16950, -1000, 94, -1000, -1000,
+	49, -260, -248, -255, -1000, -1000, 12131, -144, 529, 588,
+	91, 363, -1000, -1000, -1000, -1000, -1000, 12472, -149, -1000,
+	842, 782, 49, -1000, -153, 840, -1000, 1040, 11449, -1000,
+	-1000, -1000, 1047, 237, 237, 530, 529, -1000, -1000, -1000,
+	120, 500, -1000, -1000, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1248, 1247, 29, 67, 69, 1246, 1242, 1241, 97,
-	96, 95, 1240, 1239, 1236, 1235, 1232, 1230, 1228, 1227,
-	1226, 1225, 1224, 1223, 1222, 1220, 1219, 1218, 1216, 1215,
-	1214, 1213, 91, 1212, 85, 1211, 1210, 1209, 1206, 1204,
-	1203, 1201, 1200, 48, 174, 35, 61, 1198, 60, 1631,
-	1197, 57, 68, 65, 1193, 45, 1190, 1189, 82, 1187,
-	1185, 59, 1184, 1182, 2376, 1175, 73, 1173, 11, 36,
-	1172, 1171, 1168, 1167, 87, 276, 1166, 1164, 14, 1163,
-	1161, 100, 1160, 62, 31, 13, 32, 24, 1159, 66,
-	1158, 6, 1157, 64, 1156, 1155, 1151, 1150, 47, 1149,
-	63, 1148, 40, 23, 1147, 12, 90, 28, 19, 7,
-	1144, 1143, 16, 84, 56, 72, 1142, 1141, 530, 1139,
-	1138, 52, 1137, 1136, 1134, 42, 1133, 114, 410, 1131,
-	1122, 1118, 1117, 38, 1043, 1812, 22, 79, 1116, 1114,
-	1113, 2599, 46, 58, 17, 1112, 1111, 1110, 18, 39,
-	50, 1109, 1106, 34, 1101, 1100, 1099, 1098, 1091, 1090,
-	1089, 20, 1088, 1082, 1074, 89, 26, 1073, 1071, 70,
-	21, 1069, 1068, 1067, 54, 78, 1066, 1058, 55, 1054,
-	1052, 25, 1049, 1048, 1042, 1040, 1038, 27, 10, 1033,
-	15, 1032, 9, 1017, 33, 1015, 4, 1013, 8, 1012,
-	3, 0, 1011, 5, 53, 1, 1009, 2, 1008, 1007,
-	1360, 1135, 86, 1005, 92,
+	0, 1341, 1340, 30, 94, 72, 106, 1338, 82, 1337,
+	4, 1336, 3, 8, 1335, 1334, 1328, 1326, 1325, 1324,
+	1323, 1322, 1320, 124, 121, 120, 1319, 1318, 1317, 1316,
+	1313, 1312, 1311, 1307, 1305, 1304, 1303, 1302, 1300, 1298,
+	1296, 1294, 1291, 1290, 1287, 1285, 1026, 1284, 89, 1283,
+	1282, 1281, 1280, 1276, 1275, 1268, 1267, 47, 177, 58,
+	66, 1266, 56, 2075, 1264, 63, 65, 75, 1263, 40,
+	1262, 1261, 69, 1259, 1258, 62, 1254, 1253, 70, 1251,
+	93, 1249, 17, 39, 1248, 1247, 1246, 1244, 96, 1370,
+	1243, 1238, 14, 1236, 1235, 107, 1230, 73, 10, 18,
+	23, 27, 1229, 77, 32, 11, 1228, 67, 1227, 1215,
+	1212, 1211, 29, 1208, 64, 1206, 61, 86, 1205, 7,
+	83, 36, 28, 12, 1204, 1200, 22, 76, 49, 74,
+	1198, 1197, 606, 1196, 1195, 103, 1190, 1183, 1182, 51,
+	1179, 90, 92, 1178, 1174, 1172, 1165, 43, 1088, 1887,
+	24, 79, 1158, 1157, 1156, 2811, 46, 55, 25, 1155,
+	1153, 1152, 34, 104, 53, 1150, 1149, 48, 1146, 1145,
+	1142, 1141, 1139, 1137, 1136, 185, 1135, 1134, 1129, 19,
+	20, 1128, 1127, 71, 26, 1118, 1114, 1113, 41, 78,
+	1112, 1111, 60, 1109, 1108, 37, 1106, 1102, 1100, 1098,
+	1091, 35, 16, 1089, 21, 1082, 13, 1079, 33, 1078,
+	6, 1077, 15, 1076, 5, 0, 1073, 9, 54, 2,
+	1072, 1, 1068, 1067, 1789, 227, 95, 1066, 97,
 }
 var yyR1 = [...]int{
 
-	0, 208, 209, 209, 1, 1, 1, 1, 1, 1,
+	0, 222, 223, 223, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 201,
-	201, 201, 20, 3, 3, 3, 3, 2, 2, 8,
-	4, 5, 5, 9, 9, 35, 35, 10, 11, 11,
-	11, 11, 212, 212, 58, 58, 59, 59, 106, 106,
-	12, 13, 13, 115, 115, 114, 114, 114, 116, 116,
-	116, 116, 151, 151, 14, 14, 14, 14, 14, 14,
-	14, 203, 203, 202, 200, 200, 199, 199, 198, 21,
-	183, 185, 185, 184, 184, 184, 184, 175, 154, 154,
-	154, 154, 157, 157, 155, 155, 155, 155, 155, 155,
-	155, 155, 155, 156, 156, 156, 156, 156, 158, 158,
-	158, 158, 158, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 160, 160,
-	160, 160, 160, 160, 160, 160, 174, 174, 161, 161,
-	169, 169, 170, 170, 170, 167, 167, 168, 168, 171,
-	171, 171, 163, 163, 164, 164, 172, 172, 165, 165,
-	165, 166, 166, 166, 173, 173, 173, 173, 173, 162,
-	162, 176, 176, 193, 193, 192, 192, 192, 182, 182,
-	189, 189, 189, 189, 189, 179, 179, 179, 180, 180,
-	178, 178, 181, 181, 191, 191, 190, 177, 177, 194,
-	194, 194, 194, 206, 207, 205, 205, 205, 205, 205,
-	186, 186, 186, 187, 187, 187, 188, 188, 188, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 204, 204, 204, 204,
-	204, 204, 204, 204, 204, 204, 204, 204, 204, 204,
-	197, 195, 195, 196, 196, 16, 22, 22, 17, 17,
-	17, 17, 17, 18, 18, 23, 24, 24, 24, 24,
-	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
-	24, 24, 24, 122, 122, 124, 124, 120, 120, 123,
-	123, 121, 121, 121, 125, 125, 125, 126, 126, 152,
-	152, 152, 25, 25, 27, 27, 28, 29, 29, 146,
-	146, 147, 147, 30, 31, 36, 36, 36, 36, 36,
-	36, 38, 38, 38, 7, 7, 7, 7, 37, 37,
-	37, 6, 6, 26, 26, 26, 26, 19, 213, 32,
-	33, 33, 34, 34, 34, 40, 40, 40, 39, 39,
-	39, 45, 45, 47, 47, 47, 47, 47, 48, 48,
-	48, 48, 48, 48, 44, 44, 46, 46, 46, 46,
-	138, 138, 138, 137, 137, 50, 50, 51, 51, 52,
-	52, 53, 53, 53, 90, 67, 67, 105, 105, 107,
-	107, 54, 54, 54, 54, 55, 55, 56, 56, 57,
-	57, 145, 145, 144, 144, 144, 143, 143, 60, 60,
-	60, 62, 61, 61, 61, 61, 63, 63, 65, 65,
-	64, 64, 66, 68, 68, 68, 68, 68, 69, 69,
-	49, 49, 49, 49, 49, 49, 49, 49, 119, 119,
-	71, 71, 70, 70, 70, 70, 70, 70, 70, 70,
-	70, 70, 82, 82, 82, 82, 82, 82, 72, 72,
-	72, 72, 72, 72, 72, 43, 43, 83, 83, 83,
-	89, 84, 84, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	75, 75, 75, 75, 75, 75, 75, 79, 79, 79,
-	79, 77, 77, 77, 77, 77, 77, 77, 77, 77,
-	77, 77, 77, 77, 78, 78, 78, 78, 78, 78,
-	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
-	214, 214, 81, 80, 80, 80, 80, 80, 80, 80,
-	41, 41, 41, 41, 41, 150, 150, 153, 153, 153,
-	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
-	94, 94, 42, 42, 92, 92, 93, 95, 95, 91,
-	91, 91, 74, 74, 74, 74, 74, 74, 74, 74,
-	76, 76, 76, 96, 96, 97, 97, 98, 98, 99,
-	99, 100, 101, 101, 101, 102, 102, 102, 102, 103,
-	103, 103, 73, 73, 73, 73, 104, 104, 104, 104,
-	108, 108, 85, 85, 87, 87, 86, 88, 109, 109,
-	112, 110, 110, 110, 113, 113, 113, 113, 111, 111,
-	111, 140, 140, 140, 117, 117, 127, 127, 128, 128,
-	118, 118, 129, 129, 129, 129, 129, 129, 129, 129,
-	129, 129, 130, 130, 130, 131, 131, 132, 132, 132,
-	139, 139, 135, 135, 136, 136, 141, 141, 142, 142,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 210, 211, 148, 149, 149, 149,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 215,
+	215, 215, 34, 3, 3, 3, 3, 3, 2, 2,
+	2, 6, 7, 7, 8, 8, 22, 4, 5, 5,
+	23, 23, 49, 49, 24, 25, 25, 25, 25, 226,
+	226, 72, 72, 73, 73, 120, 120, 26, 27, 27,
+	129, 129, 128, 128, 128, 130, 130, 130, 130, 165,
+	165, 28, 28, 28, 28, 28, 28, 28, 217, 217,
+	216, 214, 214, 213, 213, 212, 35, 35, 197, 199,
+	199, 198, 198, 198, 198, 189, 168, 168, 168, 168,
+	171, 171, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 170, 170, 170, 170, 170, 172, 172, 172, 172,
+	172, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	173, 173, 173, 173, 173, 173, 174, 174, 174, 174,
+	174, 174, 174, 174, 188, 188, 175, 175, 183, 183,
+	184, 184, 184, 181, 181, 182, 182, 185, 185, 185,
+	177, 177, 178, 178, 186, 186, 179, 179, 179, 180,
+	180, 180, 187, 187, 187, 187, 187, 176, 176, 190,
+	190, 207, 207, 206, 206, 206, 196, 196, 203, 203,
+	203, 203, 203, 193, 193, 193, 194, 194, 192, 192,
+	195, 195, 205, 205, 204, 191, 191, 208, 208, 208,
+	208, 220, 221, 219, 219, 219, 219, 219, 200, 200,
+	200, 201, 201, 201, 202, 202, 202, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
+	29, 29, 29, 29, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 218, 211, 209,
+	209, 210, 210, 30, 36, 36, 31, 31, 31, 31,
+	31, 31, 32, 32, 37, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	38, 38, 136, 136, 138, 138, 134, 134, 137, 137,
+	135, 135, 135, 139, 139, 139, 140, 140, 166, 166,
+	166, 39, 39, 41, 41, 42, 43, 43, 160, 160,
+	161, 161, 44, 45, 50, 50, 50, 50, 50, 50,
+	52, 52, 52, 21, 21, 21, 21, 51, 51, 51,
+	20, 20, 40, 40, 40, 40, 33, 227, 46, 47,
+	47, 48, 48, 48, 54, 54, 54, 53, 53, 53,
+	59, 59, 61, 61, 61, 61, 61, 62, 62, 62,
+	62, 62, 62, 58, 58, 60, 60, 60, 60, 152,
+	152, 152, 151, 151, 64, 64, 65, 65, 66, 66,
+	67, 67, 67, 104, 81, 81, 119, 119, 121, 121,
+	68, 68, 68, 68, 69, 69, 70, 70, 71, 71,
+	159, 159, 158, 158, 158, 157, 157, 74, 74, 74,
+	76, 75, 75, 75, 75, 77, 77, 79, 79, 78,
+	78, 80, 82, 82, 82, 82, 82, 83, 83, 63,
+	63, 63, 63, 63, 63, 63, 63, 133, 133, 85,
+	85, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 96, 96, 96, 96, 96, 96, 86, 86, 86,
+	86, 86, 86, 86, 57, 57, 97, 97, 97, 103,
+	98, 98, 89, 89, 89, 89, 89, 89, 89, 89,
+	89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+	89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+	89, 89, 89, 89, 89, 89, 93, 93, 93, 93,
+	9, 9, 9, 10, 16, 16, 17, 17, 11, 11,
+	11, 18, 18, 12, 12, 12, 12, 12, 19, 19,
+	19, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	228, 228, 95, 94, 94, 94, 94, 94, 94, 94,
+	55, 55, 55, 55, 55, 164, 164, 167, 167, 167,
+	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
+	108, 108, 56, 56, 106, 106, 107, 109, 109, 105,
+	105, 105, 88, 88, 88, 88, 88, 88, 88, 88,
+	90, 90, 90, 110, 110, 14, 14, 15, 15, 13,
+	111, 111, 112, 112, 113, 113, 114, 115, 115, 115,
+	116, 116, 116, 116, 117, 117, 117, 87, 87, 87,
+	87, 118, 118, 118, 118, 122, 122, 99, 99, 101,
+	101, 100, 102, 123, 123, 126, 124, 124, 124, 127,
+	127, 127, 127, 125, 125, 125, 154, 154, 154, 131,
+	131, 141, 141, 142, 142, 132, 132, 143, 143, 143,
+	143, 143, 143, 143, 143, 143, 143, 144, 144, 144,
+	145, 145, 146, 146, 146, 153, 153, 149, 149, 149,
+	150, 150, 155, 155, 156, 156, 156, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 224, 225, 162, 163, 163,
+	163,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 1, 2, 4, 6, 6, 7, 4, 6, 5,
-	8, 1, 3, 7, 8, 1, 1, 9, 8, 7,
-	6, 6, 1, 1, 1, 3, 1, 3, 0, 4,
-	3, 5, 4, 1, 3, 3, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 2, 8, 4, 6, 5,
-	5, 0, 2, 1, 0, 2, 1, 3, 3, 4,
-	4, 2, 4, 1, 3, 3, 3, 8, 3, 1,
-	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 2, 2, 2, 1, 2,
-	2, 2, 1, 4, 4, 2, 2, 3, 3, 3,
-	3, 1, 1, 1, 1, 1, 6, 6, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 0, 3,
-	0, 5, 0, 3, 5, 0, 1, 0, 1, 0,
-	1, 2, 0, 2, 0, 3, 0, 1, 0, 3,
-	3, 0, 2, 2, 0, 2, 1, 2, 1, 0,
-	2, 5, 4, 1, 2, 2, 3, 2, 0, 1,
-	2, 3, 3, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 3, 2, 3, 1, 10,
-	11, 11, 12, 3, 3, 1, 1, 2, 2, 2,
-	0, 1, 3, 1, 2, 3, 1, 1, 1, 6,
-	7, 7, 7, 7, 4, 5, 4, 4, 7, 5,
-	5, 5, 12, 7, 5, 9, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	7, 1, 3, 8, 8, 3, 3, 5, 4, 6,
-	5, 4, 4, 3, 2, 3, 4, 4, 3, 4,
-	4, 4, 4, 4, 4, 3, 2, 7, 2, 3,
-	4, 3, 7, 5, 4, 2, 4, 4, 3, 3,
-	5, 2, 3, 1, 1, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
-	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
-	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 3, 3, 2, 2, 3, 3, 2, 0, 2,
-	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
-	1, 0, 1, 0, 1, 2, 3, 4, 1, 1,
-	1, 1, 1, 1, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
-	1, 1, 3, 3, 3, 3, 7, 1, 3, 1,
-	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
-	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
-	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
-	1, 3, 3, 0, 5, 4, 5, 5, 0, 2,
-	1, 3, 3, 3, 2, 3, 1, 2, 0, 3,
-	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
-	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
-	3, 1, 3, 1, 1, 1, 1, 1, 3, 3,
+	1, 1, 2, 4, 5, 6, 6, 7, 4, 5,
+	6, 2, 1, 3, 3, 6, 5, 9, 1, 3,
+	7, 8, 1, 1, 9, 8, 7, 6, 6, 1,
+	1, 1, 3, 1, 3, 0, 4, 3, 5, 4,
+	1, 3, 3, 2, 2, 2, 2, 2, 1, 1,
+	1, 2, 2, 8, 4, 6, 5, 5, 0, 2,
+	1, 0, 2, 1, 3, 3, 4, 5, 4, 2,
+	4, 1, 3, 3, 3, 8, 3, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
+	1, 4, 4, 2, 2, 3, 3, 3, 3, 1,
+	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
+	0, 3, 5, 0, 1, 0, 1, 0, 1, 2,
+	0, 2, 0, 3, 0, 1, 0, 3, 3, 0,
+	2, 2, 0, 2, 1, 2, 1, 0, 2, 5,
+	4, 1, 2, 2, 3, 2, 0, 1, 2, 3,
+	3, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 3, 2, 3, 1, 10, 11, 11,
+	12, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	3, 1, 2, 3, 1, 1, 1, 6, 7, 7,
+	7, 7, 4, 5, 4, 4, 7, 5, 5, 5,
+	12, 7, 5, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 7, 1,
+	3, 8, 8, 3, 3, 5, 4, 5, 6, 5,
+	4, 4, 3, 2, 3, 4, 4, 3, 4, 4,
+	4, 4, 4, 4, 3, 2, 7, 2, 3, 4,
+	5, 7, 5, 4, 2, 4, 4, 3, 3, 5,
+	2, 3, 1, 1, 0, 1, 0, 1, 1, 1,
+	0, 2, 2, 0, 2, 2, 0, 2, 0, 1,
+	1, 2, 1, 1, 2, 1, 1, 5, 0, 1,
+	0, 1, 2, 3, 0, 3, 3, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
+	3, 3, 2, 2, 3, 3, 2, 0, 2, 0,
+	2, 1, 2, 2, 0, 1, 1, 0, 1, 1,
+	0, 1, 0, 1, 2, 3, 4, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 2, 3, 5, 0,
+	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
+	1, 3, 3, 3, 3, 7, 1, 3, 1, 3,
+	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
+	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
+	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
+	3, 3, 0, 5, 4, 5, 5, 0, 2, 1,
+	3, 3, 3, 2, 3, 1, 2, 0, 3, 1,
+	1, 3, 3, 4, 4, 5, 3, 4, 5, 6,
+	2, 1, 2, 1, 2, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 0, 2, 1, 1, 1, 3,
+	1, 3, 1, 1, 1, 1, 1, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 3, 1, 1, 1, 1, 4, 5, 5,
-	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
+	3, 3, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 3, 1, 1, 1, 1, 5, 5, 5, 6,
+	0, 2, 4, 4, 0, 1, 0, 3, 0, 2,
+	5, 1, 1, 2, 2, 2, 2, 2, 1, 1,
+	3, 4, 4, 6, 6, 6, 8, 8, 8, 8,
 	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 8, 8,
 	0, 2, 3, 4, 4, 4, 4, 4, 4, 4,
@@ -3013,15 +3258,15 @@ var yyR2 = [...]int{
 	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
 	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
 	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
-	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
-	2, 4, 2, 1, 5, 4, 1, 3, 3, 5,
-	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
-	3, 1, 2, 3, 3, 3, 3, 3, 1, 2,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 1, 3, 5,
+	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
+	0, 2, 4, 4, 0, 2, 4, 2, 1, 5,
+	4, 1, 3, 3, 5, 0, 5, 1, 3, 1,
+	2, 3, 1, 1, 3, 3, 1, 2, 3, 3,
+	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 3, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
+	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3051,335 +3296,355 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
+	1,
 }
 var yyChk = [...]int{
 
-	-1000, -208, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -23, -24, -25, -27, -28,
-	-29, -30, -31, -6, -26, -19, -20, -4, -210, 6,
-	7, -35, 9, 10, 30, -21, 123, 124, 126, 125,
-	159, 127, 152, 53, 173, 174, 176, 177, 178, 179,
-	-38, 157, 158, 31, 32, 129, 34, 57, 8, 262,
-	154, 153, 25, -209, 364, -34, 5, -98, 15, -3,
-	-32, -213, -32, -32, -32, -32, -32, -183, -185, 57,
-	96, -132, 133, 77, 254, 130, 131, 137, -135, -201,
-	-134, 60, 61, 62, 272, 145, 304, 305, 173, 187,
-	181, 208, 200, 273, 306, 146, 198, 201, 241, 143,
-	307, 228, 235, 71, 176, 250, 308, 155, 196, 192,
-	309, 281, 190, 27, 310, 237, 213, 311, 277, 239,
-	191, 236, 129, 312, 148, 360, 141, 313, 214, 218,
-	314, 242, 315, 316, 317, 185, 186, 318, 144, 244,
-	212, 142, 33, 274, 37, 163, 245, 216, 319, 211,
-	207, 320, 321, 322, 323, 210, 184, 206, 41, 220,
-	219, 221, 240, 203, 324, 325, 326, 149, 327, 193,
-	18, 328, 329, 330, 331, 332, 248, 158, 333, 161,
-	334, 335, 336, 337, 338, 339, 238, 215, 217, 138,
-	165, 234, 276, 340, 246, 189, 341, 150, 162, 157,
-	249, 151, 342, 343, 344, 345, 346, 347, 348, 177,
-	349, 350, 351, 352, 172, 243, 252, 40, 225, 353,
-	183, 140, 354, 174, 169, 230, 204, 164, 355, 356,
-	194, 195, 209, 182, 205, 175, 361, 166, 159, 357,
-	251, 226, 278, 202, 199, 170, 358, 167, 168, 359,
-	362, 231, 232, 171, 275, 247, 197, 227, -118, 133,
-	254, 130, 232, 135, 131, 131, 132, 133, 254, 130,
-	131, -64, -141, -201, -134, 133, 131, 114, 201, 241,
-	123, 229, 237, -124, 238, 165, -152, 131, -120, 228,
-	231, 232, 171, -201, 239, 243, 242, 233, -141, 175,
-	-146, 180, -135, 178, -64, -36, 360, 127, -148, -148,
-	230, 230, -148, -84, -49, -70, 80, -75, 29, 23,
-	-74, -71, -91, -88, -89, 114, 115, 117, 116, 118,
-	103, 104, 111, 81, 119, -79, -77, -78, -80, 64,
-	63, 72, 65, 66, 67, 68, 73, 74, 75, -135,
-	-141, -86, -210, 47, 48, 263, 264, 265, 266, 271,
-	267, 83, 36, 253, 261, 260, 259, 257, 258, 255,
-	256, 269, 270, 136, 254, 130, 109, 262, -201, -134,
-	-5, -4, -210, 6, 20, 21, -102, 17, 16, -211,
-	59, -40, -47, 42, 43, -48, 21, 35, 46, 44,
-	-33, -46, 105, -49, -141, -118, -118, 11, -58, -59,
-	-64, -66, -141, -110, -151, 175, -113, 243, 242, -136,
-	-111, -135, -133, 241, 201, 240, 128, 279, 79, 22,
-	24, 223, 82, 114, 16, 83, 113, 263, 123, 51,
-	280, 255, 256, 253, 265, 266, 254, 229, 29, 10,
-	282, 25, 153, 21, 35, 107, 125, 86, 87, 156,
-	23, 154, 75, 285, 19, 54, 11, 13, 286, 287,
-	14, 136, 135, 98, 132, 49, 8, 119, 26, 95,
-	45, 288, 28, 289, 290, 291, 292, 47, 96, 17,
-	257, 258, 31, 293, 271, 160, 109, 52, 38, 80,
-	294, 295, 73, 296, 76, 55, 77, 15, 50, 297,
-	298, 299, 300, 97, 126, 262, 48, 301, 130, 6,
-	268, 30, 152, 46, 302, 131, 85, 269, 270, 134,
-	74, 5, 137, 32, 9, 53, 56, 259, 260, 261,
-	36, 84, 12, 303, 78, -184, 96, -175, -201, -64,
-	132, -64, 262, -128, 136, -128, -128, 131, -64, -201,
-	-201, 123, 125, 128, 55, -22, -64, -127, 136, -201,
-	-127, -127, -127, -64, 120, -64, -201, 30, -125, 96,
-	12, 254, -201, 165, 131, 166, 133, -149, -210, -136,
-	-179, 132, 33, 144, -149, 169, 170, -149, -123, -122,
-	235, 236, 230, 234, 12, 170, 230, 168, -149, 134,
-	-135, -37, -135, 64, -7, -3, -10, -9, -11, 88,
-	-148, -148, 58, 79, 77, 78, 95, -49, -72, 98,
-	80, 96, 97, 82, 100, 99, 110, 103, 104, 105,
-	106, 107, 108, 109, 101, 102, 113, 88, 89, 90,
-	91, 92, 93, 94, -119, -210, -89, -210, 121, 122,
-	-75, -75, -75, -75, -75, -75, -75, -75, -75, -75,
-	-210, 120, -2, -84, -4, -210, -210, -210, -210, -210,
-	-210, -210, -210, -94, -49, -210, -214, -81, -210, -214,
-	-81, -214, -81, -214, -210, -214, -81, -214, -81, -214,
-	-214, -81, -210, -210, -210, -210, -210, -210, -210, -98,
-	-3, -32, -103, 19, 31, -49, -99, -100, -49, -98,
-	38, -44, -46, -48, 42, 43, 70, 11, -138, -137,
-	22, -135, 64, 120, -65, 26, -64, -51, -52, -53,
-	-54, -67, -90, -210, -64, -64, -58, -212, 58, 11,
-	56, -212, 58, 120, 58, 175, -113, -115, -114, 244,
-	246, 88, -140, -135, 64, 29, 30, 59, 58, -64,
-	-154, -157, -159, -158, -160, -155, -156, 198, 199, 114,
-	202, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 30, 155, 194, 195, 196, 197, 214, 215, 216,
-	217, 218, 219, 220, 221, 181, 200, 273, 182, 183,
-	184, 185, 186, 187, 189, 190, 191, 192, 193, -201,
-	-149, 133, -201, 80, -201, -64, -64, -149, -149, -149,
-	167, 167, 131, 131, 172, -64, 58, 134, -58, 23,
-	55, -64, -201, -201, -142, -141, -133, -149, -125, 64,
-	-49, -149, -149, -149, -64, -149, -149, -180, 11, 98,
-	-149, -149, 11, -121, 11, 98, -49, -126, 96, 55,
-	-147, 178, 212, 361, 362, 363, -49, -49, -49, -49,
-	-82, 73, 80, 74, 75, -75, -83, -86, -89, 69,
-	98, 96, 97, 82, -75, -75, -75, -75, -75, -75,
-	-75, -75, -75, -75, -75, -75, -75, -75, -75, -150,
-	-201, 64, -201, -74, -74, -135, -45, 21, 35, -44,
-	-136, -142, -133, -34, -211, -211, -98, -44, -44, -49,
-	-49, -91, 64, -44, -91, 64, -44, -44, -39, 21,
-	35, -92, -93, 84, -91, -135, -141, -211, -75, -135,
-	-135, -44, -45, -45, -44, -44, -102, -211, 9, 98,
-	58, 18, 58, -101, 24, 25, -102, -76, -135, 65,
-	68, -50, 58, 11, -48, -64, -137, 105, -142, -106,
-	161, -64, 30, 58, -60, -62, -61, -63, 45, 49,
-	51, 46, 47, 48, 52, -145, 22, -51, -3, -210,
-	-144, 161, -143, 22, -141, 64, -106, 56, -51, -64,
-	-51, -66, -141, 105, -113, -115, 58, 245, 247, 248,
-	55, 76, -49, -166, 113, -186, -187, -188, -136, 64,
-	65, -175, -176, -177, -189, 147, -194, 138, 140, 137,
-	-178, 148, 132, 28, 59, -171, 73, 80, -167, 226,
-	-161, 57, -161, -161, -161, -161, -165, 201, -165, -165,
-	-165, 57, 57, -161, -161, -161, -169, 57, -169, -169,
-	-170, 57, -170, -139, 56, -64, -149, 23, -149, -129,
-	128, 125, 126, -197, 124, 223, 201, 71, 29, 15,
-	263, 161, 278, -201, 162, -64, -64, -64, -64, -64,
-	128, 125, -64, -64, -64, -149, -64, -64, -125, -141,
-	-141, 64, -64, -135, 73, 74, 75, -83, -75, -75,
-	-75, -43, 156, 79, -211, -211, -44, -44, -210, 120,
-	-5, -102, -211, -211, 58, 56, 22, 11, 11, -211,
-	11, 11, -211, -211, -44, -95, -93, 86, -49, -211,
-	120, -211, 58, 58, -211, -211, -211, -211, -211, -103,
-	40, -49, -49, -100, -103, -117, 19, 11, 36, 36,
-	-69, 12, -46, -51, -48, 120, -73, 30, 36, -3,
-	-210, -210, -109, -112, -91, -52, -53, -53, -52, -53,
-	45, 45, 45, 50, 45, 50, 45, -61, -141, -211,
-	-211, -3, -68, 53, 135, 54, -210, -143, -69, -51,
-	-69, -69, 120, -114, -116, 249, 246, 252, -201, 64,
-	58, -188, 88, 57, -201, 28, -178, -178, -181, -201,
-	-181, 28, -163, 29, 73, -168, 227, 65, -165, -165,
-	-166, 30, -166, -166, -166, -174, 64, -174, 65, 65,
-	55, -135, -149, -148, -204, 143, 139, 147, 148, 141,
-	60, 61, 62, 132, 28, 138, 140, 161, 137, -204,
-	-130, -131, 134, 22, 132, 28, 161, -203, 56, 167,
-	223, 167, 134, -149, -121, -121, -43, 79, -75, -75,
-	-211, -211, -45, -136, -98, -103, -153, 114, 198, 155,
-	196, 192, 212, 203, 225, 194, 226, -150, -153, -75,
-	-75, -75, -75, 272, -98, 87, -49, 85, -136, -75,
-	-75, 41, -64, -96, 13, -49, 105, -108, 55, -109,
-	-85, -87, -86, -210, -104, -135, -107, -135, -69, 58,
-	88, -56, -55, 55, 56, -57, 55, -55, 45, 45,
-	-211, 132, 132, 132, -107, -98, -69, 246, 250, 251,
-	-187, -188, -191, -190, -135, -194, -181, -181, 57, -164,
-	55, -75, 59, -166, -166, -201, 114, 59, 58, 59,
-	58, 59, 58, -64, -148, -148, -64, -148, -135, -200,
-	275, -202, -201, -135, -135, -135, -64, -125, -125, -75,
-	-211, -102, -211, -161, -161, -161, -170, -161, 186, -161,
-	186, -211, -211, 19, 19, 19, 19, -210, -42, 268,
-	-49, 58, 58, -97, 14, 16, 27, -108, 58, -211,
-	-211, 58, 120, -211, 58, -98, -112, -49, -49, 57,
-	-49, -210, -210, -210, -211, -102, 59, 58, -161, -105,
-	-135, -172, 223, 9, -165, 64, -165, 65, 65, -149,
-	26, -199, -198, -136, 57, 56, -103, -165, -201, -75,
-	-75, -75, -75, -75, -102, 64, -75, -75, -49, -84,
-	28, -87, 36, -3, -135, -135, -135, -102, -105, -105,
-	-211, -105, -105, -144, -193, -192, 56, 142, 71, -190,
-	59, 58, -173, 138, 28, 137, -78, -166, -166, 59,
-	59, -210, 58, 88, -105, -64, -211, -211, -211, -211,
-	-41, 98, 275, -211, -211, -211, 9, -85, 120, 59,
-	-211, -211, -211, -68, -192, -201, -182, 88, 64, 150,
-	-135, -162, 71, 28, 28, -195, -196, 161, -198, -188,
-	59, -211, 273, 52, 276, -109, -135, 65, -64, 64,
-	-211, 58, -135, -203, 41, 274, 277, 57, -196, 36,
-	-200, 41, -105, 163, 275, 59, 164, 276, -206, -207,
-	55, -210, 277, -207, 55, 10, 9, -75, 160, -205,
-	151, 146, 149, 30, -205, -211, -211, 145, 29, 73,
+	-1000, -222, -1, -3, -22, -23, -24, -25, -26, -27,
+	-28, -29, -30, -31, -32, -37, -38, -39, -41, -42,
+	-43, -44, -45, -20, -40, -33, -34, -4, -6, -224,
+	6, 7, -49, 9, 10, 30, -35, 123, 124, 126,
+	125, 159, 127, 152, 53, 174, 175, 177, 178, 179,
+	180, -52, 157, 158, 31, 32, 129, 34, 276, 57,
+	8, 263, 154, 153, 25, -223, 369, -48, 5, -112,
+	15, -4, 6, -3, -46, -227, -46, -46, -46, -46,
+	-46, -197, -199, 57, 96, -146, 133, 77, 255, 130,
+	131, 167, 137, -149, -215, -148, 276, 60, 61, 62,
+	273, 145, 303, 304, 174, 188, 182, 209, 201, 274,
+	305, 146, 199, 202, 242, 143, 306, 229, 236, 71,
+	177, 251, 307, 361, 155, 197, 193, 308, 282, 191,
+	27, 309, 238, 214, 310, 278, 240, 192, 237, 129,
+	364, 148, 365, 141, 311, 215, 219, 312, 243, 313,
+	314, 315, 186, 187, 316, 144, 245, 213, 142, 33,
+	275, 37, 163, 246, 217, 317, 212, 208, 318, 319,
+	320, 321, 211, 185, 207, 41, 221, 220, 222, 241,
+	204, 322, 323, 324, 149, 325, 194, 18, 326, 327,
+	328, 329, 330, 249, 158, 331, 161, 332, 333, 334,
+	363, 335, 336, 239, 216, 218, 138, 165, 235, 277,
+	337, 247, 190, 338, 150, 162, 157, 250, 151, 339,
+	340, 341, 342, 343, 344, 345, 178, 346, 347, 348,
+	349, 173, 244, 253, 40, 226, 350, 184, 140, 351,
+	175, 170, 231, 205, 164, 352, 353, 195, 196, 210,
+	183, 206, 176, 366, 166, 159, 362, 252, 227, 279,
+	203, 200, 171, 354, 168, 169, 355, 367, 232, 233,
+	172, 248, 198, 228, -132, 133, 255, 130, 233, 135,
+	131, 131, 167, 132, 133, 255, 130, 131, -78, -155,
+	-215, -148, 167, 133, 131, 114, 202, 242, 123, 230,
+	238, -138, 239, 165, -166, 131, -134, 229, 232, 233,
+	172, -215, 240, 244, 243, 234, -155, 176, -160, 181,
+	-149, 179, -78, -50, 365, 127, -162, -162, 231, 231,
+	-162, -98, -63, -84, 80, -89, 29, 23, -88, -85,
+	-105, -102, -103, 114, 115, 117, 116, 118, 103, 104,
+	111, 81, 119, -93, -91, -92, -94, 64, 63, 72,
+	65, 66, 67, 68, 73, 74, 75, -149, -155, -100,
+	-224, 47, 48, 264, 265, 266, 267, 272, 268, 83,
+	36, 254, 262, 261, 260, 258, 259, 256, 257, 270,
+	271, 136, 255, 130, 109, 263, -215, -148, -7, -8,
+	-155, -5, -4, -224, 20, 21, -116, 17, 16, -112,
+	-46, -225, 59, -54, -61, 42, 43, -62, 21, 35,
+	46, 44, -47, -60, 105, -63, -155, -132, -132, 11,
+	-72, -73, -78, -80, -155, -124, -165, 176, -127, 244,
+	243, -150, -125, -149, -147, 242, 202, 241, 128, 280,
+	79, 22, 24, 224, 82, 114, 16, 83, 113, 264,
+	123, 51, 281, 256, 257, 254, 266, 267, 255, 230,
+	29, 10, 283, 25, 153, 21, 35, 107, 125, 86,
+	87, 156, 23, 154, 75, 286, 19, 54, 11, 13,
+	287, 288, 14, 136, 135, 98, 132, 49, 8, 119,
+	26, 95, 45, 289, 28, 290, 291, 292, 293, 47,
+	96, 17, 258, 259, 31, 294, 272, 160, 109, 52,
+	38, 80, 295, 296, 73, 297, 76, 55, 77, 15,
+	50, 356, 298, 359, 299, 300, 97, 126, 263, 48,
+	360, 358, 301, 130, 6, 269, 30, 152, 46, 302,
+	131, 85, 270, 271, 134, 74, 5, 137, 32, 9,
+	53, 56, 260, 261, 262, 36, 84, 12, 357, 78,
+	-198, 96, -189, -215, -78, 132, -78, 263, -142, 136,
+	-142, -142, 131, 131, -78, -215, -215, 123, 125, 128,
+	55, -36, -78, -141, 136, 131, -215, -141, -141, -141,
+	-78, 120, -78, -215, 30, -139, 96, 12, 255, -215,
+	165, 131, 166, 133, -163, -224, -150, -193, 132, 33,
+	144, -163, 170, 171, 170, -137, -136, 236, 237, 231,
+	235, 12, 171, 231, 169, -163, 134, -149, -51, -149,
+	64, -21, -3, -24, -23, -25, 88, -162, -162, 58,
+	79, 77, 78, 95, -63, -86, 98, 80, 96, 97,
+	82, 100, 99, 110, 103, 104, 105, 106, 107, 108,
+	109, 101, 102, 113, 88, 89, 90, 91, 92, 93,
+	94, -133, -224, -103, -224, 121, 122, -89, -89, -89,
+	-89, -89, -89, -89, -89, -89, -89, -224, 120, -2,
+	-98, -4, -6, 276, -224, -224, -224, -224, -224, -224,
+	-224, -224, -108, -63, -224, -228, -95, -224, -228, -95,
+	-228, -95, -228, -224, -228, -95, -228, -95, -228, -228,
+	-95, -224, -224, -224, -224, -224, -224, -224, 58, 22,
+	-224, -112, -3, -117, 19, 31, -63, -113, -114, -63,
+	-116, 42, 43, -112, 38, -58, -60, -62, 70, 11,
+	-152, -151, 22, -149, 64, 120, -79, 26, -78, -65,
+	-66, -67, -68, -81, -104, -224, -78, -78, -72, -226,
+	58, 11, 56, -226, 58, 120, 58, 176, -127, -129,
+	-128, 245, 247, 88, -154, -149, 64, 29, 30, 59,
+	58, -78, -168, -171, -173, -172, -174, -169, -170, 199,
+	200, 114, 203, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 30, 155, 195, 196, 197, 198, 215,
+	216, 217, 218, 219, 220, 221, 222, 182, 201, 274,
+	183, 184, 185, 186, 187, 188, 190, 191, 192, 193,
+	194, -215, -163, 133, -215, 80, -215, -78, -142, -78,
+	-163, -163, -163, 168, 168, 131, 131, 173, -78, 58,
+	134, -72, 23, -141, 55, -78, -215, -215, -156, -155,
+	-147, 276, -163, -139, 64, -63, -163, -163, -163, -78,
+	-163, -163, -194, 11, 98, -163, -163, -135, 11, 98,
+	11, -135, -63, -140, 96, 55, -161, 179, 213, 366,
+	367, 368, -63, -63, -63, -63, -96, 73, 80, 74,
+	75, -89, -97, -100, -103, 69, 98, 96, 97, 82,
+	-89, -89, -89, -89, -89, -89, -89, -89, -89, -89,
+	-89, -89, -89, -89, -89, -164, -215, 64, -215, -88,
+	-88, -149, -59, 21, 35, -58, -150, -156, -147, 276,
+	-48, -225, -225, -112, -4, -58, -58, -63, -63, -105,
+	64, -58, -105, 64, -58, -58, -53, 21, 35, -106,
+	-107, 84, -105, -149, -155, -225, -89, -149, -149, -58,
+	-59, -59, -58, -58, -8, -104, -224, -119, -149, -116,
+	-225, 9, 98, 58, 18, 58, -115, 24, 25, -117,
+	-116, -90, -149, 65, 68, -64, 58, 11, -62, -78,
+	-151, 105, -156, -120, 161, -78, 30, 58, -74, -76,
+	-75, -77, 45, 49, 51, 46, 47, 48, 52, -159,
+	22, -65, -3, -224, -158, 161, -157, 22, -155, 64,
+	-120, 56, -65, -78, -65, -80, -155, 105, -127, -129,
+	58, 246, 248, 249, 55, 76, -63, -180, 113, -200,
+	-201, -202, -150, 64, 65, -189, -190, -191, -203, 147,
+	-208, 138, 140, 137, -192, 148, 132, 28, 59, -185,
+	73, 80, -181, 227, -175, 57, -175, -175, -175, -175,
+	-179, 202, -179, -179, -179, 57, 57, -175, -175, -175,
+	-183, 57, -183, -183, -184, 57, -184, -153, 56, -78,
+	-163, 23, -163, -78, -143, 128, 125, 126, -211, 124,
+	224, 202, 71, 29, 15, 264, 161, 279, -215, 162,
+	-78, -78, -78, -78, -78, 128, 125, -78, -78, -72,
+	-78, -163, -78, -139, -155, -155, -78, -139, 64, -78,
+	-149, 73, 74, 75, -97, -89, -89, -89, -57, 156,
+	79, -225, -225, -58, -58, -224, 120, -5, -116, -112,
+	-225, -225, 58, 56, 22, 11, 11, -225, 11, 11,
+	-225, -225, -58, -109, -107, 86, -63, -225, 120, -225,
+	58, 58, -225, -225, -225, -225, -225, -225, 58, -117,
+	40, -63, -63, -114, -117, -131, 19, 11, 36, 36,
+	-83, 12, -60, -65, -62, 120, -87, 30, 36, -3,
+	-224, -224, -123, -126, -105, -66, -67, -67, -66, -67,
+	45, 45, 45, 50, 45, 50, 45, -75, -155, -225,
+	-225, -3, -82, 53, 135, 54, -224, -157, -83, -65,
+	-83, -83, 120, -128, -130, 250, 247, 253, -215, 64,
+	58, -202, 88, 57, -215, 28, -192, -192, -195, -215,
+	-195, 28, -177, 29, 73, -182, 228, 65, -179, -179,
+	-180, 30, -180, -180, -180, -188, 64, -188, 65, 65,
+	55, -149, -163, -162, -218, 143, 139, 147, 148, 141,
+	60, 61, 62, 132, 28, 138, 140, 161, 137, -218,
+	-144, -145, 134, 22, 132, 28, 161, -217, 56, 168,
+	224, 168, 134, -163, -135, -135, -57, 79, -89, -89,
+	-9, 356, -225, -225, -59, -150, -112, -117, -116, -167,
+	114, 199, 155, 197, 193, 213, 204, 226, 195, 227,
+	-164, -167, -89, -89, -89, -89, 273, -112, 87, -63,
+	85, -150, -89, -89, 22, -149, 41, -78, -110, 13,
+	-63, 105, -122, 55, -123, -99, -101, -100, -224, -118,
+	-149, -121, -149, -83, 58, 88, -70, -69, 55, 56,
+	-71, 55, -69, 45, 45, -225, 132, 132, 132, -121,
+	-112, -83, 247, 251, 252, -201, -202, -205, -204, -149,
+	-208, -195, -195, 57, -178, 55, -89, 59, -180, -180,
+	-215, 114, 59, 58, 59, 58, 59, 58, -78, -162,
+	-162, -78, -162, -149, -214, 276, -216, -215, -149, -149,
+	-149, -78, -139, -139, -89, -149, -224, -225, -116, -117,
+	-225, -175, -175, -175, -184, -175, 187, -175, 187, -225,
+	-225, 19, 19, 19, 19, -224, -56, 269, -63, 58,
+	58, -104, -111, 14, 16, 27, -122, 58, -225, -225,
+	58, 120, -225, 58, -112, -126, -63, -63, 57, -63,
+	-224, -224, -224, -225, -116, 59, 58, -175, -119, -186,
+	224, 9, -179, 64, -179, 65, 65, -163, 26, -213,
+	-212, -150, 57, 56, -10, -16, 60, -117, -179, -215,
+	-89, -89, -89, -89, -89, -116, 64, -89, -89, -14,
+	357, -63, -98, 28, -101, 36, -3, -149, -149, -149,
+	-116, -119, -119, -225, -119, -119, -158, -207, -206, 56,
+	142, 71, -204, 59, -187, 138, 28, 137, -92, -180,
+	-180, 59, 59, -224, 58, 88, -119, -78, -225, -17,
+	161, -225, -225, -225, -225, -55, 98, 276, -225, -225,
+	-225, -15, -13, -149, 9, -99, 120, 59, -225, -225,
+	-225, -82, -206, -215, -196, 88, 64, 150, -176, 71,
+	28, 28, -209, -210, 161, -212, -202, 59, -112, 16,
+	-225, 274, 52, 277, 58, 22, -123, -149, 65, -78,
+	64, -225, 58, -149, -217, -11, -18, 358, 359, -98,
+	41, 275, 278, -13, -224, 57, -210, 36, -214, -12,
+	82, 361, 362, -19, 65, 68, 119, 41, -10, -119,
+	163, -12, 360, 363, 364, 363, 364, -89, 276, -225,
+	59, 164, 79, -149, 277, -220, -221, 55, -224, -12,
+	278, -221, 55, 10, 9, -89, 160, -219, 151, 146,
+	149, 30, -219, -225, -225, 145, 29, 73,
 }
 var yyDef = [...]int{
 
 	28, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 23, 24, 25, 26, 27, 617, 0, 358,
-	358, 358, 358, 358, 358, 0, 687, 670, 0, 0,
-	0, 0, -2, 323, 324, 0, 326, -2, 0, 0,
-	335, 996, 996, 0, 0, 996, 0, 994, 45, 46,
-	341, 342, 343, 1, 3, 0, 362, 625, 0, 0,
-	-2, 360, 0, 670, 670, 0, 0, 74, 75, 0,
-	0, 0, 982, 0, 668, 668, 668, 688, 689, 692,
-	693, 29, 30, 31, 819, 820, 821, 822, 823, 824,
-	825, 826, 827, 828, 829, 830, 831, 832, 833, 834,
-	835, 836, 837, 838, 839, 840, 841, 842, 843, 844,
-	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
-	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
-	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
-	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
-	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
-	895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
-	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
-	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
-	925, 926, 927, 928, 929, 930, 931, 932, 933, 934,
-	935, 936, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 951, 952, 953, 954,
-	955, 956, 957, 958, 959, 960, 961, 962, 963, 964,
-	965, 966, 967, 968, 969, 970, 971, 972, 973, 974,
-	975, 976, 977, 978, 979, 980, 981, 983, 984, 985,
-	986, 987, 988, 989, 990, 991, 992, 993, 0, 0,
-	0, 0, 0, 671, 0, 666, 0, 666, 666, 666,
-	0, 274, 440, 696, 697, 982, 0, 0, 0, 314,
-	0, 997, 286, 0, 288, 997, 0, 997, 0, 295,
-	0, 0, 301, 997, 306, 320, 321, 308, 322, 325,
-	0, 330, 333, 0, 348, 0, 860, 340, 353, 354,
-	996, 996, 357, 32, 491, 450, 0, 456, 458, 0,
-	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 523, 524, 525, 526, 602,
-	603, 604, 605, 606, 607, 608, 609, 460, 461, 599,
-	0, 647, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 590, 0, 560, 560, 560, 560, 560, 560, 560,
-	560, 0, 0, 0, 0, 0, 0, 0, -2, -2,
-	617, 41, 0, 358, 363, 364, 629, 0, 0, 617,
-	995, 0, 0, -2, -2, 374, 380, 381, 382, 383,
-	359, 0, 386, 390, 0, 0, 0, 0, 0, 0,
-	54, 56, 440, 60, 0, 970, 651, -2, -2, 0,
-	0, 694, 695, -2, 832, -2, 700, 701, 702, 703,
-	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
-	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
-	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
-	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
-	744, 745, 746, 747, 748, 749, 750, 751, 752, 753,
-	754, 755, 756, 757, 758, 759, 760, 761, 762, 763,
-	764, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	774, 775, 776, 777, 778, 779, 780, 781, 782, 783,
-	784, 785, 786, 787, 788, 789, 790, 791, 792, 793,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 0, 0, 93, 0, 91,
-	0, 997, 0, 0, 0, 0, 0, 0, 997, 997,
-	997, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 273, 0, 275, 997, 314, 278, 0,
-	0, 997, 997, 997, 0, 997, 997, 285, 998, 999,
-	0, 195, 196, 197, 289, 997, 997, 291, 0, 311,
-	309, 310, 303, 304, 0, 317, 298, 299, 302, 331,
-	334, 351, 349, 350, 352, 344, 345, 346, 347, 0,
-	355, 356, 0, 0, 0, 0, 0, 454, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 478, 479, 480,
-	481, 482, 483, 484, 457, 0, 471, 0, 0, 0,
-	513, 514, 515, 516, 517, 518, 519, 520, 521, 0,
-	371, 0, 0, 0, 617, 0, 0, 0, 0, 0,
-	0, 0, 368, 0, 591, 0, 544, 552, 0, 545,
-	553, 546, 554, 547, 0, 548, 555, 549, 556, 550,
-	551, 557, 0, 0, 0, 371, 371, 0, 0, 625,
-	0, 373, 33, 0, 0, 626, 618, 619, 622, 625,
-	0, 395, 384, 375, 378, 379, 361, 0, 387, 391,
-	0, 393, 394, 0, 58, 0, 439, 0, 397, 399,
-	400, 401, 421, 0, 423, -2, 0, 0, 0, 52,
-	53, 0, 0, 0, 0, 970, 652, 62, 63, 0,
-	0, 0, 171, 661, 662, 663, 659, 220, 0, 0,
-	159, 155, 99, 100, 101, 148, 103, 148, 148, 148,
-	148, 168, 168, 168, 168, 131, 132, 133, 134, 135,
-	0, 0, 118, 148, 148, 148, 122, 138, 139, 140,
-	141, 142, 143, 144, 145, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 150, 150, 150, 152, 152, 690,
-	77, 0, 997, 0, 997, 89, 0, 234, 236, 237,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 667,
-	0, 997, 271, 272, 441, 698, 699, 276, 277, 315,
-	316, 279, 280, 281, 282, 283, 284, 0, 198, 199,
-	290, 294, 0, 314, 0, 0, 296, 297, 0, 0,
-	0, 332, 336, 337, 338, 339, 492, 451, 452, 453,
-	455, 472, 0, 474, 476, 462, 463, 487, 488, 489,
-	0, 0, 0, 0, 485, 467, 0, 498, 499, 500,
-	501, 502, 503, 504, 505, 506, 507, 508, 509, 512,
-	575, 576, 0, 510, 511, 522, 0, 0, 0, 372,
-	600, 0, -2, 0, 490, 646, 625, 0, 0, 0,
-	0, 495, 602, 0, 495, 602, 0, 0, 0, 369,
-	370, 597, 594, 0, 0, 599, 0, 561, 0, 0,
-	0, 0, 0, 0, 0, 0, 629, 42, 630, 0,
-	0, 0, 0, 621, 623, 624, 629, 0, 610, 0,
-	0, 448, 0, 0, 376, 39, 392, 388, 0, 0,
-	0, 438, 0, 0, 0, 0, 0, 0, 428, 0,
-	0, 431, 0, 0, 0, 0, 422, 0, 0, 0,
-	443, 914, 424, 0, 426, 427, 448, 0, 448, 55,
-	448, 57, 0, 442, 653, 61, 0, 0, 66, 67,
-	654, 655, 656, 657, 0, 90, 221, 223, 226, 227,
-	228, 94, 95, 96, 0, 0, 208, 0, 0, 202,
-	202, 0, 200, 201, 92, 162, 160, 0, 157, 156,
-	102, 0, 168, 168, 125, 126, 171, 0, 171, 171,
-	171, 0, 0, 119, 120, 121, 113, 0, 114, 115,
-	116, 0, 117, 0, 0, 997, 79, 669, 80, 996,
-	0, 0, 682, 235, 672, 673, 674, 675, 676, 677,
-	678, 679, 680, 681, 0, 81, 239, 241, 240, 244,
-	0, 0, 0, 266, 997, 270, 311, 311, 293, 312,
-	313, 318, 300, 328, 473, 475, 477, 464, 485, 468,
-	0, 465, 0, 0, 459, 527, 0, 0, 371, 0,
-	617, 629, 531, 532, 0, 0, 0, 0, 0, 568,
-	0, 0, 569, 0, 617, 0, 595, 0, 0, 543,
-	0, 562, 0, 0, 563, 564, 565, 566, 567, 35,
-	0, 627, 628, 620, 34, 0, 664, 665, 611, 612,
-	613, 0, 385, 396, 377, 0, 640, 0, 0, 633,
-	0, 0, 448, 648, 0, 398, 417, 419, 0, 414,
-	429, 430, 432, 0, 434, 0, 436, 437, 402, 403,
-	404, 0, 405, 0, 0, 0, 0, 425, 617, 448,
-	50, 51, 0, 64, 65, 0, 0, 71, 172, 173,
-	0, 224, 0, 0, 0, 190, 202, 202, 193, 203,
-	194, 0, 164, 0, 161, 98, 158, 0, 171, 171,
-	127, 0, 128, 129, 130, 0, 146, 0, 0, 0,
-	0, 691, 78, 229, 996, 246, 247, 248, 249, 250,
-	251, 252, 253, 254, 255, 256, 257, 258, 259, 996,
-	0, 996, 683, 684, 685, 686, 0, 84, 0, 0,
-	0, 0, 0, 269, 314, 314, 466, 0, 486, 469,
-	528, 529, 0, 601, 625, 37, 0, 148, 148, 580,
-	148, 152, 583, 148, 585, 148, 588, 0, 0, 0,
-	0, 0, 0, 0, 592, 542, 598, 0, 600, 0,
-	0, 631, 36, 615, 0, 449, 389, 43, 0, 640,
-	632, 642, 644, 0, 0, 636, 0, 409, 617, 0,
-	0, 411, 418, 0, 0, 412, 0, 413, 433, 435,
-	-2, 0, 0, 0, 0, 625, 49, 68, 69, 70,
-	222, 225, 0, 204, 148, 207, 191, 192, 0, 166,
-	0, 163, 149, 123, 124, 169, 170, 168, 0, 168,
-	0, 153, 0, 997, 230, 231, 232, 233, 0, 238,
-	0, 82, 83, 0, 0, 243, 267, 287, 292, 470,
-	530, 629, 533, 577, 168, 581, 582, 584, 586, 587,
-	589, 535, 534, 0, 0, 0, 0, 0, 625, 0,
-	596, 0, 0, 40, 0, 0, 0, 44, 0, 645,
-	0, 0, 0, 59, 0, 625, 649, 650, 415, 0,
-	420, 0, 0, 0, 423, 48, 182, 0, 206, 0,
-	407, 174, 167, 0, 171, 147, 171, 0, 0, 76,
-	0, 85, 86, 0, 0, 0, 38, 578, 579, 0,
-	0, 0, 0, 570, 0, 593, 0, 0, 616, 614,
-	0, 643, 0, 635, 638, 637, 410, 47, 0, 0,
-	445, 0, 0, 443, 181, 183, 0, 188, 0, 205,
-	0, 0, 179, 0, 176, 178, 165, 136, 137, 151,
-	154, 0, 0, 0, 0, 245, 536, 538, 537, 539,
-	0, 0, 0, 541, 558, 559, 0, 634, 0, 416,
-	444, 446, 447, 406, 184, 185, 0, 189, 187, 0,
-	408, 97, 0, 175, 177, 0, 261, 0, 87, 88,
-	81, 540, 0, 0, 0, 641, 639, 186, 0, 180,
-	260, 0, 0, 84, 571, 0, 574, 0, 262, 0,
-	242, 572, 0, 0, 0, 209, 0, 0, 210, 211,
-	0, 0, 573, 212, 0, 0, 0, 0, 0, 213,
-	215, 216, 0, 0, 214, 263, 264, 217, 218, 219,
+	21, 22, 23, 24, 25, 26, 27, 652, 0, 0,
+	367, 367, 367, 367, 367, 367, 0, 722, 705, 0,
+	0, 0, 0, -2, 332, 333, 0, 335, -2, 0,
+	0, 344, 1037, 1037, 0, 0, 1037, 0, 0, 1035,
+	52, 53, 350, 351, 352, 1, 3, 0, 371, 660,
+	0, 652, 367, 0, -2, 369, 0, 705, 705, 0,
+	0, 81, 82, 0, 0, 0, 1024, 0, 703, 703,
+	703, 1002, 723, 724, 727, 728, 729, 29, 30, 31,
+	859, 860, 861, 862, 863, 864, 865, 866, 867, 868,
+	869, 870, 871, 872, 873, 874, 875, 876, 877, 878,
+	879, 880, 881, 882, 883, 884, 885, 886, 887, 888,
+	889, 890, 891, 892, 893, 894, 895, 896, 897, 898,
+	899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
+	909, 910, 911, 912, 913, 914, 915, 916, 917, 918,
+	919, 920, 921, 922, 923, 924, 925, 926, 927, 928,
+	929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
+	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
+	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
+	959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
+	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
+	979, 980, 981, 982, 983, 984, 985, 986, 987, 988,
+	989, 990, 991, 992, 993, 994, 995, 996, 997, 998,
+	999, 1000, 1001, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
+	1020, 1021, 1022, 1023, 1025, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1034, 0, 0, 0, 0, 0, 706,
+	0, 701, 0, 0, 701, 701, 701, 0, 283, 449,
+	732, 733, 1002, 1024, 0, 0, 0, 323, 0, 1038,
+	295, 0, 297, 1038, 0, 0, 0, 304, 0, 0,
+	310, 1038, 315, 329, 330, 317, 331, 334, 0, 339,
+	342, 0, 357, 0, 901, 349, 362, 363, 1037, 1037,
+	366, 32, 500, 459, 0, 465, 467, 0, 502, 503,
+	504, 505, 506, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 532, 533, 534, 535, 632, 633, 634,
+	635, 636, 637, 638, 639, 469, 470, 629, 0, 682,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 620,
+	0, 590, 590, 590, 590, 590, 590, 590, 590, 0,
+	0, 0, 0, 0, 0, 0, -2, -2, 41, 42,
+	0, 652, 48, 0, 372, 373, 664, 0, 0, 660,
+	382, 652, 1036, 0, 0, -2, -2, 383, 389, 390,
+	391, 392, 368, 0, 395, 399, 0, 0, 0, 0,
+	0, 0, 61, 63, 449, 67, 0, 1012, 686, -2,
+	-2, 0, 0, 730, 731, -2, 872, -2, 737, 738,
+	739, 740, 741, 742, 743, 744, 745, 746, 747, 748,
+	749, 750, 751, 752, 753, 754, 755, 756, 757, 758,
+	759, 760, 761, 762, 763, 764, 765, 766, 767, 768,
+	769, 770, 771, 772, 773, 774, 775, 776, 777, 778,
+	779, 780, 781, 782, 783, 784, 785, 786, 787, 788,
+	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
+	799, 800, 801, 802, 803, 804, 805, 806, 807, 808,
+	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
+	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
+	829, 830, 831, 832, 833, 834, 835, 836, 837, 838,
+	839, 840, 841, 842, 843, 844, 845, 846, 847, 848,
+	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
+	0, 0, 101, 0, 99, 0, 1038, 0, 0, 0,
+	0, 0, 703, 0, 1038, 1038, 1038, 0, 0, 0,
+	0, 273, 0, 0, 0, 701, 0, 0, 0, 0,
+	282, 0, 284, 1038, 323, 287, 0, 0, 1038, 1038,
+	1038, 0, 1038, 1038, 294, 1039, 1040, 0, 203, 204,
+	205, 298, 1038, 1038, 320, 0, 320, 318, 319, 312,
+	313, 0, 326, 307, 308, 311, 340, 343, 360, 358,
+	359, 361, 353, 354, 355, 356, 0, 364, 365, 0,
+	0, 0, 0, 0, 463, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 487, 488, 489, 490, 491, 492,
+	493, 466, 0, 480, 0, 0, 0, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 380, 0, 0,
+	0, 652, 0, 729, 0, 0, 0, 0, 0, 0,
+	0, 377, 0, 621, 0, 574, 582, 0, 575, 583,
+	576, 584, 577, 0, 578, 585, 579, 586, 580, 581,
+	587, 0, 0, 0, 380, 380, 0, 0, 0, 0,
+	0, 660, 0, 33, 0, 0, 661, 653, 654, 657,
+	664, 387, 388, 660, 0, 404, 393, 384, 370, 0,
+	396, 400, 0, 402, 403, 0, 65, 0, 448, 0,
+	406, 408, 409, 410, 430, 0, 432, -2, 0, 0,
+	0, 59, 60, 0, 0, 0, 0, 1012, 687, 69,
+	70, 0, 0, 0, 179, 696, 697, 698, 694, 228,
+	0, 0, 167, 163, 107, 108, 109, 156, 111, 156,
+	156, 156, 156, 176, 176, 176, 176, 139, 140, 141,
+	142, 143, 0, 0, 126, 156, 156, 156, 130, 146,
+	147, 148, 149, 150, 151, 152, 153, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 158, 158, 158, 160,
+	160, 725, 84, 0, 1038, 0, 1038, 96, 0, 0,
+	242, 244, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 702, 0, 0, 1038, 280, 281, 450, 734,
+	735, 736, 285, 286, 324, 325, 288, 289, 290, 291,
+	292, 293, 0, 206, 207, 299, 303, 323, 0, 0,
+	0, 323, 305, 306, 0, 0, 0, 341, 345, 346,
+	347, 348, 501, 460, 461, 462, 464, 481, 0, 483,
+	485, 471, 472, 496, 497, 498, 0, 0, 0, 0,
+	494, 476, 0, 507, 508, 509, 510, 511, 512, 513,
+	514, 515, 516, 517, 518, 521, 605, 606, 0, 519,
+	520, 531, 0, 0, 0, 381, 630, 0, -2, -2,
+	0, 499, 681, 660, 652, 0, 0, 0, 0, 504,
+	632, 0, 504, 632, 0, 0, 0, 378, 379, 627,
+	624, 0, 0, 629, 0, 591, 0, 0, 0, 0,
+	0, 0, 0, 0, 43, 44, 0, 0, 416, 664,
+	49, 665, 0, 0, 0, 0, 656, 658, 659, 34,
+	664, 0, 640, 0, 0, 457, 0, 0, 385, 46,
+	401, 397, 0, 0, 0, 447, 0, 0, 0, 0,
+	0, 0, 437, 0, 0, 440, 0, 0, 0, 0,
+	431, 0, 0, 0, 452, 955, 433, 0, 435, 436,
+	457, 0, 457, 62, 457, 64, 0, 451, 688, 68,
+	0, 0, 73, 74, 689, 690, 691, 692, 0, 98,
+	229, 231, 234, 235, 236, 102, 103, 104, 0, 0,
+	216, 0, 0, 210, 210, 0, 208, 209, 100, 170,
+	168, 0, 165, 164, 110, 0, 176, 176, 133, 134,
+	179, 0, 179, 179, 179, 0, 0, 127, 128, 129,
+	121, 0, 122, 123, 124, 0, 125, 0, 0, 1038,
+	86, 704, 87, 97, 1037, 0, 0, 717, 243, 707,
+	708, 709, 710, 711, 712, 713, 714, 715, 716, 0,
+	88, 247, 249, 248, 252, 0, 0, 0, 274, 277,
+	1038, 279, 320, 300, 321, 322, 320, 302, 327, 309,
+	337, 482, 484, 486, 473, 494, 477, 0, 474, 0,
+	0, 468, 540, 0, 0, 380, 0, 652, 664, 660,
+	561, 562, 0, 0, 0, 0, 0, 598, 0, 0,
+	599, 0, 652, 0, 625, 0, 0, 573, 0, 592,
+	0, 0, 593, 594, 595, 596, 597, 0, 0, 36,
+	0, 662, 663, 655, 35, 0, 699, 700, 641, 642,
+	643, 0, 394, 405, 386, 0, 675, 0, 0, 668,
+	0, 0, 457, 683, 0, 407, 426, 428, 0, 423,
+	438, 439, 441, 0, 443, 0, 445, 446, 411, 412,
+	413, 0, 414, 0, 0, 0, 0, 434, 652, 457,
+	57, 58, 0, 71, 72, 0, 0, 78, 180, 181,
+	0, 232, 0, 0, 0, 198, 210, 210, 201, 211,
+	202, 0, 172, 0, 169, 106, 166, 0, 179, 179,
+	135, 0, 136, 137, 138, 0, 154, 0, 0, 0,
+	0, 726, 85, 237, 1037, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 1037,
+	0, 1037, 718, 719, 720, 721, 0, 91, 0, 0,
+	0, 0, 0, 278, 323, 323, 475, 0, 495, 478,
+	536, 0, 537, 538, 0, 631, 660, 38, 664, 0,
+	156, 156, 610, 156, 160, 613, 156, 615, 156, 618,
+	0, 0, 0, 0, 0, 0, 0, 622, 572, 628,
+	0, 630, 0, 0, 0, 417, 666, 37, 650, 0,
+	458, 398, 50, 0, 675, 667, 677, 679, 0, 0,
+	671, 0, 418, 652, 0, 0, 420, 427, 0, 0,
+	421, 0, 422, 442, 444, -2, 0, 0, 0, 0,
+	660, 56, 75, 76, 77, 230, 233, 0, 212, 156,
+	215, 199, 200, 0, 174, 0, 171, 157, 131, 132,
+	177, 178, 176, 0, 176, 0, 161, 0, 1038, 238,
+	239, 240, 241, 0, 246, 0, 89, 90, 0, 0,
+	251, 275, 296, 301, 479, 541, 544, 539, 664, 39,
+	563, 607, 176, 611, 612, 614, 616, 617, 619, 565,
+	564, 0, 0, 0, 0, 0, 660, 0, 626, 0,
+	0, 45, 645, 0, 0, 0, 51, 0, 680, 0,
+	0, 0, 66, 0, 660, 684, 685, 424, 0, 429,
+	0, 0, 0, 432, 55, 190, 0, 214, 0, 182,
+	175, 0, 179, 155, 179, 0, 0, 83, 0, 92,
+	93, 0, 0, 0, 0, 546, 545, 40, 608, 609,
+	0, 0, 0, 0, 600, 0, 623, 0, 0, 47,
+	0, 651, 644, 0, 678, 0, 670, 673, 672, 419,
+	54, 0, 0, 454, 0, 0, 452, 189, 191, 0,
+	196, 0, 213, 0, 187, 0, 184, 186, 173, 144,
+	145, 159, 162, 0, 0, 0, 0, 253, 542, 652,
+	0, 566, 568, 567, 569, 0, 0, 0, 571, 588,
+	589, 646, 647, 0, 0, 669, 0, 425, 453, 455,
+	456, 415, 192, 193, 0, 197, 195, 0, 105, 0,
+	183, 185, 0, 269, 0, 94, 95, 88, 548, 0,
+	570, 0, 0, 0, 0, 0, 676, 674, 194, 0,
+	188, 268, 0, 0, 91, 543, 0, 551, 552, 547,
+	601, 0, 604, 648, 544, 0, 270, 0, 250, 549,
+	0, 0, 0, 0, 558, 559, 0, 602, 0, 0,
+	0, 0, 553, 554, 555, 556, 557, 0, 0, 649,
+	217, 0, 0, 560, 0, 218, 219, 0, 0, 550,
+	603, 220, 0, 0, 0, 0, 0, 221, 223, 224,
+	0, 0, 222, 271, 272, 225, 226, 227,
 }
 var yyTok1 = [...]int{
 
@@ -3388,7 +3653,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 81, 3, 3, 3, 108, 100, 3,
 	57, 59, 105, 103, 58, 104, 120, 106, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 364,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 369,
 	89, 88, 90, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3444,7 +3709,8 @@ var yyTok3 = [...]int{
 	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
 	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
 	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
-	57685, 360, 57686, 361, 57687, 362, 57688, 363, 0,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 57692, 367, 57693, 368, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3786,59 +4052,59 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:337
+//line sql.y:362
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:342
+//line sql.y:367
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:343
+//line sql.y:368
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:347
+//line sql.y:372
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:374
+//line sql.y:399
 		{
 			setParseTree(yylex, nil)
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:380
+//line sql.y:405
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:384
+//line sql.y:409
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:388
+//line sql.y:413
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
 	case 32:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:394
+//line sql.y:419
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 33:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:400
+//line sql.y:425
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3847,26 +4113,37 @@ yydefault:
 			yyVAL.selStmt = sel
 		}
 	case 34:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:433
+		{
+			sel := yyDollar[2].selStmt.(*Select)
+			sel.With = yyDollar[1].with
+			sel.OrderBy = yyDollar[3].orderBy
+			sel.Limit = yyDollar[4].limit
+			sel.Lock = yyDollar[5].str
+			yyVAL.selStmt = sel
+		}
+	case 35:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:408
+//line sql.y:442
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:412
+//line sql.y:446
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].str, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].str)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:416
+//line sql.y:450
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:439
+//line sql.y:473
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3874,39 +4151,82 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 38:
+	case 39:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:481
+		{
+			sel := yyDollar[2].selStmt.(*Select)
+			sel.With = yyDollar[1].with
+			sel.OrderBy = yyDollar[3].orderBy
+			sel.Limit = yyDollar[4].limit
+			sel.Lock = yyDollar[5].str
+			yyVAL.selStmt = sel
+		}
+	case 40:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:447
+//line sql.y:490
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].str, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].str)
 		}
-	case 39:
+	case 41:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:496
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:502
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 43:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:506
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 44:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:512
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: nil, Subquery: yyDollar[3].subquery}
+		}
+	case 45:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:516
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Subquery: yyDollar[6].subquery}
+		}
+	case 46:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:453
+//line sql.y:522
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 40:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:461
+	case 47:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:530
 		{
-			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereStr, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingStr, yyDollar[8].expr))
+			sel := NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereStr, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingStr, yyDollar[8].expr))
+			sel.Windows = yyDollar[9].namedWindows
+			yyVAL.selStmt = sel
 		}
-	case 41:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:467
+//line sql.y:538
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 42:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:471
+//line sql.y:542
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 43:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:478
+//line sql.y:549
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3918,9 +4238,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 44:
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:490
+//line sql.y:561
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3930,328 +4250,343 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 45:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:502
+//line sql.y:573
 		{
 			yyVAL.str = InsertStr
 		}
-	case 46:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:506
+//line sql.y:577
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 47:
+	case 54:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:512
+//line sql.y:583
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 48:
+	case 55:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:518
+//line sql.y:589
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 49:
+	case 56:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:522
+//line sql.y:593
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 50:
+	case 57:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:526
+//line sql.y:597
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:530
+//line sql.y:601
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:535
+//line sql.y:606
 		{
 		}
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:536
+//line sql.y:607
 		{
 		}
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:540
+//line sql.y:611
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:544
+//line sql.y:615
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:550
+//line sql.y:621
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:554
+//line sql.y:625
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:559
+//line sql.y:630
 		{
 			yyVAL.partitions = nil
 		}
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:563
+//line sql.y:634
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:569
+//line sql.y:640
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:575
+//line sql.y:646
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Characteristics: yyDollar[5].characteristics}
 		}
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:579
+//line sql.y:650
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics}
 		}
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:585
+//line sql.y:656
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:589
+//line sql.y:660
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:595
+//line sql.y:666
 		{
 			yyVAL.characteristic = &IsolationLevel{Level: string(yyDollar[3].str)}
 		}
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:599
+//line sql.y:670
 		{
 			yyVAL.characteristic = &AccessMode{Mode: TxReadWrite}
 		}
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:603
+//line sql.y:674
 		{
 			yyVAL.characteristic = &AccessMode{Mode: TxReadOnly}
 		}
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:609
+//line sql.y:680
 		{
 			yyVAL.str = RepeatableRead
 		}
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:613
+//line sql.y:684
 		{
 			yyVAL.str = ReadCommitted
 		}
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:617
+//line sql.y:688
 		{
 			yyVAL.str = ReadUncommitted
 		}
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:621
+//line sql.y:692
 		{
 			yyVAL.str = Serializable
 		}
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:627
+//line sql.y:698
 		{
 			yyVAL.str = SessionStr
 		}
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:631
+//line sql.y:702
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:637
+//line sql.y:708
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:642
+//line sql.y:713
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:648
+//line sql.y:719
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:653
+//line sql.y:724
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:657
+//line sql.y:728
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:661
+//line sql.y:732
 		{
-			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
+			var notExists bool
+			if yyDollar[3].byt != 0 {
+				notExists = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String()), IfNotExists: notExists}
 		}
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:665
+//line sql.y:740
 		{
-			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String())}
+			var notExists bool
+			if yyDollar[3].byt != 0 {
+				notExists = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].colIdent.String()), IfNotExists: notExists}
 		}
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:670
+//line sql.y:749
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:674
+//line sql.y:753
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:680
+//line sql.y:759
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:685
+//line sql.y:764
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:690
+//line sql.y:769
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:696
+//line sql.y:775
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:701
+//line sql.y:780
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:707
+//line sql.y:786
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:713
+//line sql.y:792
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 90:
+	case 97:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:797
+		{
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, Temporary: true}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 98:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:720
+//line sql.y:804
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 91:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:727
+//line sql.y:811
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 92:
+	case 100:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:731
+//line sql.y:815
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 93:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:737
+//line sql.y:821
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 94:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:742
+//line sql.y:826
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 95:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:746
+//line sql.y:830
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 96:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:750
+//line sql.y:834
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 97:
+	case 105:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:756
+//line sql.y:840
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4261,856 +4596,856 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 98:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:767
+//line sql.y:851
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 102:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:778
+//line sql.y:862
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 103:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:783
+//line sql.y:867
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 104:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:789
+//line sql.y:873
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 105:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:793
+//line sql.y:877
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 106:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:797
+//line sql.y:881
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:801
+//line sql.y:885
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 108:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:805
+//line sql.y:889
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 109:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:809
+//line sql.y:893
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 110:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:813
+//line sql.y:897
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 111:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:817
+//line sql.y:901
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 112:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:821
+//line sql.y:905
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 113:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:827
+//line sql.y:911
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 114:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:833
+//line sql.y:917
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 115:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:839
+//line sql.y:923
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 116:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:845
+//line sql.y:929
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 117:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:851
+//line sql.y:935
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 118:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:943
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 119:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:863
+//line sql.y:947
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 120:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:867
+//line sql.y:951
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 121:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:871
+//line sql.y:955
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 122:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:875
+//line sql.y:959
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:881
+//line sql.y:965
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 124:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:885
+//line sql.y:969
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 125:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:889
+//line sql.y:973
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 126:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:893
+//line sql.y:977
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 127:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:897
+//line sql.y:981
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 128:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:901
+//line sql.y:985
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 129:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:905
+//line sql.y:989
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 130:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:909
+//line sql.y:993
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 131:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:913
+//line sql.y:997
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:917
+//line sql.y:1001
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:921
+//line sql.y:1005
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:925
+//line sql.y:1009
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:929
+//line sql.y:1013
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 144:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:933
+//line sql.y:1017
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 137:
+	case 145:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:938
+//line sql.y:1022
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 138:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:944
+//line sql.y:1028
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:948
+//line sql.y:1032
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:952
+//line sql.y:1036
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:956
+//line sql.y:1040
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:960
+//line sql.y:1044
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 143:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:964
+//line sql.y:1048
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 144:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:968
+//line sql.y:1052
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 145:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:972
+//line sql.y:1056
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 146:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:978
+//line sql.y:1062
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 147:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:983
+//line sql.y:1067
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 148:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:988
+//line sql.y:1072
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 149:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:992
+//line sql.y:1076
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 150:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:997
+//line sql.y:1081
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 151:
+	case 159:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1001
+//line sql.y:1085
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 152:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1009
+//line sql.y:1093
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 153:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1013
+//line sql.y:1097
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 154:
+	case 162:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1019
+//line sql.y:1103
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 155:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1027
+//line sql.y:1111
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 156:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1031
+//line sql.y:1115
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 157:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1036
+//line sql.y:1120
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 158:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1040
+//line sql.y:1124
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 159:
+	case 167:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1046
+//line sql.y:1130
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 160:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1050
+//line sql.y:1134
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 161:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1138
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 162:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1059
+//line sql.y:1143
 		{
 			yyVAL.optVal = nil
 		}
-	case 163:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1063
+//line sql.y:1147
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 164:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1068
+//line sql.y:1152
 		{
 			yyVAL.optVal = nil
 		}
-	case 165:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1072
+//line sql.y:1156
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 166:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1077
+//line sql.y:1161
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 167:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1081
+//line sql.y:1165
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 168:
+	case 176:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1086
+//line sql.y:1170
 		{
 			yyVAL.str = ""
 		}
-	case 169:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1090
+//line sql.y:1174
 		{
 			yyVAL.str = string(yyDollar[3].colIdent.String())
 		}
-	case 170:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1094
+//line sql.y:1178
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 171:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1099
+//line sql.y:1183
 		{
 			yyVAL.str = ""
 		}
-	case 172:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1103
+//line sql.y:1187
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 173:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1107
+//line sql.y:1191
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 174:
+	case 182:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1112
+//line sql.y:1196
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 175:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1116
+//line sql.y:1200
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 176:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1120
+//line sql.y:1204
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 177:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1124
+//line sql.y:1208
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 178:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1128
+//line sql.y:1212
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 179:
+	case 187:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1133
+//line sql.y:1217
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 180:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1137
+//line sql.y:1221
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 181:
+	case 189:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1143
+//line sql.y:1227
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 182:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1147
+//line sql.y:1231
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 183:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1153
+//line sql.y:1237
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 184:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1157
+//line sql.y:1241
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 185:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1163
+//line sql.y:1247
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].colIdent.String())}
 		}
-	case 186:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1167
+//line sql.y:1251
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 187:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1172
+//line sql.y:1256
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 188:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1178
+//line sql.y:1262
 		{
 			yyVAL.str = ""
 		}
-	case 189:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1266
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1188
+//line sql.y:1272
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 191:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1192
+//line sql.y:1276
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 192:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1196
+//line sql.y:1280
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 193:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1200
+//line sql.y:1284
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 194:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1204
+//line sql.y:1288
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 195:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1294
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 196:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1298
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 197:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1302
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 198:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1225
+//line sql.y:1309
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 199:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1229
+//line sql.y:1313
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 200:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1235
+//line sql.y:1319
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 201:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1239
+//line sql.y:1323
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 202:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1244
+//line sql.y:1328
 		{
 			yyVAL.str = ""
 		}
-	case 203:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1332
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 204:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1254
+//line sql.y:1338
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 205:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1258
+//line sql.y:1342
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 206:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1348
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 207:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1270
+//line sql.y:1354
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 208:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1274
+//line sql.y:1358
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 209:
+	case 217:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1281
+//line sql.y:1365
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 210:
+	case 218:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1285
+//line sql.y:1369
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 211:
+	case 219:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1289
+//line sql.y:1373
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 212:
+	case 220:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1293
+//line sql.y:1377
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 213:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1299
+//line sql.y:1383
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 214:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1305
+//line sql.y:1389
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 215:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1311
+//line sql.y:1395
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 216:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1315
+//line sql.y:1399
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 217:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1319
+//line sql.y:1403
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 218:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1323
+//line sql.y:1407
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 219:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1327
+//line sql.y:1411
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 220:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1332
+//line sql.y:1416
 		{
 			yyVAL.str = ""
 		}
-	case 221:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1336
+//line sql.y:1420
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 222:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1340
+//line sql.y:1424
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 223:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1348
+//line sql.y:1432
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 224:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1352
+//line sql.y:1436
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 225:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1356
+//line sql.y:1440
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 226:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1362
+//line sql.y:1446
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 227:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1366
+//line sql.y:1450
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 228:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1370
+//line sql.y:1454
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 229:
+	case 237:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1376
+//line sql.y:1460
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 230:
+	case 238:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1380
+//line sql.y:1464
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 231:
+	case 239:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1384
+//line sql.y:1468
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 232:
+	case 240:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1388
+//line sql.y:1472
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 233:
+	case 241:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1393
+//line sql.y:1477
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 234:
+	case 242:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1398
+//line sql.y:1482
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 235:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1402
+//line sql.y:1486
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 236:
+	case 244:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1406
+//line sql.y:1490
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
-	case 237:
+	case 245:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1410
+//line sql.y:1494
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].colIdent.String())}
 		}
-	case 238:
+	case 246:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1414
+//line sql.y:1498
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -5122,9 +5457,9 @@ yydefault:
 				},
 			}
 		}
-	case 239:
+	case 247:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1426
+//line sql.y:1510
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -5134,21 +5469,21 @@ yydefault:
 				},
 			}
 		}
-	case 240:
+	case 248:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1436
+//line sql.y:1520
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 241:
+	case 249:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1440
+//line sql.y:1524
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 242:
+	case 250:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1444
+//line sql.y:1528
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -5161,9 +5496,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 243:
+	case 251:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1457
+//line sql.y:1541
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -5173,15 +5508,15 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 252:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1467
+//line sql.y:1551
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 245:
+	case 253:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1471
+//line sql.y:1555
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -5192,59 +5527,59 @@ yydefault:
 				},
 			}
 		}
-	case 260:
+	case 268:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1500
+//line sql.y:1584
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 261:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1506
+//line sql.y:1590
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 262:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1510
+//line sql.y:1594
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 263:
+	case 271:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1516
+//line sql.y:1600
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 264:
+	case 272:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1520
+//line sql.y:1604
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 265:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1526
+//line sql.y:1610
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 266:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1532
+//line sql.y:1616
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 267:
+	case 275:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1536
+//line sql.y:1620
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 268:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1544
+//line sql.y:1628
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5252,16 +5587,26 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 269:
+	case 277:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1636
+		{
+			var exists bool
+			if yyDollar[4].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[5].tableNames, IfExists: exists, Temporary: true}
+		}
+	case 278:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1552
+//line sql.y:1644
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 270:
+	case 279:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1557
+//line sql.y:1649
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5269,145 +5614,154 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 271:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1565
+//line sql.y:1657
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String()), IfExists: exists}
 		}
-	case 272:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1569
+//line sql.y:1665
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String())}
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].colIdent.String()), IfExists: exists}
 		}
-	case 273:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1575
+//line sql.y:1675
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 274:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1579
+//line sql.y:1679
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 275:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1584
+//line sql.y:1684
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 276:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1590
+//line sql.y:1690
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 277:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1595
+//line sql.y:1695
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Type: CharsetStr, ShowTablesOpt: showTablesOpt}
 		}
-	case 278:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1600
+//line sql.y:1700
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 279:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1605
+//line sql.y:1705
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1610
+//line sql.y:1710
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String())}
 		}
-	case 281:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1614
+//line sql.y:1714
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1618
+//line sql.y:1718
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 283:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1622
+//line sql.y:1722
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 284:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1626
+//line sql.y:1726
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1630
+//line sql.y:1730
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 295:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1634
+//line sql.y:1734
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 287:
+	case 296:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1638
+//line sql.y:1738
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 288:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1643
+//line sql.y:1743
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 289:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1647
+//line sql.y:1747
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 290:
+	case 299:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1651
+//line sql.y:1751
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 291:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1655
+	case 300:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1755
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 292:
+	case 301:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1659
+//line sql.y:1760
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 293:
+	case 302:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1664
+//line sql.y:1765
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5417,845 +5771,845 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 294:
+	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1674
+//line sql.y:1775
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1678
+//line sql.y:1779
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 296:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1682
+//line sql.y:1783
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: yyDollar[4].expr}
 		}
-	case 297:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1686
+//line sql.y:1787
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 298:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1691
+//line sql.y:1792
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1695
+//line sql.y:1796
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 309:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1699
+//line sql.y:1800
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 301:
+	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1703
+//line sql.y:1804
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1717
+//line sql.y:1818
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].colIdent.String())}
 		}
-	case 303:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1723
+//line sql.y:1824
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 304:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1727
+//line sql.y:1828
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 305:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1733
+//line sql.y:1834
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1737
+//line sql.y:1838
 		{
 			yyVAL.str = "extended "
 		}
-	case 307:
+	case 316:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1743
+//line sql.y:1844
 		{
 			yyVAL.str = ""
 		}
-	case 308:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1848
 		{
 			yyVAL.str = "full "
 		}
-	case 309:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1753
+//line sql.y:1854
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 310:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1757
+//line sql.y:1858
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 311:
+	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1763
+//line sql.y:1864
 		{
 			yyVAL.str = ""
 		}
-	case 312:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1868
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 313:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1771
+//line sql.y:1872
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 314:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1777
+//line sql.y:1878
 		{
 			yyVAL.showFilter = nil
 		}
-	case 315:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1781
+//line sql.y:1882
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 316:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1785
+//line sql.y:1886
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 317:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1791
+//line sql.y:1892
 		{
 			yyVAL.showFilter = nil
 		}
-	case 318:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1795
+//line sql.y:1896
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 319:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1801
+//line sql.y:1902
 		{
 			yyVAL.str = ""
 		}
-	case 320:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:1906
 		{
 			yyVAL.str = SessionStr
 		}
-	case 321:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:1910
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 322:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1815
+//line sql.y:1916
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 323:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1819
+//line sql.y:1920
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 324:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1825
+//line sql.y:1926
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 325:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1829
+//line sql.y:1930
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 326:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1835
+//line sql.y:1936
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 327:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1841
+//line sql.y:1942
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 328:
+	case 337:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1845
+//line sql.y:1946
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 329:
+	case 338:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1850
+//line sql.y:1951
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 330:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1852
+//line sql.y:1953
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 331:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1855
+//line sql.y:1956
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 332:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1857
+//line sql.y:1958
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 333:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1862
+//line sql.y:1963
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 334:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1868
+//line sql.y:1969
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 335:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1873
+//line sql.y:1974
 		{
 			yyVAL.str = ""
 		}
-	case 336:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1877
+//line sql.y:1978
 		{
 			yyVAL.str = JSONStr
 		}
-	case 337:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1881
+//line sql.y:1982
 		{
 			yyVAL.str = TreeStr
 		}
-	case 338:
+	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1885
+//line sql.y:1986
 		{
 			yyVAL.str = VitessStr
 		}
-	case 339:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1889
+//line sql.y:1990
 		{
 			yyVAL.str = TraditionalStr
 		}
-	case 340:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1893
+//line sql.y:1994
 		{
 			yyVAL.str = AnalyzeStr
 		}
-	case 341:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1899
+//line sql.y:2000
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 342:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1903
+//line sql.y:2004
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 343:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:2008
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 344:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:2014
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 345:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1917
+//line sql.y:2018
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 346:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1921
+//line sql.y:2022
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 347:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1925
+//line sql.y:2026
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 348:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1930
+//line sql.y:2031
 		{
 			yyVAL.str = ""
 		}
-	case 349:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1934
+//line sql.y:2035
 		{
 			yyVAL.str = ""
 		}
-	case 350:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1938
+//line sql.y:2039
 		{
 			yyVAL.str = ""
 		}
-	case 351:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1944
+//line sql.y:2045
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 352:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1948
+//line sql.y:2049
 		{
 			yyVAL.statement = &Explain{Type: yyDollar[2].str, Statement: yyDollar[3].statement}
 		}
-	case 353:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1954
+//line sql.y:2055
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 354:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1958
+//line sql.y:2059
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 355:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1962
+//line sql.y:2063
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 356:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:2067
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 357:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1972
+//line sql.y:2073
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 358:
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1976
+//line sql.y:2077
 		{
 			setAllowComments(yylex, true)
 		}
-	case 359:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1980
+//line sql.y:2081
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 360:
+	case 369:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1986
+//line sql.y:2087
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 361:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1990
+//line sql.y:2091
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 362:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1996
+//line sql.y:2097
 		{
 			yyVAL.str = UnionStr
 		}
-	case 363:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2000
+//line sql.y:2101
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 364:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2004
+//line sql.y:2105
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 365:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2009
+//line sql.y:2110
 		{
 			yyVAL.str = ""
 		}
-	case 366:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2013
+//line sql.y:2114
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 367:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2017
+//line sql.y:2118
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 368:
+	case 377:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2022
+//line sql.y:2123
 		{
 			yyVAL.str = ""
 		}
-	case 369:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2026
+//line sql.y:2127
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 370:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2030
+//line sql.y:2131
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 371:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2035
+//line sql.y:2136
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 372:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2039
+//line sql.y:2140
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 373:
+	case 382:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2044
+//line sql.y:2145
 		{
 			yyVAL.strs = nil
 		}
-	case 374:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2149
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 375:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2052
+//line sql.y:2153
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 376:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2056
+//line sql.y:2157
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 377:
+	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2060
+//line sql.y:2161
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 378:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2066
+//line sql.y:2167
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 379:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2070
+//line sql.y:2171
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 380:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2074
+//line sql.y:2175
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 381:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2078
+//line sql.y:2179
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 382:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2082
+//line sql.y:2183
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 383:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2187
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 384:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2092
+//line sql.y:2193
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 385:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2096
+//line sql.y:2197
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 386:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2102
+//line sql.y:2203
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 387:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2106
+//line sql.y:2207
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 388:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2110
+//line sql.y:2211
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 389:
+	case 398:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2114
+//line sql.y:2215
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 390:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2119
+//line sql.y:2220
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 391:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2123
+//line sql.y:2224
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 392:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2127
+//line sql.y:2228
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 394:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2134
+//line sql.y:2235
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 395:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2139
+//line sql.y:2240
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 396:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2143
+//line sql.y:2244
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 397:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2149
+//line sql.y:2250
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 398:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2153
+//line sql.y:2254
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 401:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2163
+//line sql.y:2264
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 402:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2167
+//line sql.y:2268
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 403:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2171
+//line sql.y:2272
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 404:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2177
+//line sql.y:2278
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 405:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2183
+//line sql.y:2284
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 406:
+	case 415:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2187
+//line sql.y:2288
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 407:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2193
+//line sql.y:2294
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 408:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2197
+//line sql.y:2298
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 409:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2203
+//line sql.y:2304
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 410:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2207
+//line sql.y:2308
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 411:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2220
+//line sql.y:2321
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 412:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2224
+//line sql.y:2325
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 413:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2228
+//line sql.y:2329
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 414:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2232
+//line sql.y:2333
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 415:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2238
+//line sql.y:2339
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 416:
+	case 425:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2240
+//line sql.y:2341
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 417:
+	case 426:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2244
+//line sql.y:2345
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 418:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2246
+//line sql.y:2347
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 419:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2250
+//line sql.y:2351
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 420:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2252
+//line sql.y:2353
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 421:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2255
+//line sql.y:2356
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 422:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2257
+//line sql.y:2358
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 423:
+	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2260
+//line sql.y:2361
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 424:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2264
+//line sql.y:2365
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 425:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2268
+//line sql.y:2369
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 427:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2275
+//line sql.y:2376
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 428:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2281
+//line sql.y:2382
 		{
 			yyVAL.str = JoinStr
 		}
-	case 429:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2285
+//line sql.y:2386
 		{
 			yyVAL.str = JoinStr
 		}
-	case 430:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2289
+//line sql.y:2390
 		{
 			yyVAL.str = JoinStr
 		}
-	case 431:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2295
+//line sql.y:2396
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 432:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2301
+//line sql.y:2402
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 433:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2305
+//line sql.y:2406
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 434:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2309
+//line sql.y:2410
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 435:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2313
+//line sql.y:2414
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 436:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2319
+//line sql.y:2420
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 437:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2323
+//line sql.y:2424
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -6263,489 +6617,489 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 438:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2333
+//line sql.y:2434
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 439:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2337
+//line sql.y:2438
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 440:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2343
+//line sql.y:2444
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 441:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2347
+//line sql.y:2448
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 442:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2353
+//line sql.y:2454
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 443:
+	case 452:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2358
+//line sql.y:2459
 		{
 			yyVAL.indexHints = nil
 		}
-	case 444:
+	case 453:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2362
+//line sql.y:2463
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 445:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2366
+//line sql.y:2467
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr}
 		}
-	case 446:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2370
+//line sql.y:2471
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 447:
+	case 456:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2374
+//line sql.y:2475
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 448:
+	case 457:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2379
+//line sql.y:2480
 		{
 			yyVAL.expr = nil
 		}
-	case 449:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2383
+//line sql.y:2484
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 450:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2389
+//line sql.y:2490
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 451:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2393
+//line sql.y:2494
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 452:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2397
+//line sql.y:2498
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 453:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2401
+//line sql.y:2502
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 454:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2405
+//line sql.y:2506
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 455:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2409
+//line sql.y:2510
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 456:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2413
+//line sql.y:2514
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 457:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2417
+//line sql.y:2518
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 458:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2423
+//line sql.y:2524
 		{
 			yyVAL.str = ""
 		}
-	case 459:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2427
+//line sql.y:2528
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 460:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2433
+//line sql.y:2534
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 461:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2437
+//line sql.y:2538
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 462:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2544
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 463:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2447
+//line sql.y:2548
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 464:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2451
+//line sql.y:2552
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 465:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2455
+//line sql.y:2556
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 466:
+	case 475:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2459
+//line sql.y:2560
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 467:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2463
+//line sql.y:2564
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 468:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2467
+//line sql.y:2568
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 469:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2471
+//line sql.y:2572
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 470:
+	case 479:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2475
+//line sql.y:2576
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 471:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2479
+//line sql.y:2580
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 472:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2485
+//line sql.y:2586
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 473:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2489
+//line sql.y:2590
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 474:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2493
+//line sql.y:2594
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 475:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2598
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 476:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2501
+//line sql.y:2602
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 477:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2505
+//line sql.y:2606
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 478:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2511
+//line sql.y:2612
 		{
 			yyVAL.str = EqualStr
 		}
-	case 479:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2515
+//line sql.y:2616
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 480:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2519
+//line sql.y:2620
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 481:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2523
+//line sql.y:2624
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 482:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2527
+//line sql.y:2628
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 483:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2531
+//line sql.y:2632
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 484:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2535
+//line sql.y:2636
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 485:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2540
+//line sql.y:2641
 		{
 			yyVAL.expr = nil
 		}
-	case 486:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2544
+//line sql.y:2645
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 487:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2550
+//line sql.y:2651
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 488:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2554
+//line sql.y:2655
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 489:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2558
+//line sql.y:2659
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 490:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2564
+//line sql.y:2665
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 491:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2570
+//line sql.y:2671
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 492:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2574
+//line sql.y:2675
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 493:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2580
+//line sql.y:2681
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 494:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2584
+//line sql.y:2685
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 495:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2588
+//line sql.y:2689
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 496:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2592
+//line sql.y:2693
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 497:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2596
+//line sql.y:2697
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 498:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2600
+//line sql.y:2701
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 499:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2604
+//line sql.y:2705
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 500:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2608
+//line sql.y:2709
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 501:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2612
+//line sql.y:2713
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 502:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2616
+//line sql.y:2717
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 503:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2620
+//line sql.y:2721
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 504:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2624
+//line sql.y:2725
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 505:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2628
+//line sql.y:2729
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 506:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2632
+//line sql.y:2733
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 507:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2636
+//line sql.y:2737
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 508:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2640
+//line sql.y:2741
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 509:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2644
+//line sql.y:2745
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 510:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2648
+//line sql.y:2749
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 511:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2652
+//line sql.y:2753
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 512:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+//line sql.y:2757
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 513:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2660
+//line sql.y:2761
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 514:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2664
+//line sql.y:2765
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 515:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2668
+//line sql.y:2769
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Str, Expr: yyDollar[2].expr}
 		}
-	case 516:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2672
+//line sql.y:2773
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 517:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2676
+//line sql.y:2777
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Str, Expr: yyDollar[2].expr}
 		}
-	case 518:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2680
+//line sql.y:2781
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6753,9 +7107,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 519:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2688
+//line sql.y:2789
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6769,21 +7123,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 520:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2702
+//line sql.y:2803
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 521:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2706
+//line sql.y:2807
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 522:
+	case 531:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2710
+//line sql.y:2811
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6791,497 +7145,623 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 527:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2728
+	case 536:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2829
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 528:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2732
+//line sql.y:2833
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 529:
+	case 538:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2736
+//line sql.y:2837
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 530:
+	case 539:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2740
+//line sql.y:2841
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 531:
+	case 540:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2846
+		{
+			yyVAL.overClause = nil
+		}
+	case 541:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2850
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 542:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2854
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 543:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2750
+//line sql.y:2860
+		{
+			yyVAL.windowSpec = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].frameClause}
+		}
+	case 544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2867
+		{
+			yyVAL.colIdent = NewColIdent("")
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2871
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 546:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2876
+		{
+			yyVAL.exprs = nil
+		}
+	case 547:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2880
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 548:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2885
+		{
+			yyVAL.frameClause = nil
+		}
+	case 549:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2889
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
+		}
+	case 550:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2893
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2899
+		{
+			yyVAL.str = RowsStr
+		}
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2903
+		{
+			yyVAL.str = RangeStr
+		}
+	case 553:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2909
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
+		}
+	case 554:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2913
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
+		}
+	case 555:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2917
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
+		}
+	case 556:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2921
+		{
+			yyVAL.framePoint = &FramePoint{Type: PrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 557:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2925
+		{
+			yyVAL.framePoint = &FramePoint{Type: FollowingStr, Expr: yyDollar[1].expr}
+		}
+	case 558:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2933
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 559:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2937
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 560:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2941
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 561:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2951
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 532:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2754
+//line sql.y:2955
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 533:
+	case 563:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2758
+//line sql.y:2959
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 534:
+	case 564:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2762
+//line sql.y:2963
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 535:
+	case 565:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2766
+//line sql.y:2967
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 536:
+	case 566:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2770
+//line sql.y:2971
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 537:
+	case 567:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2774
+//line sql.y:2975
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 538:
+	case 568:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2778
+//line sql.y:2979
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 539:
+	case 569:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2782
+//line sql.y:2983
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 540:
+	case 570:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2786
+//line sql.y:2987
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 541:
+	case 571:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2790
+//line sql.y:2991
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 542:
+	case 572:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2794
+//line sql.y:2995
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 543:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2798
+//line sql.y:2999
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 544:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2808
+//line sql.y:3009
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 545:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2812
+//line sql.y:3013
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 546:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2816
+//line sql.y:3017
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 547:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2821
+//line sql.y:3022
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 548:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2826
+//line sql.y:3027
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 549:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2831
+//line sql.y:3032
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 550:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2837
+//line sql.y:3038
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 551:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2842
+//line sql.y:3043
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 552:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2847
+//line sql.y:3048
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 553:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2851
+//line sql.y:3052
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 554:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2855
+//line sql.y:3056
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 555:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2860
+//line sql.y:3061
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 556:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2865
+//line sql.y:3066
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 557:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2870
+//line sql.y:3071
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 558:
+	case 588:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2874
+//line sql.y:3075
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 559:
+	case 589:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2878
+//line sql.y:3079
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 562:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2888
+//line sql.y:3089
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 563:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2898
+//line sql.y:3099
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 564:
+	case 594:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2902
+//line sql.y:3103
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 565:
+	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2906
+//line sql.y:3107
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 566:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2910
+//line sql.y:3111
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 567:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2914
+//line sql.y:3115
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 568:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2918
+//line sql.y:3119
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 569:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2922
+//line sql.y:3123
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 570:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:3129
 		{
 			yyVAL.str = ""
 		}
-	case 571:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2932
+//line sql.y:3133
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 572:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2936
+//line sql.y:3137
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 573:
+	case 603:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2940
+//line sql.y:3141
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 574:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2944
+//line sql.y:3145
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 575:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:3151
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 576:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2954
+//line sql.y:3155
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 577:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2960
+//line sql.y:3161
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 578:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2964
+//line sql.y:3165
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 579:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2968
+//line sql.y:3169
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 580:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2972
+//line sql.y:3173
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 581:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2976
+//line sql.y:3177
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 582:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2980
+//line sql.y:3181
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 583:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2986
+//line sql.y:3187
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 584:
+	case 614:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2990
+//line sql.y:3191
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 585:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2994
+//line sql.y:3195
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 586:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2998
+//line sql.y:3199
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 587:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3002
+//line sql.y:3203
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 588:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3006
+//line sql.y:3207
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 589:
+	case 619:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3010
+//line sql.y:3211
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 590:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3015
+//line sql.y:3216
 		{
 			yyVAL.expr = nil
 		}
-	case 591:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3019
+//line sql.y:3220
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 592:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3024
+//line sql.y:3225
 		{
-			yyVAL.str = string("")
+			yyVAL.str = string(",")
 		}
-	case 593:
+	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3028
+//line sql.y:3229
 		{
-			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
+			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 594:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3034
+//line sql.y:3235
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 595:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3038
+//line sql.y:3239
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 596:
+	case 626:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3044
+//line sql.y:3245
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 597:
+	case 627:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3049
+//line sql.y:3250
 		{
 			yyVAL.expr = nil
 		}
-	case 598:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3053
+//line sql.y:3254
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 599:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3059
+//line sql.y:3260
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 600:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3063
+//line sql.y:3264
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 601:
+	case 631:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3067
+//line sql.y:3268
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 602:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3073
+//line sql.y:3274
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 603:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3077
+//line sql.y:3278
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 604:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3081
+//line sql.y:3282
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 605:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3085
+//line sql.y:3286
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 606:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
+//line sql.y:3290
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 607:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3294
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 608:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3097
+//line sql.y:3298
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 609:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3101
+//line sql.y:3302
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 610:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3107
+//line sql.y:3308
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -7290,225 +7770,255 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 611:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3116
+//line sql.y:3317
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 612:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3120
+//line sql.y:3321
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 613:
+	case 643:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3125
+//line sql.y:3326
 		{
 			yyVAL.exprs = nil
 		}
-	case 614:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3129
+//line sql.y:3330
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 615:
+	case 645:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3335
+		{
+			yyVAL.namedWindows = nil
+		}
+	case 646:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3339
+		{
+			yyVAL.namedWindows = yyDollar[2].namedWindows
+		}
+	case 647:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3345
+		{
+			yyVAL.namedWindows = NamedWindows{yyDollar[1].namedWindow}
+		}
+	case 648:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3349
+		{
+			yyVAL.namedWindows = append(yyDollar[1].namedWindows, yyDollar[3].namedWindow)
+		}
+	case 649:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3355
+		{
+			yyVAL.namedWindow = &NamedWindow{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpec}
+		}
+	case 650:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3134
+//line sql.y:3360
 		{
 			yyVAL.expr = nil
 		}
-	case 616:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3138
+//line sql.y:3364
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 617:
+	case 652:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3143
+//line sql.y:3369
 		{
 			yyVAL.orderBy = nil
 		}
-	case 618:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3147
+//line sql.y:3373
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 619:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3153
+//line sql.y:3379
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 620:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3157
+//line sql.y:3383
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 621:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3163
+//line sql.y:3389
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 622:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3168
+//line sql.y:3394
 		{
 			yyVAL.str = AscScr
 		}
-	case 623:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3172
+//line sql.y:3398
 		{
 			yyVAL.str = AscScr
 		}
-	case 624:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3176
+//line sql.y:3402
 		{
 			yyVAL.str = DescScr
 		}
-	case 625:
+	case 660:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3181
+//line sql.y:3407
 		{
 			yyVAL.limit = nil
 		}
-	case 626:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3185
+//line sql.y:3411
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 627:
+	case 662:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3189
+//line sql.y:3415
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 628:
+	case 663:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3193
+//line sql.y:3419
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 629:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3198
+//line sql.y:3424
 		{
 			yyVAL.str = ""
 		}
-	case 630:
+	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3202
+//line sql.y:3428
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 631:
+	case 666:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3206
+//line sql.y:3432
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 632:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3219
+//line sql.y:3445
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 633:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3223
+//line sql.y:3449
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 634:
+	case 669:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3227
+//line sql.y:3453
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 635:
+	case 670:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3231
+//line sql.y:3457
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 636:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3237
+//line sql.y:3463
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 637:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3241
+//line sql.y:3467
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 638:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3245
+//line sql.y:3471
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 639:
+	case 674:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3249
+//line sql.y:3475
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 640:
+	case 675:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3254
+//line sql.y:3480
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 641:
+	case 676:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3258
+//line sql.y:3484
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 642:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3264
+//line sql.y:3490
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 643:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3268
+//line sql.y:3494
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 644:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3274
+//line sql.y:3500
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 645:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3278
+//line sql.y:3504
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 646:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3284
+//line sql.y:3510
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 647:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3290
+//line sql.y:3516
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -7516,319 +8026,331 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 648:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3300
+//line sql.y:3526
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 649:
+	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3304
+//line sql.y:3530
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 650:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3310
+//line sql.y:3536
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 651:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3316
+//line sql.y:3542
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 652:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3320
+//line sql.y:3546
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].str
 			yyVAL.setExprs = SetExprs{yyDollar[2].setExpr}
 		}
-	case 653:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3325
+//line sql.y:3551
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 654:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3331
+//line sql.y:3557
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 655:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3335
+//line sql.y:3561
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 656:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3339
+//line sql.y:3565
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 657:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3343
+//line sql.y:3569
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 659:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3350
+//line sql.y:3576
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 661:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3357
+//line sql.y:3583
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 662:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3361
+//line sql.y:3587
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 663:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3365
+//line sql.y:3591
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 666:
+	case 701:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3374
+//line sql.y:3600
 		{
 			yyVAL.byt = 0
 		}
-	case 667:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3376
+//line sql.y:3602
 		{
 			yyVAL.byt = 1
 		}
-	case 668:
+	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3379
+//line sql.y:3605
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.byt = 0
 		}
-	case 669:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3381
+//line sql.y:3607
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.byt = 1
 		}
-	case 670:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3384
+//line sql.y:3610
 		{
 			yyVAL.str = ""
 		}
-	case 671:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3386
+//line sql.y:3612
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 672:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3390
+//line sql.y:3616
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 673:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3392
+//line sql.y:3618
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 674:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3394
+//line sql.y:3620
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 675:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3396
+//line sql.y:3622
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 676:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3398
+//line sql.y:3624
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 677:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3400
+//line sql.y:3626
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 678:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3402
+//line sql.y:3628
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 679:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3404
+//line sql.y:3630
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 680:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3406
+//line sql.y:3632
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 681:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3408
+//line sql.y:3634
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 682:
+	case 717:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3411
+//line sql.y:3637
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 683:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3413
+//line sql.y:3639
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 684:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3415
+//line sql.y:3641
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 685:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3419
+//line sql.y:3645
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 686:
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3421
+//line sql.y:3647
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 687:
+	case 722:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3424
+//line sql.y:3650
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 688:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3426
+//line sql.y:3652
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 689:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3428
+//line sql.y:3654
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 690:
+	case 725:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3431
+//line sql.y:3657
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 691:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3433
+//line sql.y:3659
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 692:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3437
+//line sql.y:3663
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 693:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3441
+//line sql.y:3667
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 695:
+	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3448
+//line sql.y:3674
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 696:
+	case 731:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3681
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3454
+//line sql.y:3687
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 697:
+	case 733:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3691
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3458
+//line sql.y:3698
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 699:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3465
+//line sql.y:3702
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 994:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3785
+//line sql.y:4026
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 995:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3794
+//line sql.y:4035
 		{
 			decNesting(yylex)
 		}
-	case 996:
+	case 1037:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3799
+//line sql.y:4040
 		{
 			skipToEnd(yylex)
 		}
-	case 997:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3804
+//line sql.y:4045
 		{
 			skipToEnd(yylex)
 		}
-	case 998:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3808
+//line sql.y:4049
 		{
 			skipToEnd(yylex)
 		}
-	case 999:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3812
+//line sql.y:4053
 		{
 			skipToEnd(yylex)
 		}
@@ -54,6 +54,15 @@ func skipToEnd(yylex interface{}) {
   empty         struct{}
   statement     Statement
   selStmt       SelectStatement
+  with          *With
+  cte           *CommonTableExpr
+  ctes          []*CommonTableExpr
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  namedWindow   *NamedWindow
+  namedWindows  NamedWindows
   ddl           *DDL
   ins           *Insert
   byt           byte
@@ -166,7 +175,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER TEMPORARY
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES WARNINGS
 %token <bytes> SEQUENCE
@@ -207,18 +216,34 @@ func skipToEnd(yylex interface{}) {
 
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <bytes> NTH_VALUE NTILE OF PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM
+%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <bytes> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
-%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
+%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRIVILEGE_CHECKS_USER PROCESS
 %token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
-%token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
+%token <bytes> THREAD_PRIORITY TIES VCPU VISIBLE
+
+// Window functions
+%token <bytes> OVER WINDOW ROWS RANGE ROW CURRENT UNBOUNDED PRECEDING FOLLOWING
 
 // Explain tokens
 %token <bytes> FORMAT TREE VITESS TRADITIONAL
 
 %type <statement> command
 %type <selStmt> simple_select select_statement base_select union_rhs
+%type <with> with_clause
+%type <ctes> common_table_expr_list
+%type <cte> common_table_expr
+%type <overClause> over_clause_opt
+%type <windowSpec> window_spec
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <namedWindow> named_window
+%type <namedWindows> window_clause_opt named_window_list
+%type <colIdent> window_name_opt
+%type <exprs> partition_by_opt
+%type <str> frame_unit
+%type <expr> frame_offset
 %type <statement> explain_statement explainable_statement
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
@@ -283,8 +308,8 @@ func skipToEnd(yylex interface{}) {
 %type <str> ignore_opt default_opt
 %type <str> full_opt from_database_opt tables_or_processlist columns_or_fields extended_opt
 %type <showFilter> like_or_where_opt like_opt
-%type <byt> exists_opt
-%type <empty> not_exists_opt non_add_drop_or_rename_operation to_opt index_opt constraint_opt
+%type <byt> exists_opt not_exists_opt
+%type <empty> non_add_drop_or_rename_operation to_opt index_opt constraint_opt
 %type <bytes> reserved_keyword non_reserved_keyword
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt using_opt
 %type <expr> charset_value
@@ -404,6 +429,15 @@ select_statement:
     sel.Lock = $4
     $$ = sel
   }
+| with_clause base_select order_by_opt limit_opt lock_opt
+  {
+    sel := $2.(*Select)
+    sel.With = $1
+    sel.OrderBy = $3
+    sel.Limit = $4
+    sel.Lock = $5
+    $$ = sel
+  }
 | openb select_statement closeb order_by_opt limit_opt lock_opt
   {
     $$ = &Union{FirstStatement: &ParenSelect{Select: $2}, OrderBy: $4, Limit:$5, Lock:$6}
@@ -443,11 +477,46 @@ simple_select:
     sel.Lock = $4
     $$ = sel
   }
+| with_clause base_select order_by_opt limit_opt lock_opt
+  {
+    sel := $2.(*Select)
+    sel.With = $1
+    sel.OrderBy = $3
+    sel.Limit = $4
+    sel.Lock = $5
+    $$ = sel
+  }
 | simple_select union_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = Unionize($1, $3, $2, $4, $5, $6)
   }
 
+with_clause:
+  WITH common_table_expr_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+
+common_table_expr_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| common_table_expr_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id AS derived_table
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: nil, Subquery: $3}
+  }
+| table_id openb column_list closeb AS derived_table
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $3, Subquery: $6}
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -456,10 +525,12 @@ stream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereStr, $6), GroupBy($7), NewWhere(HavingStr, $8)) 
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereStr, $6), GroupBy($7), NewWhere(HavingStr, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 union_rhs:
@@ -659,11 +730,19 @@ create_statement:
   }
 | CREATE DATABASE not_exists_opt id_or_var ddl_skip_to_end
   {
-    $$ = &DBDDL{Action: CreateStr, DBName: string($4.String())}
+    var notExists bool
+    if $3 != 0 {
+      notExists = true
+    }
+    $$ = &DBDDL{Action: CreateStr, DBName: string($4.String()), IfNotExists: notExists}
   }
 | CREATE SCHEMA not_exists_opt id_or_var ddl_skip_to_end
   {
-    $$ = &DBDDL{Action: CreateStr, DBName: string($4.String())}
+    var notExists bool
+    if $3 != 0 {
+      notExists = true
+    }
+    $$ = &DBDDL{Action: CreateStr, DBName: string($4.String()), IfNotExists: notExists}
   }
 
 vindex_type_opt:
@@ -714,6 +793,11 @@ create_table_prefix:
     $$ = &DDL{Action: CreateStr, Table: $4}
     setDDL(yylex, $$)
   }
+| CREATE TEMPORARY TABLE not_exists_opt table_name
+  {
+    $$ = &DDL{Action: CreateStr, Table: $5, Temporary: true}
+    setDDL(yylex, $$)
+  }
 
 table_spec:
   '(' table_column_list ')' table_option_list
@@ -1548,6 +1632,14 @@ drop_statement:
     }
     $$ = &DDL{Action: DropStr, FromTables: $4, IfExists: exists}
   }
+| DROP TEMPORARY TABLE exists_opt table_name_list
+  {
+    var exists bool
+    if $4 != 0 {
+      exists = true
+    }
+    $$ = &DDL{Action: DropStr, FromTables: $5, IfExists: exists, Temporary: true}
+  }
 | DROP INDEX id_or_var ON table_name ddl_skip_to_end
   {
     // Change this to an alter statement
@@ -1563,11 +1655,19 @@ drop_statement:
   }
 | DROP DATABASE exists_opt id_or_var
   {
-    $$ = &DBDDL{Action: DropStr, DBName: string($4.String())}
+    var exists bool
+    if $3 != 0 {
+      exists = true
+    }
+    $$ = &DBDDL{Action: DropStr, DBName: string($4.String()), IfExists: exists}
   }
 | DROP SCHEMA exists_opt id_or_var
   {
-    $$ = &DBDDL{Action: DropStr, DBName: string($4.String())}
+    var exists bool
+    if $3 != 0 {
+      exists = true
+    }
+    $$ = &DBDDL{Action: DropStr, DBName: string($4.String()), IfExists: exists}
   }
 
 truncate_statement:
@@ -1651,9 +1751,10 @@ show_statement:
   {
     $$ = &Show{Scope: $2, Type: string($3)}
   }
-| SHOW TABLE ddl_skip_to_end
+| SHOW TABLE STATUS from_database_opt like_or_where_opt
   {
-    $$ = &Show{Type: string($2)}
+    showTablesOpt := &ShowTablesOpt{DbName:$4, Filter:$5}
+    $$ = &Show{Type: string($2) + " " + string($3), ShowTablesOpt: showTablesOpt}
   }
 | SHOW full_opt columns_or_fields FROM table_name from_database_opt like_or_where_opt
   {
@@ -2724,9 +2825,9 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -2741,6 +2842,106 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+
+// The name of an existing window can only be an ID because
+// PARTITION is a non-reserved keyword.
+window_name_opt:
+  {
+    $$ = NewColIdent("")
+  }
+| ID
+  {
+    $$ = NewColIdent(string($1))
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| frame_offset PRECEDING
+  {
+    $$ = &FramePoint{Type: PrecedingStr, Expr: $1}
+  }
+| frame_offset FOLLOWING
+  {
+    $$ = &FramePoint{Type: FollowingStr, Expr: $1}
+  }
+
+// MySQL only allows integer literals, parameter markers
+// and intervals as frame offsets.
+frame_offset:
+  INTEGRAL
+  {
+    $$ = NewIntVal($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewValArg($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -3022,11 +3223,11 @@ expression_opt:
 
 separator_opt:
   {
-    $$ = string("")
+    $$ = string(",")
   }
 | SEPARATOR STRING
   {
-    $$ = " separator '"+string($2)+"'"
+    $$ = string($2)
   }
 
 when_expression_list:
@@ -3130,6 +3331,31 @@ group_by_opt:
     $$ = $3
   }
 
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &NamedWindow{Name: $1, WindowSpec: $4}
+  }
+
 having_opt:
   {
     $$ = nil
@@ -3376,9 +3602,9 @@ exists_opt:
   { $$ = 1 }
 
 not_exists_opt:
-  { $$ = struct{}{} }
+  { $$ = 0 }
 | IF NOT EXISTS
-  { $$ = struct{}{} }
+  { $$ = 1 }
 
 ignore_opt:
   { $$ = "" }
@@ -3441,6 +3667,13 @@ sql_id:
   {
     $$ = NewColIdent(string($1))
   }
+// WITH isn't in non_reserved_keyword because it can't be a table name:
+// it would be ambiguous with the WITH clause of a derived table or of an
+// explain statement. Columns and qualified table names can still use it.
+| WITH
+  {
+    $$ = NewColIdent(string($1))
+  }
 
 reserved_sql_id:
   sql_id
@@ -3465,6 +3698,10 @@ reserved_table_id:
   {
     $$ = NewTableIdent(string($1))
   }
+| WITH
+  {
+    $$ = NewTableIdent(string($1))
+  }
 
 /*
   These are not all necessarily reserved in MySQL, but some are.
@@ -3561,12 +3798,15 @@ reserved_keyword:
 | OUTER
 | OVER
 | PERCENT_RANK
+| RANGE
 | RANK
 | RECURSIVE
 | REGEXP
 | RENAME
 | REPLACE
 | RIGHT
+| ROW
+| ROWS
 | ROW_NUMBER
 | SCHEMA
 | SELECT
@@ -3627,6 +3867,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3746,6 +3987,7 @@ non_reserved_keyword:
 | START
 | STATUS
 | TABLES
+| TEMPORARY
 | TEXT
 | THAN
 | THREAD_PRIORITY
@@ -3775,7 +4017,6 @@ non_reserved_keyword:
 | VITESS_METADATA
 | VSCHEMA
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
@@ -135,6 +135,7 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -185,6 +186,7 @@ var keywords = map[string]int{
 	"float4":              UNUSED,
 	"float8":              UNUSED,
 	"flush":               FLUSH,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
@@ -292,16 +294,18 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"over":                OVER,
 	"partition":           PARTITION,
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
@@ -323,6 +327,8 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"savepoint":           SAVEPOINT,
 	"schema":              SCHEMA,
 	"second_microsecond":  UNUSED,
@@ -358,6 +364,7 @@ var keywords = map[string]int{
 	"stream":              STREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
+	"temporary":           TEMPORARY,
 	"terminated":          UNUSED,
 	"text":                TEXT,
 	"than":                THAN,
@@ -377,6 +384,7 @@ var keywords = map[string]int{
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
@@ -407,6 +415,7 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"work":                WORK,
 	"write":               WRITE,
@@ -25,6 +25,7 @@ import (
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
@@ -64,8 +65,10 @@ func initVtgateExecutor(vSchemaStr string, opts *Options) error {
 	vtgateSession.TargetString = opts.Target
 
 	streamSize := 10
-	queryPlanCacheSize := int64(10)
-	vtgateExecutor = vtgate.NewExecutor(context.Background(), explainTopo, vtexplainCell, resolver, opts.Normalize, streamSize, queryPlanCacheSize)
+	// The plans are collected from the cache after every query,
+	// so an entry-bounded LRU cache is all that's needed.
+	cacheCfg := &cache.Config{MaxEntries: 10}
+	vtgateExecutor = vtgate.NewExecutor(context.Background(), explainTopo, vtexplainCell, resolver, opts.Normalize, streamSize, cacheCfg)
 
 	return nil
 }
@@ -0,0 +1,247 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	dbDDLPluginName = flag.String("dbddl_plugin", "topo", "the plugin that handles CREATE DATABASE and DROP DATABASE. 'topo' creates and deletes unsharded keyspaces, 'fail' rejects the statements")
+	dbDDLTimeout    = flag.Duration("dbddl_timeout", 1*time.Minute, "how long CREATE DATABASE and DROP DATABASE wait for the tablets of the database to be up or gone")
+
+	// dbDDLPollInterval is how often the topo plugin checks the
+	// tablets of a database that's being created or dropped.
+	dbDDLPollInterval = 500 * time.Millisecond
+)
+
+// DBDDLPlugin handles the CREATE DATABASE and DROP DATABASE statements.
+type DBDDLPlugin interface {
+	// CreateDatabase creates the database, and returns
+	// once it can be used.
+	CreateDatabase(ctx context.Context, name string, ifNotExists bool) error
+
+	// DropDatabase drops the database, and returns once
+	// it's gone.
+	DropDatabase(ctx context.Context, name string, ifExists bool) error
+}
+
+// DBDDLPluginCreator is the factory method which can create a DBDDLPlugin.
+type DBDDLPluginCreator func(serv srvtopo.Server, gw Gateway, cell string) DBDDLPlugin
+
+var dbDDLPluginCreators = make(map[string]DBDDLPluginCreator)
+
+// RegisterDBDDLPlugin registers a DBDDLPluginCreator with given name.
+func RegisterDBDDLPlugin(name string, creator DBDDLPluginCreator) {
+	if _, ok := dbDDLPluginCreators[name]; ok {
+		log.Fatalf("DB DDL plugin %s already exists", name)
+	}
+	dbDDLPluginCreators[name] = creator
+}
+
+func init() {
+	RegisterDBDDLPlugin("fail", func(srvtopo.Server, Gateway, string) DBDDLPlugin {
+		return failDBDDL{}
+	})
+	RegisterDBDDLPlugin("topo", func(serv srvtopo.Server, gw Gateway, cell string) DBDDLPlugin {
+		return &topoDBDDL{serv: serv, gw: gw, cell: cell}
+	})
+}
+
+// newDBDDLPlugin returns the plugin specified by the dbddl_plugin flag.
+func newDBDDLPlugin(serv srvtopo.Server, gw Gateway, cell string) DBDDLPlugin {
+	creator, ok := dbDDLPluginCreators[*dbDDLPluginName]
+	if !ok {
+		log.Exitf("No DB DDL plugin registered as %s", *dbDDLPluginName)
+	}
+	return creator(serv, gw, cell)
+}
+
+// failDBDDL rejects all the statements.
+type failDBDDL struct{}
+
+func (failDBDDL) CreateDatabase(ctx context.Context, name string, ifNotExists bool) error {
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "create database is not supported")
+}
+
+func (failDBDDL) DropDatabase(ctx context.Context, name string, ifExists bool) error {
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "drop database is not supported")
+}
+
+// topoDBDDL maps every database to an unsharded keyspace. It creates
+// the keyspace in the topo, and waits until the healthcheck reports a
+// serving master tablet for it. The tablets themselves are provisioned
+// outside of Vitess. When the database is dropped, the keyspace is
+// deleted once its tablets are gone.
+type topoDBDDL struct {
+	serv srvtopo.Server
+	gw   Gateway
+	cell string
+}
+
+func (t *topoDBDDL) CreateDatabase(ctx context.Context, name string, ifNotExists bool) error {
+	ts, err := t.serv.GetTopoServer()
+	if err != nil {
+		return err
+	}
+	if err := ts.CreateKeyspace(ctx, name, &topodatapb.Keyspace{}); err != nil {
+		if !topo.IsErrType(err, topo.NodeExists) {
+			return err
+		}
+		if ifNotExists {
+			return nil
+		}
+		return mysql.NewSQLError(mysql.ERDbCreateExists, mysql.SSUnknownSQLState, "Can't create database '%s'; database exists", name)
+	}
+	// A keyspace with an empty VSchema is unsharded.
+	if err := ts.SaveVSchema(ctx, name, &vschemapb.Keyspace{}); err != nil {
+		return err
+	}
+	if err := ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *dbDDLTimeout)
+	defer cancel()
+	for {
+		targets, err := t.masterTargets(ctx, name)
+		if err == nil {
+			if err := t.gw.WaitForTargets(ctx, targets); err != nil {
+				return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "database %s was created, but its tablets are not serving: %v", name, err)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "database %s was created, but its tablets are not serving: %v", name, err)
+		case <-time.After(dbDDLPollInterval):
+		}
+	}
+}
+
+// masterTargets returns the master targets of the keyspace. It fails
+// until the serving graph of the keyspace is built.
+func (t *topoDBDDL) masterTargets(ctx context.Context, keyspace string) ([]*querypb.Target, error) {
+	srvKeyspace, err := t.serv.GetSrvKeyspace(ctx, t.cell, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	var targets []*querypb.Target
+	for _, partition := range srvKeyspace.Partitions {
+		if partition.ServedType != topodatapb.TabletType_MASTER {
+			continue
+		}
+		for _, shard := range partition.ShardReferences {
+			targets = append(targets, &querypb.Target{
+				Cell:       t.cell,
+				Keyspace:   keyspace,
+				Shard:      shard.Name,
+				TabletType: topodatapb.TabletType_MASTER,
+			})
+		}
+	}
+	if len(targets) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "keyspace %s has no master shards", keyspace)
+	}
+	return targets, nil
+}
+
+func (t *topoDBDDL) DropDatabase(ctx context.Context, name string, ifExists bool) error {
+	ts, err := t.serv.GetTopoServer()
+	if err != nil {
+		return err
+	}
+	if _, err := ts.GetKeyspace(ctx, name); err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			return err
+		}
+		if ifExists {
+			return nil
+		}
+		return mysql.NewSQLError(mysql.ERDbDropExists, mysql.SSUnknownSQLState, "Can't drop database '%s'; database doesn't exist", name)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *dbDDLTimeout)
+	defer cancel()
+	var shards []string
+	for {
+		shards, err = ts.GetShardNames(ctx, name)
+		if err != nil {
+			return err
+		}
+		tablets := 0
+		for _, shard := range shards {
+			tabletMap, err := ts.GetTabletMapForShard(ctx, name, shard)
+			if err != nil {
+				return err
+			}
+			tablets += len(tabletMap)
+		}
+		if tablets == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "database %s still has %d tablets", name, tablets)
+		case <-time.After(dbDDLPollInterval):
+		}
+	}
+
+	cells, err := ts.GetKnownCells(ctx)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		for _, cell := range cells {
+			if err := ts.DeleteShardReplication(ctx, cell, name, shard); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return err
+			}
+		}
+		if err := ts.DeleteShard(ctx, name, shard); err != nil && !topo.IsErrType(err, topo.NoNode) {
+			return err
+		}
+	}
+	for _, cell := range cells {
+		if err := ts.DeleteSrvKeyspace(ctx, cell, name); err != nil && !topo.IsErrType(err, topo.NoNode) {
+			return err
+		}
+		if err := ts.DeleteKeyspaceReplication(ctx, cell, name); err != nil && !topo.IsErrType(err, topo.NoNode) {
+			return err
+		}
+	}
+	if err := ts.DeleteVSchema(ctx, name); err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return err
+	}
+	if err := ts.DeleteKeyspace(ctx, name); err != nil {
+		return err
+	}
+	return ts.RebuildSrvVSchema(ctx, cells)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// waitGateway is a Gateway that records the targets it waits for.
+type waitGateway struct {
+	Gateway
+	targets []*querypb.Target
+}
+
+func (gw *waitGateway) WaitForTargets(ctx context.Context, targets []*querypb.Target) error {
+	gw.targets = append(gw.targets, targets...)
+	return nil
+}
+
+func TestTopoDBDDL(t *testing.T) {
+	defer func(interval time.Duration) {
+		dbDDLPollInterval = interval
+	}(dbDDLPollInterval)
+	dbDDLPollInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("aa")
+	gw := &waitGateway{}
+	plugin := &topoDBDDL{serv: srvtopo.NewResilientServer(ts, "TestTopoDBDDL"), gw: gw, cell: "aa"}
+
+	// Create the serving graph the tablets will have built.
+	err := ts.UpdateSrvKeyspace(ctx, "aa", "db", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:      topodatapb.TabletType_MASTER,
+			ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+		}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, plugin.CreateDatabase(ctx, "db", false))
+	_, err = ts.GetKeyspace(ctx, "db")
+	require.NoError(t, err)
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "aa")
+	require.NoError(t, err)
+	assert.False(t, srvVSchema.Keyspaces["db"].Sharded)
+	assert.Equal(t, []*querypb.Target{{
+		Cell:       "aa",
+		Keyspace:   "db",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_MASTER,
+	}}, gw.targets)
+
+	err = plugin.CreateDatabase(ctx, "db", false)
+	assert.EqualError(t, err, "Can't create database 'db'; database exists (errno 1007) (sqlstate HY000)")
+	assert.NoError(t, plugin.CreateDatabase(ctx, "db", true))
+
+	// The keyspace isn't dropped while it has tablets.
+	require.NoError(t, ts.CreateShard(ctx, "db", "0"))
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "aa", Uid: 1},
+		Keyspace: "db",
+		Shard:    "0",
+	}
+	require.NoError(t, ts.CreateTablet(ctx, tablet))
+	shortCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err = plugin.DropDatabase(shortCtx, "db", false)
+	assert.EqualError(t, err, "database db still has 1 tablets")
+
+	require.NoError(t, ts.DeleteTablet(ctx, tablet.Alias))
+	require.NoError(t, plugin.DropDatabase(ctx, "db", false))
+	_, err = ts.GetKeyspace(ctx, "db")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), fmt.Sprintf("%v", err))
+	_, err = ts.GetSrvKeyspace(ctx, "aa", "db")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), fmt.Sprintf("%v", err))
+	srvVSchema, err = ts.GetSrvVSchema(ctx, "aa")
+	require.NoError(t, err)
+	assert.NotContains(t, srvVSchema.Keyspaces, "db")
+
+	err = plugin.DropDatabase(ctx, "db", false)
+	assert.EqualValues(t, mysql.ERDbDropExists, err.(*mysql.SQLError).Number())
+	assert.NoError(t, plugin.DropDatabase(ctx, "db", true))
+}
+
+// fakeDBDDL records the statements it handles.
+type fakeDBDDL struct {
+	log []string
+}
+
+func (f *fakeDBDDL) CreateDatabase(ctx context.Context, name string, ifNotExists bool) error {
+	f.log = append(f.log, fmt.Sprintf("create %s %v", name, ifNotExists))
+	return nil
+}
+
+func (f *fakeDBDDL) DropDatabase(ctx context.Context, name string, ifExists bool) error {
+	f.log = append(f.log, fmt.Sprintf("drop %s %v", name, ifExists))
+	return nil
+}
+
+func TestExecutorDBDDL(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	plugin := &fakeDBDDL{}
+	executor.dbDDL = plugin
+
+	for _, sql := range []string{
+		"create database db",
+		"create schema if not exists db",
+		"drop database db",
+		"drop database if exists db",
+	} {
+		qr, err := executorExec(executor, sql, nil)
+		require.NoError(t, err, sql)
+		assert.EqualValues(t, 1, qr.RowsAffected, sql)
+	}
+	assert.Equal(t, []string{
+		"create db false",
+		"create db true",
+		"drop db false",
+		"drop db true",
+	}, plugin.log)
+
+	executor.dbDDL = failDBDDL{}
+	_, err := executorExec(executor, "create database db", nil)
+	assert.EqualError(t, err, "create database is not supported")
+}
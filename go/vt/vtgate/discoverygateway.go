@@ -212,6 +212,11 @@ func (dg *DiscoveryGateway) WaitForTablets(ctx context.Context, tabletTypesToWai
 	return dg.tsc.WaitForAllServingTablets(ctx, targets)
 }
 
+// WaitForTargets is part of the Gateway interface.
+func (dg *DiscoveryGateway) WaitForTargets(ctx context.Context, targets []*querypb.Target) error {
+	return dg.tsc.WaitForAllServingTablets(ctx, targets)
+}
+
 // Close shuts down underlying connections.
 // This function hides the inner implementation.
 func (dg *DiscoveryGateway) Close(ctx context.Context) error {
@@ -235,6 +240,16 @@ func (dg *DiscoveryGateway) CacheStatus() TabletCacheStatusList {
 	return res
 }
 
+// TableStatistics is part of the Gateway interface.
+func (dg *DiscoveryGateway) TableStatistics(target *querypb.Target) []*querypb.TableStatistics {
+	for _, ts := range dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType) {
+		if stats := ts.Stats.GetTableStatistics(); stats != nil {
+			return stats
+		}
+	}
+	return nil
+}
+
 // withRetry gets available connections and executes the action. If there are retryable errors,
 // it retries retryCount times before failing. It does not retry if the connection is in
 // the middle of a transaction. While returning the error check if it maybe a result of
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"container/heap"
 	"sort"
 	"strings"
 	"sync"
@@ -35,6 +36,10 @@ var _ Primitive = (*Concatenate)(nil)
 //Concatenate specified the parameter for concatenate primitive
 type Concatenate struct {
 	Sources []Primitive
+
+	// OrderBy is set if the rows of every source are sorted by it.
+	// The sources are then merged, so that the result is also sorted.
+	OrderBy []OrderbyParams `json:",omitempty"`
 }
 
 //RouteType returns a description of the query routing type used by the primitive
@@ -79,6 +84,7 @@ func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		}(i, source)
 	}
 	wg.Wait()
+	var sourceRows [][][]sqltypes.Value
 	for i := 0; i < len(c.Sources); i++ {
 		if errs[i] != nil {
 			return nil, vterrors.Wrap(errs[i], "Concatenate.Execute")
@@ -97,6 +103,43 @@ func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.Bind
 				return nil, mysql.NewSQLError(mysql.ERWrongNumberOfColumnsInSelect, "21000", "The used SELECT statements have a different number of columns")
 			}
 			result.RowsAffected += qr.RowsAffected
+			sourceRows = append(sourceRows, qr.Rows)
+		}
+	}
+	if len(c.OrderBy) != 0 {
+		rows, err := mergeSortedRows(sourceRows, c.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = rows
+	}
+	return result, nil
+}
+
+// mergeSortedRows merges the row lists, which are all sorted by orderBy,
+// into a single sorted list.
+func mergeSortedRows(sourceRows [][][]sqltypes.Value, orderBy []OrderbyParams) ([][]sqltypes.Value, error) {
+	sh := &scatterHeap{
+		rows:    make([]streamRow, 0, len(sourceRows)),
+		orderBy: orderBy,
+	}
+	next := make([]int, len(sourceRows))
+	for i, rows := range sourceRows {
+		sh.rows = append(sh.rows, streamRow{row: rows[0], id: i})
+		next[i] = 1
+	}
+	heap.Init(sh)
+	var result [][]sqltypes.Value
+	for len(sh.rows) != 0 {
+		sr := heap.Pop(sh).(streamRow)
+		result = append(result, sr.row)
+		if next[sr.id] < len(sourceRows[sr.id]) {
+			sr.row = sourceRows[sr.id][next[sr.id]]
+			next[sr.id]++
+			heap.Push(sh, sr)
+		}
+		if sh.err != nil {
+			return nil, sh.err
 		}
 	}
 	return result, nil
@@ -104,6 +147,10 @@ func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.Bind
 
 // StreamExecute performs a streaming exec.
 func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	if len(c.OrderBy) != 0 {
+		return c.streamMerge(vcursor, bindVars, wantfields, callback)
+	}
+
 	var seenFields []*querypb.Field
 	var fieldset sync.WaitGroup
 	fieldsSent := false
@@ -154,6 +201,18 @@ func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 	return nil
 }
 
+// streamMerge merge-sorts the streams of the sources.
+// The fields are those of the first source.
+func (c *Concatenate) streamMerge(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	ms := &MergeSort{
+		OrderBy: c.OrderBy,
+	}
+	for _, source := range c.Sources {
+		ms.Primitives = append(ms.Primitives, source)
+	}
+	return ms.StreamExecute(vcursor, bindVars, wantfields, callback)
+}
+
 // GetFields fetches the field info.
 func (c *Concatenate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	firstQr, err := c.Sources[0].GetFields(vcursor, bindVars)
@@ -192,7 +251,16 @@ func (c *Concatenate) Inputs() []Primitive {
 }
 
 func (c *Concatenate) description() PrimitiveDescription {
-	return PrimitiveDescription{OperatorType: c.RouteType()}
+	var other map[string]interface{}
+	if len(c.OrderBy) != 0 {
+		other = map[string]interface{}{
+			"OrderBy": c.OrderBy,
+		}
+	}
+	return PrimitiveDescription{
+		OperatorType: c.RouteType(),
+		Other:        other,
+	}
 }
 
 func compareFields(fields1 []*querypb.Field, fields2 []*querypb.Field) error {
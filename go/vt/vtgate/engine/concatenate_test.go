@@ -248,3 +248,29 @@ func TestConcatenate_WithSourcesErrLast(t *testing.T) {
 		})
 	}
 }
+
+func TestConcatenateOrdered(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|col", "int64|varchar")
+	inputs := []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "1|a", "4|b", "4|c", "9|d"),
+		sqltypes.MakeTestResult(fields),
+		sqltypes.MakeTestResult(fields, "2|e", "5|f", "10|g"),
+	}
+	var fps []Primitive
+	for _, input := range inputs {
+		fps = append(fps, &fakePrimitive{results: []*sqltypes.Result{input, input}})
+	}
+	concatenate := &Concatenate{
+		Sources: fps,
+		OrderBy: []OrderbyParams{{Col: 0}},
+	}
+	want := sqltypes.MakeTestResult(fields, "1|a", "2|e", "4|b", "4|c", "5|f", "9|d", "10|g")
+
+	qr, err := concatenate.Execute(&noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, want, qr)
+
+	qr, err = wrapStreamExecute(concatenate, &noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%v", want.Rows), fmt.Sprintf("%v", qr.Rows))
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var _ Primitive = (*DBDDL)(nil)
+
+// DBDDL creates or drops a database. The databases
+// are managed by the DB DDL plugin of the vtgate.
+type DBDDL struct {
+	DDL *sqlparser.DBDDL
+
+	noTxNeeded
+
+	noInputs
+}
+
+func (d *DBDDL) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "DBDDL",
+		Other: map[string]interface{}{
+			"query": sqlparser.String(d.DDL),
+		},
+	}
+}
+
+// RouteType implements the Primitive interface
+func (d *DBDDL) RouteType() string {
+	return "DBDDL"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (d *DBDDL) GetKeyspaceName() string {
+	return d.DDL.DBName
+}
+
+// GetTableName implements the Primitive interface
+func (d *DBDDL) GetTableName() string {
+	return ""
+}
+
+// Execute implements the Primitive interface
+func (d *DBDDL) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if err := vcursor.ExecuteDBDDL(d.DDL); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (d *DBDDL) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := d.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (d *DBDDL) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "not reachable")
+}
@@ -37,9 +37,6 @@ var _ Primitive = (*Delete)(nil)
 // Delete represents the instructions to perform a delete.
 type Delete struct {
 	DML
-
-	// Delete does not take inputs
-	noInputs
 }
 
 var delName = map[DMLOpcode]string{
@@ -48,6 +45,7 @@ var delName = map[DMLOpcode]string{
 	In:            "DeleteIn",
 	Scatter:       "DeleteScatter",
 	ByDestination: "DeleteByDestination",
+	MultiTable:    "DeleteMultiTable",
 }
 
 // RouteType returns a description of the query routing type used by the primitive
@@ -86,12 +84,22 @@ func (del *Delete) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return del.execDeleteByDestination(vcursor, bindVars, key.DestinationAllShards{})
 	case ByDestination:
 		return del.execDeleteByDestination(vcursor, bindVars, del.TargetDestination)
+	case MultiTable:
+		return del.execDeleteMultiTable(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", del)
 	}
 }
 
+// Inputs returns the input of a MultiTable delete.
+func (del *Delete) Inputs() []Primitive {
+	if del.Input == nil {
+		return nil
+	}
+	return []Primitive{del.Input}
+}
+
 // StreamExecute performs a streaming exec.
 func (del *Delete) StreamExecute(VCursor, map[string]*querypb.BindVariable, bool, func(*sqltypes.Result) error) error {
 	return fmt.Errorf("query %q cannot be used for streaming", del.Query)
@@ -118,7 +126,7 @@ func (del *Delete) execDeleteUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := del.Values[0].ResolveValue(bindVars)
+	key, err := resolveValues(del.Values, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteEqual")
 	}
@@ -135,7 +143,7 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 		return &sqltypes.Result{}, nil
 	}
 	if del.OwnedVindexQuery != "" {
-		err = del.deleteVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
+		err = del.deleteVindexEntries(vcursor, []*srvtopo.ResolvedShard{rs}, []map[string]*querypb.BindVariable{bindVars})
 		if err != nil {
 			return nil, vterrors.Wrap(err, "execDeleteEqual")
 		}
@@ -154,7 +162,7 @@ func (del *Delete) execDeleteIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 	}
 
 	if del.OwnedVindexQuery != "" {
-		if err := del.deleteVindexEntries(vcursor, bindVars, rss); err != nil {
+		if err := del.deleteVindexEntries(vcursor, rss, sameShardVars(bindVars, rss)); err != nil {
 			return nil, vterrors.Wrap(err, "execDeleteIn")
 		}
 	}
@@ -179,7 +187,7 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 		}
 	}
 	if len(del.Table.Owned) > 0 {
-		err = del.deleteVindexEntries(vcursor, bindVars, rss)
+		err = del.deleteVindexEntries(vcursor, rss, sameShardVars(bindVars, rss))
 		if err != nil {
 			return nil, err
 		}
@@ -187,15 +195,32 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit)
 }
 
+func (del *Delete) execDeleteMultiTable(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, shardVars, err := del.resolveInputShards(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteMultiTable")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+
+	if del.OwnedVindexQuery != "" {
+		if err := del.deleteVindexEntries(vcursor, rss, shardVars); err != nil {
+			return nil, vterrors.Wrap(err, "execDeleteMultiTable")
+		}
+	}
+	return execMultiShard(vcursor, rss, shardQueries(del.Query, shardVars), del.MultiShardAutocommit)
+}
+
 // deleteVindexEntries performs an delete if table owns vindex.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
-func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bindVars}
-	}
-	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
+func (del *Delete) deleteVindexEntries(vcursor VCursor, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) error {
+	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, shardQueries(del.OwnedVindexQuery, shardVars), false, false)
 	for _, err := range errors {
 		if err != nil {
 			return vterrors.Wrap(err, "deleteVindexEntries")
@@ -207,8 +232,8 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 	}
 
 	for _, row := range subQueryResults.Rows {
-		colnum := 1
-		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[0])
+		colnum := del.KsidLength
+		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[:colnum])
 		if err != nil {
 			return err
 		}
@@ -255,6 +280,7 @@ func addFieldsIfNotEmpty(dml DML, other map[string]interface{}) {
 	}
 	if dml.KsidVindex != nil {
 		other["KsidVindex"] = dml.KsidVindex.String()
+		other["KsidLength"] = dml.KsidLength
 	}
 	if len(dml.Values) > 0 {
 		other["Values"] = dml.Values
@@ -87,6 +87,33 @@ func TestDeleteEqual(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteEqual: missing bind var aa")
 }
 
+func TestDeleteEqualMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{
+		"column_count": "2",
+		"column_bytes": "1,7",
+	})
+	del := &Delete{
+		DML: DML{
+			Opcode: Equal,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: vindex,
+			Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Key: "eid"}},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{"eid": sqltypes.Int64BindVariable(2)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(1606e7ea22ce9270)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {eid: type:INT64 value:"2" } true true`,
+	})
+}
+
 func TestDeleteEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -152,6 +179,7 @@ func TestDeleteOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -264,6 +292,7 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -335,3 +364,114 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
 	})
 }
+
+func TestDeleteMultiTable(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:           MultiTable,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_delete",
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
+			Input: &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"pk|id",
+						"int64|int64",
+					),
+					"10|1",
+					"11|2",
+					// Duplicate rows are produced by joins.
+					"10|1",
+					// NULL rows are produced by outer joins.
+					"null|null",
+				)},
+			},
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+	)}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-"}
+	vc.results = results
+
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// The duplicate and NULL rows are skipped.
+		`ResolveDestinations sharded [type:INT64 value:"10"  type:INT64 value:"11" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > } ` +
+			`sharded.20-: dummy_subquery {__dml_vals: type:TUPLE values:<type:INT64 value:"11" > } false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > } ` +
+			`sharded.20-: dummy_delete {__dml_vals: type:TUPLE values:<type:INT64 value:"11" > } true false`,
+	})
+
+	// No rows selected
+	del.Input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"pk|id",
+				"int64|int64",
+			),
+		)},
+	}
+	vc = newDMLTestVCursor("-20", "20-")
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, nil)
+
+	// Failure case
+	del.Input = &fakePrimitive{sendErr: errors.New("input_error")}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteMultiTable: input_error")
+}
+
+func TestDeleteMultiTableSamePKOnShards(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:     MultiTable,
+			Keyspace:   ks.Keyspace,
+			Query:      "dummy_delete",
+			Table:      ks.Tables["t1"],
+			KsidVindex: ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength: 1,
+			Input: &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"pk|id",
+						"int64|int64",
+					),
+					"10|1",
+					// The same primary key in another shard is another row.
+					"10|2",
+					"12|1",
+				)},
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20"}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	// Every shard only gets the primary keys of its own rows.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"10"  type:INT64 value:"10"  type:INT64 value:"12" ] ` +
+			`Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"12" > } ` +
+			`sharded.20-: dummy_delete {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > } true false`,
+	})
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// Distinct is a primitive that removes the duplicate rows
+// of its input. It's used for a UNION that can't be executed
+// as a single route. The first occurrence of every row is
+// returned as soon as it's received, so the results are streamed.
+type Distinct struct {
+	Input Primitive
+
+	// CheckCols are the offsets of the columns that are compared
+	// to detect duplicates. Text columns are compared by their
+	// weight strings, because we can't mimic mysql's collations.
+	CheckCols []int
+
+	// Ordered is set if the rows of the input are sorted by the
+	// CheckCols. Duplicate rows are then adjacent, and only the
+	// last row is remembered. Otherwise, all distinct rows are kept
+	// in memory, which is limited by the max memory rows.
+	Ordered bool `json:",omitempty"`
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (d *Distinct) RouteType() string {
+	return d.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (d *Distinct) GetKeyspaceName() string {
+	return d.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (d *Distinct) GetTableName() string {
+	return d.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (d *Distinct) SetTruncateColumnCount(count int) {
+	d.TruncateColumnCount = count
+}
+
+// Execute satisfies the Primitive interface.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := d.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rs := d.newRowSet()
+	rows, err := rs.filter(vcursor, result.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows = rows
+	result.RowsAffected = uint64(len(rows))
+	return result.Truncate(d.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	rs := d.newRowSet()
+	return d.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		rows, err := rs.filter(vcursor, qr.Rows)
+		if err != nil {
+			return err
+		}
+		if qr.Fields == nil && len(rows) == 0 {
+			return nil
+		}
+		result := &sqltypes.Result{Fields: qr.Fields, Rows: rows}
+		return callback(result.Truncate(d.TruncateColumnCount))
+	})
+}
+
+// GetFields satisfies the Primitive interface.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result, err := d.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return result.Truncate(d.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to distinct
+func (d *Distinct) Inputs() []Primitive {
+	return []Primitive{d.Input}
+}
+
+// NeedsTransaction satisfies the Primitive interface.
+func (d *Distinct) NeedsTransaction() bool {
+	return d.Input.NeedsTransaction()
+}
+
+func (d *Distinct) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"CheckCols": intsToString(d.CheckCols),
+	}
+	if d.Ordered {
+		other["Ordered"] = true
+	}
+	if d.TruncateColumnCount != 0 {
+		other["TruncateColumnCount"] = d.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "Distinct",
+		Other:        other,
+	}
+}
+
+func (d *Distinct) newRowSet() *rowSet {
+	return &rowSet{
+		cols:    d.CheckCols,
+		ordered: d.Ordered,
+		buckets: make(map[string][][]sqltypes.Value),
+	}
+}
+
+// rowSet tracks the distinct rows seen by a Distinct
+// during one execution.
+type rowSet struct {
+	cols    []int
+	ordered bool
+
+	// last is the last distinct row, if ordered is set.
+	last []sqltypes.Value
+
+	// buckets contains the distinct rows grouped
+	// by distinctKey, if ordered is not set.
+	buckets map[string][][]sqltypes.Value
+	count   int
+}
+
+// filter returns the rows that were not seen before,
+// and remembers them.
+func (rs *rowSet) filter(vcursor VCursor, in [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for _, row := range in {
+		seen, err := rs.seen(row)
+		if err != nil {
+			return nil, err
+		}
+		if seen {
+			continue
+		}
+		rows = append(rows, row)
+		if rs.ordered {
+			rs.last = row
+			continue
+		}
+		key := distinctKey(row, rs.cols)
+		rs.buckets[key] = append(rs.buckets[key], row)
+		rs.count++
+		if rs.count > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return rows, nil
+}
+
+func (rs *rowSet) seen(row []sqltypes.Value) (bool, error) {
+	if rs.ordered {
+		if rs.last == nil {
+			return false, nil
+		}
+		return rowsEqual(rs.last, row, rs.cols)
+	}
+	for _, candidate := range rs.buckets[distinctKey(row, rs.cols)] {
+		equal, err := rowsEqual(candidate, row, rs.cols)
+		if err != nil || equal {
+			return equal, err
+		}
+	}
+	return false, nil
+}
+
+// distinctKey builds the hash key for the specified columns of
+// the row. Unlike hashKey, NULL values are part of the key.
+func distinctKey(row []sqltypes.Value, cols []int) string {
+	var buf strings.Builder
+	for _, col := range cols {
+		key, ok := hashKey(row, []int{col})
+		if !ok {
+			key = "null"
+		}
+		buf.WriteString(key)
+	}
+	return buf.String()
+}
+
+// rowsEqual returns true if the specified columns of the rows
+// are equal. NULL values are equal to each other. Values that
+// evalengine can't compare are compared by their bytes.
+func rowsEqual(row1, row2 []sqltypes.Value, cols []int) (bool, error) {
+	for _, col := range cols {
+		v1, v2 := row1[col], row2[col]
+		cmp, err := evalengine.NullsafeCompare(v1, v2)
+		if err != nil {
+			if v1.IsNull() || v2.IsNull() || sqltypes.IsNumber(v1.Type()) || sqltypes.IsNumber(v2.Type()) {
+				return false, err
+			}
+			if !bytes.Equal(v1.Raw(), v2.Raw()) {
+				return false, nil
+			}
+			continue
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2|weight_string(col2)",
+		"decimal|varchar|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a|A",
+			"1.0|A|A",
+			"null|b|B",
+			"2|c|C",
+			"null|B|B",
+			"1|c|C",
+			"2|c|C",
+		)},
+	}
+	d := &Distinct{
+		Input:               fp,
+		CheckCols:           []int{0, 2},
+		TruncateColumnCount: 2,
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"decimal|varchar",
+		),
+		"1|a",
+		"null|b",
+		"2|c",
+		"1|c",
+	)
+
+	result, err := d.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "d.Execute", result, want)
+
+	// Streaming
+	fp.rewind()
+	result, err = wrapStreamExecute(d, noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "d.StreamExecute", result, want)
+}
+
+func TestDistinctExecuteText(t *testing.T) {
+	// Text values that evalengine can't compare are compared by their bytes.
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"varchar",
+			),
+			"a",
+			"A",
+			"a",
+		)},
+	}
+	d := &Distinct{
+		Input:     fp,
+		CheckCols: []int{0},
+	}
+	result, err := d.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"varchar",
+		),
+		"a",
+		"A",
+	)
+	expectResult(t, "d.Execute", result, want)
+}
+
+func TestDistinctExecuteOrdered(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"int64|varchar",
+			),
+			"1|a",
+			"1|b",
+			"2|c",
+			"2|d",
+			"2|e",
+			"3|f",
+		)},
+	}
+	d := &Distinct{
+		Input:               fp,
+		CheckCols:           []int{0},
+		Ordered:             true,
+		TruncateColumnCount: 1,
+	}
+
+	// The memory limit doesn't apply to ordered inputs.
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	// The fake primitive streams two rows at a time, so
+	// duplicates are also detected across results.
+	result, err := wrapStreamExecute(d, noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+		"2",
+		"3",
+	)
+	expectResult(t, "d.StreamExecute", result, want)
+}
+
+func TestDistinctExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"int64",
+			),
+			"1",
+			"2",
+			"1",
+			"3",
+		)},
+	}
+	d := &Distinct{
+		Input:     fp,
+		CheckCols: []int{0},
+	}
+	_, err := d.Execute(noopVCursor{}, nil, false)
+	want := "in-memory row count exceeded allowed limit of 2"
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(): %v, want %v", err, want)
+	}
+
+	fp.rewind()
+	_, err = wrapStreamExecute(d, noopVCursor{}, nil, false)
+	if err == nil || err.Error() != want {
+		t.Errorf("StreamExecute(): %v, want %v", err, want)
+	}
+}
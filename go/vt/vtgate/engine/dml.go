@@ -17,6 +17,8 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -40,14 +42,20 @@ type DML struct {
 	Query string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 
 	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// For a MultiColumn vindex, there is one value for each
+	// of its columns. Otherwise, only one value is specified.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
-	KsidVindex vindexes.SingleColumn
+	KsidVindex vindexes.Vindex
+
+	// KsidLength is the number of columns of the KsidVindex.
+	// They are the leading columns of the rows selected by the
+	// OwnedVindexQuery.
+	KsidLength int
 
 	// Table specifies the table for the update.
 	Table *vindexes.Table
@@ -62,9 +70,20 @@ type DML struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
+	// Input is the primitive that selects the rows to change for
+	// MultiTable plans. The first column of its result is the
+	// primary key of the table. For sharded keyspaces, the next
+	// KsidLength columns are the columns of the KsidVindex.
+	Input Primitive
+
 	txNeeded
 }
 
+// DMLVals is the name of the bind variable that holds the primary
+// keys of the rows selected by the Input of a MultiTable plan that
+// are in the shard the statement is sent to.
+const DMLVals = "__dml_vals"
+
 // DMLOpcode is a number representing the opcode
 // for the Update or Delete primitve.
 type DMLOpcode int
@@ -86,6 +105,11 @@ const (
 	// Is used when the query explicitly sets a target destination:
 	// in the clause e.g: UPDATE `keyspace[-]`.x1 SET foo=1
 	ByDestination
+	// MultiTable is for dml statements that join multiple tables,
+	// and can't be sent to the shards as is. The Input selects the
+	// primary keys of the rows to change, and the statement is sent
+	// to their shards with the DMLVals bind variable.
+	MultiTable
 )
 
 var opcodeName = map[DMLOpcode]string{
@@ -94,18 +118,19 @@ var opcodeName = map[DMLOpcode]string{
 	In:            "In",
 	Scatter:       "Scatter",
 	ByDestination: "ByDestination",
+	MultiTable:    "MultiTable",
 }
 
 func (op DMLOpcode) String() string {
 	return opcodeName[op]
 }
 
-func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.SingleColumn) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.Vindex) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
 	keys, err := pv.ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "execDeleteIn")
 	}
-	rss, err := resolveMultiShard(vcursor, vindex, keyspace, keys)
+	rss, err := resolveMultiShard(vcursor, vindex, keyspace, singleColumnRows(keys))
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "execDeleteIn")
 	}
@@ -119,6 +144,97 @@ func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query
 	return rss, queries, nil
 }
 
+// resolveInputShards executes the Input of a MultiTable plan, and resolves
+// the shards of the selected rows. The primary keys of the rows of each
+// shard are bound as DMLVals in the bind variables returned for that shard,
+// since a primary key may only be unique within a shard. If no rows were
+// selected, no shards are returned.
+func (dml *DML) resolveInputShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	result, err := dml.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(result.Rows) > vcursor.MaxMemoryRows() {
+		return nil, nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+
+	var pks []*querypb.Value
+	var ksids [][]sqltypes.Value
+	seen := make(map[string]bool)
+	for _, row := range result.Rows {
+		// An outer join can produce rows that don't match the table.
+		if row[0].IsNull() {
+			continue
+		}
+		// A joined row can match more than one row of the other tables.
+		// The same primary key can also be selected from different shards,
+		// so the row is identified by its keyspace id columns as well.
+		ksid := row[1 : 1+dml.KsidLength]
+		id := fmt.Sprintf("%v", row[:1+dml.KsidLength])
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		pks = append(pks, sqltypes.ValueToProto(row[0]))
+		ksids = append(ksids, ksid)
+	}
+	if len(pks) == 0 {
+		return nil, nil, nil
+	}
+
+	var destinations []key.Destination
+	if dml.Keyspace.Sharded {
+		destinations, err = vindexes.Map(dml.KsidVindex, vcursor, ksids)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		destinations = make([]key.Destination, len(pks))
+		for i := range destinations {
+			destinations[i] = key.DestinationAllShards{}
+		}
+	}
+	rss, values, err := vcursor.ResolveDestinations(dml.Keyspace.Name, pks, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shardVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		shardVars[i] = make(map[string]*querypb.BindVariable, len(bindVars)+1)
+		for k, v := range bindVars {
+			shardVars[i][k] = v
+		}
+		shardVars[i][DMLVals] = &querypb.BindVariable{
+			Type:   querypb.Type_TUPLE,
+			Values: values[i],
+		}
+	}
+	return rss, shardVars, nil
+}
+
+// sameShardVars returns bindVars as the bind variables of each of the rss.
+func sameShardVars(bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) []map[string]*querypb.BindVariable {
+	shardVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		shardVars[i] = bindVars
+	}
+	return shardVars
+}
+
+// shardQueries returns the query for each of the shards,
+// with the bind variables of the shard.
+func shardQueries(query string, shardVars []map[string]*querypb.BindVariable) []*querypb.BoundQuery {
+	queries := make([]*querypb.BoundQuery, len(shardVars))
+	for i, bv := range shardVars {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           query,
+			BindVariables: bv,
+		}
+	}
+	return queries
+}
+
 func execMultiShard(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, multiShardAutoCommit bool) (*sqltypes.Result, error) {
 	autocommit := (len(rss) == 1 || multiShardAutoCommit) && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
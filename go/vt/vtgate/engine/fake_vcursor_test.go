@@ -61,10 +61,55 @@ func (t noopVCursor) SetSysVar(name string, expr string) {
 	//panic("implement me")
 }
 
+func (t noopVCursor) SysVar(name string) (string, bool) {
+	return "", false
+}
+
+func (t noopVCursor) UnsetSysVar(name string) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) SetFoundRows(foundRows uint64) {
+	panic("implement me")
+}
+
 func (t noopVCursor) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.DDL) error {
 	panic("implement me")
 }
 
+func (t noopVCursor) ExecuteDBDDL(dbddl *sqlparser.DBDDL) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	panic("implement me")
+}
+
+func (t noopVCursor) TransactionMode() vtgatepb.TransactionMode {
+	return vtgatepb.TransactionMode_MULTI
+}
+
+func (t noopVCursor) RecordLockAction(target *querypb.Target, action LockAction, name string) {
+	panic("implement me")
+}
+
+func (t noopVCursor) NeedsReservedConn() {
+}
+
+func (t noopVCursor) UseTemporaryTables() {
+}
+
+func (t noopVCursor) AddTemporaryTables(names []string) {
+}
+
+func (t noopVCursor) DropTemporaryTables(names []string) error {
+	return nil
+}
+
+func (t noopVCursor) ShardSession() []*srvtopo.ResolvedShard {
+	return nil
+}
+
 func (t noopVCursor) Session() SessionActions {
 	return t
 }
@@ -149,6 +194,12 @@ type loggingVCursor struct {
 	log []string
 
 	resolvedTargetTabletType topodatapb.TabletType
+
+	transactionMode vtgatepb.TransactionMode
+
+	shardSession []*srvtopo.ResolvedShard
+
+	sysVars map[string]string
 }
 
 func (f *loggingVCursor) SetUDV(key string, value interface{}) error {
@@ -160,10 +211,65 @@ func (f *loggingVCursor) SetSysVar(name string, expr string) {
 	f.log = append(f.log, fmt.Sprintf("SysVar set with (%s,%v)", name, expr))
 }
 
+func (f *loggingVCursor) SysVar(name string) (string, bool) {
+	expr, ok := f.sysVars[name]
+	return expr, ok
+}
+
+func (f *loggingVCursor) UnsetSysVar(name string) error {
+	f.log = append(f.log, fmt.Sprintf("SysVar unset (%s)", name))
+	return nil
+}
+
+func (f *loggingVCursor) SetFoundRows(foundRows uint64) {
+	f.log = append(f.log, fmt.Sprintf("FoundRows set to %d", foundRows))
+}
+
 func (f *loggingVCursor) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.DDL) error {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) ExecuteDBDDL(dbddl *sqlparser.DBDDL) error {
+	panic("implement me")
+}
+
+func (f *loggingVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	f.log = append(f.log, fmt.Sprintf("ExecuteLock %s.%s: %s {%s}", rs.Target.Keyspace, rs.Target.Shard, query.Sql, printBindVars(query.BindVariables)))
+	return f.nextResult()
+}
+
+func (f *loggingVCursor) RecordLockAction(target *querypb.Target, action LockAction, name string) {
+	f.log = append(f.log, fmt.Sprintf("RecordLockAction %s.%s %v %s", target.Keyspace, target.Shard, action, name))
+}
+
+func (f *loggingVCursor) NeedsReservedConn() {
+	f.log = append(f.log, "NeedsReservedConn")
+}
+
+func (f *loggingVCursor) UseTemporaryTables() {
+	f.log = append(f.log, "UseTemporaryTables")
+}
+
+func (f *loggingVCursor) AddTemporaryTables(names []string) {
+	f.log = append(f.log, fmt.Sprintf("AddTemporaryTables %v", names))
+}
+
+func (f *loggingVCursor) DropTemporaryTables(names []string) error {
+	f.log = append(f.log, fmt.Sprintf("DropTemporaryTables %v", names))
+	return nil
+}
+
+func (f *loggingVCursor) ShardSession() []*srvtopo.ResolvedShard {
+	return f.shardSession
+}
+
+func (f *loggingVCursor) TransactionMode() vtgatepb.TransactionMode {
+	if f.transactionMode == vtgatepb.TransactionMode_UNSPECIFIED {
+		return vtgatepb.TransactionMode_MULTI
+	}
+	return f.transactionMode
+}
+
 func (f *loggingVCursor) Session() SessionActions {
 	return f
 }
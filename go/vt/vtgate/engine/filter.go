@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that discards the rows of its input
+// for which the predicate does not evaluate to true.
+// It is used to evaluate conditions that cannot be pushed
+// down to the tablets, like a HAVING clause that references
+// the results of a cross-shard aggregation.
+type Filter struct {
+	// Predicate is evaluated against every row of Input.
+	Predicate evalengine.Expr
+	Input     Primitive
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := f.filter(bindVars, result.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows = rows
+	result.RowsAffected = uint64(len(rows))
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Rows) == 0 {
+			return callback(qr)
+		}
+		rows, err := f.filter(bindVars, qr.Rows)
+		if err != nil {
+			return err
+		}
+		return callback(&sqltypes.Result{Fields: qr.Fields, Rows: rows})
+	})
+}
+
+// GetFields satisfies the Primitive interface.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return f.Input.GetFields(vcursor, bindVars)
+}
+
+// Inputs returns the input to filter
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+// NeedsTransaction satisfies the Primitive interface.
+func (f *Filter) NeedsTransaction() bool {
+	return f.Input.NeedsTransaction()
+}
+
+func (f *Filter) filter(bindVars map[string]*querypb.BindVariable, in [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+	}
+	var rows [][]sqltypes.Value
+	for _, row := range in {
+		env.Row = row
+		res, err := f.Predicate.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if res.IsTrue() {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (f *Filter) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "Filter",
+		Other: map[string]interface{}{
+			"Predicate": f.Predicate.String(),
+		},
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFilterExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|12",
+			"c|null",
+			"d|11",
+		)},
+	}
+	// count(*) > :threshold
+	f := &Filter{
+		Predicate: &evalengine.BinaryOp{
+			Expr:  &evalengine.GreaterThan{},
+			Left:  &evalengine.Column{Offset: 1},
+			Right: &evalengine.BindVariable{Key: "threshold"},
+		},
+		Input: fp,
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"threshold": sqltypes.Int64BindVariable(10),
+	}
+	want := sqltypes.MakeTestResult(
+		fields,
+		"b|12",
+		"d|11",
+	)
+
+	result, err := f.Execute(nil, bindVars, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(f, nil, bindVars, true)
+	require.NoError(t, err)
+	assert.Equal(t, want, result)
+}
+
+func TestFilterExecuteError(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("col", "int64"),
+			"1",
+		)},
+	}
+	f := &Filter{
+		Predicate: &evalengine.BinaryOp{
+			Expr:  &evalengine.Equals{},
+			Left:  &evalengine.Column{Offset: 0},
+			Right: &evalengine.BindVariable{Key: "missing"},
+		},
+		Input: fp,
+	}
+
+	_, err := f.Execute(nil, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Bind variable not found")
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, which executes the RHS once for every LHS row,
+// HashJoin executes each side only once. The RHS rows are loaded
+// into an in-memory hash table keyed by the join columns, which
+// is then probed with every LHS row. The rows are therefore
+// returned in the order of the LHS, just like Join.
+type HashJoin struct {
+	// Left and Right are the LHS and RHS primitives
+	// of the HashJoin. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. It follows the same convention
+	// as Join.Cols.
+	Cols []int `json:",omitempty"`
+
+	// LHSKeys and RHSKeys are the offsets of the join columns
+	// in the left and right results. A pair of rows matches
+	// if every LHSKeys[i] compares equal to RHSKeys[i].
+	// Rows with NULL keys never match.
+	LHSKeys []int `json:",omitempty"`
+	RHSKeys []int `json:",omitempty"`
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	table := newHashTable(hj.RHSKeys)
+	if err := table.add(vcursor, rresult.Rows); err != nil {
+		return nil, err
+	}
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	for _, lrow := range lresult.Rows {
+		rrows, err := table.probe(lrow, hj.LHSKeys)
+		if err != nil {
+			return nil, err
+		}
+		for _, rrow := range rrows {
+			result.Rows = append(result.Rows, joinRows(lrow, rrow, hj.Cols))
+		}
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+// The RHS is fully loaded in memory before the LHS is streamed.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var rfields []*querypb.Field
+	table := newHashTable(hj.RHSKeys)
+	err := hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		if rresult.Fields != nil {
+			rfields = rresult.Fields
+		}
+		return table.add(vcursor, rresult.Rows)
+	})
+	if err != nil {
+		return err
+	}
+
+	return hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if wantfields && lresult.Fields != nil {
+			wantfields = false
+			if rfields == nil {
+				rresult, err := hj.Right.GetFields(vcursor, bindVars)
+				if err != nil {
+					return err
+				}
+				rfields = rresult.Fields
+			}
+			result.Fields = joinFields(lresult.Fields, rfields, hj.Cols)
+		}
+		for _, lrow := range lresult.Rows {
+			rrows, err := table.probe(lrow, hj.LHSKeys)
+			if err != nil {
+				return err
+			}
+			for _, rrow := range rrows {
+				result.Rows = append(result.Rows, joinRows(lrow, rrow, hj.Cols))
+			}
+		}
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": intsToString(hj.Cols),
+		"LHSKeys":           intsToString(hj.LHSKeys),
+		"RHSKeys":           intsToString(hj.RHSKeys),
+	}
+	return PrimitiveDescription{
+		OperatorType: "HashJoin",
+		Variant:      NormalJoin.String(),
+		Other:        other,
+	}
+}
+
+func intsToString(ints []int) string {
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ints)), ","), "[]")
+}
+
+// hashTable stores rows grouped by a hash of their key columns.
+// Rows that hash to the same bucket are compared with
+// evalengine.NullsafeCompare to eliminate collisions.
+type hashTable struct {
+	keys    []int
+	buckets map[string][][]sqltypes.Value
+	count   int
+}
+
+func newHashTable(keys []int) *hashTable {
+	return &hashTable{
+		keys:    keys,
+		buckets: make(map[string][][]sqltypes.Value),
+	}
+}
+
+// add adds the rows to the hash table. Rows with NULL keys are
+// discarded because they can't match anything.
+func (ht *hashTable) add(vcursor VCursor, rows [][]sqltypes.Value) error {
+	for _, row := range rows {
+		key, ok := hashKey(row, ht.keys)
+		if !ok {
+			continue
+		}
+		ht.buckets[key] = append(ht.buckets[key], row)
+		ht.count++
+		if ht.count > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return nil
+}
+
+// probe returns the rows of the hash table that match the
+// row, whose key columns are specified by keys.
+func (ht *hashTable) probe(row []sqltypes.Value, keys []int) ([][]sqltypes.Value, error) {
+	key, ok := hashKey(row, keys)
+	if !ok {
+		return nil, nil
+	}
+	var matches [][]sqltypes.Value
+	for _, candidate := range ht.buckets[key] {
+		match := true
+		for i, col := range keys {
+			cmp, err := evalengine.NullsafeCompare(row[col], candidate[ht.keys[i]])
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				match = false
+				break
+			}
+		}
+		if match {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches, nil
+}
+
+// hashKey builds the hash key for the specified columns of the row.
+// Values that evalengine.NullsafeCompare considers equal yield the
+// same key, as long as they're of the same kind, which the planner
+// guarantees: integers and decimals are hashed by their normalized
+// decimal text, floats by their value, and everything else, like the
+// weight strings of text columns, by their bytes. It returns false
+// if one of the columns is NULL.
+func hashKey(row []sqltypes.Value, cols []int) (string, bool) {
+	var buf strings.Builder
+	for _, col := range cols {
+		v := row[col]
+		if v.IsNull() {
+			return "", false
+		}
+		var part string
+		switch {
+		case v.IsIntegral() || v.Type() == sqltypes.Decimal:
+			part = "n" + normalizeDecimal(v.ToString())
+		case v.IsFloat():
+			f, err := evalengine.ToFloat64(v)
+			if err != nil {
+				part = "b" + v.ToString()
+				break
+			}
+			if f == 0 {
+				// Normalize negative zero.
+				f = 0
+			}
+			part = "f" + strconv.FormatFloat(f, 'g', -1, 64)
+		default:
+			part = "b" + v.ToString()
+		}
+		buf.WriteString(strconv.Itoa(len(part)))
+		buf.WriteByte(':')
+		buf.WriteString(part)
+	}
+	return buf.String(), true
+}
+
+// normalizeDecimal returns the canonical text of a decimal number,
+// without leading zeros in the integer part and trailing zeros in the
+// fractional part, so that equal values have the same text: 1, 1.0
+// and 01.00 are all normalized to 1.
+func normalizeDecimal(s string) string {
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+	s = strings.TrimLeft(s, "0")
+	if s == "" || s[0] == '.' {
+		s = "0" + s
+	}
+	if neg && s != "0" {
+		s = "-" + s
+	}
+	return s
+}
@@ -0,0 +1,362 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newHashJoinTestPrimitives() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varbinary",
+				),
+				"1|a",
+				"2|b",
+				"null|c",
+				"3|d",
+				"1|e",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"decimal|varbinary",
+				),
+				"1.0|x",
+				"null|y",
+				"3|z",
+				"1|w",
+			),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinTestPrimitives()
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varbinary|varbinary",
+		),
+		"1|a|x",
+		"1|a|w",
+		"3|d|z",
+		"1|e|x",
+		"1|e|w",
+	)
+	expectResult(t, "hj.Execute", r, wantResult)
+
+	// Streaming
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = wrapStreamExecute(hj, noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute a: type:INT64 value:"10"  true`,
+	})
+	expectResult(t, "hj.StreamExecute", r, wantResult)
+}
+
+func TestHashJoinExecuteMultipleKeys(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinTestPrimitives()
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1, 2},
+		LHSKeys: []int{0, 1},
+		RHSKeys: []int{0, 1},
+	}
+	rightPrim.results[0].Rows[3][1] = sqltypes.NewVarBinary("e")
+	r, err := hj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3|col4",
+			"int64|decimal|varbinary",
+		),
+		"1|1|e",
+	)
+	wantResult.Fields = nil
+	expectResult(t, "hj.Execute", r, wantResult)
+}
+
+func TestHashJoinExecuteVarcharKeys(t *testing.T) {
+	// VARCHAR keys are compared by the weight strings
+	// that the planner adds next to them.
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|weight_string(col1)",
+					"varchar|varbinary",
+				),
+				"abc|ABC",
+				"def|DEF",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2|weight_string(col2)",
+					"varchar|varbinary",
+				),
+				"ABC|ABC",
+				"xyz|XYZ",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{1},
+		RHSKeys: []int{1},
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|varchar",
+		),
+		"abc|ABC",
+	)
+	expectResult(t, "hj.Execute", r, wantResult)
+}
+
+func TestHashJoinExecuteDecimalKeys(t *testing.T) {
+	// Integers and decimals are hashed by their exact decimal text,
+	// so values that are only equal as floats don't match.
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"9007199254740993",
+				"-5",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2",
+					"decimal",
+				),
+				"9007199254740992.0",
+				"9007199254740993.00",
+				"-5.000",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|decimal",
+		),
+		"9007199254740993|9007199254740993.00",
+		"-5|-5.000",
+	)
+	expectResult(t, "hj.Execute", r, wantResult)
+}
+
+func TestNormalizeDecimal(t *testing.T) {
+	tcases := []struct {
+		in, out string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"100", "100"},
+		{"1.0", "1"},
+		{"01.500", "1.5"},
+		{"0.50", "0.5"},
+		{"-0.00", "0"},
+		{"-10.10", "-10.1"},
+		{".5", "0.5"},
+	}
+	for _, tcase := range tcases {
+		if got := normalizeDecimal(tcase.in); got != tcase.out {
+			t.Errorf("normalizeDecimal(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
+func TestHashJoinExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	hj := &HashJoin{
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+
+	// The hash table holds 3 rows.
+	hj.Left, hj.Right = newHashJoinTestPrimitives()
+	_, err := hj.Execute(noopVCursor{}, nil, false)
+	want := "in-memory row count exceeded allowed limit of 2"
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(): %v, want %v", err, want)
+	}
+
+	hj.Left, hj.Right = newHashJoinTestPrimitives()
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, false)
+	if err == nil || err.Error() != want {
+		t.Errorf("StreamExecute(): %v, want %v", err, want)
+	}
+
+	// The hash table holds 2 rows, but the join produces 4.
+	testMaxMemoryRows = 3
+	leftPrim, rightPrim := newHashJoinTestPrimitives()
+	rows := rightPrim.results[0].Rows
+	rightPrim.results[0].Rows = [][]sqltypes.Value{rows[0], rows[3]}
+	hj.Left, hj.Right = leftPrim, rightPrim
+	_, err = hj.Execute(noopVCursor{}, nil, false)
+	want = "in-memory row count exceeded allowed limit of 3"
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(): %v, want %v", err, want)
+	}
+}
+
+func TestHashJoinExecuteNoResult(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "hj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+	))
+}
+
+func TestHashJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := newHashJoinTestPrimitives()
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := hj.GetFields(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	expectResult(t, "hj.GetFields", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col4",
+			"int64|varbinary",
+		),
+	))
+}
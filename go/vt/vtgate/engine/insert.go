@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -50,8 +51,8 @@ type Insert struct {
 	Keyspace *vindexes.Keyspace
 
 	// Query specifies the query to be executed.
-	// For InsertSharded plans, this value is unused,
-	// and Prefix, Mid and Suffix are used instead.
+	// For InsertSharded and InsertSelect plans, this value
+	// is unused, and Prefix, Mid and Suffix are used instead.
 	Query string
 
 	// VindexValues specifies values for all the vindex columns.
@@ -68,10 +69,46 @@ type Insert struct {
 	Generate *Generate
 
 	// Prefix, Mid and Suffix are for sharded insert plans.
+	// InsertSelect plans don't use Mid: the values are
+	// generated from the rows returned by Input.
 	Prefix string
 	Mid    []string
 	Suffix string
 
+	// Input is the primitive that produces the rows to be
+	// inserted by an InsertSelect plan.
+	Input Primitive
+
+	// VindexValueOffset specifies the offsets of the vindex columns
+	// in the rows returned by Input, for InsertSelect plans.
+	// VindexValueOffset[i][j] is the offset of the j'th column
+	// of the i'th colVindex.
+	VindexValueOffset [][]int
+
+	// Ignore is set for InsertSelect plans of INSERT IGNORE
+	// and INSERT...ON DUPLICATE KEY constructs.
+	Ignore bool
+
+	// ConflictQueries are used by InsertShardedReplace and
+	// InsertShardedUpsert plans. ConflictQueries[k] selects the existing
+	// rows that row k conflicts with: the rows with the same primary key,
+	// or with the same values for an owned unique vindex. If the table has
+	// no primary key in the VSchema, the columns of the primary vindex are
+	// used instead. The selected columns are the columns of the primary
+	// vindex, followed by the columns of the owned vindexes.
+	ConflictQueries []string
+
+	// ConflictDMLs[k] changes the rows selected by ConflictQueries[k],
+	// if they're in another shard than row k. It deletes them for
+	// InsertShardedReplace plans, and applies the ON DUPLICATE KEY
+	// UPDATE clause to them for InsertShardedUpsert plans.
+	ConflictDMLs []string
+
+	// UpsertVindexValues[k] contains the values that the ON DUPLICATE
+	// KEY UPDATE clause of an InsertShardedUpsert plan assigns to owned
+	// vindex columns, if row k conflicts with an existing row.
+	UpsertVindexValues []map[string]VindexValues
+
 	// Option to override the standard behavior and allow a multi-shard insert
 	// to use single round trip autocommit.
 	//
@@ -84,9 +121,6 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
-
 	// Insert needs tx handling
 	txNeeded
 }
@@ -132,6 +166,9 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is the position of the column in the rows
+	// returned by the input of an InsertSelect.
+	Offset int
 }
 
 // InsertOpcode is a number representing the opcode
@@ -150,12 +187,31 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertSelect is for routing the rows of an
+	// INSERT...SELECT to individual shards. The rows are
+	// produced by the Input primitive, and the keyspace ids
+	// are computed from their vindex columns.
+	InsertSelect
+	// InsertShardedReplace is for REPLACE statements. The rows
+	// are inserted one at a time, after the rows they replace
+	// are deleted from the owned vindexes. Replaced rows in
+	// other shards are deleted by ConflictDMLs.
+	InsertShardedReplace
+	// InsertShardedUpsert is for INSERT...ON DUPLICATE KEY
+	// UPDATE statements that change owned vindex columns.
+	// The rows are inserted one at a time. If a row conflicts
+	// with an existing row, the owned vindexes of that row are
+	// updated instead of being created.
+	InsertShardedUpsert
 )
 
 var insName = map[InsertOpcode]string{
-	InsertUnsharded:     "InsertUnsharded",
-	InsertSharded:       "InsertSharded",
-	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertUnsharded:      "InsertUnsharded",
+	InsertSharded:        "InsertSharded",
+	InsertShardedIgnore:  "InsertShardedIgnore",
+	InsertSelect:         "InsertSelect",
+	InsertShardedReplace: "InsertShardedReplace",
+	InsertShardedUpsert:  "InsertShardedUpsert",
 }
 
 // String returns the opcode
@@ -199,12 +255,24 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return ins.execInsertUnsharded(vcursor, bindVars)
 	case InsertSharded, InsertShardedIgnore:
 		return ins.execInsertSharded(vcursor, bindVars)
+	case InsertSelect:
+		return ins.execInsertSelect(vcursor, bindVars)
+	case InsertShardedReplace, InsertShardedUpsert:
+		return ins.execInsertShardedConflicts(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported query route: %v", ins)
 	}
 }
 
+// Inputs returns the input of an InsertSelect.
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 // StreamExecute performs a streaming exec.
 func (ins *Insert) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	return fmt.Errorf("query %q cannot be used for streaming", ins.Query)
@@ -273,6 +341,299 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result, err := ins.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	rows := result.Rows
+	if len(rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	if len(rows) > vcursor.MaxMemoryRows() {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	// The input can be a 'select *', whose columns are only known now.
+	minColumns := ins.minSelectColumns()
+	for rowNum, row := range rows {
+		if len(row) < minColumns {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column count doesn't match value count at row %d", rowNum+1)
+		}
+	}
+
+	insertID, err := ins.processGenerateFromRows(vcursor, rows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	rss, queries, err := ins.getInsertSelectQueries(vcursor, bindVars, rows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	if len(rss) == 0 {
+		// All the rows were dropped by INSERT IGNORE.
+		return &sqltypes.Result{}, nil
+	}
+
+	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
+	if errs != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "execInsertSelect")
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// conflictRow is an existing row that a row of an InsertShardedReplace
+// or InsertShardedUpsert plan conflicts with.
+type conflictRow struct {
+	ksid []byte
+	// values are the values selected by the ConflictQueries.
+	values []sqltypes.Value
+}
+
+// execInsertShardedConflicts executes InsertShardedReplace and
+// InsertShardedUpsert plans. The rows are inserted one at a time,
+// because every row can conflict with the rows inserted before it.
+// The plans need a transaction, so that the vindexes are consistent
+// with the rows if one of the rows fails.
+func (ins *Insert) execInsertShardedConflicts(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	insertID, err := ins.processGenerate(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertShardedConflicts")
+	}
+	vindexRowsValues, err := ins.resolveVindexRowsValues(bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertShardedConflicts")
+	}
+	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertShardedConflicts")
+	}
+
+	result := &sqltypes.Result{}
+	for rowNum, ksid := range keyspaceIDs {
+		ins.setVindexBindVars(bindVars, vindexRowsValues, rowNum)
+		conflicts, err := ins.selectConflicts(vcursor, bindVars, vindexRowsValues, rowNum, ksid)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertShardedConflicts")
+		}
+		var qr *sqltypes.Result
+		if ins.Opcode == InsertShardedReplace {
+			qr, err = ins.replaceRow(vcursor, bindVars, vindexRowsValues, rowNum, ksid, conflicts)
+		} else {
+			qr, err = ins.upsertRow(vcursor, bindVars, vindexRowsValues, rowNum, ksid, conflicts)
+		}
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertShardedConflicts")
+		}
+		result.RowsAffected += qr.RowsAffected
+		if result.InsertID == 0 {
+			result.InsertID = qr.InsertID
+		}
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// selectConflicts returns the existing rows that a row conflicts with.
+// They're looked up in the shard of the row, and in the shards that
+// the owned unique vindexes map the values of the row to.
+func (ins *Insert) selectConflicts(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, rowNum int, ksid []byte) ([]conflictRow, error) {
+	destinations := []key.Destination{key.DestinationKeyspaceID(ksid)}
+	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
+		colVindex := ins.Table.ColumnVindexes[vIdx]
+		if !colVindex.Owned || !colVindex.Vindex.IsUnique() {
+			continue
+		}
+		mapped, err := vindexes.Map(colVindex.Vindex, vcursor, [][]sqltypes.Value{vindexRowsValues[vIdx][rowNum]})
+		if err != nil {
+			return nil, err
+		}
+		if existing, ok := mapped[0].(key.DestinationKeyspaceID); ok && !bytes.Equal(existing, ksid) {
+			destinations = append(destinations, existing)
+		}
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, err
+	}
+	if err := allowOnlyMaster(rss...); err != nil {
+		return nil, err
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           ins.ConflictQueries[rowNum],
+			BindVariables: bindVars,
+		}
+	}
+	qr, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+
+	primary := ins.Table.ColumnVindexes[0]
+	conflicts := make([]conflictRow, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		existing, err := resolveKeyspaceID(vcursor, primary.Vindex, row[:len(primary.Columns)])
+		if err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, conflictRow{ksid: existing, values: row})
+	}
+	return conflicts, nil
+}
+
+// replaceRow deletes the owned vindex entries of the rows that a row
+// replaces, and the replaced rows that are in other shards. Then it
+// inserts the row.
+func (ins *Insert) replaceRow(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, rowNum int, ksid []byte, conflicts []conflictRow) (*sqltypes.Result, error) {
+	var others []key.Destination
+	for _, conflict := range conflicts {
+		if err := ins.deleteOwnedVindexEntries(vcursor, conflict); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(conflict.ksid, ksid) {
+			others = append(others, key.DestinationKeyspaceID(conflict.ksid))
+		}
+	}
+
+	var deleted uint64
+	if len(others) != 0 {
+		// The REPLACE only deletes the rows of its own shard.
+		rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, others)
+		if err != nil {
+			return nil, err
+		}
+		queries := make([]*querypb.BoundQuery, len(rss))
+		for i := range rss {
+			queries[i] = &querypb.BoundQuery{
+				Sql:           ins.ConflictDMLs[rowNum],
+				BindVariables: bindVars,
+			}
+		}
+		qr, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, false /* canAutocommit */)
+		if err := vterrors.Aggregate(errs); err != nil {
+			return nil, err
+		}
+		deleted = qr.RowsAffected
+	}
+
+	qr, err := ins.insertRow(vcursor, bindVars, vindexRowsValues, rowNum, ksid)
+	if err != nil {
+		return nil, err
+	}
+	qr.RowsAffected += deleted
+	return qr, nil
+}
+
+// upsertRow inserts a row if it doesn't conflict with an existing row.
+// Otherwise, it updates the owned vindex entries of the existing row,
+// and applies the ON DUPLICATE KEY UPDATE clause to it.
+func (ins *Insert) upsertRow(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, rowNum int, ksid []byte, conflicts []conflictRow) (*sqltypes.Result, error) {
+	if len(conflicts) == 0 {
+		return ins.insertRow(vcursor, bindVars, vindexRowsValues, rowNum, ksid)
+	}
+
+	// Like MySQL, only one of the conflicting rows is updated.
+	// A row of the same shard is updated by the insert itself.
+	conflict := conflicts[0]
+	for _, c := range conflicts {
+		if bytes.Equal(c.ksid, ksid) {
+			conflict = c
+			break
+		}
+	}
+	if err := ins.updateOwnedVindexEntries(vcursor, bindVars, rowNum, conflict); err != nil {
+		return nil, err
+	}
+
+	query := ins.ConflictDMLs[rowNum]
+	if bytes.Equal(conflict.ksid, ksid) {
+		query = ins.Prefix + ins.Mid[rowNum] + ins.Suffix
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationKeyspaceID(conflict.ksid)})
+	if err != nil {
+		return nil, err
+	}
+	return execShard(vcursor, query, bindVars, rss[0], true /* rollbackOnError */, false /* canAutocommit */)
+}
+
+// insertRow creates the vindex entries of a row, and inserts it.
+func (ins *Insert) insertRow(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, rowNum int, ksid []byte) (*sqltypes.Result, error) {
+	rowValues := make([][][]sqltypes.Value, len(vindexRowsValues))
+	for vIdx := range vindexRowsValues {
+		rowValues[vIdx] = vindexRowsValues[vIdx][rowNum : rowNum+1]
+	}
+	if err := ins.processSecondaryVindexes(vcursor, rowValues, [][]byte{ksid}); err != nil {
+		return nil, err
+	}
+	// The unowned vindex values may have been reverse mapped.
+	ins.setVindexBindVars(bindVars, vindexRowsValues, rowNum)
+
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationKeyspaceID(ksid)})
+	if err != nil {
+		return nil, err
+	}
+	query := ins.Prefix + ins.Mid[rowNum] + ins.Suffix
+	return execShard(vcursor, query, bindVars, rss[0], true /* rollbackOnError */, false /* canAutocommit */)
+}
+
+// deleteOwnedVindexEntries deletes the owned vindex entries of an existing row.
+func (ins *Insert) deleteOwnedVindexEntries(vcursor VCursor, conflict conflictRow) error {
+	colnum := len(ins.Table.ColumnVindexes[0].Columns)
+	for _, colVindex := range ins.Table.Owned {
+		fromIds := conflict.values[colnum : colnum+len(colVindex.Columns)]
+		colnum += len(colVindex.Columns)
+		if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, conflict.ksid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateOwnedVindexEntries changes the owned vindex entries of an existing
+// row to the values assigned by the ON DUPLICATE KEY UPDATE clause.
+func (ins *Insert) updateOwnedVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rowNum int, conflict conflictRow) error {
+	colnum := len(ins.Table.ColumnVindexes[0].Columns)
+	for _, colVindex := range ins.Table.Owned {
+		fromIds := conflict.values[colnum : colnum+len(colVindex.Columns)]
+		colnum += len(colVindex.Columns)
+		changed, ok := ins.UpsertVindexValues[rowNum][colVindex.Name]
+		if !ok {
+			continue
+		}
+		toIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+		for i, col := range colVindex.Columns {
+			pv, ok := changed[col.String()]
+			if !ok {
+				// The column keeps its value.
+				toIds = append(toIds, fromIds[i])
+				continue
+			}
+			value, err := pv.ResolveValue(bindVars)
+			if err != nil {
+				return err
+			}
+			toIds = append(toIds, value)
+		}
+		if err := colVindex.Vindex.(vindexes.Lookup).Update(vcursor, fromIds, conflict.ksid, toIds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processGenerate generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
@@ -287,8 +648,42 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return 0, vterrors.Wrap(err, "processGenerate")
 	}
+	insertID, err = ins.generateValues(vcursor, resolved)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range resolved {
+		bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
+	}
+	return insertID, nil
+}
+
+// processGenerateFromRows is like processGenerate, but it generates
+// the values for the rows returned by the input of an InsertSelect.
+func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+
+	values := make([]sqltypes.Value, len(rows))
+	for rowNum, row := range rows {
+		values[rowNum] = row[ins.Generate.Offset]
+	}
+	insertID, err = ins.generateValues(vcursor, values)
+	if err != nil {
+		return 0, err
+	}
+	for rowNum, row := range rows {
+		row[ins.Generate.Offset] = values[rowNum]
+	}
+	return insertID, nil
+}
+
+// generateValues replaces the NULL values with new values from the
+// sequence. If no value was generated, it returns 0.
+func (ins *Insert) generateValues(vcursor VCursor, values []sqltypes.Value) (insertID int64, err error) {
 	count := int64(0)
-	for _, val := range resolved {
+	for _, val := range values {
 		if val.IsNull() {
 			count++
 		}
@@ -318,12 +713,10 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 
 	// Fill the holes where no value was supplied.
 	cur := insertID
-	for i, v := range resolved {
+	for i, v := range values {
 		if v.IsNull() {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
+			values[i] = sqltypes.NewInt64(cur)
 			cur++
-		} else {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
 		}
 	}
 	return insertID, nil
@@ -337,6 +730,49 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 // For unowned vindexes with values, it validates.
 // If it's an IGNORE or ON DUPLICATE key insert, it drops unroutable rows.
 func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	vindexRowsValues, err := ins.resolveVindexRowsValues(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+	}
+
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+	}
+
+	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
+	// we're executing an insert ignore.
+	for rowNum, ksid := range keyspaceIDs {
+		if ksid == nil {
+			// InsertShardedIgnore: skip the row.
+			continue
+		}
+		ins.setVindexBindVars(bindVars, vindexRowsValues, rowNum)
+	}
+
+	rss, rowsPerRss, err := ins.resolveShards(vcursor, keyspaceIDs)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		mids := make([]string, len(rowsPerRss[i]))
+		for j, rowNum := range rowsPerRss[i] {
+			mids[j] = ins.Mid[rowNum]
+		}
+		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
+		queries[i] = &querypb.BoundQuery{
+			Sql:           rewritten,
+			BindVariables: bindVars,
+		}
+	}
+
+	return rss, queries, nil
+}
+
+// resolveVindexRowsValues resolves the values of all the vindex columns.
+func (ins *Insert) resolveVindexRowsValues(bindVars map[string]*querypb.BindVariable) ([][][]sqltypes.Value, error) {
 	// vindexRowsValues builds the values of all vindex columns.
 	// the 3-d structure indexes are colVindex, row, col. Note that
 	// ins.Values indexes are colVindex, col, row. So, the conversion
@@ -347,23 +783,23 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	rowCount := 0
 	for vIdx, vColValues := range ins.VindexValues {
 		if len(vColValues.Values) != len(ins.Table.ColumnVindexes[vIdx].Columns) {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column values don't match vschema: %v %v", vColValues, ins.Table.ColumnVindexes[vIdx].Columns)
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column values don't match vschema: %v %v", vColValues, ins.Table.ColumnVindexes[vIdx].Columns)
 		}
 		for colIdx, colValues := range vColValues.Values {
 			rowsResolvedValues, err := colValues.ResolveList(bindVars)
 			if err != nil {
-				return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+				return nil, err
 			}
 			// This is the first iteration: allocate for transpose.
 			if colIdx == 0 {
 				if len(rowsResolvedValues) == 0 {
-					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: rowcount is zero for inserts: %v", rowsResolvedValues)
+					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: rowcount is zero for inserts: %v", rowsResolvedValues)
 				}
 				if rowCount == 0 {
 					rowCount = len(rowsResolvedValues)
 				}
 				if rowCount != len(rowsResolvedValues) {
-					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: uneven row values for inserts: %d %d", rowCount, len(rowsResolvedValues))
+					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: uneven row values for inserts: %d %d", rowCount, len(rowsResolvedValues))
 				}
 				vindexRowsValues[vIdx] = make([][]sqltypes.Value, rowCount)
 			}
@@ -373,16 +809,39 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 			}
 		}
 	}
+	return vindexRowsValues, nil
+}
 
-	// The output from the following 'process' functions is a list of
-	// keyspace ids. For regular inserts, a failure to find a route
-	// results in an error. For 'ignore' type inserts, the keyspace
-	// id is returned as nil, which is used later to drop the corresponding rows.
+// setVindexBindVars sets the bind variables of the vindex
+// columns of a row.
+func (ins *Insert) setVindexBindVars(bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, rowNum int) {
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		for colIdx, vindexKey := range vindexRowsValues[vIdx][rowNum] {
+			name := InsertVarName(colVindex.Columns[colIdx], rowNum)
+			bindVars[name] = sqltypes.ValueBindVariable(vindexKey)
+		}
+	}
+}
+
+// processVindexes computes the keyspace ids of the rows from the values
+// of their vindex columns. The 3-d structure indexes are colVindex, row, col.
+// For regular inserts, a failure to find a route results in an error.
+// For 'ignore' type inserts, the keyspace id is returned as nil, which
+// is used later to drop the corresponding rows.
+func (ins *Insert) processVindexes(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value) ([][]byte, error) {
 	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
 	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		return nil, err
 	}
+	if err := ins.processSecondaryVindexes(vcursor, vindexRowsValues, keyspaceIDs); err != nil {
+		return nil, err
+	}
+	return keyspaceIDs, nil
+}
 
+// processSecondaryVindexes creates the entries of the owned vindexes,
+// and reverse maps or validates the values of the unowned vindexes.
+func (ins *Insert) processSecondaryVindexes(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value, keyspaceIDs [][]byte) error {
 	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
 		colVindex := ins.Table.ColumnVindexes[vIdx]
 		var err error
@@ -392,27 +851,17 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
 		}
 		if err != nil {
-			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
-		}
-	}
-
-	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
-	// we're executing an insert ignore.
-	for vIdx, colVindex := range ins.Table.ColumnVindexes {
-		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
-			if keyspaceIDs[rowNum] == nil {
-				// InsertShardedIgnore: skip the row.
-				continue
-			}
-			for colIdx, vindexKey := range rowColumnKeys {
-				col := colVindex.Columns[colIdx]
-				name := InsertVarName(col, rowNum)
-				bindVars[name] = sqltypes.ValueBindVariable(vindexKey)
-			}
+			return err
 		}
 	}
+	return nil
+}
 
-	// We need to know the keyspace ids and the Mids associated with
+// resolveShards resolves the shards of the keyspace ids, and returns
+// the row numbers that go to each of them. Rows with nil keyspace ids
+// are skipped. If there are no rows left, no shards are returned.
+func (ins *Insert) resolveShards(vcursor VCursor, keyspaceIDs [][]byte) ([]*srvtopo.ResolvedShard, [][]int, error) {
+	// We need to know the keyspace ids and the rows associated with
 	// each RSS.  So we pass the ksid indexes in as ids, and get them back
 	// as values. We also skip nil KeyspaceIds, no need to resolve them.
 	var indexes []*querypb.Value
@@ -433,25 +882,107 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 
 	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
 	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		return nil, nil, err
 	}
 
-	queries := make([]*querypb.BoundQuery, len(rss))
+	rowsPerRss := make([][]int, len(rss))
 	for i := range rss {
-		var mids []string
 		for _, indexValue := range indexesPerRss[i] {
 			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
-			if keyspaceIDs[index] != nil {
-				mids = append(mids, ins.Mid[index])
+			rowsPerRss[i] = append(rowsPerRss[i], int(index))
+		}
+	}
+	return rss, rowsPerRss, nil
+}
+
+// minSelectColumns returns the number of columns the rows returned
+// by the input of an InsertSelect need for the vindex and auto-inc
+// columns to be present.
+func (ins *Insert) minSelectColumns() int {
+	count := 0
+	for _, offsets := range ins.VindexValueOffset {
+		for _, offset := range offsets {
+			if offset >= count {
+				count = offset + 1
 			}
 		}
+	}
+	if ins.Generate != nil && ins.Generate.Offset >= count {
+		count = ins.Generate.Offset + 1
+	}
+	return count
+}
+
+// ignore returns true if unroutable rows must be dropped
+// instead of failing the insert.
+func (ins *Insert) ignore() bool {
+	return ins.Opcode == InsertShardedIgnore || ins.Ignore
+}
+
+// getInsertSelectQueries is the InsertSelect counterpart of
+// getInsertShardedRoute. The vindex values are taken from the rows
+// returned by the input, and every shard receives a single insert
+// with the rows that belong to it.
+func (ins *Insert) getInsertSelectQueries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	// vindexRowsValues uses the same colVindex, row, col indexes
+	// as in getInsertShardedRoute.
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		if len(offsets) != len(ins.Table.ColumnVindexes[vIdx].Columns) {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column offsets don't match vschema: %v %v", offsets, ins.Table.ColumnVindexes[vIdx].Columns)
+		}
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for rowNum, row := range rows {
+			rowColumnKeys := make([]sqltypes.Value, len(offsets))
+			for colIdx, offset := range offsets {
+				rowColumnKeys[colIdx] = row[offset]
+			}
+			vindexRowsValues[vIdx][rowNum] = rowColumnKeys
+		}
+	}
+
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Copy back the values that were reverse mapped.
+	for vIdx, offsets := range ins.VindexValueOffset {
+		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
+			for colIdx, offset := range offsets {
+				rows[rowNum][offset] = rowColumnKeys[colIdx]
+			}
+		}
+	}
+
+	rss, rowsPerRss, err := ins.resolveShards(vcursor, keyspaceIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		// Every shard only receives the values of its own rows.
+		shardBindVars := make(map[string]*querypb.BindVariable, len(bindVars))
+		for k, v := range bindVars {
+			shardBindVars[k] = v
+		}
+		mids := make([]string, len(rowsPerRss[i]))
+		for j, rowNum := range rowsPerRss[i] {
+			names := make([]string, len(rows[rowNum]))
+			for colNum, value := range rows[rowNum] {
+				name := InsertSelectVarName(rowNum, colNum)
+				shardBindVars[name] = sqltypes.ValueBindVariable(value)
+				names[colNum] = ":" + name
+			}
+			mids[j] = "(" + strings.Join(names, ", ") + ")"
+		}
 		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
 		queries[i] = &querypb.BoundQuery{
 			Sql:           rewritten,
-			BindVariables: bindVars,
+			BindVariables: shardBindVars,
 		}
 	}
-
 	return rss, queries, nil
 }
 
@@ -470,7 +1001,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 			keyspaceIDs[i] = d
 		case key.DestinationNone:
 			// No valid keyspace id, we may return an error.
-			if ins.Opcode != InsertShardedIgnore {
+			if !ins.ignore() {
 				return nil, fmt.Errorf("could not map %v to a keyspace id", vindexColumnsKeys[i])
 			}
 		default:
@@ -483,7 +1014,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 
 // processOwned creates vindex entries for the values of an owned column.
 func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte) error {
-	if ins.Opcode == InsertSharded {
+	if !ins.ignore() {
 		return colVindex.Vindex.(vindexes.Lookup).Create(vcursor, vindexColumnsKeys, ksids, false /* ignoreMode */)
 	}
 
@@ -575,7 +1106,7 @@ func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltype
 		for i, v := range verified {
 			rowNum := verifyIndexes[i]
 			if !v {
-				if ins.Opcode != InsertShardedIgnore {
+				if !ins.ignore() {
 					mismatchVindexKeys = append(mismatchVindexKeys, vindexColumnsKeys[rowNum])
 					continue
 				}
@@ -596,6 +1127,12 @@ func InsertVarName(col sqlparser.ColIdent, rowNum int) string {
 	return fmt.Sprintf("_%s_%d", col.CompliantName(), rowNum)
 }
 
+// InsertSelectVarName returns the name of the bind var for a value
+// produced by the input of an InsertSelect.
+func InsertSelectVarName(rowNum, colNum int) string {
+	return fmt.Sprintf("__ins%d_%d", rowNum, colNum)
+}
+
 func (ins *Insert) description() PrimitiveDescription {
 	other := map[string]interface{}{
 		"Query":                ins.Query,
@@ -603,6 +1140,10 @@ func (ins *Insert) description() PrimitiveDescription {
 		"MultiShardAutocommit": ins.MultiShardAutocommit,
 		"QueryTimeout":         ins.QueryTimeout,
 	}
+	if len(ins.ConflictQueries) > 0 {
+		other["ConflictQueries"] = ins.ConflictQueries
+		other["ConflictDMLs"] = ins.ConflictDMLs
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
@@ -1566,3 +1566,475 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertSelectGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewSimpleInsert(InsertSelect, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.Suffix = " suffix"
+	ins.VindexValueOffset = [][]int{{0}}
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query:  "dummy_generate",
+		Offset: 1,
+	}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|seq",
+					"int64|int64",
+				),
+				"1|10",
+				"2|null",
+				"3|null",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"20",
+		),
+		{InsertID: 1},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 -20`,
+		// Based on shardForKsid, values returned will be 20-, -20, 20-.
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		// Every shard only receives the values of its own rows.
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:__ins0_0, :__ins0_1),(:__ins2_0, :__ins2_1) suffix ` +
+			`{__ins0_0: type:INT64 value:"1" __ins0_1: type:INT64 value:"10" ` +
+			`__ins2_0: type:INT64 value:"3" __ins2_1: type:INT64 value:"21" } ` +
+			`sharded.-20: prefix (:__ins1_0, :__ins1_1) suffix ` +
+			`{__ins1_0: type:INT64 value:"2" __ins1_1: type:INT64 value:"20" } ` +
+			`true false`,
+	})
+
+	// The insert id returned by ExecuteMultiShard should be overwritten by processGenerate.
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 20})
+}
+
+func TestInsertSelectOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewSimpleInsert(InsertSelect, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.VindexValueOffset = [][]int{{1}, {0}}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"c3|id",
+					"int64|int64",
+				),
+				"10|1",
+				"11|2",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "20-"}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1) ` +
+			`from_0: type:INT64 value:"10" from_1: type:INT64 value:"11" ` +
+			`toc_0: type:VARBINARY value:"\026k@\264J\272K\326" toc_1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:__ins0_0, :__ins0_1),(:__ins1_0, :__ins1_1) ` +
+			`{__ins0_0: type:INT64 value:"10" __ins0_1: type:INT64 value:"1" ` +
+			`__ins1_0: type:INT64 value:"11" __ins1_1: type:INT64 value:"2" } ` +
+			`true true`,
+	})
+}
+
+func TestInsertSelectIgnoreUnownedVerifyFail(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewSimpleInsert(InsertSelect, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.VindexValueOffset = [][]int{{0}, {1}}
+	ins.Ignore = true
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c3",
+					"int64|int64",
+				),
+				"1|10",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+
+	// nothing returned for the verify: the only row is dropped.
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`Execute select from from lkp1 where from = :from and toc = :toc from: type:INT64 value:"10" toc: type:VARBINARY value:"\026k@\264J\272K\326"  false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+
+	// Without IGNORE, the insert fails.
+	ins.Ignore = false
+	ins.Input.(*fakePrimitive).rewind()
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSelect: values [[INT64(10)]] for column [c3] does not map to keyspace ids")
+}
+
+func TestInsertShardedReplace(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1"},
+		" suffix",
+	)
+	ins.ConflictQueries = []string{"select conflicts"}
+	ins.ConflictDMLs = []string{"delete conflicts"}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "20-", "-20"}
+	vc.results = []*sqltypes.Result{
+		// The lookup maps c3 to the row with id 2.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("from|toc", "int64|varbinary"),
+			"10|\x06\xe7\xea\x22\xce\x92\x70\x8f",
+		),
+		// The conflicting row.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|c3", "int64|int64"),
+			"2|10",
+		),
+		// The lookup row is deleted.
+		{},
+		// The conflicting row is deleted.
+		{RowsAffected: 1},
+		// The lookup row is created.
+		{},
+		// The row is inserted.
+		{RowsAffected: 1},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`Execute select from, toc from lkp1 where from in ::from from: type:TUPLE values:<type:INT64 value:"10" >  false`,
+		// The conflicts are selected in the shard of the row, and in the shard of the lookup row.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: select conflicts {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } ` +
+			`sharded.20-: select conflicts {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } true false`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"10" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		// The conflicting row is in another shard.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: delete conflicts {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } true false`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"10" toc_0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2})
+}
+
+func TestInsertShardedUpsert(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedUpsert,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(20),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.ConflictQueries = []string{"select conflicts1", "select conflicts2"}
+	ins.ConflictDMLs = []string{"update conflicts1", "update conflicts2"}
+	ins.UpsertVindexValues = []map[string]VindexValues{{
+		"onecol": {"c3": {Key: "_c3_0"}},
+	}, {
+		"onecol": {"c3": {Key: "_c3_1"}},
+	}}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{
+		// c3 of the first row isn't in the lookup.
+		{},
+		// The first row conflicts with the row with id 1.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|c3", "int64|int64"),
+			"1|5",
+		),
+		// The lookup row is updated.
+		{},
+		{},
+		// The row is updated.
+		{RowsAffected: 2},
+		// c3 of the second row isn't in the lookup.
+		{},
+		// The second row doesn't conflict.
+		{},
+		// The lookup row is created.
+		{},
+		// The row is inserted.
+		{RowsAffected: 1},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`Execute select from, toc from lkp1 where from in ::from from: type:TUPLE values:<type:INT64 value:"10" >  false`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: select conflicts1 {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } true false`,
+		// The lookup row of the conflicting row is updated.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"10" toc_0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// The conflicting row is in the same shard.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } true false`,
+		`Execute select from, toc from lkp1 where from in ::from from: type:TUPLE values:<type:INT64 value:"20" >  false`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: select conflicts2 {_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"20" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } true false`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"20" toc_0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: prefix mid2 suffix {_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"20" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Lock)(nil)
+
+// LockAction is a change to the named locks held by a session.
+type LockAction int
+
+// These are the possible changes to the named locks.
+const (
+	// LockAcquire is the acquisition of a lock by GET_LOCK().
+	LockAcquire = LockAction(iota)
+	// LockRelease is the release of a lock by RELEASE_LOCK().
+	LockRelease
+	// LockReleaseAll is the release of all the locks by RELEASE_ALL_LOCKS().
+	LockReleaseAll
+)
+
+// Lock is a primitive that executes a select calling named lock
+// functions, like GET_LOCK(). All the named locks of a keyspace are
+// taken on a designated shard, over a connection that the session
+// reserves for them. The connection is kept until the session is
+// closed, which releases the locks that are still held.
+type Lock struct {
+	// Keyspace specifies the keyspace to send the query to.
+	Keyspace *vindexes.Keyspace
+
+	// TargetDestination specifies the designated shard of the keyspace.
+	TargetDestination key.Destination
+
+	// Query specifies the query to be executed.
+	Query string
+
+	// FieldQuery specifies the query to be executed for a GetFields request.
+	FieldQuery string
+
+	// LockFuncs are the select expressions that acquire or release
+	// a named lock. Their results are used to keep track of the locks
+	// held by the session.
+	LockFuncs []*LockFunc
+
+	noInputs
+	noTxNeeded
+}
+
+// LockFunc is a named lock function that is a select expression of
+// a Lock primitive.
+type LockFunc struct {
+	// Action is the change that the function makes if it succeeds.
+	Action LockAction
+
+	// Column is the column of the result that contains
+	// the return value of the function.
+	Column int
+
+	// Name is the name of the lock. It's not set for LockReleaseAll.
+	Name sqltypes.PlanValue
+}
+
+// RouteType implements the Primitive interface.
+func (l *Lock) RouteType() string {
+	return "Lock"
+}
+
+// GetKeyspaceName implements the Primitive interface.
+func (l *Lock) GetKeyspaceName() string {
+	return l.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface.
+func (l *Lock) GetTableName() string {
+	return "dual"
+}
+
+// Execute implements the Primitive interface.
+func (l *Lock) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rs, err := l.resolveShard(vcursor)
+	if err != nil {
+		return nil, err
+	}
+	query := &querypb.BoundQuery{
+		Sql:           l.Query,
+		BindVariables: bindVars,
+	}
+	result, err := vcursor.ExecuteLock(rs, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Rows) != 1 {
+		return result, nil
+	}
+	for _, lf := range l.LockFuncs {
+		// GET_LOCK() and RELEASE_LOCK() return 1 if they succeed,
+		// RELEASE_ALL_LOCKS() returns the number of released locks.
+		value := result.Rows[0][lf.Column]
+		if value.IsNull() || (lf.Action != LockReleaseAll && value.ToString() != "1") {
+			continue
+		}
+		var name string
+		if lf.Action != LockReleaseAll {
+			nameValue, err := lf.Name.ResolveValue(bindVars)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "lockExecute")
+			}
+			name = nameValue.ToString()
+		}
+		vcursor.Session().RecordLockAction(rs.Target, lf.Action, name)
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface.
+func (l *Lock) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := l.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface.
+func (l *Lock) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rs, err := l.resolveShard(vcursor)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "lockGetFields")
+	}
+	query := &querypb.BoundQuery{
+		Sql:           l.FieldQuery,
+		BindVariables: bindVars,
+	}
+	qr, err := vcursor.ExecuteLock(rs, query)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "lockGetFields")
+	}
+	qr.Rows = nil
+	qr.RowsAffected = 0
+	return qr, nil
+}
+
+// resolveShard returns the designated shard of the keyspace.
+func (l *Lock) resolveShard(vcursor VCursor) (*srvtopo.ResolvedShard, error) {
+	rss, _, err := vcursor.ResolveDestinations(l.Keyspace.Name, nil, []key.Destination{l.TargetDestination})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "lockExecute")
+	}
+	if len(rss) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "lock query can be routed to only one shard: %v", rss)
+	}
+	return rss[0], nil
+}
+
+func (l *Lock) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType:      "Lock",
+		Keyspace:          l.Keyspace,
+		TargetDestination: l.TargetDestination,
+		Other: map[string]interface{}{
+			"Query":      l.Query,
+			"FieldQuery": l.FieldQuery,
+		},
+	}
+}
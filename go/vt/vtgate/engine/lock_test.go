@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newTestLock() *Lock {
+	return &Lock{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		TargetDestination: key.DestinationKeyspaceID{0},
+		Query:             "select get_lock(:vtg1, :vtg2), release_lock(:vtg3), release_all_locks(), is_free_lock(:vtg1) from dual",
+		FieldQuery:        "select get_lock(:vtg1, :vtg2), release_lock(:vtg3), release_all_locks(), is_free_lock(:vtg1) from dual where 1 != 1",
+		LockFuncs: []*LockFunc{{
+			Action: LockAcquire,
+			Column: 0,
+			Name:   sqltypes.PlanValue{Key: "vtg1"},
+		}, {
+			Action: LockRelease,
+			Column: 1,
+			Name:   sqltypes.PlanValue{Key: "vtg3"},
+		}, {
+			Action: LockReleaseAll,
+			Column: 2,
+		}},
+	}
+}
+
+func TestLockExecute(t *testing.T) {
+	lock := newTestLock()
+	bindVars := map[string]*querypb.BindVariable{
+		"vtg1": sqltypes.StringBindVariable("a"),
+		"vtg2": sqltypes.Int64BindVariable(10),
+		"vtg3": sqltypes.StringBindVariable("b"),
+	}
+	fields := sqltypes.MakeTestFields("get_lock|release_lock|release_all_locks|is_free_lock", "int64|int64|int64|int64")
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1|1|0|0")},
+	}
+	result, err := lock.Execute(vc, bindVars, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteLock ks.-20: select get_lock(:vtg1, :vtg2), release_lock(:vtg3), release_all_locks(), is_free_lock(:vtg1) from dual {vtg1: type:VARBINARY value:"a" vtg2: type:INT64 value:"10" vtg3: type:VARBINARY value:"b" }`,
+		`RecordLockAction ks.-20 0 a`,
+		`RecordLockAction ks.-20 1 b`,
+		`RecordLockAction ks.-20 2 `,
+	})
+	expectResult(t, "lock.Execute", result, sqltypes.MakeTestResult(fields, "1|1|0|0"))
+
+	// A lock that isn't acquired or released isn't recorded.
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "0|null|null|1")},
+	}
+	_, err = lock.Execute(vc, bindVars, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteLock ks.-20: select get_lock(:vtg1, :vtg2), release_lock(:vtg3), release_all_locks(), is_free_lock(:vtg1) from dual {vtg1: type:VARBINARY value:"a" vtg2: type:INT64 value:"10" vtg3: type:VARBINARY value:"b" }`,
+	})
+
+	vc = &loggingVCursor{
+		shards:    []string{"-20", "20-"},
+		resultErr: errors.New("lock wait timeout"),
+	}
+	_, err = lock.Execute(vc, bindVars, false)
+	require.EqualError(t, err, "lock wait timeout")
+}
+
+func TestLockGetFields(t *testing.T) {
+	lock := newTestLock()
+	fields := sqltypes.MakeTestFields("get_lock|release_lock|release_all_locks|is_free_lock", "int64|int64|int64|int64")
+
+	vc := &loggingVCursor{
+		shards:  []string{"0"},
+		results: []*sqltypes.Result{{Fields: fields}},
+	}
+	result, err := lock.GetFields(vc, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(00)`,
+		`ExecuteLock ks.-20: select get_lock(:vtg1, :vtg2), release_lock(:vtg3), release_all_locks(), is_free_lock(:vtg1) from dual where 1 != 1 {}`,
+	})
+	expectResult(t, "lock.GetFields", result, &sqltypes.Result{Fields: fields})
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -62,12 +66,30 @@ type OrderedAggregate struct {
 type AggregateParams struct {
 	Opcode AggregateOpcode
 	Col    int
-	// Alias is set only for distinct opcodes.
+	// CountCol is the input column that contains the number of
+	// rows that were aggregated into Col. It's used by avg, which
+	// receives a sum in Col, and group_concat, which receives
+	// the distinct values to concatenate in Col.
+	CountCol int `json:",omitempty"`
+	// Separator is the separator used by group_concat.
+	Separator string `json:",omitempty"`
+	// Alias is set only for opcodes that change the column
+	// that they receive from the input.
 	Alias string `json:",omitempty"`
 }
 
 func (ap AggregateParams) isDistinct() bool {
-	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct
+	switch ap.Opcode {
+	case AggregateCountDistinct, AggregateSumDistinct, AggregateGroupConcatDistinct:
+		return true
+	}
+	return false
+}
+
+// needsConversion returns true if the values received
+// from the input need to be converted before aggregation.
+func (ap AggregateParams) needsConversion() bool {
+	return ap.isDistinct() || ap.Opcode == AggregateGroupConcat
 }
 
 func (ap AggregateParams) String() string {
@@ -89,30 +111,50 @@ const (
 	AggregateMax
 	AggregateCountDistinct
 	AggregateSumDistinct
+	AggregateBitAnd
+	AggregateBitOr
+	AggregateBitXor
+	AggregateAvg
+	AggregateGroupConcat
+	AggregateGroupConcatDistinct
 )
 
 var (
 	opcodeType = map[AggregateOpcode]querypb.Type{
-		AggregateCountDistinct: sqltypes.Int64,
-		AggregateSumDistinct:   sqltypes.Decimal,
+		AggregateCountDistinct:       sqltypes.Int64,
+		AggregateSumDistinct:         sqltypes.Decimal,
+		AggregateGroupConcat:         sqltypes.VarChar,
+		AggregateGroupConcatDistinct: sqltypes.VarChar,
 	}
 	// Some predefined values
-	countZero = sqltypes.MakeTrusted(sqltypes.Int64, []byte("0"))
-	countOne  = sqltypes.MakeTrusted(sqltypes.Int64, []byte("1"))
-	sumZero   = sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0"))
+	countZero  = sqltypes.MakeTrusted(sqltypes.Int64, []byte("0"))
+	countOne   = sqltypes.MakeTrusted(sqltypes.Int64, []byte("1"))
+	sumZero    = sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0"))
+	bitAndZero = sqltypes.NewUint64(math.MaxUint64)
+	bitOrZero  = sqltypes.NewUint64(0)
 )
 
+// avgScaleIncrement is the number of digits that MySQL adds to
+// the scale of a decimal when dividing it (div_precision_increment).
+const avgScaleIncrement = 4
+
 // SupportedAggregates maps the list of supported aggregate
 // functions to their opcodes.
 var SupportedAggregates = map[string]AggregateOpcode{
-	"count": AggregateCount,
-	"sum":   AggregateSum,
-	"min":   AggregateMin,
-	"max":   AggregateMax,
+	"count":        AggregateCount,
+	"sum":          AggregateSum,
+	"min":          AggregateMin,
+	"max":          AggregateMax,
+	"bit_and":      AggregateBitAnd,
+	"bit_or":       AggregateBitOr,
+	"bit_xor":      AggregateBitXor,
+	"avg":          AggregateAvg,
+	"group_concat": AggregateGroupConcat,
 	// These functions don't exist in mysql, but are used
 	// to display the plan.
-	"count_distinct": AggregateCountDistinct,
-	"sum_distinct":   AggregateSumDistinct,
+	"count_distinct":        AggregateCountDistinct,
+	"sum_distinct":          AggregateSumDistinct,
+	"group_concat_distinct": AggregateGroupConcatDistinct,
 }
 
 func (code AggregateOpcode) String() string {
@@ -169,11 +211,12 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
 	}
 	// This code is similar to the one in StreamExecute.
+	maxLen := oa.groupConcatMaxLen(vcursor)
 	var current []sqltypes.Value
 	var curDistinct sqltypes.Value
 	for _, row := range result.Rows {
 		if current == nil {
-			current, curDistinct = oa.convertRow(row)
+			current, curDistinct = oa.convertRow(row, maxLen)
 			continue
 		}
 
@@ -183,14 +226,14 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		}
 
 		if equal {
-			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct)
+			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct, maxLen)
 			if err != nil {
 				return nil, err
 			}
 			continue
 		}
-		out.Rows = append(out.Rows, current)
-		current, curDistinct = oa.convertRow(row)
+		out.Rows = append(out.Rows, oa.finalizeRow(current))
+		current, curDistinct = oa.convertRow(row, maxLen)
 	}
 
 	if len(result.Rows) == 0 && len(oa.Keys) == 0 {
@@ -204,7 +247,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	}
 
 	if current != nil {
-		out.Rows = append(out.Rows, current)
+		out.Rows = append(out.Rows, oa.finalizeRow(current))
 	}
 	out.RowsAffected = uint64(len(out.Rows))
 	return out, nil
@@ -212,6 +255,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 
 // StreamExecute is a Primitive function.
 func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	maxLen := oa.groupConcatMaxLen(vcursor)
 	var current []sqltypes.Value
 	var curDistinct sqltypes.Value
 	var fields []*querypb.Field
@@ -230,7 +274,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
 			if current == nil {
-				current, curDistinct = oa.convertRow(row)
+				current, curDistinct = oa.convertRow(row, maxLen)
 				continue
 			}
 
@@ -240,16 +284,16 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 			}
 
 			if equal {
-				current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
+				current, curDistinct, err = oa.merge(fields, current, row, curDistinct, maxLen)
 				if err != nil {
 					return err
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{oa.finalizeRow(current)}}); err != nil {
 				return err
 			}
-			current, curDistinct = oa.convertRow(row)
+			current, curDistinct = oa.convertRow(row, maxLen)
 		}
 		return nil
 	})
@@ -258,7 +302,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{oa.finalizeRow(current)}}); err != nil {
 			return err
 		}
 	}
@@ -266,29 +310,49 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 }
 
 func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Field {
-	if !oa.HasDistinct {
+	if len(fields) == 0 {
 		return fields
 	}
-
 	for _, aggr := range oa.Aggregates {
-		if !aggr.isDistinct() {
-			continue
-		}
-		fields[aggr.Col] = &querypb.Field{
-			Name: aggr.Alias,
-			Type: opcodeType[aggr.Opcode],
+		switch {
+		case aggr.Opcode == AggregateAvg:
+			// The input supplies a sum, which has the same type
+			// as the average.
+			fields[aggr.Col] = &querypb.Field{
+				Name: aggr.Alias,
+				Type: fields[aggr.Col].Type,
+			}
+		case aggr.needsConversion():
+			fields[aggr.Col] = &querypb.Field{
+				Name: aggr.Alias,
+				Type: opcodeType[aggr.Opcode],
+			}
 		}
 	}
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
-	if !oa.HasDistinct {
+func (oa *OrderedAggregate) needsConversion() bool {
+	for _, aggr := range oa.Aggregates {
+		if aggr.needsConversion() {
+			return true
+		}
+	}
+	return false
+}
+
+func (oa *OrderedAggregate) convertRow(row []sqltypes.Value, maxLen uint64) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
+	if !oa.needsConversion() {
 		return row, sqltypes.NULL
 	}
 	newRow = append(newRow, row...)
 	for _, aggr := range oa.Aggregates {
 		switch aggr.Opcode {
+		case AggregateGroupConcat:
+			newRow[aggr.Col] = concatRepeated(sqltypes.NULL, row[aggr.Col], row[aggr.CountCol], aggr.Separator, maxLen)
+		case AggregateGroupConcatDistinct:
+			curDistinct = row[aggr.Col]
+			newRow[aggr.Col] = concatRepeated(sqltypes.NULL, row[aggr.Col], countOne, aggr.Separator, maxLen)
 		case AggregateCountDistinct:
 			curDistinct = row[aggr.Col]
 			// Type is int64. Ok to call MakeTrusted.
@@ -341,7 +405,7 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value, maxLen uint64) ([]sqltypes.Value, sqltypes.Value, error) {
 	result := sqltypes.CopyRow(row1)
 	for _, aggr := range oa.Aggregates {
 		if aggr.isDistinct() {
@@ -369,6 +433,17 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], countOne, opcodeType[aggr.Opcode])
 		case AggregateSumDistinct:
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], opcodeType[aggr.Opcode])
+		case AggregateBitAnd, AggregateBitOr, AggregateBitXor:
+			result[aggr.Col], err = mergeBits(aggr.Opcode, row1[aggr.Col], row2[aggr.Col])
+		case AggregateAvg:
+			// Col contains the sum and CountCol the number of rows.
+			// The average is computed by finalizeRow.
+			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
+			result[aggr.CountCol] = evalengine.NullsafeAdd(row1[aggr.CountCol], row2[aggr.CountCol], sqltypes.Int64)
+		case AggregateGroupConcat:
+			result[aggr.Col] = concatRepeated(row1[aggr.Col], row2[aggr.Col], row2[aggr.CountCol], aggr.Separator, maxLen)
+		case AggregateGroupConcatDistinct:
+			result[aggr.Col] = concatRepeated(row1[aggr.Col], row2[aggr.Col], countOne, aggr.Separator, maxLen)
 		default:
 			return nil, sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
 		}
@@ -379,6 +454,128 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 	return result, curDistinct, nil
 }
 
+// finalizeRow computes the final values of the aggregates that
+// cannot be returned as they were accumulated by merge.
+func (oa *OrderedAggregate) finalizeRow(row []sqltypes.Value) []sqltypes.Value {
+	var out []sqltypes.Value
+	for _, aggr := range oa.Aggregates {
+		if aggr.Opcode != AggregateAvg {
+			continue
+		}
+		if out == nil {
+			out = sqltypes.CopyRow(row)
+		}
+		out[aggr.Col] = average(row[aggr.Col], row[aggr.CountCol])
+	}
+	if out == nil {
+		return row
+	}
+	return out
+}
+
+// average divides sum by count. If the sum is a decimal, the result
+// is a decimal whose scale is increased the same way MySQL does it.
+// Otherwise, the result is a float.
+func average(sum, count sqltypes.Value) sqltypes.Value {
+	n, err := evalengine.ToInt64(count)
+	if err != nil || n == 0 || sum.IsNull() {
+		return sqltypes.NULL
+	}
+	if sum.Type() != sqltypes.Decimal {
+		f, err := evalengine.ToFloat64(sum)
+		if err != nil {
+			return sqltypes.NULL
+		}
+		return sqltypes.NewFloat64(f / float64(n))
+	}
+	rat, ok := new(big.Rat).SetString(sum.ToString())
+	if !ok {
+		return sqltypes.NULL
+	}
+	rat.Quo(rat, new(big.Rat).SetInt64(n))
+	scale := 0
+	if i := strings.IndexByte(sum.ToString(), '.'); i >= 0 {
+		scale = len(sum.ToString()) - i - 1
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(rat.FloatString(scale+avgScaleIncrement)))
+}
+
+// mergeBits merges the results of two bit_and, bit_or or bit_xor aggregations.
+func mergeBits(opcode AggregateOpcode, v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	u1, err := evalengine.ToUint64(v1)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	u2, err := evalengine.ToUint64(v2)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch opcode {
+	case AggregateBitAnd:
+		return sqltypes.NewUint64(u1 & u2), nil
+	case AggregateBitOr:
+		return sqltypes.NewUint64(u1 | u2), nil
+	}
+	return sqltypes.NewUint64(u1 ^ u2), nil
+}
+
+// defaultGroupConcatMaxLen is the default value of group_concat_max_len
+// in MySQL.
+const defaultGroupConcatMaxLen = 1024
+
+// groupConcatMaxLen returns the length, in bytes, the merged group_concat
+// results are truncated to. That is the group_concat_max_len of the
+// session, or the MySQL default if the session didn't set it.
+func (oa *OrderedAggregate) groupConcatMaxLen(vcursor VCursor) uint64 {
+	hasGroupConcat := false
+	for _, aggr := range oa.Aggregates {
+		if aggr.Opcode == AggregateGroupConcat || aggr.Opcode == AggregateGroupConcatDistinct {
+			hasGroupConcat = true
+		}
+	}
+	if !hasGroupConcat {
+		return defaultGroupConcatMaxLen
+	}
+	expr, ok := vcursor.Session().SysVar("group_concat_max_len")
+	if !ok {
+		return defaultGroupConcatMaxLen
+	}
+	maxLen, err := strconv.ParseUint(expr, 10, 64)
+	if err != nil {
+		return defaultGroupConcatMaxLen
+	}
+	return maxLen
+}
+
+// concatRepeated appends val to the concatenated value cur as many times
+// as specified by count, using the separator. NULL values are skipped.
+// Like in MySQL, the result is truncated to maxLen bytes.
+func concatRepeated(cur, val, count sqltypes.Value, separator string, maxLen uint64) sqltypes.Value {
+	if val.IsNull() {
+		return cur
+	}
+	n, err := evalengine.ToInt64(count)
+	if err != nil || n < 1 {
+		n = 1
+	}
+	var buf bytes.Buffer
+	first := cur.IsNull()
+	if !first {
+		buf.Write(cur.ToBytes())
+	}
+	for i := int64(0); i < n && uint64(buf.Len()) < maxLen; i++ {
+		if !first {
+			buf.WriteString(separator)
+		}
+		first = false
+		buf.Write(val.ToBytes())
+	}
+	if uint64(buf.Len()) > maxLen {
+		buf.Truncate(int(maxLen))
+	}
+	return sqltypes.MakeTrusted(sqltypes.VarChar, buf.Bytes())
+}
+
 // creates the empty row for the case when we are missing grouping keys and have empty input table
 func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
 	out := make([]sqltypes.Value, len(oa.Aggregates))
@@ -402,8 +599,17 @@ func createEmptyValueFor(opcode AggregateOpcode) (sqltypes.Value, error) {
 		AggregateSumDistinct,
 		AggregateSum,
 		AggregateMin,
-		AggregateMax:
+		AggregateMax,
+		AggregateAvg,
+		AggregateGroupConcat,
+		AggregateGroupConcatDistinct:
 		return sqltypes.NULL, nil
+	case AggregateBitAnd:
+		return bitAndZero, nil
+	case
+		AggregateBitOr,
+		AggregateBitXor:
+		return bitOrZero, nil
 
 	}
 	return sqltypes.NULL, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown aggregation %v", opcode)
@@ -650,13 +650,13 @@ func TestMerge(t *testing.T) {
 		"1|3|2.8|2|bc",
 	)
 
-	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
+	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL, defaultGroupConcatMaxLen)
 	assert.NoError(err)
 	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
-	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL)
+	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL, defaultGroupConcatMaxLen)
 	assert.NoError(err)
 	assert.Equal(want, merged)
 }
@@ -697,6 +697,16 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		AggregateMin,
 		"null",
 		"int64",
+	}, {
+		"avg(col1)",
+		AggregateAvg,
+		"null",
+		"int64",
+	}, {
+		"group_concat(col1)",
+		AggregateGroupConcat,
+		"null",
+		"varchar",
 	}}
 
 	for _, test := range testCases {
@@ -723,7 +733,7 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 				Input: fp,
 			}
 
-			result, err := oa.Execute(nil, nil, false)
+			result, err := oa.Execute(&noopVCursor{}, nil, false)
 			assert.NoError(err)
 
 			wantResult := sqltypes.MakeTestResult(
@@ -737,3 +747,231 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		})
 	}
 }
+
+func TestOrderedAggregateBitOperations(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|bit_and(a)|bit_or(a)|bit_xor(a)",
+		"varbinary|uint64|uint64|uint64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|6|6|6",
+			"a|3|3|3",
+			"b|5|5|5",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateBitAnd,
+			Col:    1,
+		}, {
+			Opcode: AggregateBitOr,
+			Col:    2,
+		}, {
+			Opcode: AggregateBitXor,
+			Col:    3,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|2|7|5",
+		"b|5|5|5",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateAvg(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|sum(a)|count(a)",
+				"varbinary|decimal|int64",
+			),
+			"a|1|1",
+			"a|2.5|2",
+			"b|null|0",
+			"c|1|3",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:   AggregateAvg,
+			Col:      1,
+			CountCol: 2,
+			Alias:    "avg(a)",
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|avg(a)",
+			"varbinary|decimal",
+		),
+		"a|1.16667",
+		"b|null",
+		"c|0.3333",
+	)
+
+	result, err := oa.Execute(nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateGroupConcat(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|group_concat(a)|count(*)",
+				"varbinary|varbinary|int64",
+			),
+			"a|x|2",
+			"a|null|1",
+			"a|y|1",
+			"b|null|3",
+			"c|z|1",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateGroupConcat,
+			Col:       1,
+			CountCol:  2,
+			Separator: ";",
+			Alias:     "group_concat(a)",
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|group_concat(a)",
+			"varbinary|varchar",
+		),
+		"a|x;x;y",
+		"b|null",
+		"c|z",
+	)
+
+	result, err := oa.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateGroupConcatDistinct(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|a",
+				"varbinary|varbinary",
+			),
+			"a|x",
+			"a|x",
+			"a|y",
+			"b|null",
+			"b|z",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateGroupConcatDistinct,
+			Col:       1,
+			Separator: ",",
+			Alias:     "group_concat(distinct a)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|group_concat(distinct a)",
+			"varbinary|varchar",
+		),
+		"a|x,y",
+		"b|z",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateGroupConcatMaxLen(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|group_concat(a)|count(*)",
+				"varbinary|varbinary|int64",
+			),
+			"a|xyz|1000",
+			"a|uvw|1",
+			"b|abcdef|1",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateGroupConcat,
+			Col:       1,
+			CountCol:  2,
+			Separator: ",",
+			Alias:     "group_concat(a)",
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	// Without a session value, the MySQL default of 1024 applies.
+	result, err := oa.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1024, len(result.Rows[0][1].ToBytes()))
+	assert.Equal(t, "abcdef", result.Rows[1][1].ToString())
+
+	vc := &loggingVCursor{sysVars: map[string]string{"group_concat_max_len": "5"}}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|group_concat(a)",
+			"varbinary|varchar",
+		),
+		"a|xyz,x",
+		"b|abcde",
+	)
+
+	fp.rewind()
+	result, err = oa.Execute(vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, vc, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+}
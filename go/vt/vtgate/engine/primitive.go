@@ -78,6 +78,14 @@ type (
 
 		ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.DDL) error
 
+		// ExecuteDBDDL creates or drops a database.
+		ExecuteDBDDL(dbddl *sqlparser.DBDDL) error
+
+		// ExecuteLock executes a query that calls named lock functions
+		// on the connection that the session reserves for the locks on
+		// the shard.
+		ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
+
 		Session() SessionActions
 	}
 
@@ -91,6 +99,48 @@ type (
 		SetUDV(key string, value interface{}) error
 
 		SetSysVar(name string, expr string)
+
+		// SysVar returns the expression the session set the system
+		// variable to, if it did.
+		SysVar(name string) (string, bool)
+
+		// UnsetSysVar removes the system variable from the session, once
+		// it's back at the value of the tablets. The session stops using
+		// reserved connections when it sets no system variables anymore.
+		UnsetSysVar(name string) error
+
+		// SetFoundRows sets the number of rows the statement found,
+		// which is returned by FOUND_ROWS() afterwards.
+		SetFoundRows(foundRows uint64)
+
+		// TransactionMode returns the transaction mode of the session,
+		// or the default one of vtgate if the session doesn't set it.
+		TransactionMode() vtgatepb.TransactionMode
+
+		// RecordLockAction records a change to the named locks held by
+		// the session on the lock connection to the target.
+		RecordLockAction(target *querypb.Target, action LockAction, name string)
+
+		// NeedsReservedConn makes the session run its queries on
+		// reserved connections from now on.
+		NeedsReservedConn()
+
+		// UseTemporaryTables makes the current query run on the reserved
+		// connections of the session, which its temporary tables are on.
+		UseTemporaryTables()
+
+		// AddTemporaryTables records the temporary tables that the
+		// session created.
+		AddTemporaryTables(names []string)
+
+		// DropTemporaryTables forgets the temporary tables that the
+		// session dropped. The reserved connections are released once
+		// the session doesn't need them anymore.
+		DropTemporaryTables(names []string) error
+
+		// ShardSession returns the shards the session has a
+		// connection to.
+		ShardSession() []*srvtopo.ResolvedShard
 	}
 
 	// Plan represents the execution strategy for a given query.
@@ -105,6 +155,7 @@ type (
 		sqlparser.BindVarNeeds                         // Stores BindVars needed to be provided as part of expression rewriting
 
 		mu           sync.Mutex    // Mutex to protect the fields below
+		CacheHits    uint64        // Count of times this plan was found in the plan cache
 		ExecCount    uint64        // Count of times this plan was executed
 		ExecTime     time.Duration // Total execution time
 		ShardQueries uint64        // Total number of shard queries
@@ -157,6 +208,13 @@ func (p *Plan) AddStats(execCount uint64, execTime time.Duration, shardQueries,
 	p.mu.Unlock()
 }
 
+// AddCacheHit records that the plan was found in the plan cache.
+func (p *Plan) AddCacheHit() {
+	p.mu.Lock()
+	p.CacheHits++
+	p.mu.Unlock()
+}
+
 // Stats returns a copy of the plan execution statistics
 func (p *Plan) Stats() (execCount uint64, execTime time.Duration, shardQueries, rows, errors uint64) {
 	p.mu.Lock()
@@ -195,6 +253,23 @@ func (p *Plan) Size() int {
 	return 1
 }
 
+// planOverhead is the estimated memory usage of a Plan,
+// excluding its query and instructions.
+const planOverhead = 256
+
+// CachedSize is defined so that Plan can be given to a cache.LFUCache,
+// which is bounded by the memory usage of its values. The size of the
+// instructions is approximated by the length of their description.
+func (p *Plan) CachedSize() int64 {
+	size := int64(planOverhead + len(p.Original))
+	if p.Instructions != nil {
+		if description, err := json.Marshal(PrimitiveToPlanDescription(p.Instructions)); err == nil {
+			size += int64(len(description))
+		}
+	}
+	return size
+}
+
 //MarshalJSON serializes the plan into a JSON representation.
 func (p *Plan) MarshalJSON() ([]byte, error) {
 	var instructions *PrimitiveDescription
@@ -207,6 +282,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		QueryType    string
 		Original     string                `json:",omitempty"`
 		Instructions *PrimitiveDescription `json:",omitempty"`
+		CacheHits    uint64                `json:",omitempty"`
 		ExecCount    uint64                `json:",omitempty"`
 		ExecTime     time.Duration         `json:",omitempty"`
 		ShardQueries uint64                `json:",omitempty"`
@@ -216,6 +292,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
 		Instructions: instructions,
+		CacheHits:    p.CacheHits,
 		ExecCount:    p.ExecCount,
 		ExecTime:     p.ExecTime,
 		ShardQueries: p.ShardQueries,
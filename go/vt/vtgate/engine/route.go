@@ -70,8 +70,10 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a MultiColumn vindex, there is one value for each
+	// of its leading columns.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -382,11 +384,11 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
+	key, err := resolveValues(route.Values, bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
+	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, [][]sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
@@ -402,22 +404,24 @@ func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
-	rss, values, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, values, err := resolveShards(vcursor, route.Vindex, route.Keyspace, singleColumnRows(keys))
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
 	return rss, shardVars(bindVars, values), nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
-	// Convert vindexKeys to []*querypb.Value
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	// Convert the first column of vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
-		ids[i] = sqltypes.ValueToProto(vik)
+		if len(vik) != 0 {
+			ids[i] = sqltypes.ValueToProto(vik[0])
+		}
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := vindexes.Map(vindex, vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -426,6 +430,29 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	return vcursor.ResolveDestinations(keyspace.Name, ids, destinations)
 }
 
+// resolveValues resolves the values of all the columns of a vindex key.
+func resolveValues(pvs []sqltypes.PlanValue, bindVars map[string]*querypb.BindVariable) ([]sqltypes.Value, error) {
+	key := make([]sqltypes.Value, 0, len(pvs))
+	for _, pv := range pvs {
+		value, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		key = append(key, value)
+	}
+	return key, nil
+}
+
+// singleColumnRows converts the values of a single column
+// vindex to the rows expected by vindexes.Map.
+func singleColumnRows(values []sqltypes.Value) [][]sqltypes.Value {
+	rows := make([][]sqltypes.Value, 0, len(values))
+	for _, value := range values {
+		rows = append(rows, []sqltypes.Value{value})
+	}
+	return rows
+}
+
 func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	var err error
 	// Since Result is immutable, we make a copy.
@@ -467,8 +494,8 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
-func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -491,8 +518,8 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	return rss[0], ksid, nil
 }
 
-func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
-	destinations, err := vindex.Map(vcursor, vindexKey)
+func resolveMultiShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, vindexKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +530,8 @@ func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *
 	return rss, nil
 }
 
-func resolveKeyspaceID(vcursor VCursor, vindex vindexes.SingleColumn, vindexKey sqltypes.Value) ([]byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKey []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, err
 	}
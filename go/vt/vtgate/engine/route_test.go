@@ -326,6 +326,52 @@ func TestSelectEqualNoRoute(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, nil)
 }
 
+func TestSelectEqualMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{
+		"column_count": "2",
+		"column_bytes": "1,7",
+	})
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyspaceID(1606e7ea22ce9270)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// Only the first column routes to the keyrange of its rows.
+	sel.Opcode = SelectEqual
+	sel.Values = sel.Values[:1]
+	vc.Rewind()
+	result, err = sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyRange(16-17)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} ks.20-: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+}
+
 func TestSelectINUnique(t *testing.T) {
 	vindex, _ := vindexes.NewHash("", nil)
 	sel := NewRoute(
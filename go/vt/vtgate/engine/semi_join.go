@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// DefaultSemiJoinBatchSize is the number of LHS rows for which
+// a SemiJoin executes the RHS at once.
+const DefaultSemiJoinBatchSize = 500
+
+// SemiJoin specifies the parameters for a semi-join primitive.
+// It's used for correlated subqueries that can't be merged with
+// the outer query, like EXISTS (select ... where b.x = a.x).
+// The LHS rows are processed in batches. For every batch, the
+// RHS is executed once, with the distinct values of every LHS key
+// column in a list bind variable. An LHS row is returned if one of
+// the RHS rows matches its keys. For AntiJoin, it's returned if none
+// of them does. The rows are returned in the order of the LHS.
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+
+	// Left and Right are the LHS and RHS primitives
+	// of the SemiJoin. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// LHSKeys and RHSKeys are the offsets of the correlated
+	// columns in the left and right results. A left row matches
+	// a right row if every LHSKeys[i] compares equal to RHSKeys[i].
+	// Rows with NULL keys never match.
+	LHSKeys []int `json:",omitempty"`
+	RHSKeys []int `json:",omitempty"`
+
+	// ListVars specifies the list bind variables that receive the
+	// distinct non-NULL values of LHS columns for every batch.
+	// The key is the bind variable name, and the value is the
+	// offset of the column in the LHS results. Like Join.Vars,
+	// these are usually the same columns as the LHSKeys, unless
+	// the keys are compared by their weight strings.
+	ListVars map[string]int `json:",omitempty"`
+
+	// BatchSize is the maximum number of LHS rows in a batch.
+	BatchSize int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. The key columns may have been added to
+	// the LHS results just for the SemiJoin.
+	TruncateColumnCount int `json:",omitempty"`
+}
+
+// Execute performs a non-streaming exec.
+func (sj *SemiJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: lresult.Fields}
+	result.Rows, err = sj.filter(vcursor, bindVars, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result.Truncate(sj.TruncateColumnCount), nil
+}
+
+// StreamExecute performs a streaming exec.
+func (sj *SemiJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return sj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		rows, err := sj.filter(vcursor, bindVars, lresult.Rows)
+		if err != nil {
+			return err
+		}
+		if lresult.Fields == nil && len(rows) == 0 {
+			return nil
+		}
+		result := &sqltypes.Result{Fields: lresult.Fields, Rows: rows}
+		return callback(result.Truncate(sj.TruncateColumnCount))
+	})
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: lresult.Fields}
+	return result.Truncate(sj.TruncateColumnCount), nil
+}
+
+// filter returns the LHS rows that pass the SemiJoin.
+func (sj *SemiJoin) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	batchSize := sj.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultSemiJoinBatchSize
+	}
+	var rows [][]sqltypes.Value
+	for len(lrows) > 0 {
+		batch := lrows
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		lrows = lrows[len(batch):]
+
+		table, err := sj.execBatch(vcursor, bindVars, batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, lrow := range batch {
+			matched := false
+			if table != nil {
+				rrows, err := table.probe(lrow, sj.LHSKeys)
+				if err != nil {
+					return nil, err
+				}
+				matched = len(rrows) != 0
+			}
+			if matched == (sj.Opcode == Semi) {
+				rows = append(rows, lrow)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// execBatch executes the RHS for a batch of LHS rows, and returns
+// its rows in a hash table. If no LHS row has non-NULL keys, the RHS
+// is not executed, and a nil table is returned.
+func (sj *SemiJoin) execBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, batch [][]sqltypes.Value) (*hashTable, error) {
+	combinedVars := make(map[string]*querypb.BindVariable, len(bindVars)+len(sj.ListVars))
+	for k, v := range bindVars {
+		combinedVars[k] = v
+	}
+	for listVar, col := range sj.ListVars {
+		values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+		seen := make(map[string]bool)
+		for _, lrow := range batch {
+			key, ok := hashKey(lrow, []int{col})
+			if !ok || seen[key] {
+				continue
+			}
+			seen[key] = true
+			values.Values = append(values.Values, sqltypes.ValueToProto(lrow[col]))
+		}
+		if len(values.Values) == 0 {
+			return nil, nil
+		}
+		combinedVars[listVar] = values
+	}
+
+	rresult, err := sj.Right.Execute(vcursor, combinedVars, false)
+	if err != nil {
+		return nil, err
+	}
+	table := newHashTable(sj.RHSKeys)
+	if err := table.add(vcursor, rresult.Rows); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// Inputs returns the input primitives for this semi-join
+func (sj *SemiJoin) Inputs() []Primitive {
+	return []Primitive{sj.Left, sj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return sj.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (sj *SemiJoin) NeedsTransaction() bool {
+	return sj.Right.NeedsTransaction() || sj.Left.NeedsTransaction()
+}
+
+func (sj *SemiJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName": sj.GetTableName(),
+		"LHSKeys":   intsToString(sj.LHSKeys),
+		"RHSKeys":   intsToString(sj.RHSKeys),
+		"ListVars":  sj.ListVars,
+		"BatchSize": sj.BatchSize,
+	}
+	if sj.TruncateColumnCount != 0 {
+		other["TruncateColumnCount"] = sj.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "SemiJoin",
+		Variant:      sj.Opcode.String(),
+		Other:        other,
+	}
+}
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	Semi = SemiJoinOpcode(iota)
+	Anti
+)
+
+func (code SemiJoinOpcode) String() string {
+	if code == Semi {
+		return "SemiJoin"
+	}
+	return "AntiJoin"
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func newSemiJoinTestPrimitives() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varbinary",
+				),
+				"1|a",
+				"2|b",
+				"null|c",
+				"3|d",
+				"1|e",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3",
+		"decimal",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(rightFields, "1.0"),
+			sqltypes.MakeTestResult(rightFields),
+			sqltypes.MakeTestResult(rightFields, "1"),
+		},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestSemiJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinTestPrimitives()
+	sj := &SemiJoin{
+		Opcode:    Semi,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		LHSKeys:   []int{0},
+		RHSKeys:   []int{0},
+		ListVars:  map[string]int{"__sj1": 0},
+		BatchSize: 2,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sj1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:INT64 value:"3" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:INT64 value:"1" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varbinary",
+		),
+		"1|a",
+		"1|e",
+	))
+
+	// AntiJoin
+	leftPrim.rewind()
+	rightPrim.rewind()
+	sj.Opcode = Anti
+	sj.TruncateColumnCount = 1
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"2",
+		"null",
+		"3",
+	))
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinTestPrimitives()
+	sj := &SemiJoin{
+		Opcode:              Semi,
+		Left:                leftPrim,
+		Right:               rightPrim,
+		LHSKeys:             []int{0},
+		RHSKeys:             []int{0},
+		ListVars:            map[string]int{"__sj1": 0},
+		TruncateColumnCount: 1,
+	}
+	// The fake primitive streams the LHS two rows at a time.
+	r, err := wrapStreamExecute(sj, noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sj1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:INT64 value:"3" >  false`,
+		`Execute __sj1: type:TUPLE values:<type:INT64 value:"1" >  false`,
+	})
+	expectResult(t, "sj.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+		"1",
+		"1",
+	))
+}
+
+func TestSemiJoinVarcharKeys(t *testing.T) {
+	// VARCHAR keys are compared by the weight strings that the
+	// planner adds next to them, but the list variable gets the
+	// values of the columns.
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|weight_string(col1)",
+					"varchar|varbinary",
+				),
+				"abc|ABC",
+				"def|DEF",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2|weight_string(col2)",
+					"varchar|varbinary",
+				),
+				"ABC|ABC",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:              Semi,
+		Left:                leftPrim,
+		Right:               rightPrim,
+		LHSKeys:             []int{1},
+		RHSKeys:             []int{1},
+		ListVars:            map[string]int{"__sj1": 0},
+		TruncateColumnCount: 1,
+	}
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sj1: type:TUPLE values:<type:VARCHAR value:"abc" > values:<type:VARCHAR value:"def" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"varchar",
+		),
+		"abc",
+	))
+}
+
+func TestSemiJoinNullKeys(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varbinary",
+				),
+				"null|a",
+				"null|b",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{}
+	sj := &SemiJoin{
+		Opcode:   Anti,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		LHSKeys:  []int{0},
+		RHSKeys:  []int{0},
+		ListVars: map[string]int{"__sj1": 0},
+	}
+
+	// The RHS is not executed if there are no keys to look up.
+	r, err := sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.Execute", r, leftPrim.results[0])
+
+	leftPrim.rewind()
+	sj.Opcode = Semi
+	r, err = sj.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.Execute", r, &sqltypes.Result{Fields: leftPrim.results[0].Fields})
+}
+
+func TestSemiJoinExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim, rightPrim := newSemiJoinTestPrimitives()
+	rightPrim.results[0].Rows = append(rightPrim.results[0].Rows, rightPrim.results[0].Rows[0])
+	sj := &SemiJoin{
+		Opcode:   Semi,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		LHSKeys:  []int{0},
+		RHSKeys:  []int{0},
+		ListVars: map[string]int{"__sj1": 0},
+	}
+	_, err := sj.Execute(noopVCursor{}, nil, false)
+	want := "in-memory row count exceeded allowed limit of 1"
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(): %v, want %v", err, want)
+	}
+}
+
+func TestSemiJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := newSemiJoinTestPrimitives()
+	sj := &SemiJoin{
+		Opcode:              Semi,
+		Left:                leftPrim,
+		Right:               rightPrim,
+		LHSKeys:             []int{0},
+		RHSKeys:             []int{0},
+		ListVars:            map[string]int{"__sj1": 0},
+		TruncateColumnCount: 1,
+	}
+	r, err := sj.GetFields(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.GetFields", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col1",
+			"int64",
+		),
+	})
+}
@@ -45,6 +45,16 @@ type Send struct {
 	// SingleShardOnly specifies that the query must be send to only single shard
 	SingleShardOnly bool
 
+	// TemporaryTables are the temporary tables that the query creates,
+	// or drops if DropsTemporaryTables is set. A temporary table only
+	// exists on the connection that created it, so the query runs on a
+	// reserved connection, and the session records its temporary tables
+	// to run the queries that use them on the same connection.
+	TemporaryTables []string
+
+	// DropsTemporaryTables specifies that the query drops the TemporaryTables.
+	DropsTemporaryTables bool
+
 	noInputs
 }
 
@@ -100,12 +110,26 @@ func (s *Send) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariabl
 		canAutocommit = len(rss) == 1 && vcursor.AutocommitApproval()
 	}
 
+	if len(s.TemporaryTables) > 0 {
+		vcursor.Session().UseTemporaryTables()
+	}
+
 	rollbackOnError := s.IsDML // for non-dml queries, there's no need to do a rollback
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, rollbackOnError, canAutocommit)
 	err = vterrors.Aggregate(errs)
 	if err != nil {
 		return nil, err
 	}
+
+	if len(s.TemporaryTables) > 0 {
+		if s.DropsTemporaryTables {
+			if err := vcursor.Session().DropTemporaryTables(s.TemporaryTables); err != nil {
+				return nil, err
+			}
+		} else {
+			vcursor.Session().AddTemporaryTables(s.TemporaryTables)
+		}
+	}
 	return result, nil
 }
 
@@ -156,6 +180,12 @@ func (s *Send) description() PrimitiveDescription {
 		"IsDML":           s.IsDML,
 		"SingleShardOnly": s.SingleShardOnly,
 	}
+	if len(s.TemporaryTables) > 0 {
+		other["TemporaryTables"] = s.TemporaryTables
+	}
+	if s.DropsTemporaryTables {
+		other["DropsTemporaryTables"] = true
+	}
 	return PrimitiveDescription{
 		OperatorType:      "Send",
 		Keyspace:          s.Keyspace,
@@ -39,6 +39,8 @@ func TestSendTable(t *testing.T) {
 		expectedError    string
 		isDML            bool
 		singleShardOnly  bool
+		temporaryTables  []string
+		dropsTemporary   bool
 	}
 
 	singleShard := []string{"0"}
@@ -111,6 +113,35 @@ func TestSendTable(t *testing.T) {
 			isDML:           true,
 			singleShardOnly: true,
 		},
+		{
+			testName:    "sharded creating a temporary table",
+			sharded:     true,
+			shards:      twoShards,
+			destination: key.DestinationShard("20-"),
+			expectedQueryLog: []string{
+				`ResolveDestinations ks [] Destinations:DestinationShard(20-)`,
+				`UseTemporaryTables`,
+				`ExecuteMultiShard ks.DestinationShard(20-): dummy_query {} false false`,
+				`AddTemporaryTables [temp]`,
+			},
+			singleShardOnly: true,
+			temporaryTables: []string{"temp"},
+		},
+		{
+			testName:    "sharded dropping temporary tables",
+			sharded:     true,
+			shards:      twoShards,
+			destination: key.DestinationShard("20-"),
+			expectedQueryLog: []string{
+				`ResolveDestinations ks [] Destinations:DestinationShard(20-)`,
+				`UseTemporaryTables`,
+				`ExecuteMultiShard ks.DestinationShard(20-): dummy_query {} false false`,
+				`DropTemporaryTables [temp1 temp2]`,
+			},
+			singleShardOnly: true,
+			temporaryTables: []string{"temp1", "temp2"},
+			dropsTemporary:  true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -120,10 +151,12 @@ func TestSendTable(t *testing.T) {
 					Name:    "ks",
 					Sharded: tc.sharded,
 				},
-				Query:             "dummy_query",
-				TargetDestination: tc.destination,
-				IsDML:             tc.isDML,
-				SingleShardOnly:   tc.singleShardOnly,
+				Query:                "dummy_query",
+				TargetDestination:    tc.destination,
+				IsDML:                tc.isDML,
+				SingleShardOnly:      tc.singleShardOnly,
+				TemporaryTables:      tc.temporaryTables,
+				DropsTemporaryTables: tc.dropsTemporary,
 			}
 			vc := &loggingVCursor{shards: tc.shards}
 			_, err := send.Execute(vc, map[string]*querypb.BindVariable{}, false)
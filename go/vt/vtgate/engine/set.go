@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -67,7 +68,9 @@ type (
 		Expr              string
 	}
 
-	// SysVarSet implements the SetOp interface and will write the changes variable into the session
+	// SysVarSet implements the SetOp interface and will write the changes variable into the session.
+	// A session that sets a value other than the one of the tablet connections
+	// uses reserved connections, to which the values are applied.
 	SysVarSet struct {
 		Name              string
 		Keyspace          *vindexes.Keyspace
@@ -273,11 +276,59 @@ func (svs *SysVarSet) Execute(vcursor VCursor, res evalengine.ExpressionEnv) err
 	if len(rss) != 1 {
 		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unexpected error, DestinationKeyspaceID mapping to multiple shards: %v", svs.TargetDestination)
 	}
-	sysVarExprValidationQuery := fmt.Sprintf("select %s from dual where false", svs.Expr)
-	_, err = execShard(vcursor, sysVarExprValidationQuery, res.BindVars, rss[0], false /* rollbackOnError */, false /* canAutocommit */)
+	// The query evaluates the expression, and only returns it if it's
+	// not the value of the connection. That is the value of the tablet
+	// as long as the session doesn't use reserved connections.
+	sysVarCheckQuery := fmt.Sprintf("select %s from dual where @@%s != %s", svs.Expr, svs.Name, svs.Expr)
+	result, err := execShard(vcursor, sysVarCheckQuery, res.BindVars, rss[0], false /* rollbackOnError */, false /* canAutocommit */)
 	if err != nil {
 		return err
 	}
-	vcursor.Session().SetSysVar(svs.Name, svs.Expr)
+	if len(result.Rows) == 0 {
+		return nil
+	}
+
+	// The evaluated value is kept, since the expression can depend on
+	// the connection it runs on.
+	buf := &bytes.Buffer{}
+	result.Rows[0][0].EncodeSQL(buf)
+	value := buf.String()
+
+	// The session that set the variable before ran the query on a reserved
+	// connection, so it's checked again on a connection of the tablet. Once
+	// the variable is back at the value of the tablet, the session doesn't
+	// need reserved connections for it anymore.
+	isDefault := false
+	if _, ok := vcursor.Session().SysVar(svs.Name); ok {
+		defaultCheckQuery := fmt.Sprintf("select 1 from dual where @@%s = %s", svs.Name, value)
+		result, err := vcursor.ExecuteStandalone(defaultCheckQuery, nil, rss[0])
+		if err != nil {
+			return err
+		}
+		isDefault = len(result.Rows) > 0
+	}
+	if !isDefault {
+		vcursor.Session().SetSysVar(svs.Name, value)
+		vcursor.Session().NeedsReservedConn()
+	}
+
+	// The connections that will be reserved get all the values of the session,
+	// the ones that are already reserved only need the new one.
+	if rss := vcursor.Session().ShardSession(); len(rss) > 0 {
+		queries := make([]*querypb.BoundQuery, len(rss))
+		for i := range rss {
+			queries[i] = &querypb.BoundQuery{
+				Sql:           fmt.Sprintf("set @@%s = %s", svs.Name, value),
+				BindVariables: res.BindVars,
+			}
+		}
+		_, errs := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* canAutocommit */)
+		if err := vterrors.Aggregate(errs); err != nil {
+			return err
+		}
+	}
+	if isDefault {
+		return vcursor.Session().UnsetSysVar(svs.Name)
+	}
 	return nil
 }
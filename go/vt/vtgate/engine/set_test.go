@@ -26,6 +26,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -36,6 +37,8 @@ func TestSetTable(t *testing.T) {
 		testName         string
 		setOps           []SetOp
 		qr               []*sqltypes.Result
+		shardSession     []*srvtopo.ResolvedShard
+		sysVars          map[string]string
 		expectedQueryLog []string
 		expectedWarning  []*querypb.QueryWarning
 		expectedError    string
@@ -196,8 +199,113 @@ func TestSetTable(t *testing.T) {
 			},
 			expectedQueryLog: []string{
 				`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
-				`ExecuteMultiShard ks.-20: select dummy_expr from dual where false {} false false`,
-				`SysVar set with (x,dummy_expr)`,
+				`ExecuteMultiShard ks.-20: select dummy_expr from dual where @@x != dummy_expr {} false false`,
+				`SysVar set with (x,'foo')`,
+				`NeedsReservedConn`,
+			},
+			qr: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"dummy_expr",
+					"varchar",
+				),
+				"foo",
+			)},
+		},
+		{
+			testName: "sysvar set to the value of the connection",
+			setOps: []SetOp{
+				&SysVarSet{
+					Name: "x",
+					Keyspace: &vindexes.Keyspace{
+						Name:    "ks",
+						Sharded: true,
+					},
+					TargetDestination: key.DestinationAnyShard{},
+					Expr:              "dummy_expr",
+				},
+			},
+			expectedQueryLog: []string{
+				`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+				`ExecuteMultiShard ks.-20: select dummy_expr from dual where @@x != dummy_expr {} false false`,
+			},
+			qr: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"dummy_expr",
+					"varchar",
+				),
+			)},
+		},
+		{
+			testName: "sysvar set on reserved connections",
+			setOps: []SetOp{
+				&SysVarSet{
+					Name: "x",
+					Keyspace: &vindexes.Keyspace{
+						Name:    "ks",
+						Sharded: true,
+					},
+					TargetDestination: key.DestinationAnyShard{},
+					Expr:              "dummy_expr",
+				},
+			},
+			shardSession: []*srvtopo.ResolvedShard{
+				{Target: &querypb.Target{Keyspace: "ks", Shard: "-20"}},
+				{Target: &querypb.Target{Keyspace: "ks", Shard: "20-"}},
+			},
+			expectedQueryLog: []string{
+				`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+				`ExecuteMultiShard ks.-20: select dummy_expr from dual where @@x != dummy_expr {} false false`,
+				`SysVar set with (x,'foo')`,
+				`NeedsReservedConn`,
+				`ExecuteMultiShard ks.-20: set @@x = 'foo' {} ks.20-: set @@x = 'foo' {} false false`,
+			},
+			qr: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"dummy_expr",
+					"varchar",
+				),
+				"foo",
+			)},
+		},
+		{
+			testName: "sysvar set back to the value of the tablet",
+			setOps: []SetOp{
+				&SysVarSet{
+					Name: "x",
+					Keyspace: &vindexes.Keyspace{
+						Name:    "ks",
+						Sharded: true,
+					},
+					TargetDestination: key.DestinationAnyShard{},
+					Expr:              "dummy_expr",
+				},
+			},
+			shardSession: []*srvtopo.ResolvedShard{
+				{Target: &querypb.Target{Keyspace: "ks", Shard: "-20"}},
+			},
+			sysVars: map[string]string{"x": "'bar'"},
+			expectedQueryLog: []string{
+				`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+				`ExecuteMultiShard ks.-20: select dummy_expr from dual where @@x != dummy_expr {} false false`,
+				`ExecuteStandalone select 1 from dual where @@x = 'foo'  ks -20`,
+				`ExecuteMultiShard ks.-20: set @@x = 'foo' {} false false`,
+				`SysVar unset (x)`,
+			},
+			qr: []*sqltypes.Result{
+				sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"dummy_expr",
+						"varchar",
+					),
+					"foo",
+				),
+				sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"1",
+						"int64",
+					),
+					"1",
+				),
 			},
 		},
 	}
@@ -209,8 +317,10 @@ func TestSetTable(t *testing.T) {
 				Input: &SingleRow{},
 			}
 			vc := &loggingVCursor{
-				shards:  []string{"-20", "20-"},
-				results: tc.qr,
+				shards:       []string{"-20", "20-"},
+				results:      tc.qr,
+				shardSession: tc.shardSession,
+				sysVars:      tc.sysVars,
 			}
 			_, err := set.Execute(vc, map[string]*querypb.BindVariable{}, false)
 			if tc.expectedError == "" {
@@ -241,7 +351,7 @@ func TestSysVarSetErr(t *testing.T) {
 
 	expectedQueryLog := []string{
 		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
-		`ExecuteMultiShard ks.-20: select dummy_expr from dual where false {} false false`,
+		`ExecuteMultiShard ks.-20: select dummy_expr from dual where @@x != dummy_expr {} false false`,
 	}
 
 	set := &Set{
@@ -0,0 +1,322 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Show)(nil)
+
+// ShowTableStatus is the type of the SHOW TABLE STATUS statement,
+// which is the only SHOW statement whose result is aggregated
+// across all the shards of the keyspace.
+const ShowTableStatus = "table status"
+
+// Show is a primitive that sends a SHOW statement about the tables of
+// a keyspace to its shards. The statement must not name a database:
+// every tablet answers it for its own MySQL database.
+//
+// Since all the shards of a keyspace have the same schema, most SHOW
+// statements are sent to any shard. If the shard is unavailable, the
+// other shards are tried in turn. SHOW TABLE STATUS is sent to all the
+// shards, and the statistics of every table are added up.
+type Show struct {
+	// Keyspace specifies the keyspace to send the statement to.
+	Keyspace *vindexes.Keyspace
+
+	// Type is the lowercase type of the SHOW statement.
+	Type string
+
+	// Query specifies the statement to be executed.
+	Query string
+
+	noInputs
+	noTxNeeded
+}
+
+// RouteType implements the Primitive interface.
+func (s *Show) RouteType() string {
+	return "Show"
+}
+
+// GetKeyspaceName implements the Primitive interface.
+func (s *Show) GetKeyspaceName() string {
+	return s.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface.
+func (s *Show) GetTableName() string {
+	return ""
+}
+
+// Execute implements the Primitive interface.
+func (s *Show) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	var result *sqltypes.Result
+	var err error
+	if s.Type == ShowTableStatus {
+		result, err = s.executeAllShards(vcursor, bindVars)
+	} else {
+		result, err = s.executeAnyShard(vcursor, bindVars)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.renameFields(result)
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface.
+func (s *Show) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := s.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface.
+func (s *Show) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := s.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "showGetFields")
+	}
+	qr.Rows = nil
+	qr.RowsAffected = 0
+	return qr, nil
+}
+
+// executeAnyShard sends the statement to any shard of the keyspace,
+// and tries the other shards if the shard isn't available.
+func (s *Show) executeAnyShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(s.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "showExecute")
+	}
+	if len(rss) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "no shards for keyspace: %s", s.Keyspace.Name)
+	}
+	result, err := s.executeShard(vcursor, rss[0], bindVars)
+	if vterrors.Code(err) != vtrpcpb.Code_UNAVAILABLE {
+		return result, err
+	}
+
+	all, _, rerr := vcursor.ResolveDestinations(s.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if rerr != nil {
+		return nil, vterrors.Wrap(rerr, "showExecute")
+	}
+	for _, rs := range all {
+		if rs.Target.Shard == rss[0].Target.Shard {
+			continue
+		}
+		result, err = s.executeShard(vcursor, rs, bindVars)
+		if vterrors.Code(err) != vtrpcpb.Code_UNAVAILABLE {
+			return result, err
+		}
+	}
+	return nil, err
+}
+
+func (s *Show) executeShard(vcursor VCursor, rs *srvtopo.ResolvedShard, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	queries := []*querypb.BoundQuery{{
+		Sql:           s.Query,
+		BindVariables: bindVars,
+	}}
+	result, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, queries, false, false)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// executeAllShards sends the statement to all the shards of the keyspace,
+// and merges the rows of every table.
+func (s *Show) executeAllShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(s.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "showExecute")
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           s.Query,
+			BindVariables: bindVars,
+		}
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, false, false)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	if len(rss) == 1 {
+		return result, nil
+	}
+	return mergeTableStatus(result)
+}
+
+// renameFields renames the column of SHOW TABLES, which contains
+// the name of the MySQL database, after the keyspace.
+func (s *Show) renameFields(result *sqltypes.Result) {
+	// The fields may be shared with other results.
+	fields := make([]*querypb.Field, len(result.Fields))
+	for i, field := range result.Fields {
+		fields[i] = field
+		if !strings.HasPrefix(field.Name, "Tables_in_") {
+			continue
+		}
+		name := "Tables_in_" + s.Keyspace.Name
+		// SHOW TABLES LIKE adds the pattern to the column name.
+		if j := strings.Index(field.Name, " ("); j >= 0 {
+			name += field.Name[j:]
+		}
+		fields[i] = proto.Clone(field).(*querypb.Field)
+		fields[i].Name = name
+	}
+	result.Fields = fields
+}
+
+// tableStatusSums are the columns of SHOW TABLE STATUS that are
+// added up across shards.
+var tableStatusSums = []string{"Rows", "Data_length", "Index_length", "Data_free"}
+
+// tableStatusMaxes are the columns of SHOW TABLE STATUS whose
+// maximum across shards is returned.
+var tableStatusMaxes = []string{"Auto_increment", "Update_time"}
+
+// mergeTableStatus merges the rows of SHOW TABLE STATUS that every shard
+// returned for the same table. The other columns are taken from the first
+// shard. A WHERE clause of the statement is applied by every shard to its
+// own rows, not to the merged ones.
+func mergeTableStatus(result *sqltypes.Result) (*sqltypes.Result, error) {
+	columns := make(map[string]int, len(result.Fields))
+	for i, field := range result.Fields {
+		columns[strings.ToLower(field.Name)] = i
+	}
+	nameCol, ok := columns["name"]
+	if !ok {
+		return result, nil
+	}
+
+	var rows [][]sqltypes.Value
+	tables := make(map[string][]sqltypes.Value)
+	for _, row := range result.Rows {
+		name := row[nameCol].ToString()
+		merged, ok := tables[name]
+		if !ok {
+			merged = append([]sqltypes.Value(nil), row...)
+			tables[name] = merged
+			rows = append(rows, merged)
+			continue
+		}
+		for _, column := range tableStatusSums {
+			if i, ok := columns[strings.ToLower(column)]; ok {
+				sum, err := addUint64Values(merged[i], row[i])
+				if err != nil {
+					return nil, err
+				}
+				merged[i] = sum
+			}
+		}
+		for _, column := range tableStatusMaxes {
+			if i, ok := columns[strings.ToLower(column)]; ok {
+				max, err := evalengine.Max(merged[i], row[i])
+				if err != nil {
+					return nil, err
+				}
+				merged[i] = max
+			}
+		}
+	}
+
+	// The average row length is computed from the merged values.
+	avgCol, ok1 := columns["avg_row_length"]
+	dataCol, ok2 := columns["data_length"]
+	rowsCol, ok3 := columns["rows"]
+	if ok1 && ok2 && ok3 {
+		for _, row := range rows {
+			if row[avgCol].IsNull() || row[dataCol].IsNull() || row[rowsCol].IsNull() {
+				continue
+			}
+			data, err := evalengine.ToUint64(row[dataCol])
+			if err != nil {
+				return nil, err
+			}
+			count, err := evalengine.ToUint64(row[rowsCol])
+			if err != nil {
+				return nil, err
+			}
+			avg := uint64(0)
+			if count > 0 {
+				avg = data / count
+			}
+			row[avgCol] = sqltypes.MakeTrusted(row[avgCol].Type(), strconv.AppendUint(nil, avg, 10))
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i][nameCol].ToString() < rows[j][nameCol].ToString()
+	})
+	return &sqltypes.Result{
+		Fields:       result.Fields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}, nil
+}
+
+// addUint64Values adds up two unsigned values. A NULL value is ignored.
+// The sum keeps the type of the values.
+func addUint64Values(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() {
+		return v2, nil
+	}
+	if v2.IsNull() {
+		return v1, nil
+	}
+	n1, err := evalengine.ToUint64(v1)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	n2, err := evalengine.ToUint64(v2)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.MakeTrusted(v1.Type(), strconv.AppendUint(nil, n1+n2, 10)), nil
+}
+
+func (s *Show) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "Show",
+		Keyspace:     s.Keyspace,
+		Other: map[string]interface{}{
+			"Query": s.Query,
+			"Type":  s.Type,
+		},
+	}
+}
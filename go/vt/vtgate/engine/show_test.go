@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestShowAnyShard(t *testing.T) {
+	show := &Show{
+		Keyspace: &vindexes.Keyspace{Name: "ks", Sharded: true},
+		Type:     "tables",
+		Query:    "show tables like 'u%'",
+	}
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Tables_in_vt_ks (u%)", "varchar"),
+			"user",
+		)},
+	}
+	result, err := show.Execute(vc, nil, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.-20: show tables like 'u%' {} false false`,
+	})
+	// The column is named after the keyspace.
+	expectResult(t, "show tables", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Tables_in_ks (u%)", "varchar"),
+		"user",
+	))
+
+	// An unavailable shard is skipped.
+	vc = &loggingVCursor{
+		shards:    []string{"-20", "20-"},
+		results:   []*sqltypes.Result{nil, sqltypes.MakeTestResult(sqltypes.MakeTestFields("Tables_in_vt_ks", "varchar"))},
+		resultErr: vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"),
+	}
+	result, err = show.Execute(vc, nil, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.-20: show tables like 'u%' {} false false`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.20-: show tables like 'u%' {} false false`,
+	})
+	assert.Equal(t, "Tables_in_ks", result.Fields[0].Name)
+
+	// Other errors are returned right away.
+	vc = &loggingVCursor{
+		shards:    []string{"-20", "20-"},
+		resultErr: vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error"),
+	}
+	_, err = show.Execute(vc, nil, true)
+	require.EqualError(t, err, "syntax error")
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.-20: show tables like 'u%' {} false false`,
+	})
+}
+
+func TestShowTableStatus(t *testing.T) {
+	show := &Show{
+		Keyspace: &vindexes.Keyspace{Name: "ks", Sharded: true},
+		Type:     ShowTableStatus,
+		Query:    "show table status",
+	}
+	fields := sqltypes.MakeTestFields(
+		"Name|Engine|Rows|Avg_row_length|Data_length|Index_length|Data_free|Auto_increment|Update_time",
+		"varchar|varchar|uint64|uint64|uint64|uint64|uint64|uint64|datetime",
+	)
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			// The rows of both shards.
+			"user|InnoDB|10|100|1000|200|0|11|2020-01-01 00:00:00",
+			"music|InnoDB|0|0|16384|0|0|null|null",
+			"user|InnoDB|30|200|6000|400|10|31|2020-01-02 00:00:00",
+			"music|InnoDB|2|8192|16384|0|0|null|2020-01-03 00:00:00",
+		)},
+	}
+	result, err := show.Execute(vc, nil, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: show table status {} ks.20-: show table status {} false false`,
+	})
+	expectResult(t, "show table status", result, sqltypes.MakeTestResult(
+		fields,
+		"music|InnoDB|2|16384|32768|0|0|null|2020-01-03 00:00:00",
+		"user|InnoDB|40|175|7000|600|10|31|2020-01-02 00:00:00",
+	))
+}
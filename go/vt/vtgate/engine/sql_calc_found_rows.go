@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*SQLCalcFoundRows)(nil)
+
+// SQLCalcFoundRows is a primitive for a SELECT SQL_CALC_FOUND_ROWS
+// with a LIMIT. The rows are returned by the limited query, and the
+// number of rows the query would return without the LIMIT is counted
+// by a separate query. The count is stored in the session, which
+// answers FOUND_ROWS() afterwards.
+type SQLCalcFoundRows struct {
+	LimitPrimitive Primitive
+	CountPrimitive Primitive
+
+	// CountRows is set if CountPrimitive returns the rows to count
+	// instead of their count. It's used for the queries whose count
+	// can't be computed by the shards, and the rows are then streamed
+	// and counted by vtgate.
+	CountRows bool
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (s *SQLCalcFoundRows) RouteType() string {
+	return "SQLCalcFoundRows"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (s *SQLCalcFoundRows) GetKeyspaceName() string {
+	return s.LimitPrimitive.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (s *SQLCalcFoundRows) GetTableName() string {
+	return s.LimitPrimitive.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (s *SQLCalcFoundRows) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := s.LimitPrimitive.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.countFoundRows(vcursor, bindVars); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (s *SQLCalcFoundRows) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	if err := s.LimitPrimitive.StreamExecute(vcursor, bindVars, wantfields, callback); err != nil {
+		return err
+	}
+	return s.countFoundRows(vcursor, bindVars)
+}
+
+// GetFields satisfies the Primitive interface.
+func (s *SQLCalcFoundRows) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return s.LimitPrimitive.GetFields(vcursor, bindVars)
+}
+
+// NeedsTransaction satisfies the Primitive interface.
+func (s *SQLCalcFoundRows) NeedsTransaction() bool {
+	return s.LimitPrimitive.NeedsTransaction()
+}
+
+// Inputs returns the limit and the count primitives.
+func (s *SQLCalcFoundRows) Inputs() []Primitive {
+	return []Primitive{s.LimitPrimitive, s.CountPrimitive}
+}
+
+func (s *SQLCalcFoundRows) description() PrimitiveDescription {
+	var other map[string]interface{}
+	if s.CountRows {
+		other = map[string]interface{}{"CountRows": true}
+	}
+	return PrimitiveDescription{
+		OperatorType: "SQL_CALC_FOUND_ROWS",
+		Other:        other,
+	}
+}
+
+// countFoundRows executes the count primitive, and stores
+// its result in the session.
+func (s *SQLCalcFoundRows) countFoundRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable) error {
+	if s.CountRows {
+		var foundRows uint64
+		err := s.CountPrimitive.StreamExecute(vcursor, bindVars, false, func(result *sqltypes.Result) error {
+			foundRows += uint64(len(result.Rows))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		vcursor.Session().SetFoundRows(foundRows)
+		return nil
+	}
+	result, err := s.CountPrimitive.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return err
+	}
+	if len(result.Rows) != 1 || len(result.Rows[0]) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "count query of SQL_CALC_FOUND_ROWS returned %d rows", len(result.Rows))
+	}
+	foundRows, err := evalengine.ToUint64(result.Rows[0][0])
+	if err != nil {
+		return err
+	}
+	vcursor.Session().SetFoundRows(foundRows)
+	return nil
+}
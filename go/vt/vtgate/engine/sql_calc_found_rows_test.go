@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestSQLCalcFoundRowsExecute(t *testing.T) {
+	limitResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+		"2|b",
+	)
+	limit := &fakePrimitive{
+		results: []*sqltypes.Result{limitResult},
+	}
+	count := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"count(*)",
+				"int64",
+			),
+			"42",
+		)},
+	}
+	s := &SQLCalcFoundRows{
+		LimitPrimitive: limit,
+		CountPrimitive: count,
+	}
+
+	vc := &loggingVCursor{}
+	result, err := s.Execute(vc, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "s.Execute", result, limitResult)
+	vc.ExpectLog(t, []string{"FoundRows set to 42"})
+	limit.ExpectLog(t, []string{"Execute  true"})
+	count.ExpectLog(t, []string{"Execute  false"})
+
+	// Streaming
+	limit.rewind()
+	count.rewind()
+	vc = &loggingVCursor{}
+	result, err = wrapStreamExecute(s, vc, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "s.StreamExecute", result, limitResult)
+	vc.ExpectLog(t, []string{"FoundRows set to 42"})
+}
+
+func TestSQLCalcFoundRowsCountRows(t *testing.T) {
+	limitResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"varchar",
+		),
+		"a",
+		"b",
+	)
+	limit := &fakePrimitive{
+		results: []*sqltypes.Result{limitResult},
+	}
+	rows := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"varchar",
+			),
+			"a",
+			"b",
+			"c",
+			"d",
+			"e",
+		)},
+	}
+	s := &SQLCalcFoundRows{
+		LimitPrimitive: limit,
+		CountPrimitive: rows,
+		CountRows:      true,
+	}
+
+	vc := &loggingVCursor{}
+	result, err := s.Execute(vc, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "s.Execute", result, limitResult)
+	vc.ExpectLog(t, []string{"FoundRows set to 5"})
+	rows.ExpectLog(t, []string{"StreamExecute  false"})
+
+	// Streaming
+	limit.rewind()
+	rows.rewind()
+	vc = &loggingVCursor{}
+	result, err = wrapStreamExecute(s, vc, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "s.StreamExecute", result, limitResult)
+	vc.ExpectLog(t, []string{"FoundRows set to 5"})
+
+	rows.rewind()
+	rows.sendErr = errors.New("rows error")
+	rows.results = nil
+	limit.rewind()
+	_, err = s.Execute(&loggingVCursor{}, nil, true)
+	require.EqualError(t, err, "rows error")
+}
+
+func TestSQLCalcFoundRowsErrors(t *testing.T) {
+	s := &SQLCalcFoundRows{
+		LimitPrimitive: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+			)},
+		},
+		CountPrimitive: &fakePrimitive{
+			sendErr: errors.New("count error"),
+		},
+	}
+	_, err := s.Execute(&loggingVCursor{}, nil, true)
+	require.EqualError(t, err, "count error")
+
+	s.CountPrimitive = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"count(*)",
+				"int64",
+			),
+			"1",
+			"2",
+		)},
+	}
+	s.LimitPrimitive.(*fakePrimitive).rewind()
+	_, err = s.Execute(&loggingVCursor{}, nil, true)
+	require.EqualError(t, err, "count query of SQL_CALC_FOUND_ROWS returned 2 rows")
+}
@@ -19,17 +19,20 @@ package engine
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -45,8 +48,19 @@ type Update struct {
 	// ChangedVindexValues contains values for updated Vindexes during an update statement.
 	ChangedVindexValues map[string]VindexValues
 
-	// Update does not take inputs
-	noInputs
+	// MoveQuery is set if the update changes the columns of the primary
+	// vindex, which moves the rows to the shards of their new keyspace ids.
+	// It selects the rows to update for update. The new values of the
+	// MoveColumns are followed by all the columns of the rows.
+	MoveQuery string
+
+	// MoveColumns are the columns changed by the update, in the order
+	// of their new values in the rows selected by MoveQuery.
+	MoveColumns []string
+
+	// MoveDeleteQuery deletes the rows selected by MoveQuery from
+	// their current shards.
+	MoveDeleteQuery string
 }
 
 var updName = map[DMLOpcode]string{
@@ -55,6 +69,7 @@ var updName = map[DMLOpcode]string{
 	In:            "UpdateIn",
 	Scatter:       "UpdateScatter",
 	ByDestination: "UpdateByDestination",
+	MultiTable:    "UpdateMultiTable",
 }
 
 // RouteType returns a description of the query routing type used by the primitive
@@ -93,12 +108,22 @@ func (upd *Update) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return upd.execUpdateByDestination(vcursor, bindVars, key.DestinationAllShards{})
 	case ByDestination:
 		return upd.execUpdateByDestination(vcursor, bindVars, upd.TargetDestination)
+	case MultiTable:
+		return upd.execUpdateMultiTable(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", upd)
 	}
 }
 
+// Inputs returns the input of a MultiTable update.
+func (upd *Update) Inputs() []Primitive {
+	if upd.Input == nil {
+		return nil
+	}
+	return []Primitive{upd.Input}
+}
+
 // StreamExecute performs a streaming exec.
 func (upd *Update) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	return fmt.Errorf("query %q cannot be used for streaming", upd.Query)
@@ -125,7 +150,7 @@ func (upd *Update) execUpdateUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := upd.Values[0].ResolveValue(bindVars)
+	key, err := resolveValues(upd.Values, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execUpdateEqual")
 	}
@@ -140,8 +165,11 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if upd.MoveQuery != "" {
+		return upd.moveRows(vcursor, []*srvtopo.ResolvedShard{rs}, []map[string]*querypb.BindVariable{bindVars})
+	}
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}, []map[string]*querypb.BindVariable{bindVars}); err != nil {
 			return nil, vterrors.Wrap(err, "execUpdateEqual")
 		}
 	}
@@ -157,8 +185,11 @@ func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 	if err != nil {
 		return nil, err
 	}
+	if upd.MoveQuery != "" {
+		return upd.moveRows(vcursor, rss, sameShardVars(bindVars, rss))
+	}
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, rss, sameShardVars(bindVars, rss)); err != nil {
 			return nil, vterrors.Wrap(err, "execUpdateIn")
 		}
 	}
@@ -174,6 +205,9 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	if err != nil {
 		return nil, err
 	}
+	if upd.MoveQuery != "" {
+		return upd.moveRows(vcursor, rss, sameShardVars(bindVars, rss))
+	}
 
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
@@ -185,25 +219,47 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 
 	// update any owned vindexes
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, rss, sameShardVars(bindVars, rss)); err != nil {
 			return nil, vterrors.Wrap(err, "execUpdateByDestination")
 		}
 	}
 	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit)
 }
 
+func (upd *Update) execUpdateMultiTable(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, shardVars, err := upd.resolveInputShards(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateMultiTable")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+	if upd.MoveQuery != "" {
+		return upd.moveRows(vcursor, rss, shardVars)
+	}
+
+	if len(upd.ChangedVindexValues) != 0 {
+		if err := upd.updateVindexEntries(vcursor, bindVars, rss, shardVars); err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateMultiTable")
+		}
+	}
+	return execMultiShard(vcursor, rss, shardQueries(upd.Query, shardVars), upd.MultiShardAutocommit)
+}
+
 // updateVindexEntries performs an update when a vindex is being modified
 // by the statement.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
 // Note 2: While changes are being committed, the changing row could be
 // unreachable by either the new or old column values.
-func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: bindVars}
-	}
-	subQueryResult, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
+// The rows are selected from each of the rss with its shardVars, and
+// the new vindex values are resolved with bindVars.
+func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) error {
+	subQueryResult, errors := vcursor.ExecuteMultiShard(rss, shardQueries(upd.OwnedVindexQuery, shardVars), false, false)
 	for _, err := range errors {
 		if err != nil {
 			return vterrors.Wrap(err, "updateVindexEntries")
@@ -220,7 +276,7 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*que
 	}
 
 	for _, row := range subQueryResult.Rows {
-		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[0])
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[:upd.KsidLength])
 		if err != nil {
 			return err
 		}
@@ -254,6 +310,116 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*que
 	return nil
 }
 
+// moveRows performs an update that changes the columns of the primary
+// vindex. Every row is deleted from its shard, along with its owned vindex
+// entries, and inserted with its new values in the shard of its new
+// keyspace id. The rows can move across shards, so the update must run
+// in a transaction that can span multiple shards.
+func (upd *Update) moveRows(vcursor VCursor, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if mode := vcursor.Session().TransactionMode(); mode == vtgatepb.TransactionMode_SINGLE {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "unsupported: changing the primary vindex columns requires a MULTI or TWOPC transaction mode, the current mode is %v", mode)
+	}
+
+	selected, errs := vcursor.ExecuteMultiShard(rss, shardQueries(upd.MoveQuery, shardVars), true /* rollbackOnError */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, vterrors.Wrap(err, "moveRows")
+	}
+	if len(selected.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	// The new values are followed by the columns of the rows.
+	fields := selected.Fields[len(upd.MoveColumns):]
+	colNums := make(map[string]int, len(fields))
+	for colNum, field := range fields {
+		colNums[strings.ToLower(field.Name)] = colNum
+	}
+	columnValues := func(row []sqltypes.Value, cols []sqlparser.ColIdent) []sqltypes.Value {
+		values := make([]sqltypes.Value, 0, len(cols))
+		for _, col := range cols {
+			values = append(values, row[colNums[col.Lowered()]])
+		}
+		return values
+	}
+
+	primary := upd.Table.ColumnVindexes[0]
+	oldRows := make([][]sqltypes.Value, len(selected.Rows))
+	newRows := make([][]sqltypes.Value, len(selected.Rows))
+	for i, row := range selected.Rows {
+		oldRows[i] = row[len(upd.MoveColumns):]
+		newRows[i] = append([]sqltypes.Value(nil), oldRows[i]...)
+		for j, col := range upd.MoveColumns {
+			colNum, ok := colNums[strings.ToLower(col)]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "moveRows: column %s not found", col)
+			}
+			newRows[i][colNum] = row[j]
+		}
+
+		ksid, err := resolveKeyspaceID(vcursor, primary.Vindex, columnValues(oldRows[i], primary.Columns))
+		if err != nil {
+			return nil, vterrors.Wrap(err, "moveRows")
+		}
+		for _, colVindex := range upd.Table.Owned {
+			fromIds := [][]sqltypes.Value{columnValues(oldRows[i], colVindex.Columns)}
+			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, fromIds, ksid); err != nil {
+				return nil, vterrors.Wrap(err, "moveRows")
+			}
+		}
+	}
+
+	if _, errs := vcursor.ExecuteMultiShard(rss, shardQueries(upd.MoveDeleteQuery, shardVars), true /* rollbackOnError */, false /* canAutocommit */); vterrors.Aggregate(errs) != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "moveRows")
+	}
+
+	insert, columns := upd.moveInsertQuery(fields)
+	for _, row := range newRows {
+		ksid, err := resolveKeyspaceID(vcursor, primary.Vindex, columnValues(row, primary.Columns))
+		if err != nil {
+			return nil, vterrors.Wrap(err, "moveRows")
+		}
+		for _, colVindex := range upd.Table.Owned {
+			toIds := [][]sqltypes.Value{columnValues(row, colVindex.Columns)}
+			if err := colVindex.Vindex.(vindexes.Lookup).Create(vcursor, toIds, [][]byte{ksid}, false /* ignoreMode */); err != nil {
+				return nil, vterrors.Wrap(err, "moveRows")
+			}
+		}
+		rowBindVars := make(map[string]*querypb.BindVariable, len(columns))
+		for colNum, col := range columns {
+			rowBindVars[InsertVarName(col, 0)] = sqltypes.ValueBindVariable(row[colNum])
+		}
+		rss, _, err := vcursor.ResolveDestinations(upd.Keyspace.Name, nil, []key.Destination{key.DestinationKeyspaceID(ksid)})
+		if err != nil {
+			return nil, vterrors.Wrap(err, "moveRows")
+		}
+		if err := allowOnlyMaster(rss...); err != nil {
+			return nil, err
+		}
+		if _, err := execShard(vcursor, insert, rowBindVars, rss[0], true /* rollbackOnError */, false /* canAutocommit */); err != nil {
+			return nil, vterrors.Wrap(err, "moveRows")
+		}
+	}
+	return &sqltypes.Result{RowsAffected: uint64(len(newRows))}, nil
+}
+
+// moveInsertQuery returns the query that inserts a moved row
+// in its new shard, and the columns that it inserts.
+func (upd *Update) moveInsertQuery(fields []*querypb.Field) (string, sqlparser.Columns) {
+	columns := make(sqlparser.Columns, len(fields))
+	values := make(sqlparser.ValTuple, len(fields))
+	for i, field := range fields {
+		columns[i] = sqlparser.NewColIdent(field.Name)
+		values[i] = sqlparser.NewValArg([]byte(":" + InsertVarName(columns[i], 0)))
+	}
+	insert := &sqlparser.Insert{
+		Action:  sqlparser.InsertStr,
+		Table:   sqlparser.TableName{Name: upd.Table.Name},
+		Columns: columns,
+		Rows:    sqlparser.Values{values},
+	}
+	return sqlparser.String(insert), columns
+}
+
 func (upd *Update) description() PrimitiveDescription {
 	other := map[string]interface{}{
 		"Query":                upd.Query,
@@ -262,6 +428,10 @@ func (upd *Update) description() PrimitiveDescription {
 		"MultiShardAutocommit": upd.MultiShardAutocommit,
 		"QueryTimeout":         upd.QueryTimeout,
 	}
+	if upd.MoveQuery != "" {
+		other["MoveQuery"] = upd.MoveQuery
+		other["MoveDeleteQuery"] = upd.MoveDeleteQuery
+	}
 
 	addFieldsIfNotEmpty(upd.DML, other)
 
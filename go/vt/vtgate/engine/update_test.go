@@ -29,6 +29,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 func TestUpdateUnsharded(t *testing.T) {
@@ -204,6 +205,7 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]VindexValues{
 			"twocol": {
@@ -305,6 +307,7 @@ func TestUpdateScatterChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]VindexValues{
 			"twocol": {
@@ -440,6 +443,7 @@ func TestUpdateInChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]VindexValues{
 			"twocol": {
@@ -545,6 +549,150 @@ func TestUpdateInChangedVindex(t *testing.T) {
 	})
 }
 
+func TestUpdateMultiTable(t *testing.T) {
+	upd := &Update{
+		DML: DML{
+			Opcode: MultiTable,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: false,
+			},
+			Query: "dummy_update",
+			Input: &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"pk",
+						"int64",
+					),
+					"10",
+					"11",
+				)},
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("0")
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"10"  type:INT64 value:"11" ] Destinations:DestinationAllShards(),DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_update {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"11" > a: type:INT64 value:"1" } true true`,
+	})
+}
+
+func TestUpdateMultiTableChangedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		DML: DML{
+			Opcode:           MultiTable,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_update",
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
+			Input: &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"pk|id",
+						"int64|int64",
+					),
+					"10|1",
+				)},
+			},
+		},
+		ChangedVindexValues: map[string]VindexValues{
+			"onecol": {
+				"c3": {Value: sqltypes.NewInt64(3)},
+			},
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+	)}
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = results
+
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"10" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > } false false`,
+		// 6 has to be replaced by 3.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"3" toc_0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_update {__dml_vals: type:TUPLE values:<type:INT64 value:"10" > } true true`,
+	})
+}
+
+func TestUpdateEqualMoveRow(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		DML: DML{
+			Opcode:     Equal,
+			Keyspace:   ks.Keyspace,
+			Query:      "dummy_update",
+			Vindex:     ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Values:     []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+			Table:      ks.Tables["t1"],
+			KsidVindex: ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength: 1,
+		},
+		MoveQuery:       "dummy_select",
+		MoveColumns:     []string{"id", "c3"},
+		MoveDeleteQuery: "dummy_delete",
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"2|7|id|c1|c2|c3|val",
+			"int64|int64|int64|int64|int64|int64|varchar",
+		),
+		"2|7|1|4|5|6|a",
+	)}
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-"}
+	vc.results = results
+
+	result, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_select {} true false`,
+		// The vindex entries of the old row are deleted.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true false`,
+		// The vindex entries of the new row are created, and it's inserted in its new shard.
+		`Execute insert into lkp2(from1, from2, toc) values(:from1_0, :from2_0, :toc_0) from1_0: type:INT64 value:"4" from2_0: type:INT64 value:"5" toc_0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"7" toc_0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: insert into t1(id, c1, c2, c3, val) values (:_id_0, :_c1_0, :_c2_0, :_c3_0, :_val_0) ` +
+			`{_c1_0: type:INT64 value:"4" _c2_0: type:INT64 value:"5" _c3_0: type:INT64 value:"7" _id_0: type:INT64 value:"2" _val_0: type:VARCHAR value:"a" } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 1})
+
+	// No rows moving.
+	vc = newDMLTestVCursor("-20", "20-")
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_select {} true false`,
+	})
+
+	// Rows can't move in a SINGLE transaction.
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.transactionMode = vtgatepb.TransactionMode_SINGLE
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "unsupported: changing the primary vindex columns requires a MULTI or TWOPC transaction mode, the current mode is SINGLE")
+}
+
 func TestUpdateNoStream(t *testing.T) {
 	upd := &Update{}
 	err := upd.StreamExecute(nil, nil, false, nil)
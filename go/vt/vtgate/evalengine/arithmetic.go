@@ -367,7 +367,7 @@ func ToNative(v sqltypes.Value) (interface{}, error) {
 func newEvalResult(v sqltypes.Value) (evalResult, error) {
 	raw := v.Raw()
 	switch {
-	case v.IsBinary() || v.IsText():
+	case v.IsQuoted():
 		return evalResult{bytes: raw, typ: sqltypes.VarBinary}, nil
 	case v.IsSigned():
 		ival, err := strconv.ParseInt(string(raw), 10, 64)
@@ -381,12 +381,18 @@ func newEvalResult(v sqltypes.Value) (evalResult, error) {
 			return evalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return evalResult{uval: uval, typ: sqltypes.Uint64}, nil
-	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+	case v.IsFloat():
 		fval, err := strconv.ParseFloat(string(raw), 64)
 		if err != nil {
 			return evalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return evalResult{fval: fval, typ: sqltypes.Float64}, nil
+	case v.Type() == sqltypes.Decimal:
+		fval, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			return evalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		return evalResult{fval: fval, typ: sqltypes.Float64, bytes: raw}, nil
 	}
 	return evalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not be reached. got %s", v.String())
 }
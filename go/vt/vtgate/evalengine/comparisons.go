@@ -0,0 +1,248 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	resultTrue  = evalResult{typ: sqltypes.Int64, ival: 1}
+	resultFalse = evalResult{typ: sqltypes.Int64, ival: 0}
+	resultNull  = evalResult{typ: sqltypes.Null}
+)
+
+//Evaluate implements the BinaryExpr interface
+func (e *Equals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (n *NotEquals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp != 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (n *NullSafeEquals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	leftNull := left.typ == sqltypes.Null
+	rightNull := right.typ == sqltypes.Null
+	if leftNull || rightNull {
+		return boolResult(leftNull && rightNull), nil
+	}
+	return compareWith(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (l *LessThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp < 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (l *LessEqualThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp <= 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (g *GreaterThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp > 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (g *GreaterEqualThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp >= 0 })
+}
+
+//Type implements the BinaryExpr interface
+func (e *Equals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (n *NotEquals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (n *NullSafeEquals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (l *LessThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (l *LessEqualThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (g *GreaterThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (g *GreaterEqualThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the BinaryExpr interface
+func (e *Equals) String() string {
+	return "="
+}
+
+//String implements the BinaryExpr interface
+func (n *NotEquals) String() string {
+	return "!="
+}
+
+//String implements the BinaryExpr interface
+func (n *NullSafeEquals) String() string {
+	return "<=>"
+}
+
+//String implements the BinaryExpr interface
+func (l *LessThan) String() string {
+	return "<"
+}
+
+//String implements the BinaryExpr interface
+func (l *LessEqualThan) String() string {
+	return "<="
+}
+
+//String implements the BinaryExpr interface
+func (g *GreaterThan) String() string {
+	return ">"
+}
+
+//String implements the BinaryExpr interface
+func (g *GreaterEqualThan) String() string {
+	return ">="
+}
+
+//Evaluate implements the Expr interface.
+//As in MySQL, the result is NULL if the left side is NULL, or if
+//no value of the list matches and the list contains a NULL.
+//Text values are compared by MySQL with their collation, which
+//vtgate can't mimic. So, comparing two text values fails.
+func (i *In) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	sawNull := false
+	for _, expr := range i.List {
+		val, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val.typ == sqltypes.Null {
+			sawNull = true
+			continue
+		}
+		if left.typ == sqltypes.VarBinary && val.typ == sqltypes.VarBinary {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in vtgate: comparison of text values in %s", i.String())
+		}
+		if compareResults(left, val) == 0 {
+			return boolResult(!i.Negate), nil
+		}
+	}
+	if sawNull {
+		return resultNull, nil
+	}
+	return boolResult(i.Negate), nil
+}
+
+//Type implements the Expr interface
+func (i *In) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the Expr interface
+func (i *In) String() string {
+	var list []string
+	for _, expr := range i.List {
+		list = append(list, expr.String())
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(list, ", ") + ")"
+}
+
+// compareWith compares the two values and converts the outcome of the
+// comparison into a boolean result using the supplied function. As in
+// MySQL, a comparison involving a NULL yields NULL.
+func compareWith(left, right evalResult, outcome func(cmp int) bool) (evalResult, error) {
+	if left.typ == sqltypes.Null || right.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	return boolResult(outcome(compareResults(left, right))), nil
+}
+
+// compareResults returns 0 if v1==v2, -1 if v1<v2, and 1 if v1>v2.
+// If either side is a number, a numeric comparison is performed
+// after converting the other side. Otherwise, the values are
+// compared as binary strings.
+func compareResults(v1, v2 evalResult) int {
+	if sqltypes.IsNumber(v1.typ) || sqltypes.IsNumber(v2.typ) {
+		return compareNumeric(toNumeric(v1), toNumeric(v2))
+	}
+	return bytes.Compare(v1.bytes, v2.bytes)
+}
+
+// toNumeric converts a non-numerical value into a number.
+// Strings that are not valid numbers evaluate to 0, like in MySQL.
+func toNumeric(v evalResult) evalResult {
+	if sqltypes.IsNumber(v.typ) {
+		return v
+	}
+	if ival, err := strconv.ParseInt(string(v.bytes), 10, 64); err == nil {
+		return evalResult{ival: ival, typ: sqltypes.Int64}
+	}
+	return evalResult{fval: toFloat64(v), typ: sqltypes.Float64}
+}
+
+// toFloat64 returns the float value of a non-numerical value.
+func toFloat64(v evalResult) float64 {
+	fval, err := strconv.ParseFloat(string(v.bytes), 64)
+	if err != nil {
+		return 0
+	}
+	return fval
+}
+
+func boolResult(b bool) evalResult {
+	if b {
+		return resultTrue
+	}
+	return resultFalse
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+//Evaluate implements the Expr interface.
+//The result is converted to the type of the CASE, so that all
+//rows get values of the same type, regardless of the branch taken.
+func (c *Case) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		base, err = c.Base.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	}
+	result, err := c.evaluateBranch(env, base)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return coerceTo(result, c.Type(env))
+}
+
+func (c *Case) evaluateBranch(env ExpressionEnv, base EvalResult) (EvalResult, error) {
+	for _, when := range c.Whens {
+		cond, err := when.Cond.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if c.Base != nil {
+			// A NULL base doesn't match anything, not even NULL.
+			cond, err = compareWith(base, cond, func(cmp int) bool { return cmp == 0 })
+			if err != nil {
+				return EvalResult{}, err
+			}
+		}
+		if cond.IsTrue() {
+			return when.Val.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+//Type implements the Expr interface
+func (c *Case) Type(env ExpressionEnv) querypb.Type {
+	var types []querypb.Type
+	for _, when := range c.Whens {
+		types = append(types, when.Val.Type(env))
+	}
+	if c.Else != nil {
+		types = append(types, c.Else.Type(env))
+	}
+	return aggregateTypes(types)
+}
+
+//String implements the Expr interface
+func (c *Case) String() string {
+	var buf strings.Builder
+	buf.WriteString("case")
+	if c.Base != nil {
+		buf.WriteString(" " + c.Base.String())
+	}
+	for _, when := range c.Whens {
+		buf.WriteString(" when " + when.Cond.String() + " then " + when.Val.String())
+	}
+	if c.Else != nil {
+		buf.WriteString(" else " + c.Else.String())
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
+
+// aggregateTypes returns the type of an expression that can
+// return the result of any of the expressions of the specified
+// types, like CASE or COALESCE. NULLs don't affect the result.
+// As in MySQL, mixing strings and numbers results in a string.
+func aggregateTypes(types []querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		typ = evalType(typ)
+		switch {
+		case typ == sqltypes.Null:
+		case result == sqltypes.Null:
+			result = typ
+		case typ == sqltypes.VarBinary || result == sqltypes.VarBinary:
+			result = sqltypes.VarBinary
+		default:
+			result = mergeNumericalTypes(result, typ)
+		}
+	}
+	return result
+}
+
+// coerceTo converts a value into the specified type, which
+// must be one of the types returned by evalType. Negative
+// integers can't be converted to Uint64.
+func coerceTo(v evalResult, typ querypb.Type) (evalResult, error) {
+	if v.typ == sqltypes.Null || v.typ == typ {
+		return v, nil
+	}
+	switch typ {
+	case sqltypes.VarBinary:
+		return evalResult{typ: sqltypes.VarBinary, bytes: toBytes(v)}, nil
+	case sqltypes.Float64:
+		v = toNumeric(v)
+		switch v.typ {
+		case sqltypes.Int64:
+			return evalResult{typ: sqltypes.Float64, fval: float64(v.ival)}, nil
+		case sqltypes.Uint64:
+			return evalResult{typ: sqltypes.Float64, fval: float64(v.uval)}, nil
+		}
+	case sqltypes.Uint64:
+		if v.typ == sqltypes.Int64 {
+			if v.ival < 0 {
+				return evalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT UNSIGNED value is out of range: %d", v.ival)
+			}
+			return evalResult{typ: sqltypes.Uint64, uval: uint64(v.ival)}, nil
+		}
+	}
+	return v, nil
+}
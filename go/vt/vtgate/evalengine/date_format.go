@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// dateLayouts are the formats in which dates and datetimes
+// are accepted by DATE_FORMAT.
+var dateLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+//Evaluate implements the Function interface.
+//As in MySQL, the result is NULL if the date can't be parsed.
+func (d *DateFormat) Evaluate(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Null || args[1].typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	t, ok := parseDate(string(toBytes(args[0])))
+	if !ok {
+		return resultNull, nil
+	}
+	return evalResult{typ: sqltypes.VarBinary, bytes: formatDate(t, toBytes(args[1]))}, nil
+}
+
+//Type implements the Function interface
+func (d *DateFormat) Type([]querypb.Type) querypb.Type {
+	return sqltypes.VarBinary
+}
+
+//String implements the Function interface
+func (d *DateFormat) String() string {
+	return "date_format"
+}
+
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// formatDate formats the time using the specifiers of the MySQL
+// DATE_FORMAT function. Like in MySQL, a '%' followed by a character
+// that is not a specifier produces that character.
+func formatDate(t time.Time, format []byte) []byte {
+	var out []byte
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			out = append(out, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			out = append(out, t.Weekday().String()[:3]...)
+		case 'b':
+			out = append(out, t.Month().String()[:3]...)
+		case 'c':
+			out = strconv.AppendInt(out, int64(t.Month()), 10)
+		case 'D':
+			out = strconv.AppendInt(out, int64(t.Day()), 10)
+			out = append(out, daySuffix(t.Day())...)
+		case 'd':
+			out = appendPadded(out, t.Day(), 2)
+		case 'e':
+			out = strconv.AppendInt(out, int64(t.Day()), 10)
+		case 'f':
+			out = appendPadded(out, t.Nanosecond()/1000, 6)
+		case 'H':
+			out = appendPadded(out, t.Hour(), 2)
+		case 'h', 'I':
+			out = appendPadded(out, hour12(t), 2)
+		case 'i':
+			out = appendPadded(out, t.Minute(), 2)
+		case 'j':
+			out = appendPadded(out, t.YearDay(), 3)
+		case 'k':
+			out = strconv.AppendInt(out, int64(t.Hour()), 10)
+		case 'l':
+			out = strconv.AppendInt(out, int64(hour12(t)), 10)
+		case 'M':
+			out = append(out, t.Month().String()...)
+		case 'm':
+			out = appendPadded(out, int(t.Month()), 2)
+		case 'p':
+			out = append(out, t.Format("PM")...)
+		case 'r':
+			out = append(out, t.Format("03:04:05 PM")...)
+		case 'S', 's':
+			out = appendPadded(out, t.Second(), 2)
+		case 'T':
+			out = append(out, t.Format("15:04:05")...)
+		case 'U':
+			out = appendPadded(out, sundayWeek(t), 2)
+		case 'u':
+			out = appendPadded(out, mondayWeek(t), 2)
+		case 'V':
+			_, week := sundayYearWeek(t)
+			out = appendPadded(out, week, 2)
+		case 'v':
+			_, week := t.ISOWeek()
+			out = appendPadded(out, week, 2)
+		case 'W':
+			out = append(out, t.Weekday().String()...)
+		case 'w':
+			out = strconv.AppendInt(out, int64(t.Weekday()), 10)
+		case 'X':
+			year, _ := sundayYearWeek(t)
+			out = appendPadded(out, year, 4)
+		case 'x':
+			year, _ := t.ISOWeek()
+			out = appendPadded(out, year, 4)
+		case 'Y':
+			out = appendPadded(out, t.Year(), 4)
+		case 'y':
+			out = appendPadded(out, t.Year()%100, 2)
+		default:
+			out = append(out, format[i])
+		}
+	}
+	return out
+}
+
+func appendPadded(out []byte, v, width int) []byte {
+	s := strconv.Itoa(v)
+	for i := len(s); i < width; i++ {
+		out = append(out, '0')
+	}
+	return append(out, s...)
+}
+
+func hour12(t time.Time) int {
+	h := t.Hour() % 12
+	if h == 0 {
+		return 12
+	}
+	return h
+}
+
+func daySuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// sundayWeek returns the week of the year (0..53) where Sunday is the
+// first day of the week, which is what WEEK(date, 0) returns.
+func sundayWeek(t time.Time) int {
+	return (t.YearDay() - 1 - int(t.Weekday()) + 7) / 7
+}
+
+// sundayYearWeek is like sundayWeek, but the days before the first
+// Sunday belong to the last week of the previous year.
+func sundayYearWeek(t time.Time) (int, int) {
+	if week := sundayWeek(t); week > 0 {
+		return t.Year(), week
+	}
+	lastDay := time.Date(t.Year()-1, time.December, 31, 0, 0, 0, 0, time.UTC)
+	return lastDay.Year(), sundayWeek(lastDay)
+}
+
+// mondayWeek returns the week of the year (0..53) where Monday is the
+// first day of the week and the first week has more than three days,
+// which is what WEEK(date, 1) returns.
+func mondayWeek(t time.Time) int {
+	year, week := t.ISOWeek()
+	switch {
+	case year < t.Year():
+		return 0
+	case year > t.Year():
+		_, week = t.AddDate(0, 0, -7).ISOWeek()
+		return week + 1
+	}
+	return week
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 
@@ -29,10 +30,12 @@ import (
 
 type (
 	evalResult struct {
-		typ   querypb.Type
-		ival  int64
-		uval  uint64
-		fval  float64
+		typ  querypb.Type
+		ival int64
+		uval uint64
+		fval float64
+		// bytes is the value of a VarBinary. For a Float64 that
+		// is a DECIMAL in MySQL, it's the exact text of the value.
 		bytes []byte
 	}
 	//ExpressionEnv contains the environment that the expression
@@ -59,6 +62,14 @@ type (
 		String() string
 	}
 
+	// Function is implemented by the built-in functions that a Call evaluates.
+	// The arguments are evaluated by the Call before the function is invoked.
+	Function interface {
+		Evaluate(args []EvalResult) (EvalResult, error)
+		Type(args []querypb.Type) querypb.Type
+		String() string
+	}
+
 	// Expressions
 	Literal      struct{ Val EvalResult }
 	BindVariable struct{ Key string }
@@ -67,12 +78,53 @@ type (
 		Expr        BinaryExpr
 		Left, Right Expr
 	}
+	Not struct{ Inner Expr }
+	Is  struct {
+		Inner Expr
+		Check IsCheck
+	}
+	In struct {
+		Left Expr
+		List []Expr
+		// Negate is set for NOT IN.
+		Negate bool
+	}
+	Case struct {
+		// Base is nil for a searched CASE, which evaluates
+		// the conditions of the Whens instead of comparing them.
+		Base  Expr
+		Whens []When
+		Else  Expr
+	}
+	When struct {
+		Cond, Val Expr
+	}
+	Call struct {
+		Func Function
+		Args []Expr
+	}
 
 	// Binary ops
 	Addition       struct{}
 	Subtraction    struct{}
 	Multiplication struct{}
 	Division       struct{}
+
+	// Comparison ops
+	Equals           struct{}
+	NotEquals        struct{}
+	NullSafeEquals   struct{}
+	LessThan         struct{}
+	LessEqualThan    struct{}
+	GreaterThan      struct{}
+	GreaterEqualThan struct{}
+
+	// Logical ops
+	And struct{}
+	Or  struct{}
+
+	// IsCheck is the test performed by an IS expression
+	IsCheck int
 )
 
 //Value allows for retrieval of the value we expose for public consumption
@@ -80,6 +132,22 @@ func (e EvalResult) Value() sqltypes.Value {
 	return castFromNumeric(e, e.typ)
 }
 
+//IsTrue returns true if the result is not NULL and is not zero, which is
+//how MySQL decides if a row passes a WHERE or HAVING condition
+func (e EvalResult) IsTrue() bool {
+	switch e.typ {
+	case sqltypes.Null:
+		return false
+	case sqltypes.Int64:
+		return e.ival != 0
+	case sqltypes.Uint64:
+		return e.uval != 0
+	case sqltypes.Float64:
+		return e.fval != 0
+	}
+	return toFloat64(e) != 0
+}
+
 //NewLiteralInt returns a literal expression
 func NewLiteralInt(val []byte) (Expr, error) {
 	ival, err := strconv.ParseInt(string(val), 10, 64)
@@ -95,23 +163,42 @@ func NewLiteralFloat(val []byte) (Expr, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Literal{evalResult{typ: sqltypes.Float64, fval: fval}}, nil
+	return &Literal{floatResult(fval, val)}, nil
 }
 
-//NewLiteralFloat returns a literal expression
+//NewLiteralString returns a literal expression
 func NewLiteralString(val []byte) (Expr, error) {
 	return &Literal{evalResult{typ: sqltypes.VarBinary, bytes: val}}, nil
 }
 
+//NewLiteralNull returns a literal expression
+func NewLiteralNull() Expr {
+	return &Literal{resultNull}
+}
+
 var _ Expr = (*Literal)(nil)
 var _ Expr = (*BindVariable)(nil)
 var _ Expr = (*BinaryOp)(nil)
 var _ Expr = (*Column)(nil)
+var _ Expr = (*Not)(nil)
+var _ Expr = (*Is)(nil)
+var _ Expr = (*In)(nil)
+var _ Expr = (*Case)(nil)
+var _ Expr = (*Call)(nil)
 
 var _ BinaryExpr = (*Addition)(nil)
 var _ BinaryExpr = (*Subtraction)(nil)
 var _ BinaryExpr = (*Multiplication)(nil)
 var _ BinaryExpr = (*Division)(nil)
+var _ BinaryExpr = (*Equals)(nil)
+var _ BinaryExpr = (*NotEquals)(nil)
+var _ BinaryExpr = (*NullSafeEquals)(nil)
+var _ BinaryExpr = (*LessThan)(nil)
+var _ BinaryExpr = (*LessEqualThan)(nil)
+var _ BinaryExpr = (*GreaterThan)(nil)
+var _ BinaryExpr = (*GreaterEqualThan)(nil)
+var _ BinaryExpr = (*And)(nil)
+var _ BinaryExpr = (*Or)(nil)
 
 //Evaluate implements the Expr interface
 func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
@@ -143,6 +230,9 @@ func (b *BindVariable) Evaluate(env ExpressionEnv) (EvalResult, error) {
 //Evaluate implements the Expr interface
 func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	value := env.Row[c.Offset]
+	if value.IsNull() {
+		return evalResult{typ: sqltypes.Null}, nil
+	}
 	numeric, err := newEvalResult(value)
 	return numeric, err
 }
@@ -206,9 +296,13 @@ func (l *Literal) Type(ExpressionEnv) querypb.Type {
 	return l.Val.typ
 }
 
-//Type implements the Expr interface
-func (c *Column) Type(ExpressionEnv) querypb.Type {
-	return sqltypes.Float64
+//Type implements the Expr interface.
+//If the row is not available, the column is assumed to be numeric.
+func (c *Column) Type(env ExpressionEnv) querypb.Type {
+	if c.Offset >= len(env.Row) {
+		return sqltypes.Float64
+	}
+	return evalType(env.Row[c.Offset].Type())
 }
 
 //String implements the BinaryExpr interface
@@ -233,7 +327,31 @@ func (a *Addition) String() string {
 
 //String implements the Expr interface
 func (b *BinaryOp) String() string {
-	return b.Left.String() + " " + b.Expr.String() + " " + b.Right.String()
+	return b.operandString(b.Left) + " " + b.Expr.String() + " " + b.operandString(b.Right)
+}
+
+// operandString returns the string of an operand, in parenthesis
+// if it's an operation with a lower precedence than b.
+func (b *BinaryOp) operandString(operand Expr) string {
+	if op, ok := operand.(*BinaryOp); ok && precedence(op.Expr) < precedence(b.Expr) {
+		return "(" + op.String() + ")"
+	}
+	return operand.String()
+}
+
+func precedence(op BinaryExpr) int {
+	switch op.(type) {
+	case *Or:
+		return 1
+	case *And:
+		return 2
+	case *Addition, *Subtraction:
+		return 4
+	case *Multiplication, *Division:
+		return 5
+	}
+	// Comparisons
+	return 3
 }
 
 //String implements the Expr interface
@@ -265,6 +383,47 @@ func mergeNumericalTypes(ltype, rtype querypb.Type) querypb.Type {
 	return ltype
 }
 
+// evalType returns the type that a value of the specified type
+// has once it's evaluated. Numbers are evaluated as 64 bit values,
+// and all other non-NULL values as binary strings.
+func evalType(typ querypb.Type) querypb.Type {
+	switch {
+	case typ == sqltypes.Null:
+		return sqltypes.Null
+	case sqltypes.IsSigned(typ):
+		return sqltypes.Int64
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Uint64
+	case sqltypes.IsFloat(typ) || typ == sqltypes.Decimal:
+		return sqltypes.Float64
+	}
+	return sqltypes.VarBinary
+}
+
+// toBytes returns the string representation of a value.
+func toBytes(v evalResult) []byte {
+	switch v.typ {
+	case sqltypes.Int64:
+		return strconv.AppendInt(nil, v.ival, 10)
+	case sqltypes.Uint64:
+		return strconv.AppendUint(nil, v.uval, 10)
+	case sqltypes.Float64:
+		return strconv.AppendFloat(nil, v.fval, 'g', -1, 64)
+	}
+	return v.bytes
+}
+
+// floatResult returns the result for a number literal. Unless the
+// literal has an exponent, it's a DECIMAL in MySQL, and its text is
+// kept, so that the value can be rounded exactly.
+func floatResult(fval float64, text []byte) evalResult {
+	result := evalResult{typ: sqltypes.Float64, fval: fval}
+	if !bytes.ContainsAny(text, "eE") {
+		result.bytes = text
+	}
+	return result
+}
+
 func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 	switch val.Type {
 	case sqltypes.Int64:
@@ -282,9 +441,15 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 	case sqltypes.Float64:
 		fval, err := strconv.ParseFloat(string(val.Value), 64)
 		if err != nil {
-			fval = 0
+			return evalResult{typ: sqltypes.Float64}, nil
+		}
+		return floatResult(fval, val.Value), nil
+	case sqltypes.Decimal:
+		fval, err := strconv.ParseFloat(string(val.Value), 64)
+		if err != nil {
+			return evalResult{typ: sqltypes.Float64}, nil
 		}
-		return evalResult{typ: sqltypes.Float64, fval: fval}, nil
+		return evalResult{typ: sqltypes.Float64, fval: fval, bytes: val.Value}, nil
 	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
 		return evalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
 	case sqltypes.Null:
@@ -105,3 +105,84 @@ func TestBinaryOpTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestEvaluateOnRow(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewInt32(1),
+		sqltypes.NewVarChar("abc"),
+		sqltypes.NULL,
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-02-29 10:11:12")),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.45")),
+	}
+	tests := []struct {
+		expr     Expr
+		expected sqltypes.Value
+	}{{
+		expr: &Case{
+			Whens: []When{{Cond: &Column{Offset: 2}, Val: &Column{Offset: 0}}},
+			Else:  &Column{Offset: 0},
+		},
+		expected: sqltypes.NewInt64(1),
+	}, {
+		// The string column makes the CASE a string.
+		expr: &Case{
+			Whens: []When{{Cond: &Column{Offset: 0}, Val: &Column{Offset: 0}}},
+			Else:  &Column{Offset: 1},
+		},
+		expected: sqltypes.NewVarBinary("1"),
+	}, {
+		expr:     &Call{Func: &IfNull{}, Args: []Expr{&Column{Offset: 2}, &Column{Offset: 1}}},
+		expected: sqltypes.NewVarBinary("abc"),
+	}, {
+		expr:     &Call{Func: &Upper{}, Args: []Expr{&Column{Offset: 1}}},
+		expected: sqltypes.NewVarBinary("ABC"),
+	}, {
+		expr:     &Call{Func: &DateFormat{}, Args: []Expr{&Column{Offset: 3}, &Literal{evalResult{typ: sqltypes.VarBinary, bytes: []byte("%d/%m/%y %l%p")}}}},
+		expected: sqltypes.NewVarBinary("29/02/20 10AM"),
+	}, {
+		expr:     &Call{Func: &Round{}, Args: []Expr{&Column{Offset: 4}, &Literal{evalResult{typ: sqltypes.Int64, ival: 1}}}},
+		expected: sqltypes.NewFloat64(2.5),
+	}, {
+		expr:     &In{Left: &Column{Offset: 0}, List: []Expr{&Literal{evalResult{typ: sqltypes.VarBinary, bytes: []byte("abc")}}, &Column{Offset: 0}}},
+		expected: sqltypes.NewInt64(1),
+	}, {
+		expr:     &Is{Inner: &Column{Offset: 2}, Check: IsNotNull},
+		expected: sqltypes.NewInt64(0),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expr.String(), func(t *testing.T) {
+			r, err := test.expr.Evaluate(ExpressionEnv{Row: row})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value())
+		})
+	}
+}
+
+func TestEvaluateOnRowErrors(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(-1),
+		sqltypes.NewVarChar("abc"),
+	}
+	tests := []struct {
+		expr Expr
+		err  string
+	}{{
+		expr: &In{Left: &Column{Offset: 1}, List: []Expr{&Literal{evalResult{typ: sqltypes.VarBinary, bytes: []byte("ABC")}}}},
+		err:  `unsupported: in vtgate: comparison of text values in [1] in (VARBINARY("ABC"))`,
+	}, {
+		// The CASE is unsigned, because its branches are.
+		expr: &Case{
+			Whens: []When{{Cond: &Column{Offset: 0}, Val: &Column{Offset: 0}}},
+			Else:  &Literal{evalResult{typ: sqltypes.Uint64, uval: 1}},
+		},
+		err: "BIGINT UNSIGNED value is out of range: -1",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expr.String(), func(t *testing.T) {
+			_, err := test.expr.Evaluate(ExpressionEnv{Row: row})
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// Built-in functions
+	Concat     struct{}
+	Lower      struct{}
+	Upper      struct{}
+	IfNull     struct{}
+	Coalesce   struct{}
+	Round      struct{}
+	DateFormat struct{}
+
+	// builtin describes a built-in function and
+	// the number of arguments that it accepts.
+	builtin struct {
+		fn      Function
+		minArgs int
+		// maxArgs is -1 if the number of arguments is not limited.
+		maxArgs int
+	}
+)
+
+var _ Function = (*Concat)(nil)
+var _ Function = (*Lower)(nil)
+var _ Function = (*Upper)(nil)
+var _ Function = (*IfNull)(nil)
+var _ Function = (*Coalesce)(nil)
+var _ Function = (*Round)(nil)
+var _ Function = (*DateFormat)(nil)
+
+var builtins = map[string]builtin{
+	"concat":      {fn: &Concat{}, minArgs: 1, maxArgs: -1},
+	"lower":       {fn: &Lower{}, minArgs: 1, maxArgs: 1},
+	"lcase":       {fn: &Lower{}, minArgs: 1, maxArgs: 1},
+	"upper":       {fn: &Upper{}, minArgs: 1, maxArgs: 1},
+	"ucase":       {fn: &Upper{}, minArgs: 1, maxArgs: 1},
+	"ifnull":      {fn: &IfNull{}, minArgs: 2, maxArgs: 2},
+	"coalesce":    {fn: &Coalesce{}, minArgs: 1, maxArgs: -1},
+	"round":       {fn: &Round{}, minArgs: 1, maxArgs: 2},
+	"date_format": {fn: &DateFormat{}, minArgs: 2, maxArgs: 2},
+}
+
+//IsBuiltin returns true if the function can be evaluated by a Call
+func IsBuiltin(name string) bool {
+	_, ok := builtins[strings.ToLower(name)]
+	return ok
+}
+
+//NewCall returns an expression that calls the named built-in function
+func NewCall(name string, args []Expr) (Expr, error) {
+	b, ok := builtins[strings.ToLower(name)]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "function not supported: %s", name)
+	}
+	if len(args) < b.minArgs || (b.maxArgs != -1 && len(args) > b.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to native function '%s'", b.fn.String())
+	}
+	return &Call{Func: b.fn, Args: args}, nil
+}
+
+//Evaluate implements the Expr interface
+func (c *Call) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, 0, len(c.Args))
+	for _, arg := range c.Args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		args = append(args, val)
+	}
+	result, err := c.Func.Evaluate(args)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return coerceTo(result, c.Type(env))
+}
+
+//Type implements the Expr interface
+func (c *Call) Type(env ExpressionEnv) querypb.Type {
+	types := make([]querypb.Type, 0, len(c.Args))
+	for _, arg := range c.Args {
+		types = append(types, arg.Type(env))
+	}
+	return c.Func.Type(types)
+}
+
+//String implements the Expr interface
+func (c *Call) String() string {
+	var args []string
+	for _, arg := range c.Args {
+		args = append(args, arg.String())
+	}
+	return c.Func.String() + "(" + strings.Join(args, ", ") + ")"
+}
+
+//Evaluate implements the Function interface.
+//The arguments are converted to strings, and the result is NULL
+//if any of the arguments is NULL.
+func (c *Concat) Evaluate(args []EvalResult) (EvalResult, error) {
+	var buf bytes.Buffer
+	for _, arg := range args {
+		if arg.typ == sqltypes.Null {
+			return resultNull, nil
+		}
+		buf.Write(toBytes(arg))
+	}
+	return evalResult{typ: sqltypes.VarBinary, bytes: buf.Bytes()}, nil
+}
+
+//Type implements the Function interface
+func (c *Concat) Type([]querypb.Type) querypb.Type {
+	return sqltypes.VarBinary
+}
+
+//String implements the Function interface
+func (c *Concat) String() string {
+	return "concat"
+}
+
+//Evaluate implements the Function interface
+func (l *Lower) Evaluate(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	return evalResult{typ: sqltypes.VarBinary, bytes: bytes.ToLower(toBytes(args[0]))}, nil
+}
+
+//Type implements the Function interface
+func (l *Lower) Type([]querypb.Type) querypb.Type {
+	return sqltypes.VarBinary
+}
+
+//String implements the Function interface
+func (l *Lower) String() string {
+	return "lower"
+}
+
+//Evaluate implements the Function interface
+func (u *Upper) Evaluate(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	return evalResult{typ: sqltypes.VarBinary, bytes: bytes.ToUpper(toBytes(args[0]))}, nil
+}
+
+//Type implements the Function interface
+func (u *Upper) Type([]querypb.Type) querypb.Type {
+	return sqltypes.VarBinary
+}
+
+//String implements the Function interface
+func (u *Upper) String() string {
+	return "upper"
+}
+
+//Evaluate implements the Function interface
+func (i *IfNull) Evaluate(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Null {
+		return args[1], nil
+	}
+	return args[0], nil
+}
+
+//Type implements the Function interface
+func (i *IfNull) Type(args []querypb.Type) querypb.Type {
+	return aggregateTypes(args)
+}
+
+//String implements the Function interface
+func (i *IfNull) String() string {
+	return "ifnull"
+}
+
+//Evaluate implements the Function interface
+func (c *Coalesce) Evaluate(args []EvalResult) (EvalResult, error) {
+	for _, arg := range args {
+		if arg.typ != sqltypes.Null {
+			return arg, nil
+		}
+	}
+	return resultNull, nil
+}
+
+//Type implements the Function interface
+func (c *Coalesce) Type(args []querypb.Type) querypb.Type {
+	return aggregateTypes(args)
+}
+
+//String implements the Function interface
+func (c *Coalesce) String() string {
+	return "coalesce"
+}
+
+//Evaluate implements the Function interface.
+//As in MySQL, halves of exact values are rounded away from zero, and
+//DECIMALs are rounded on their text. Halves of floats are rounded to
+//the nearest even value.
+func (r *Round) Evaluate(args []EvalResult) (EvalResult, error) {
+	for _, arg := range args {
+		if arg.typ == sqltypes.Null {
+			return resultNull, nil
+		}
+	}
+	var decimals int64
+	if len(args) == 2 {
+		d := toNumeric(args[1])
+		switch d.typ {
+		case sqltypes.Int64:
+			decimals = d.ival
+		case sqltypes.Uint64:
+			decimals = int64(d.uval)
+		case sqltypes.Float64:
+			decimals = int64(math.Round(d.fval))
+		}
+	}
+	val := toNumeric(args[0])
+	switch val.typ {
+	case sqltypes.Int64:
+		return evalResult{typ: sqltypes.Int64, ival: roundInt(val.ival, decimals)}, nil
+	case sqltypes.Uint64:
+		return evalResult{typ: sqltypes.Uint64, uval: roundUint(val.uval, decimals)}, nil
+	}
+	if val.bytes != nil {
+		text := roundDecimal(val.bytes, decimals)
+		fval, err := strconv.ParseFloat(string(text), 64)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		return evalResult{typ: sqltypes.Float64, fval: fval, bytes: text}, nil
+	}
+	return evalResult{typ: sqltypes.Float64, fval: roundFloat(val.fval, decimals)}, nil
+}
+
+//Type implements the Function interface
+func (r *Round) Type(args []querypb.Type) querypb.Type {
+	switch typ := evalType(args[0]); typ {
+	case sqltypes.Int64, sqltypes.Uint64, sqltypes.Null:
+		return typ
+	}
+	return sqltypes.Float64
+}
+
+//String implements the Function interface
+func (r *Round) String() string {
+	return "round"
+}
+
+// roundInt rounds an integer to the specified number of
+// decimals, which only has an effect if it's negative.
+func roundInt(v, decimals int64) int64 {
+	if decimals >= 0 {
+		return v
+	}
+	if decimals < -18 {
+		return 0
+	}
+	p := pow10(-decimals)
+	q, rem := v/p, v%p
+	switch {
+	case rem*2 >= p:
+		q++
+	case rem*2 <= -p:
+		q--
+	}
+	return q * p
+}
+
+func roundUint(v uint64, decimals int64) uint64 {
+	if decimals >= 0 {
+		return v
+	}
+	if decimals < -19 {
+		return 0
+	}
+	p := uint64(1)
+	for i := int64(0); i < -decimals; i++ {
+		p *= 10
+	}
+	q, rem := v/p, v%p
+	if rem >= p-rem {
+		q++
+	}
+	return q * p
+}
+
+func roundFloat(v float64, decimals int64) float64 {
+	if decimals > 30 {
+		return v
+	}
+	p := math.Pow(10, float64(decimals))
+	shifted := v * p
+	if math.IsInf(shifted, 0) {
+		return v
+	}
+	return math.RoundToEven(shifted) / p
+}
+
+// roundDecimal rounds the text of a DECIMAL to the specified
+// number of decimals. Halves are rounded away from zero.
+func roundDecimal(text []byte, decimals int64) []byte {
+	str := string(text)
+	neg := strings.HasPrefix(str, "-")
+	str = strings.TrimLeft(str, "+-")
+	intPart, fracPart := str, ""
+	if dot := strings.IndexByte(str, '.'); dot >= 0 {
+		intPart, fracPart = str[:dot], str[dot+1:]
+	}
+	if decimals >= int64(len(fracPart)) {
+		return text
+	}
+	digits := []byte(intPart + fracPart)
+	cut := int64(len(intPart)) + decimals
+	if cut < 0 {
+		return []byte("0")
+	}
+	roundUp := digits[cut] >= '5'
+	digits = digits[:cut]
+	for i := len(digits) - 1; roundUp && i >= 0; i-- {
+		if digits[i] == '9' {
+			digits[i] = '0'
+			continue
+		}
+		digits[i]++
+		roundUp = false
+	}
+	if roundUp {
+		digits = append([]byte{'1'}, digits...)
+	}
+
+	var buf strings.Builder
+	if decimals > 0 {
+		intLen := len(digits) - int(decimals)
+		buf.WriteString(trimLeadingZeros(string(digits[:intLen])))
+		buf.WriteByte('.')
+		buf.Write(digits[intLen:])
+	} else {
+		buf.WriteString(trimLeadingZeros(string(digits) + strings.Repeat("0", int(-decimals))))
+	}
+	result := buf.String()
+	if neg && strings.Trim(result, "0.") != "" {
+		result = "-" + result
+	}
+	return []byte(result)
+}
+
+func trimLeadingZeros(s string) string {
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+func pow10(n int64) int64 {
+	p := int64(1)
+	for i := int64(0); i < n; i++ {
+		p *= 10
+	}
+	return p
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+//Evaluate implements the BinaryExpr interface.
+//It follows the MySQL three-valued logic: FALSE wins over NULL,
+//and NULL wins over TRUE.
+func (a *And) Evaluate(left, right EvalResult) (EvalResult, error) {
+	leftNull := left.typ == sqltypes.Null
+	rightNull := right.typ == sqltypes.Null
+	switch {
+	case !leftNull && !left.IsTrue(), !rightNull && !right.IsTrue():
+		return resultFalse, nil
+	case leftNull || rightNull:
+		return resultNull, nil
+	}
+	return resultTrue, nil
+}
+
+//Type implements the BinaryExpr interface
+func (a *And) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the BinaryExpr interface
+func (a *And) String() string {
+	return "and"
+}
+
+//Evaluate implements the BinaryExpr interface.
+//It follows the MySQL three-valued logic: TRUE wins over NULL,
+//and NULL wins over FALSE.
+func (o *Or) Evaluate(left, right EvalResult) (EvalResult, error) {
+	leftNull := left.typ == sqltypes.Null
+	rightNull := right.typ == sqltypes.Null
+	switch {
+	case left.IsTrue(), right.IsTrue():
+		return resultTrue, nil
+	case leftNull || rightNull:
+		return resultNull, nil
+	}
+	return resultFalse, nil
+}
+
+//Type implements the BinaryExpr interface
+func (o *Or) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the BinaryExpr interface
+func (o *Or) String() string {
+	return "or"
+}
+
+//Evaluate implements the Expr interface
+func (n *Not) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if val.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	return boolResult(!val.IsTrue()), nil
+}
+
+//Type implements the Expr interface
+func (n *Not) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the Expr interface
+func (n *Not) String() string {
+	return "not " + n.Inner.String()
+}
+
+const (
+	// IsNull is the check of IS NULL
+	IsNull = IsCheck(iota)
+	// IsNotNull is the check of IS NOT NULL
+	IsNotNull
+	// IsTrue is the check of IS TRUE
+	IsTrue
+	// IsNotTrue is the check of IS NOT TRUE
+	IsNotTrue
+	// IsFalse is the check of IS FALSE
+	IsFalse
+	// IsNotFalse is the check of IS NOT FALSE
+	IsNotFalse
+)
+
+var isCheckName = map[IsCheck]string{
+	IsNull:     "is null",
+	IsNotNull:  "is not null",
+	IsTrue:     "is true",
+	IsNotTrue:  "is not true",
+	IsFalse:    "is false",
+	IsNotFalse: "is not false",
+}
+
+//Evaluate implements the Expr interface.
+//Unlike most expressions, an IS expression never evaluates to NULL.
+func (i *Is) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	isNull := val.typ == sqltypes.Null
+	var result bool
+	switch i.Check {
+	case IsNull:
+		result = isNull
+	case IsNotNull:
+		result = !isNull
+	case IsTrue:
+		result = val.IsTrue()
+	case IsNotTrue:
+		result = !val.IsTrue()
+	case IsFalse:
+		result = !isNull && !val.IsTrue()
+	case IsNotFalse:
+		result = isNull || val.IsTrue()
+	}
+	return boolResult(result), nil
+}
+
+//Type implements the Expr interface
+func (i *Is) Type(ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the Expr interface
+func (i *Is) String() string {
+	return i.Inner.String() + " " + isCheckName[i.Check]
+}
@@ -92,10 +92,13 @@ type Executor struct {
 	vschema      *vindexes.VSchema
 	normalize    bool
 	streamSize   int
-	plans        *cache.LRUCache
+	plans        cache.Cache
 	vschemaStats *VSchemaStats
+	dbDDL        DBDDLPlugin
 
 	vm *VSchemaManager
+
+	lockSessions *lockSessions
 }
 
 var executorOnce sync.Once
@@ -105,16 +108,19 @@ const pathScatterStats = "/debug/scatter_stats"
 const pathVSchema = "/debug/vschema"
 
 // NewExecutor creates a new Executor.
-func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver *Resolver, normalize bool, streamSize int, queryPlanCacheSize int64) *Executor {
+func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver *Resolver, normalize bool, streamSize int, cacheCfg *cache.Config) *Executor {
 	e := &Executor{
 		serv:        serv,
 		cell:        cell,
 		resolver:    resolver,
 		scatterConn: resolver.scatterConn,
 		txConn:      resolver.scatterConn.txConn,
-		plans:       cache.NewLRUCache(queryPlanCacheSize),
+		plans:       cache.NewCache(cacheCfg),
 		normalize:   normalize,
 		streamSize:  streamSize,
+		dbDDL:       newDBDDLPlugin(serv, resolver.scatterConn.gateway, cell),
+
+		lockSessions: newLockSessions(),
 	}
 
 	vschemaacl.Init()
@@ -143,8 +149,24 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	trace.AnnotateSQL(span, sql)
 	defer span.Finish()
 
+	return e.executeAndLog(ctx, method, safeSession, sql, nil, bindVars)
+}
+
+// ExecutePrepared executes a prepared statement. It reuses the plan
+// bound to the statement when it's still valid.
+func (e *Executor) ExecutePrepared(ctx context.Context, method string, safeSession *SafeSession, ps *PreparedStatement, bindVars map[string]*querypb.BindVariable) (result *sqltypes.Result, err error) {
+	span, ctx := trace.NewSpan(ctx, "executor.ExecutePrepared")
+	span.Annotate("method", method)
+	trace.AnnotateSQL(span, ps.Query)
+	defer span.Finish()
+
+	return e.executeAndLog(ctx, method, safeSession, ps.Query, ps, bindVars)
+}
+
+func (e *Executor) executeAndLog(ctx context.Context, method string, safeSession *SafeSession, sql string, ps *PreparedStatement, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	logStats := NewLogStats(ctx, method, sql, bindVars)
-	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+	safeSession.foundRowsHandled = false
+	stmtType, result, err := e.execute(ctx, safeSession, sql, ps, bindVars, logStats)
 	logStats.Error = err
 	saveSessionStats(safeSession, stmtType, result, err)
 	if result != nil && len(result.Rows) > *warnMemoryRows {
@@ -160,7 +182,9 @@ func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType
 	if err != nil {
 		return
 	}
-	safeSession.FoundRows = result.RowsAffected
+	if !safeSession.foundRowsHandled {
+		safeSession.FoundRows = result.RowsAffected
+	}
 	if result.InsertID > 0 {
 		safeSession.LastInsertId = result.InsertID
 	}
@@ -172,8 +196,8 @@ func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType
 	}
 }
 
-func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
-	stmtType, qr, err := e.newExecute(ctx, safeSession, sql, bindVars, logStats)
+func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, ps *PreparedStatement, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	stmtType, qr, err := e.newExecute(ctx, safeSession, sql, ps, bindVars, logStats)
 	if err == planbuilder.ErrPlanNotSupported {
 		return e.legacyExecute(ctx, safeSession, sql, bindVars, logStats)
 	}
@@ -343,11 +367,77 @@ func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession
 }
 
 // CloseSession releases the current connection, which rollbacks open transactions and closes reserved connections.
+// The lock sessions are closed too, which releases the named locks held by the session.
 // It is called then the MySQL servers closes the connection to its client.
 func (e *Executor) CloseSession(ctx context.Context, safeSession *SafeSession) error {
+	e.lockSessions.remove(safeSession.LockSessions)
+	lockErr := e.txConn.ReleaseLock(ctx, safeSession)
+	if err := e.txConn.Release(ctx, safeSession); err != nil {
+		return err
+	}
+	return lockErr
+}
+
+// ReleaseUnneededConns releases the reserved connections of the session
+// if it doesn't need them anymore, like once its last temporary table
+// was dropped outside of a transaction.
+func (e *Executor) ReleaseUnneededConns(ctx context.Context, safeSession *SafeSession) error {
+	if !safeSession.ReservedConnsUnneeded() {
+		return nil
+	}
 	return e.txConn.Release(ctx, safeSession)
 }
 
+// ExecuteLock executes a query that calls named lock functions
+// on the lock session of the shard. If the reserved connection of the
+// lock session was lost, the named locks were released with it, so the
+// lock session is forgotten and the next query reserves a new one.
+func (e *Executor) ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, safeSession *SafeSession) (*sqltypes.Result, error) {
+	qr, err := e.scatterConn.ExecuteLock(ctx, rs, query, safeSession)
+	if err != nil && wasConnectionLost(err) {
+		if lockSession := safeSession.RemoveLockSession(rs.Target); lockSession != nil {
+			e.lockSessions.remove([]*vtgatepb.Session_ShardSession{lockSession})
+		}
+	}
+	return qr, err
+}
+
+// wasConnectionLost returns true if err shows that the reserved
+// connection the query was sent to is gone. The tablet aborts the
+// queries on a reserved connection that it killed or doesn't know.
+func wasConnectionLost(err error) bool {
+	if vterrors.Code(err) == vtrpcpb.Code_ABORTED {
+		return true
+	}
+	sqlErr := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+	switch sqlErr.Number() {
+	case mysql.CRServerGone, mysql.CRServerLost:
+		return true
+	}
+	return false
+}
+
+// RecordLockAction records a change to the named locks held on the lock
+// session of the target, so that they're shown on the status page.
+func (e *Executor) RecordLockAction(ctx context.Context, safeSession *SafeSession, target *querypb.Target, action engine.LockAction, name string) {
+	reservedID, alias := safeSession.FindLockSession(target)
+	if reservedID == 0 {
+		return
+	}
+	lockSession := &vtgatepb.Session_ShardSession{
+		Target:      target,
+		ReservedId:  reservedID,
+		TabletAlias: alias,
+	}
+	username := callerid.ImmediateCallerIDFromContext(ctx).GetUsername()
+	e.lockSessions.record(lockSession, username, action, name)
+}
+
+// LockSessionStats returns the lock sessions that hold named locks.
+func (e *Executor) LockSessionStats() []*LockSessionStats {
+	return e.lockSessions.Stats()
+}
+
 func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats) (*sqltypes.Result, error) {
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
@@ -501,6 +591,19 @@ func handleSessionSetting(ctx context.Context, name string, session *SafeSession
 		default:
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for skip_query_plan_cache: %d", val)
 		}
+	case "read_after_write_consistency":
+		val, err := validateSetOnOff(value, name)
+		if err != nil {
+			return err
+		}
+		switch val {
+		case 0:
+			session.SetReadAfterWriteConsistency(false)
+		case 1:
+			session.SetReadAfterWriteConsistency(true)
+		default:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for read_after_write_consistency: %d", val)
+		}
 	case "sql_safe_updates":
 		val, err := validateSetOnOff(value, name)
 		if err != nil {
@@ -601,7 +704,7 @@ func handleSessionSetting(ctx context.Context, name string, session *SafeSession
 		if !ok {
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for wait_timeout: %T", value)
 		}
-	case "sql_mode", "net_write_timeout", "net_read_timeout", "lc_messages", "collation_connection", "foreign_key_checks", "sql_quote_show_create", "unique_checks":
+	case "net_write_timeout", "net_read_timeout":
 		log.Warningf("Ignored inapplicable SET %v = %v", name, value)
 		warnings.Add("IgnoredSet", 1)
 	case "charset", "names":
@@ -784,55 +887,6 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Rows:         rows,
 			RowsAffected: rowsAffected,
 		}, err
-	case "create table":
-		if !show.Table.Qualifier.IsEmpty() {
-			// Explicit keyspace was passed. Use that for targeting but remove from the query itself.
-			destKeyspace = show.Table.Qualifier.String()
-			show.Table.Qualifier = sqlparser.NewTableIdent("")
-		} else {
-			// No keyspace was indicated. Try to find one using the vschema.
-			tbl, err := e.VSchema().FindTable(destKeyspace, show.Table.Name.String())
-			if err != nil {
-				return nil, err
-			}
-			destKeyspace = tbl.Keyspace.Name
-		}
-		sql = sqlparser.String(show)
-	case sqlparser.KeywordString(sqlparser.COLUMNS):
-		if !show.OnTable.Qualifier.IsEmpty() {
-			destKeyspace = show.OnTable.Qualifier.String()
-			show.OnTable.Qualifier = sqlparser.NewTableIdent("")
-		} else if show.ShowTablesOpt != nil {
-			if show.ShowTablesOpt.DbName != "" {
-				destKeyspace = show.ShowTablesOpt.DbName
-				show.ShowTablesOpt.DbName = ""
-			}
-		} else {
-			break
-		}
-		sql = sqlparser.String(show)
-	case sqlparser.KeywordString(sqlparser.INDEX), sqlparser.KeywordString(sqlparser.KEYS), sqlparser.KeywordString(sqlparser.INDEXES):
-		if !show.OnTable.Qualifier.IsEmpty() {
-			destKeyspace = show.OnTable.Qualifier.String()
-			show.OnTable.Qualifier = sqlparser.NewTableIdent("")
-		} else if show.ShowTablesOpt != nil {
-			if show.ShowTablesOpt.DbName != "" {
-				destKeyspace = show.ShowTablesOpt.DbName
-				show.ShowTablesOpt.DbName = ""
-			}
-		} else {
-			break
-		}
-		sql = sqlparser.String(show)
-	case sqlparser.KeywordString(sqlparser.TABLES):
-		if show.ShowTablesOpt != nil && show.ShowTablesOpt.DbName != "" {
-			if destKeyspace == "" {
-				// Change "show tables from <keyspace>" to "show tables" directed to that keyspace.
-				destKeyspace = show.ShowTablesOpt.DbName
-			}
-			show.ShowTablesOpt.DbName = ""
-		}
-		sql = sqlparser.String(show)
 	case sqlparser.KeywordString(sqlparser.DATABASES), "vitess_keyspaces", "keyspaces":
 		keyspaces, err := e.resolver.resolver.GetAllKeyspaces(ctx)
 		if err != nil {
@@ -1289,6 +1343,23 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 
 }
 
+// TableStatistics returns the table statistics published
+// by the healthy tablets of the target.
+func (e *Executor) TableStatistics(target *querypb.Target) []*querypb.TableStatistics {
+	return e.scatterConn.gateway.TableStatistics(target)
+}
+
+// TransactionMode returns the transaction mode of the sessions
+// that don't set their own.
+func (e *Executor) TransactionMode() vtgatepb.TransactionMode {
+	return e.txConn.mode
+}
+
+// DBDDLPlugin returns the plugin that creates and drops databases.
+func (e *Executor) DBDDLPlugin() DBDDLPlugin {
+	return e.dbDDL
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func (e *Executor) ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(targetString, defaultTabletType)
@@ -1313,8 +1384,8 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, errors.New("vschema not initialized")
 	}
 	planKey := vcursor.planPrefixKey() + ":" + sql
-	if plan, ok := e.plans.Get(planKey); ok {
-		return plan.(*engine.Plan), nil
+	if plan, ok := e.getCachedPlan(planKey); ok {
+		return plan, nil
 	}
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
@@ -1346,8 +1417,8 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 
 	planKey = vcursor.planPrefixKey() + ":" + query
-	if plan, ok := e.plans.Get(planKey); ok {
-		return plan.(*engine.Plan), nil
+	if plan, ok := e.getCachedPlan(planKey); ok {
+		return plan, nil
 	}
 	plan, err := planbuilder.BuildFromStmt(query, statement, vcursor, bindVarNeeds)
 	if err != nil {
@@ -1359,6 +1430,17 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	return plan, nil
 }
 
+// getCachedPlan returns the plan cached for the key, and records the hit.
+func (e *Executor) getCachedPlan(planKey string) (*engine.Plan, bool) {
+	result, ok := e.plans.Get(planKey)
+	if !ok {
+		return nil, false
+	}
+	plan := result.(*engine.Plan)
+	plan.AddCacheHit()
+	return plan, true
+}
+
 // skipQueryPlanCache extracts SkipQueryPlanCache from session
 func skipQueryPlanCache(safeSession *SafeSession) bool {
 	if safeSession == nil || safeSession.Options == nil {
@@ -1398,8 +1480,8 @@ func returnAsJSON(response http.ResponseWriter, stuff interface{}) {
 	_, _ = response.Write(ebuf.Bytes())
 }
 
-// Plans returns the LRU plan cache
-func (e *Executor) Plans() *cache.LRUCache {
+// Plans returns the plan cache
+func (e *Executor) Plans() cache.Cache {
 	return e.plans
 }
 
@@ -1608,6 +1690,12 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtExplain:
 		return nil, nil
 	case sqlparser.StmtShow:
+		// The SHOW statements about the tables of a keyspace are planned.
+		fields, err := e.handlePrepare(ctx, safeSession, sql, bindVars, logStats)
+		if err != planbuilder.ErrPlanNotSupported {
+			return fields, err
+		}
+		logStats.Error = nil
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 		if err != nil {
 			return nil, err
@@ -1658,6 +1746,6 @@ func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.Resolve
 }
 
 // StreamExecuteMulti implements the IExecutor interface
-func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error {
-	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, options, callback)
+func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) error {
+	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, session, callback)
 }
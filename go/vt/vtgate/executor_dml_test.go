@@ -268,6 +268,50 @@ func TestUpdateMultiOwned(t *testing.T) {
 	utils.MustMatch(t, wantQueries, sbclookup.Queries, "sbclookup.Queries")
 }
 
+func TestUpdateMovePrimaryVindex(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("3|Id|name", "int64|int64|varchar"),
+			"3|1|foo",
+		),
+	})
+
+	// The row moves from -20 to 40-60.
+	_, err := executorExec(executor, "update user set id = 3 where id = 1", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select 3, user.* from user where id = 1 for update",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "delete from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, wantQueries, sbc1.Queries, "sbc1.Queries")
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "insert into user(Id, name) values (:_Id_0, :_name_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_Id_0":   sqltypes.Int64BindVariable(3),
+			"_name_0": sqltypes.ValueBindVariable(sqltypes.NewVarChar("foo")),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbc2.Queries, "sbc2.Queries")
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "delete from name_user_map where name = :name and user_id = :user_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name":    sqltypes.ValueBindVariable(sqltypes.NewVarChar("foo")),
+			"user_id": sqltypes.Uint64BindVariable(1),
+		},
+	}, {
+		Sql: "insert into name_user_map(name, user_id) values (:name_0, :user_id_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name_0":    sqltypes.ValueBindVariable(sqltypes.NewVarChar("foo")),
+			"user_id_0": sqltypes.Uint64BindVariable(3),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "sbclookup.Queries")
+}
+
 func TestUpdateComments(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 
@@ -548,6 +592,57 @@ func TestDeleteComments(t *testing.T) {
 	}
 }
 
+func TestDeleteMultiTableLookupOwned(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|user_id",
+				"int64|int64",
+			),
+			"3|1",
+		),
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"user_id|id",
+				"int64|int64",
+			),
+			"1|3",
+		),
+	})
+
+	_, err := executorExec(executor, "delete m from music as m join user as u on m.id = u.id where m.user_id = 1 and u.name = 'foo'", nil)
+	require.NoError(t, err)
+	dmlVals := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(3))},
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select m.id, m.user_id from music as m where m.user_id = 1 for update",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select user_id, id from music where id in ::__dml_vals for update",
+		BindVariables: map[string]*querypb.BindVariable{"__dml_vals": dmlVals},
+	}, {
+		Sql:           "delete m from music as m where id in ::__dml_vals",
+		BindVariables: map[string]*querypb.BindVariable{"__dml_vals": dmlVals},
+	}}
+	utils.MustMatch(t, wantQueries, sbc1.Queries, "sbc1.Queries")
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "select 1 from user as u where u.id = :m_id and u.name = 'foo' for update",
+		BindVariables: map[string]*querypb.BindVariable{"m_id": sqltypes.Int64BindVariable(3)},
+	}}
+	utils.MustMatch(t, wantQueries, sbc2.Queries, "sbc2.Queries")
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "delete from music_user_map where music_id = :music_id and user_id = :user_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id": sqltypes.Int64BindVariable(3),
+			"user_id":  sqltypes.Uint64BindVariable(1),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "sbclookup.Queries")
+}
+
 func TestInsertSharded(t *testing.T) {
 	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
 
@@ -1124,6 +1219,92 @@ func TestInsertLookupOwned(t *testing.T) {
 	utils.MustMatch(t, wantQueries, sbclookup.Queries, "sbclookup.Queries")
 }
 
+func TestReplaceLookupOwned(t *testing.T) {
+	executor, sbc, _, sbclookup := createExecutorEnv()
+	// The existing row of music 3 has user_id 2.
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("user_id|id", "int64|int64"),
+			"2|3",
+		),
+	})
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "replace into music(user_id, id) values (2, 3)", nil)
+	require.NoError(t, err)
+	bindVars := map[string]*querypb.BindVariable{
+		"_user_id_0": sqltypes.Int64BindVariable(2),
+		"_id_0":      sqltypes.Int64BindVariable(3),
+		"__seq0":     sqltypes.Int64BindVariable(3),
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select user_id, id from music where id = :_id_0 for update",
+		BindVariables: bindVars,
+	}, {
+		Sql:           "replace into music(user_id, id) values (:_user_id_0, :_id_0)",
+		BindVariables: bindVars,
+	}}
+	utils.MustMatch(t, wantQueries, sbc.Queries, "sbc.Queries")
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "select music_id, user_id from music_user_map where music_id in ::music_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id": sqltypes.TestBindVariable([]interface{}{sqltypes.NewInt64(3)}),
+		},
+	}, {
+		Sql: "delete from music_user_map where music_id = :music_id and user_id = :user_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id": sqltypes.Int64BindVariable(3),
+			"user_id":  sqltypes.Uint64BindVariable(2),
+		},
+	}, {
+		Sql: "insert into music_user_map(music_id, user_id) values (:music_id_0, :user_id_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id_0": sqltypes.Int64BindVariable(3),
+			"user_id_0":  sqltypes.Uint64BindVariable(2),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "sbclookup.Queries")
+	// The statement runs in a transaction, even in autocommit mode.
+	assert.EqualValues(t, 1, sbc.BeginCount.Get(), "sbc.BeginCount")
+	assert.EqualValues(t, 1, sbclookup.BeginCount.Get(), "sbclookup.BeginCount")
+	assert.False(t, session.InTransaction(), "session.InTransaction")
+}
+
+func TestInsertSelectLookupOwned(t *testing.T) {
+	executor, sbc, _, sbclookup := createExecutorEnv()
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"user_id|id",
+				"int64|int64",
+			),
+			"2|3",
+		),
+	})
+
+	_, err := executorExec(executor, "insert into music(user_id, id) select user_id, id from user_extra where user_id = 2", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select user_id, id from user_extra where user_id = 2",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql: "insert into music(user_id, id) values (:__ins0_0, :__ins0_1)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__ins0_0": sqltypes.Int64BindVariable(2),
+			"__ins0_1": sqltypes.Int64BindVariable(3),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbc.Queries, "sbc.Queries")
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "insert into music_user_map(music_id, user_id) values (:music_id_0, :user_id_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"music_id_0": sqltypes.Int64BindVariable(3),
+			"user_id_0":  sqltypes.Uint64BindVariable(2),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "sbclookup.Queries")
+}
+
 func TestInsertLookupOwnedGenerator(t *testing.T) {
 	executor, sbc, _, sbclookup := createExecutorEnv()
 
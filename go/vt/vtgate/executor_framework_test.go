@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/discovery"
@@ -159,6 +160,7 @@ var executorVSchema = `
 					"name": "music_user_map"
 				}
 			],
+			"primary_key": ["id"],
 			"auto_increment": {
 				"column": "id",
 				"sequence": "user_seq"
@@ -268,10 +270,9 @@ var unshardedVSchema = `
 }
 `
 
-const (
-	testBufferSize = 10
-	testCacheSize  = int64(10)
-)
+const testBufferSize = 10
+
+var testCacheConfig = &cache.Config{MaxEntries: 10}
 
 type DestinationAnyShardPickerFirstShard struct{}
 
@@ -364,7 +365,7 @@ func createExecutorEnv() (executor *Executor, sbc1, sbc2, sbclookup *sandboxconn
 	bad.VSchema = badVSchema
 
 	getSandbox(KsTestUnsharded).VSchema = unshardedVSchema
-	executor = NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor = NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	key.AnyShardPicker = DestinationAnyShardPickerFirstShard{}
 	return executor, sbc1, sbc2, sbclookup
@@ -384,7 +385,7 @@ func createCustomExecutor(vschema string) (executor *Executor, sbc1, sbc2, sbclo
 	sbclookup = hc.AddTestTablet(cell, "0", 1, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
 	getSandbox(KsTestUnsharded).VSchema = unshardedVSchema
 
-	executor = NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor = NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 	return executor, sbc1, sbc2, sbclookup
 }
 
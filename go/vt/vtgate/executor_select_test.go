@@ -299,6 +299,40 @@ func TestFoundRows(t *testing.T) {
 	utils.MustMatch(t, result, wantResult, "Mismatch")
 }
 
+func TestSelectSQLCalcFoundRows(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+
+	limitResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+	countResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), "42")
+	sbclookup.SetResults([]*sqltypes.Result{limitResult, countResult})
+	result, err := executor.Execute(context.Background(), "TestExecute", session, "select sql_calc_found_rows id from main1 limit 2", nil)
+	require.NoError(t, err)
+	utils.MustMatch(t, limitResult, result, "")
+	bindVars := map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(2)}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from main1 limit :vtg1",
+		BindVariables: bindVars,
+	}, {
+		Sql:           "select count(*) from main1",
+		BindVariables: bindVars,
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "")
+
+	result, err = executor.Execute(context.Background(), "TestExecute", session, "select found_rows()", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewUint64(42)}}, result.Rows)
+
+	// The next select finds the rows it returns.
+	sbclookup.SetResults([]*sqltypes.Result{limitResult})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from main1", nil)
+	require.NoError(t, err)
+	result, err = executor.Execute(context.Background(), "TestExecute", session, "select found_rows()", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewUint64(2)}}, result.Rows)
+}
+
 func TestRowCount(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	executor.normalize = true
@@ -941,7 +975,7 @@ func TestSelectScatter(t *testing.T) {
 		sbc := hc.AddTestTablet(cell, shard, 1, "TestExecutor", shard, topodatapb.TabletType_MASTER, true, 1, nil)
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 	logChan := QueryLogger.Subscribe("Test")
 	defer QueryLogger.Unsubscribe(logChan)
 
@@ -975,7 +1009,7 @@ func TestSelectScatterPartial(t *testing.T) {
 		conns = append(conns, sbc)
 	}
 
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 	logChan := QueryLogger.Subscribe("Test")
 	defer QueryLogger.Unsubscribe(logChan)
 
@@ -1032,7 +1066,7 @@ func TestStreamSelectScatter(t *testing.T) {
 	for _, shard := range shards {
 		_ = hc.AddTestTablet(cell, shard, 1, "TestExecutor", shard, topodatapb.TabletType_MASTER, true, 1, nil)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	sql := "select id from user"
 	result, err := executorStream(executor, sql)
@@ -1086,7 +1120,7 @@ func TestSelectScatterOrderBy(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select col1, col2 from user order by col2 desc"
 	gotResult, err := executorExec(executor, query, nil)
@@ -1157,7 +1191,7 @@ func TestSelectScatterOrderByVarChar(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select col1, textcol from user order by textcol desc"
 	gotResult, err := executorExec(executor, query, nil)
@@ -1223,7 +1257,7 @@ func TestStreamSelectScatterOrderBy(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select id, col from user order by col desc"
 	gotResult, err := executorStream(executor, query)
@@ -1285,7 +1319,7 @@ func TestStreamSelectScatterOrderByVarChar(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select id, textcol from user order by textcol desc"
 	gotResult, err := executorStream(executor, query)
@@ -1347,7 +1381,7 @@ func TestSelectScatterAggregate(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select col, sum(foo) from user group by col"
 	gotResult, err := executorExec(executor, query, nil)
@@ -1410,7 +1444,7 @@ func TestStreamSelectScatterAggregate(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select col, sum(foo) from user group by col"
 	gotResult, err := executorStream(executor, query)
@@ -1473,7 +1507,7 @@ func TestSelectScatterLimit(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select col1, col2 from user order by col2 desc limit 3"
 	gotResult, err := executorExec(executor, query, nil)
@@ -1545,7 +1579,7 @@ func TestStreamSelectScatterLimit(t *testing.T) {
 		}})
 		conns = append(conns, sbc)
 	}
-	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheSize)
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, testBufferSize, testCacheConfig)
 
 	query := "select col1, col2 from user order by col2 desc limit 3"
 	gotResult, err := executorStream(executor, query)
@@ -1862,6 +1896,56 @@ func TestLeftJoinStream(t *testing.T) {
 	}
 }
 
+func TestCrossShardLeftJoinFilter(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|col",
+			"int32|int32",
+		),
+		"1|3",
+	)})
+	sbc2.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int32",
+		),
+	)})
+	// The rows without a match have NULL values for the RHS,
+	// which the filter and the expression are evaluated against.
+	sql := "select u1.id, ifnull(u2.id, 0) as id2 from user u1 left join user u2 on u2.id = u1.col where u1.id = 1 and u2.id is null"
+	result, err := executorExec(executor, sql, nil)
+	require.NoError(t, err)
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewInt32(1),
+		sqltypes.NewInt64(0),
+	}}
+	if !reflect.DeepEqual(result.Rows, wantRows) {
+		t.Errorf("result.Rows: %v, want %v", result.Rows, wantRows)
+	}
+
+	// A match is discarded by the filter.
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|col",
+			"int32|int32",
+		),
+		"1|3",
+	)})
+	sbc2.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int32",
+		),
+		"3",
+	)})
+	result, err = executorExec(executor, sql, nil)
+	require.NoError(t, err)
+	if len(result.Rows) != 0 {
+		t.Errorf("result: %+v, want no rows", result)
+	}
+}
+
 func TestEmptyJoin(t *testing.T) {
 	executor, sbc1, _, _ := createExecutorEnv()
 	// Empty result requires a field query for the second part of join,
@@ -2075,6 +2159,41 @@ func TestCrossShardSubquery(t *testing.T) {
 	}
 }
 
+func TestCrossShardSubqueryExpressions(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	result1 := []*sqltypes.Result{{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int32},
+			{Name: "col", Type: sqltypes.Int32},
+		},
+		RowsAffected: 1,
+		InsertID:     0,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt32(1),
+			sqltypes.NewInt32(3),
+		}},
+	}}
+	sbc1.SetResults(result1)
+	// The expressions and the filter on the results of the join are evaluated by vtgate.
+	query := "select id1 + 1 as id2, concat(id1, '-', col) as name from (select u1.id id1, u1.col, u2.id from user u1 join user u2 on u2.id = u1.col where u1.id = 1) as t where col > 2"
+	result, err := executorExec(executor, query, nil)
+	require.NoError(t, err)
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarBinary("1-3"),
+	}}
+	if !reflect.DeepEqual(result.Rows, wantRows) {
+		t.Errorf("result.Rows: %v, want %v", result.Rows, wantRows)
+	}
+
+	sbc1.SetResults(result1)
+	result, err = executorExec(executor, strings.Replace(query, "col > 2", "col > 3", 1), nil)
+	require.NoError(t, err)
+	if len(result.Rows) != 0 {
+		t.Errorf("result.Rows: %v, want none", result.Rows)
+	}
+}
+
 func TestCrossShardSubqueryStream(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 	result1 := []*sqltypes.Result{{
@@ -2233,3 +2352,97 @@ func TestSelectWithUnionAll(t *testing.T) {
 	utils.MustMatch(t, sbc1WantQueries, sbc1.Queries, "sbc1")
 	utils.MustMatch(t, sbc2WantQueries, sbc2.Queries, "sbc2")
 }
+
+func TestSelectLock(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@master"})
+	lockResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("get_lock('a', 10)", "int64"), "1")
+
+	// The first lock function reserves a connection on the shard of keyspace id 0.
+	sbc1.SetResults([]*sqltypes.Result{lockResult})
+	result, err := executor.Execute(context.Background(), "TestSelectLock", session, "select get_lock('a', 10) from dual", nil)
+	require.NoError(t, err)
+	utils.MustMatch(t, lockResult, result, "result")
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select get_lock('a', 10) from dual",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, wantQueries, sbc1.Queries, "sbc1.Queries")
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get(), "sbc1.ReserveCount")
+	require.Len(t, session.LockSessions, 1)
+	assert.Equal(t, "-20", session.LockSessions[0].Target.Shard)
+	assert.False(t, session.InReservedConn(), "session.InReservedConn")
+
+	stats := executor.LockSessionStats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, []string{"a"}, stats[0].Locks)
+	assert.Equal(t, session.LockSessions[0].ReservedId, stats[0].ReservedID)
+
+	// The next ones use the same connection, even in a transaction.
+	_, err = executor.Execute(context.Background(), "TestSelectLock", session, "begin", nil)
+	require.NoError(t, err)
+	sbc1.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("get_lock('b', 10)|release_lock('a')", "int64|int64"), "1|1"),
+	})
+	_, err = executor.Execute(context.Background(), "TestSelectLock", session, "select get_lock('b', 10), release_lock('a') from dual", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get(), "sbc1.ReserveCount")
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get(), "sbc1.ExecCount")
+	require.Len(t, session.LockSessions, 1)
+	assert.Empty(t, session.ShardSessions, "session.ShardSessions")
+	_, err = executor.Execute(context.Background(), "TestSelectLock", session, "rollback", nil)
+	require.NoError(t, err)
+
+	stats = executor.LockSessionStats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, []string{"b"}, stats[0].Locks)
+
+	// Closing the session releases the connection, and the locks with it.
+	err = executor.CloseSession(context.Background(), session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReleaseCount.Get(), "sbc1.ReleaseCount")
+	assert.Empty(t, session.LockSessions, "session.LockSessions")
+	assert.Empty(t, executor.LockSessionStats(), "executor.LockSessionStats")
+}
+
+func TestSelectLockConnectionLost(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@master"})
+	lockResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("get_lock('a', 10)", "int64"), "1")
+
+	sbc1.SetResults([]*sqltypes.Result{lockResult})
+	_, err := executor.Execute(context.Background(), "TestSelectLockConnectionLost", session, "select get_lock('a', 10) from dual", nil)
+	require.NoError(t, err)
+	require.Len(t, session.LockSessions, 1)
+	require.Len(t, executor.LockSessionStats(), 1)
+
+	// The tablet killed the reserved connection, which released the locks.
+	sbc1.ExecuteErr = vterrors.New(vtrpcpb.Code_ABORTED, "transaction 1: ended at 2020-01-01 00:00:00.000 UTC (exceeded timeout: 30s)")
+	_, err = executor.Execute(context.Background(), "TestSelectLockConnectionLost", session, "select get_lock('b', 10) from dual", nil)
+	require.Error(t, err)
+	assert.Empty(t, session.LockSessions, "session.LockSessions")
+	assert.Empty(t, executor.LockSessionStats(), "executor.LockSessionStats")
+
+	// The next lock function reserves a new connection.
+	sbc1.SetResults([]*sqltypes.Result{lockResult})
+	_, err = executor.Execute(context.Background(), "TestSelectLockConnectionLost", session, "select get_lock('a', 10) from dual", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ReserveCount.Get(), "sbc1.ReserveCount")
+	require.Len(t, session.LockSessions, 1)
+
+	// Other errors keep the lock session.
+	sbc1.ExecuteErr = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error")
+	_, err = executor.Execute(context.Background(), "TestSelectLockConnectionLost", session, "select get_lock('b', 10) from dual", nil)
+	require.Error(t, err)
+	require.Len(t, session.LockSessions, 1)
+	require.Len(t, executor.LockSessionStats(), 1)
+}
+
+func TestSelectLockUnsupported(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@master"})
+
+	_, err := executor.Execute(context.Background(), "TestSelectLockUnsupported", session, "select get_lock('a', 10) from user", nil)
+	require.EqualError(t, err, "unsupported: lock functions in a select with a FROM clause other than dual")
+	assert.Empty(t, session.LockSessions, "session.LockSessions")
+}
@@ -19,7 +19,6 @@ package vtgate
 import (
 	"testing"
 
-	"vitess.io/vitess/go/mysql"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 
 	"vitess.io/vitess/go/test/utils"
@@ -126,6 +125,18 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set client_found_rows = 2",
 		err: "unexpected value for client_found_rows: 2",
+	}, {
+		in:  "set read_after_write_consistency = 1",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWriteConsistency: true},
+	}, {
+		in:  "set read_after_write_consistency = on",
+		out: &vtgatepb.Session{Autocommit: true, ReadAfterWriteConsistency: true},
+	}, {
+		in:  "set read_after_write_consistency = 0",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set read_after_write_consistency = 2",
+		err: "unexpected value for read_after_write_consistency: 2",
 	}, {
 		in:  "set transaction_mode = 'unspecified'",
 		out: &vtgatepb.Session{Autocommit: true, TransactionMode: vtgatepb.TransactionMode_UNSPECIFIED},
@@ -222,15 +233,6 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set net_read_timeout = 600",
 		out: &vtgatepb.Session{Autocommit: true},
-	}, {
-		in:  "set sql_quote_show_create = 1",
-		out: &vtgatepb.Session{Autocommit: true},
-	}, {
-		in:  "set foreign_key_checks = 0",
-		out: &vtgatepb.Session{Autocommit: true},
-	}, {
-		in:  "set unique_checks = 0",
-		out: &vtgatepb.Session{Autocommit: true},
 	}, {
 		in:  "set skip_query_plan_cache = 1",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{SkipQueryPlanCache: true}},
@@ -300,25 +302,40 @@ func TestExecutorSet(t *testing.T) {
 }
 
 func TestExecutorSetOp(t *testing.T) {
-	executor, sbc1, _, _ := createExecutorEnv()
+	executor, _, _, sbclookup := createExecutorEnv()
+	sysVarResult := func(value string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "varchar"), value)
+	}
+	sameValueResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "varchar"))
 
 	testcases := []struct {
-		in      string
-		warning []*querypb.QueryWarning
-		sysVars map[string]string
+		in           string
+		result       *sqltypes.Result
+		sysVars      map[string]string
+		reservedConn bool
 	}{{
-		in: "set sql_mode = 'STRICT_ALL_TABLES'",
-		warning: []*querypb.QueryWarning{{
-			Code:    mysql.ERNotSupportedYet,
-			Message: "Ignored inapplicable SET sql_mode = 'STRICT_ALL_TABLES'",
-		}},
-	}, {
-		in:      "set sql_safe_updates = 2",
-		sysVars: map[string]string{"sql_safe_updates": "2"},
+		in:           "set sql_mode = 'STRICT_ALL_TABLES'",
+		result:       sysVarResult("STRICT_ALL_TABLES"),
+		sysVars:      map[string]string{"sql_mode": "'STRICT_ALL_TABLES'"},
+		reservedConn: true,
+	}, {
+		in:           "set sql_safe_updates = 1",
+		result:       sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "int64"), "1"),
+		sysVars:      map[string]string{"sql_safe_updates": "1"},
+		reservedConn: true,
+	}, {
+		in:     "set sql_quote_show_create = 1",
+		result: sameValueResult,
+	}, {
+		in:     "set foreign_key_checks = 0",
+		result: sameValueResult,
+	}, {
+		in:     "set unique_checks = 0",
+		result: sameValueResult,
 	}}
 	for _, tcase := range testcases {
 		t.Run(tcase.in, func(t *testing.T) {
-			sbc1.SetResults([]*sqltypes.Result{{}})
+			sbclookup.SetResults([]*sqltypes.Result{tcase.result})
 			session := NewAutocommitSession(masterSession)
 			session.TargetString = KsTestUnsharded
 			_, err := executor.Execute(
@@ -328,12 +345,88 @@ func TestExecutorSetOp(t *testing.T) {
 				tcase.in,
 				nil)
 			require.NoError(t, err)
-			utils.MustMatch(t, tcase.warning, session.Warnings, "")
+			assert.Empty(t, session.Warnings)
 			utils.MustMatch(t, tcase.sysVars, session.SystemVariables, "")
+			assert.Equal(t, tcase.reservedConn, session.InReservedConn())
 		})
 	}
 }
 
+func TestExecutorSysVarOnReservedConn(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+
+	// The value is only kept in the session, until a connection is reserved.
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "varchar"), "STRICT_ALL_TABLES")})
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = 'STRICT_ALL_TABLES'", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbclookup.ReserveCount.Get())
+
+	sbclookup.Queries = nil
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from main1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ReserveCount.Get())
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "set @@sql_mode = 'STRICT_ALL_TABLES'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from main1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "")
+	require.Len(t, session.ShardSessions, 1)
+	assert.NotZero(t, session.ShardSessions[0].ReservedId)
+
+	// A value that is set afterwards is applied to the reserved connection.
+	sbclookup.Queries = nil
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "int64"), "1")})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_safe_updates = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ReserveCount.Get())
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "select 1 from dual where @@sql_safe_updates != 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "set @@sql_safe_updates = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "")
+	assert.Equal(t, []string{"set @@sql_mode = 'STRICT_ALL_TABLES', @@sql_safe_updates = 1"}, session.SetPreQueries())
+
+	// A value that is set back to the one of the tablet is removed from the session.
+	sbclookup.Queries = nil
+	sbclookup.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "int64"), "0"),
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"),
+	})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_safe_updates = 0", nil)
+	require.NoError(t, err)
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "select 0 from dual where @@sql_safe_updates != 0",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select 1 from dual where @@sql_safe_updates = 0",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "set @@sql_safe_updates = 0",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	utils.MustMatch(t, wantQueries, sbclookup.Queries, "")
+	assert.Equal(t, []string{"set @@sql_mode = 'STRICT_ALL_TABLES'"}, session.SetPreQueries())
+	assert.True(t, session.InReservedConn())
+
+	// Once the session sets no values anymore, its reserved connections are released.
+	sbclookup.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("expr", "varchar"), ""),
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"),
+	})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set sql_mode = ''", nil)
+	require.NoError(t, err)
+	assert.False(t, session.InReservedConn())
+	assert.Empty(t, session.ShardSessions)
+	assert.EqualValues(t, 1, sbclookup.ReleaseCount.Get())
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -35,6 +35,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
+	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
@@ -394,6 +395,27 @@ func TestExecutorShowColumns(t *testing.T) {
 
 }
 
+func TestExecutorPrepareShow(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+
+	sbc1.SetResults([]*sqltypes.Result{{
+		Fields: []*querypb.Field{{Name: "Tables_in_vt_TestExecutor", Type: sqltypes.VarChar}},
+	}})
+	fields, err := executorPrepare(executor, "show tables from TestExecutor", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*querypb.Field{{Name: "Tables_in_TestExecutor", Type: sqltypes.VarChar}}, fields)
+	assert.Equal(t, []*querypb.BoundQuery{{
+		Sql:           "show tables",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+
+	// The statements that aren't planned are still answered by vtgate.
+	fields, err = executorPrepare(executor, "show databases", nil)
+	require.NoError(t, err)
+	assert.Equal(t, buildVarCharFields("Databases"), fields)
+}
+
 func TestExecutorShow(t *testing.T) {
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
@@ -460,7 +482,14 @@ func TestExecutorShow(t *testing.T) {
 		}
 	}
 
-	wantqr := showResults
+	// The column is named after the keyspace, not the MySQL database.
+	wantqr := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Tables_in_TestUnsharded", Type: sqltypes.VarChar},
+		},
+		RowsAffected: 1,
+		Rows:         showResults.Rows,
+	}
 	if !reflect.DeepEqual(qr, wantqr) {
 		t.Errorf("%v:\n%+v, want\n%+v", query, qr, wantqr)
 	}
@@ -1200,6 +1229,64 @@ func TestExecutorDDL(t *testing.T) {
 	}
 }
 
+func TestExecutorTemporaryTable(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestUnsharded@master", Autocommit: true})
+
+	// The DDL reserves a connection, which the session keeps for the temporary table.
+	_, err := executor.Execute(ctx, "TestExecute", session, "create temporary table temp(id bigint)", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ReserveCount.Get(), "sbclookup.ReserveCount")
+	assert.Equal(t, []string{"temp"}, session.TemporaryTables, "session.TemporaryTables")
+	assert.False(t, session.InReservedConn(), "session.InReservedConn")
+	require.Len(t, session.ShardSessions, 1)
+	assert.NotZero(t, session.ShardSessions[0].ReservedId, "ReservedId")
+
+	// The queries that use the temporary table run on the same connection,
+	// so they can't be autocommitted.
+	_, err = executor.Execute(ctx, "TestExecute", session, "insert into temp(id) values (1)", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from temp", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ReserveCount.Get(), "sbclookup.ReserveCount")
+	assert.EqualValues(t, 1, sbclookup.CommitCount.Get(), "sbclookup.CommitCount")
+	require.Len(t, session.ShardSessions, 1)
+	assert.NotZero(t, session.ShardSessions[0].ReservedId, "ReservedId")
+	assert.Zero(t, session.ShardSessions[0].TransactionId, "TransactionId")
+
+	// The other queries don't, so they're autocommitted.
+	_, err = executor.Execute(ctx, "TestExecute", session, "insert into main1(id) values (1)", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.CommitCount.Get(), "sbclookup.CommitCount")
+
+	// Dropping the last temporary table releases the connection.
+	_, err = executor.Execute(ctx, "TestExecute", session, "drop temporary table temp", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ReleaseCount.Get(), "sbclookup.ReleaseCount")
+	assert.Empty(t, session.TemporaryTables, "session.TemporaryTables")
+	assert.Empty(t, session.ShardSessions, "session.ShardSessions")
+
+	// Closing the session releases the connection too.
+	_, err = executor.Execute(ctx, "TestExecute", session, "create temporary table temp(id bigint)", nil)
+	require.NoError(t, err)
+	err = executor.CloseSession(ctx, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbclookup.ReleaseCount.Get(), "sbclookup.ReleaseCount")
+	assert.Empty(t, session.TemporaryTables, "session.TemporaryTables")
+
+	// A temporary table in a sharded keyspace needs a targeted shard.
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor@master", Autocommit: true})
+	_, err = executor.Execute(ctx, "TestExecute", session, "create temporary table temp(id bigint)", nil)
+	require.EqualError(t, err, "unsupported: temporary tables in a sharded keyspace without a targeted shard")
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor:-20@master", Autocommit: true})
+	_, err = executor.Execute(ctx, "TestExecute", session, "create temporary table temp(id bigint)", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from temp", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get(), "sbc1.ReserveCount")
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get(), "sbc1.ExecCount")
+}
+
 func TestExecutorAlterVSchemaKeyspace(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -1838,6 +1925,106 @@ func TestDebugVSchema(t *testing.T) {
 	}
 }
 
+func TestDebugQueryPlans(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.plans = cache.NewLFUCache(1024 * 1024)
+
+	sql := "select id from user where id = 1"
+	for i := 0; i < 2; i++ {
+		_, err := executorExec(executor, sql, nil)
+		require.NoError(t, err)
+	}
+	// The LFU cache is sized by the memory usage of the plans.
+	assert.EqualValues(t, 1, executor.plans.Length())
+	assert.Greater(t, executor.plans.Size(), int64(len(sql)))
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/debug/query_plans", nil)
+	executor.ServeHTTP(resp, req)
+	var items []struct {
+		Key   string
+		Value struct {
+			Original  string
+			CacheHits uint64
+			ExecCount uint64
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &items), resp.Body.String())
+	require.Len(t, items, 1)
+	assert.Equal(t, "@master:"+sql, items[0].Key)
+	assert.Equal(t, sql, items[0].Value.Original)
+	assert.EqualValues(t, 1, items[0].Value.CacheHits)
+	assert.EqualValues(t, 2, items[0].Value.ExecCount)
+}
+
+func TestExecutePrepared(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(masterSession)
+	ps := NewPreparedStatement("select id from user where id = :v1 and name = 'foo'")
+	normalized := "select id from user where id = :v1 and name = :vtg1"
+	wantBindVars := map[string]*querypb.BindVariable{
+		"v1":   sqltypes.Int64BindVariable(1),
+		"vtg1": sqltypes.StringBindVariable("foo"),
+	}
+
+	_, err := executor.ExecutePrepared(context.Background(), "TestExecute", session, ps, map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(1),
+	})
+	require.NoError(t, err)
+	plan := ps.plan
+	require.NotNil(t, plan)
+	assert.Equal(t, normalized, plan.Original)
+	assert.Equal(t, []*querypb.BoundQuery{{Sql: normalized, BindVariables: wantBindVars}}, sbc1.Queries)
+
+	// The next executions use the bound plan, and don't look it up in the cache.
+	sbc1.Queries = nil
+	_, err = executor.ExecutePrepared(context.Background(), "TestExecute", session, ps, map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(1),
+	})
+	require.NoError(t, err)
+	assert.True(t, plan == ps.plan)
+	assert.EqualValues(t, 0, plan.CacheHits)
+	assert.EqualValues(t, 2, plan.ExecCount)
+	assert.Equal(t, []*querypb.BoundQuery{{Sql: normalized, BindVariables: wantBindVars}}, sbc1.Queries)
+
+	// A new VSchema invalidates the bound plan.
+	vschema := *executor.VSchema()
+	executor.SaveVSchema(&vschema, nil)
+	_, err = executor.ExecutePrepared(context.Background(), "TestExecute", session, ps, map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(1),
+	})
+	require.NoError(t, err)
+	assert.False(t, plan == ps.plan)
+	assert.True(t, &vschema == ps.vschema)
+
+	// So does a different target.
+	plan = ps.plan
+	_, err = executor.ExecutePrepared(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"}), ps, map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(1),
+	})
+	require.NoError(t, err)
+	assert.False(t, plan == ps.plan)
+	assert.Equal(t, "TestExecutor@master", ps.prefixKey)
+}
+
+func TestExecutePreparedSkipQueryPlanCache(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(masterSession)
+	ps := NewPreparedStatement("select /*vt+ SKIP_QUERY_PLAN_CACHE=1 */ id from user where id = :v1")
+
+	for i := 0; i < 2; i++ {
+		_, err := executor.ExecutePrepared(context.Background(), "TestExecute", session, ps, map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(1),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, ps.plan)
+	}
+	assert.Len(t, sbc1.Queries, 2)
+	assert.Zero(t, executor.plans.Length())
+}
+
 func TestGenerateCharsetRows(t *testing.T) {
 	rows := make([][]sqltypes.Value, 0, 4)
 	rows0 := [][]sqltypes.Value{
@@ -2066,6 +2253,14 @@ func TestExecutorExplain(t *testing.T) {
 			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")]]`,
 		expected,
 		fmt.Sprintf("%v", result.Rows), fmt.Sprintf("%v", result.Rows))
+
+	result, err = executorExec(executor, "explain format = vitess select /*vt+ ALLOW_HASH_JOIN */ u.id from user u join user u2 on u.textcol = u2.textcol", bindVars)
+	require.NoError(t, err)
+	require.Equal(t,
+		`[[VARCHAR("HashJoin") VARCHAR("Join") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")] `+
+			`[VARCHAR("├─ Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select /*vt+ ALLOW_HASH_JOIN */ u.id, u.textcol, weight_string(u.textcol) from user as u")] `+
+			`[VARCHAR("└─ Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select /*vt+ ALLOW_HASH_JOIN */ u2.textcol, weight_string(u2.textcol) from user as u2")]]`,
+		fmt.Sprintf("%v", result.Rows))
 }
 
 func TestExecutorOtherAdmin(t *testing.T) {
@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -57,6 +58,11 @@ type Gateway interface {
 	// - any other error: log.Fatalf out.
 	WaitForTablets(ctx context.Context, tabletTypesToWait []topodatapb.TabletType) error
 
+	// WaitForTargets waits until all the targets have a healthy
+	// serving tablet. It returns ctx.Err() if the context is
+	// canceled before.
+	WaitForTargets(ctx context.Context, targets []*querypb.Target) error
+
 	// RegisterStats registers exported stats for the gateway
 	RegisterStats()
 
@@ -68,6 +74,10 @@ type Gateway interface {
 
 	// TabletByAlias returns a QueryService
 	QueryServiceByAlias(alias *topodatapb.TabletAlias) (queryservice.QueryService, error)
+
+	// TableStatistics returns the table statistics published by a
+	// healthy tablet of the target, or nil if there are none.
+	TableStatistics(target *querypb.Target) []*querypb.TableStatistics
 }
 
 // Creator is the factory method which can create the actual gateway object.
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// LockSessionStats describes a lock session, which is the connection
+// reserved by a session for the named locks of a keyspace, and the
+// named locks that are held on it.
+// It is used to display a table with the information in the status page.
+type LockSessionStats struct {
+	Keyspace    string
+	Shard       string
+	TabletAlias string
+	ReservedID  int64
+	Username    string
+	Since       time.Time
+	Locks       []string
+
+	// counts has the number of times every lock is held,
+	// since a session can acquire the same lock more than once.
+	counts map[string]int
+}
+
+// lockSessions keeps track of the named locks that are held on the
+// lock sessions. Only the lock sessions that hold named locks are kept.
+type lockSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*LockSessionStats
+}
+
+func newLockSessions() *lockSessions {
+	return &lockSessions{
+		sessions: make(map[string]*LockSessionStats),
+	}
+}
+
+func lockSessionKey(lockSession *vtgatepb.Session_ShardSession) string {
+	return fmt.Sprintf("%s/%d", topoproto.TabletAliasString(lockSession.TabletAlias), lockSession.ReservedId)
+}
+
+// record records a change to the named locks held on the lock session.
+func (ls *lockSessions) record(lockSession *vtgatepb.Session_ShardSession, username string, action engine.LockAction, name string) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	key := lockSessionKey(lockSession)
+	stats, ok := ls.sessions[key]
+	switch action {
+	case engine.LockAcquire:
+		if !ok {
+			stats = &LockSessionStats{
+				Keyspace:    lockSession.Target.Keyspace,
+				Shard:       lockSession.Target.Shard,
+				TabletAlias: topoproto.TabletAliasString(lockSession.TabletAlias),
+				ReservedID:  lockSession.ReservedId,
+				Username:    username,
+				Since:       time.Now(),
+				counts:      make(map[string]int),
+			}
+			ls.sessions[key] = stats
+		}
+		stats.counts[name]++
+	case engine.LockRelease:
+		if !ok {
+			return
+		}
+		if stats.counts[name] > 1 {
+			stats.counts[name]--
+		} else {
+			delete(stats.counts, name)
+		}
+		if len(stats.counts) == 0 {
+			delete(ls.sessions, key)
+		}
+	case engine.LockReleaseAll:
+		delete(ls.sessions, key)
+	}
+}
+
+// remove forgets the lock sessions, whose connections are released.
+func (ls *lockSessions) remove(lockSessions []*vtgatepb.Session_ShardSession) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	for _, lockSession := range lockSessions {
+		delete(ls.sessions, lockSessionKey(lockSession))
+	}
+}
+
+// Stats returns the lock sessions that hold named locks,
+// ordered by the time they acquired their first lock.
+func (ls *lockSessions) Stats() []*LockSessionStats {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	stats := make([]*LockSessionStats, 0, len(ls.sessions))
+	for _, s := range ls.sessions {
+		copied := *s
+		copied.Locks = make([]string, 0, len(s.counts))
+		for name, count := range s.counts {
+			if count > 1 {
+				name = fmt.Sprintf("%s (%d times)", name, count)
+			}
+			copied.Locks = append(copied.Locks, name)
+		}
+		sort.Strings(copied.Locks)
+		copied.counts = nil
+		stats = append(stats, &copied)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if !stats[i].Since.Equal(stats[j].Since) {
+			return stats[i].Since.Before(stats[j].Since)
+		}
+		return stats[i].ReservedID < stats[j].ReservedID
+	})
+	return stats
+}
+
+const (
+	// LockSessionsTemplate is the HTML template to display LockSessionStats.
+	LockSessionsTemplate = `
+<style>
+  table {
+    border-collapse: collapse;
+  }
+  td, th {
+    border: 1px solid #999;
+    padding: 0.2rem;
+  }
+</style>
+<table>
+  <tr>
+    <th colspan="7">Sessions Holding Named Locks</th>
+  </tr>
+  <tr>
+    <th>Keyspace</th>
+    <th>Shard</th>
+    <th>Tablet</th>
+    <th>Reserved Connection</th>
+    <th>User</th>
+    <th>Since</th>
+    <th>Locks</th>
+  </tr>
+{{range $i, $s := .}}  <tr>
+    <td>{{$s.Keyspace}}</td>
+    <td>{{$s.Shard}}</td>
+    <td>{{$s.TabletAlias}}</td>
+    <td>{{$s.ReservedID}}</td>
+    <td>{{$s.Username}}</td>
+    <td>{{$s.Since.Format "2006-01-02 15:04:05"}}</td>
+    <td>{{range $j, $lock := $s.Locks}}{{if $j}}, {{end}}{{$lock}}{{end}}</td>
+  </tr>{{end}}
+</table>
+`
+)
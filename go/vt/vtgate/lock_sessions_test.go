@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestLockSessions(t *testing.T) {
+	ls := newLockSessions()
+	sess1 := &vtgatepb.Session_ShardSession{
+		Target:      &querypb.Target{Keyspace: "ks", Shard: "-80"},
+		TabletAlias: &topodatapb.TabletAlias{Cell: "aa", Uid: 1},
+		ReservedId:  1,
+	}
+	sess2 := &vtgatepb.Session_ShardSession{
+		Target:      &querypb.Target{Keyspace: "ks", Shard: "-80"},
+		TabletAlias: &topodatapb.TabletAlias{Cell: "aa", Uid: 1},
+		ReservedId:  2,
+	}
+
+	ls.record(sess1, "user1", engine.LockAcquire, "a")
+	ls.record(sess1, "user1", engine.LockAcquire, "b")
+	ls.record(sess1, "user1", engine.LockAcquire, "b")
+	ls.record(sess2, "user2", engine.LockAcquire, "c")
+	stats := ls.Stats()
+	require.Len(t, stats, 2)
+	assert.Equal(t, "aa-0000000001", stats[0].TabletAlias)
+	assert.Equal(t, int64(1), stats[0].ReservedID)
+	assert.Equal(t, "user1", stats[0].Username)
+	assert.Equal(t, []string{"a", "b (2 times)"}, stats[0].Locks)
+	assert.Equal(t, int64(2), stats[1].ReservedID)
+	assert.Equal(t, []string{"c"}, stats[1].Locks)
+
+	// A lock that is acquired twice has to be released twice.
+	ls.record(sess1, "user1", engine.LockRelease, "b")
+	ls.record(sess1, "user1", engine.LockRelease, "a")
+	stats = ls.Stats()
+	require.Len(t, stats, 2)
+	assert.Equal(t, []string{"b"}, stats[0].Locks)
+
+	// A session that doesn't hold locks anymore isn't shown.
+	ls.record(sess1, "user1", engine.LockReleaseAll, "")
+	stats = ls.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, int64(2), stats[0].ReservedID)
+
+	ls.remove([]*vtgatepb.Session_ShardSession{sess2})
+	assert.Empty(t, ls.Stats())
+}
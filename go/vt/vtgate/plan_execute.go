@@ -31,7 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 )
 
-func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql string, ps *PreparedStatement, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
 	// 1: Prepare before planning and execution
 
 	// Start an implicit transaction if necessary.
@@ -50,15 +50,28 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		return 0, nil, err
 	}
 
-	// 2: Create a plan for the query
-	plan, err := e.getPlan(
-		vcursor,
-		query,
-		comments,
-		bindVars,
-		skipQueryPlanCache(safeSession),
-		logStats,
-	)
+	// 2: Create a plan for the query, or reuse the one bound to the prepared statement
+	var plan *engine.Plan
+	if ps != nil {
+		plan, err = e.getPreparedPlan(
+			vcursor,
+			ps,
+			query,
+			comments,
+			bindVars,
+			skipQueryPlanCache(safeSession),
+			logStats,
+		)
+	} else {
+		plan, err = e.getPlan(
+			vcursor,
+			query,
+			comments,
+			bindVars,
+			skipQueryPlanCache(safeSession),
+			logStats,
+		)
+	}
 	if err == planbuilder.ErrPlanNotSupported {
 		return 0, nil, err
 	}
@@ -106,6 +119,11 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 	}
 
 	// 3: Prepare for execution
+	// The queries that use the temporary tables of the session run on the
+	// reserved connections that the temporary tables are on.
+	safeSession.SetTemporaryTableQuery(usesTemporaryTables(safeSession, query))
+	defer safeSession.SetTemporaryTableQuery(false)
+
 	err = e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession)
 	if err != nil {
 		logStats.Error = err
@@ -149,7 +167,9 @@ func (e *Executor) insideTransaction(ctx context.Context, safeSession *SafeSessi
 	// do is likely not final.
 	// The control flow is such that autocommitable can only be turned on
 	// at the beginning, but never after.
-	safeSession.SetAutocommittable(mustCommit)
+	// A session on reserved connections can't autocommit, because the
+	// query has to run on the reserved connection of the shard.
+	safeSession.SetAutocommittable(mustCommit && !safeSession.InReservedConn())
 
 	// Execute!
 	stmtType, result, err := f(logStats, safeSession)
@@ -167,6 +187,25 @@ func (e *Executor) insideTransaction(ctx context.Context, safeSession *SafeSessi
 	return stmtType, result, nil
 }
 
+// usesTemporaryTables returns true if the query uses a temporary table of the session.
+func usesTemporaryTables(safeSession *SafeSession, query string) bool {
+	if !safeSession.HasTemporaryTables() {
+		return false
+	}
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return false
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if table, ok := node.(sqlparser.TableName); ok && safeSession.IsTemporaryTable(table.Name.String()) {
+			found = true
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
 type currFunc func(*LogStats, *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error)
 
 func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
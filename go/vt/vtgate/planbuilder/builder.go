@@ -131,6 +131,23 @@ type ContextVSchema interface {
 	TabletType() topodatapb.TabletType
 	TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error)
 	AnyKeyspace() (*vindexes.Keyspace, error)
+
+	// TableStatistics returns the statistics of a table,
+	// or nil if they're not known.
+	TableStatistics(keyspace, tableName string) *TableStatistics
+}
+
+// TableStatistics are the coarse statistics of a table, as published
+// by the tablets of its keyspace. The row count and the cardinalities
+// are the averages over the shards.
+type TableStatistics struct {
+	// Shards is the number of shards of the keyspace.
+	Shards int
+	// RowCount is the estimated number of rows per shard.
+	RowCount uint64
+	// ColumnCardinality is the estimated number of distinct values
+	// per shard of the columns that lead an index.
+	ColumnCardinality map[string]uint64
 }
 
 //-------------------------------------------------------------------------
@@ -215,9 +232,8 @@ func (rsb *resultsBuilder) ResultColumns() []*resultColumn {
 	return rsb.resultColumns
 }
 
-// SupplyCol is currently unreachable because the builders using resultsBuilder
-// are currently above a join, which is the only builder that uses it for now.
-// This can change if we start supporting correlated subqueries.
+// SupplyCol can be called by a semiJoin that is above the builders
+// using resultsBuilder, when it needs the key columns of the outer query.
 func (rsb *resultsBuilder) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
 	c := col.Metadata.(*column)
 	for i, rc := range rsb.resultColumns {
@@ -333,8 +349,10 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 	case *sqlparser.Set:
 		return buildSetPlan(stmt, vschema)
 	case *sqlparser.DBDDL:
-		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Database DDL %v", sqlparser.String(stmt))
-	case *sqlparser.Show, *sqlparser.SetTransaction:
+		return buildDBDDLPlan(stmt, vschema)
+	case *sqlparser.Show:
+		return buildShowPlan(stmt, vschema)
+	case *sqlparser.SetTransaction:
 		return nil, ErrPlanNotSupported
 	case *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback, *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
 		// Empty by design. Not executed by a plan
@@ -26,6 +26,10 @@ import (
 type concatenate struct {
 	lhs, rhs builder
 	order    int
+
+	// orderBy is set by distinct if the rows of lhs
+	// and rhs are sorted, so that they can be merged.
+	orderBy []engine.OrderbyParams
 }
 
 var _ builder = (*concatenate)(nil)
@@ -74,8 +78,22 @@ func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNu
 	panic("implement me")
 }
 
+// SupplyWeightString satisfies the builder interface.
+// The weight string is requested from both sides, which
+// must return it at the same position.
 func (c *concatenate) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
-	panic("implement me")
+	lhsCol, err := c.lhs.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	rhsCol, err := c.rhs.SupplyWeightString(colNumber)
+	if err != nil {
+		return 0, err
+	}
+	if lhsCol != rhsCol {
+		return 0, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "unsupported: weight_string of column %d is at different positions in the UNION", colNumber)
+	}
+	return lhsCol, nil
 }
 
 func (c *concatenate) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
@@ -107,6 +125,7 @@ func (c *concatenate) Primitive() engine.Primitive {
 
 	return &engine.Concatenate{
 		Sources: []engine.Primitive{lhs, rhs},
+		OrderBy: c.orderBy,
 	}
 }
 
@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// This file has functions to analyze the WITH clause.
+
+// inlineCTEs replaces every reference to the common table expressions
+// of the WITH clause of stmt with an equivalent derived table, and removes
+// the WITH clause. Non-recursive CTEs are semantically equivalent to derived
+// tables. This allows the planner to merge them with the rest of the query
+// when everything goes to a single route, and to treat them as subqueries
+// otherwise.
+func inlineCTEs(stmt sqlparser.SelectStatement, with *sqlparser.With) error {
+	ctes := make(map[string]*sqlparser.CommonTableExpr, len(with.CTEs))
+	for _, cte := range with.CTEs {
+		name := cte.Name.String()
+		if _, ok := ctes[name]; ok {
+			return &mysql.SQLError{
+				Num:     mysql.ERNonUniqTable,
+				State:   "42000",
+				Message: fmt.Sprintf("Not unique table/alias: '%s'", name),
+			}
+		}
+		// A CTE can reference the ones that precede it.
+		if err := replaceCTERefs(cte.Subquery, ctes); err != nil {
+			return err
+		}
+		ctes[name] = cte
+	}
+	return replaceCTERefs(stmt, ctes)
+}
+
+// replaceCTERefs replaces the references to the specified CTEs found in node.
+// References in nested statements that redefine a CTE of the same name are
+// left alone.
+func replaceCTERefs(node sqlparser.SQLNode, ctes map[string]*sqlparser.CommonTableExpr) error {
+	var err error
+	sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			return replaceInScope(node, node.With, ctes, &err)
+		case *sqlparser.Union:
+			return replaceInScope(node, node.With, ctes, &err)
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true
+			}
+			cte, ok := ctes[tableName.Name.String()]
+			if !ok {
+				return true
+			}
+			var subquery *sqlparser.Subquery
+			subquery, err = derivedTableFor(cte)
+			if err != nil {
+				return false
+			}
+			node.Expr = subquery
+			if node.As.IsEmpty() {
+				node.As = tableName.Name
+			}
+			return false
+		}
+		return true
+	}, nil)
+	return err
+}
+
+// replaceInScope handles a statement that's nested in the scope of
+// the CTEs. If the statement redefines some of them, it's processed
+// separately with the CTEs that remain visible, and false is returned
+// to prevent the caller from descending into it.
+func replaceInScope(node sqlparser.SQLNode, with *sqlparser.With, ctes map[string]*sqlparser.CommonTableExpr, errp *error) bool {
+	if with == nil {
+		return true
+	}
+	visible := make(map[string]*sqlparser.CommonTableExpr, len(ctes))
+	for name, cte := range ctes {
+		visible[name] = cte
+	}
+	for _, cte := range with.CTEs {
+		delete(visible, cte.Name.String())
+	}
+	if len(visible) == len(ctes) {
+		return true
+	}
+	if len(visible) != 0 {
+		*errp = replaceCTERefs(node, visible)
+	}
+	return false
+}
+
+// derivedTableFor returns a new subquery that can replace a reference
+// to the CTE. A fresh copy of the CTE definition is produced for every
+// reference because the planner annotates the AST while analyzing it.
+// If the CTE has a column list, it's applied as aliases to the select
+// expressions.
+func derivedTableFor(cte *sqlparser.CommonTableExpr) (*sqlparser.Subquery, error) {
+	sel := sqlparser.CloneSQLNode(cte.Subquery.Select).(sqlparser.SelectStatement)
+	if len(cte.Columns) == 0 {
+		return &sqlparser.Subquery{Select: sel}, nil
+	}
+
+	firstSelect, err := firstSelectOf(sel)
+	if err != nil {
+		return nil, err
+	}
+	if len(firstSelect.SelectExprs) != len(cte.Columns) {
+		return nil, fmt.Errorf("in definition of common table expression %s, SELECT list and column names list have different column counts", cte.Name.String())
+	}
+	for i, expr := range firstSelect.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: '*' expression in common table expression with a column list: %s", cte.Name.String())
+		}
+		aliased.As = cte.Columns[i]
+	}
+	return &sqlparser.Subquery{Select: sel}, nil
+}
+
+// firstSelectOf returns the select that determines
+// the column names of the statement.
+func firstSelectOf(stmt sqlparser.SelectStatement) (*sqlparser.Select, error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return stmt, nil
+	case *sqlparser.Union:
+		return firstSelectOf(stmt.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return firstSelectOf(stmt.Select)
+	}
+	return nil, fmt.Errorf("BUG: unexpected SELECT type: %T", stmt)
+}
@@ -2,8 +2,11 @@ package planbuilder
 
 import (
 	"vitess.io/vitess/go/vt/key"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 func buildDDLPlan(sql string, in sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
@@ -14,6 +17,10 @@ func buildDDLPlan(sql string, in sqlparser.Statement, vschema ContextVSchema) (e
 		return nil, err
 	}
 
+	if stmt.Temporary {
+		return buildTemporaryDDLPlan(sql, stmt, destination, keyspace)
+	}
+
 	if destination == nil {
 		destination = key.DestinationAllShards{}
 	}
@@ -27,6 +34,38 @@ func buildDDLPlan(sql string, in sqlparser.Statement, vschema ContextVSchema) (e
 	}, nil
 }
 
+// buildTemporaryDDLPlan builds the plan of a DDL on temporary tables.
+// A temporary table only exists on the connection that created it, so
+// the DDL runs on a reserved connection, and the session keeps track of
+// its temporary tables to run the later queries that use them there.
+// Those queries reach the same connection as long as they go to the same
+// shard, which is why the keyspace has to be unsharded, or the shard has
+// to be targeted.
+func buildTemporaryDDLPlan(sql string, stmt *sqlparser.DDL, destination key.Destination, keyspace *vindexes.Keyspace) (engine.Primitive, error) {
+	if destination == nil {
+		if keyspace.Sharded {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: temporary tables in a sharded keyspace without a targeted shard")
+		}
+		destination = key.DestinationAllShards{}
+	}
+	tables := []string{stmt.Table.Name.String()}
+	if stmt.Action == sqlparser.DropStr {
+		tables = nil
+		for _, table := range stmt.FromTables {
+			tables = append(tables, table.Name.String())
+		}
+	}
+	return &engine.Send{
+		Keyspace:             keyspace,
+		TargetDestination:    destination,
+		Query:                sql,
+		IsDML:                false,
+		SingleShardOnly:      true,
+		TemporaryTables:      tables,
+		DropsTemporaryTables: stmt.Action == sqlparser.DropStr,
+	}, nil
+}
+
 func buildVSchemaDDLPlan(stmt *sqlparser.DDL, vschema ContextVSchema) (engine.Primitive, error) {
 	_, keyspace, _, err := vschema.TargetDestination(stmt.Table.Qualifier.String())
 	if err != nil {
@@ -37,3 +76,11 @@ func buildVSchemaDDLPlan(stmt *sqlparser.DDL, vschema ContextVSchema) (engine.Pr
 		DDL:      stmt,
 	}, nil
 }
+
+func buildDBDDLPlan(stmt *sqlparser.DBDDL, vschema ContextVSchema) (engine.Primitive, error) {
+	switch stmt.Action {
+	case sqlparser.CreateStr, sqlparser.DropStr:
+		return &engine.DBDDL{DDL: stmt}, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Database DDL %v", sqlparser.String(stmt))
+}
@@ -26,7 +26,7 @@ import (
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	del := stmt.(*sqlparser.Delete)
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
+	dml, ksidVindex, ksidCols, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +38,13 @@ func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		return edel, nil
 	}
 
+	if dml.Opcode == engine.MultiTable {
+		if len(edel.Table.Owned) > 0 {
+			edel.OwnedVindexQuery = generateDMLSubquery(multiTableWhere(edel.Table), nil, nil, edel.Table, ksidCols)
+		}
+		return edel, nil
+	}
+
 	if len(del.Targets) > 1 {
 		return nil, vterrors.New(vtrpc.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement in sharded keyspace")
 	}
@@ -47,8 +54,9 @@ func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 	}
 
 	if len(edel.Table.Owned) > 0 {
-		edel.OwnedVindexQuery = generateDMLSubquery(del.Where, del.OrderBy, del.Limit, edel.Table, ksidCol)
+		edel.OwnedVindexQuery = generateDMLSubquery(del.Where, del.OrderBy, del.Limit, edel.Table, ksidCols)
 		edel.KsidVindex = ksidVindex
+		edel.KsidLength = len(ksidCols)
 	}
 
 	return edel, nil
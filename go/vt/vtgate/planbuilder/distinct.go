@@ -0,0 +1,261 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*distinct)(nil)
+
+// distinct is the builder for engine.Distinct.
+// This gets built for a UNION that can't be
+// executed as a single route.
+type distinct struct {
+	resultsBuilder
+	eDistinct *engine.Distinct
+
+	// colCount is the number of columns of the union. Columns
+	// supplied later on, like weight strings, aren't compared.
+	colCount int
+}
+
+// newDistinct builds a new distinct.
+func newDistinct(bldr builder) *distinct {
+	eDistinct := &engine.Distinct{}
+	return &distinct{
+		resultsBuilder: newResultsBuilder(bldr, eDistinct),
+		eDistinct:      eDistinct,
+		colCount:       len(bldr.ResultColumns()),
+	}
+}
+
+// Primitive satisfies the builder interface.
+func (d *distinct) Primitive() engine.Primitive {
+	d.eDistinct.Input = d.input.Primitive()
+	return d.eDistinct
+}
+
+// PushLock satisfies the builder interface.
+func (d *distinct) PushLock(lock string) error {
+	return d.input.PushLock(lock)
+}
+
+// PushFilter satisfies the builder interface.
+func (d *distinct) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("distinct.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (d *distinct) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("distinct.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (d *distinct) MakeDistinct() error {
+	return errors.New("distinct.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (d *distinct) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("distinct.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The rows are sorted in memory after the duplicates are removed.
+func (d *distinct) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			orderBy = nil
+		}
+	}
+	if orderBy == nil {
+		return d, nil
+	}
+	return newMemorySort(d, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because the limit can only
+// be applied after the duplicates are removed.
+func (d *distinct) SetUpperLimit(count *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// Like memorySort, text columns are compared by their weight_string.
+// The type of a column is only known if it's the same in all the
+// parts of the union. Otherwise, it's also compared by weight_string.
+// If all the parts are sorted the same way by all the columns,
+// their rows are merged, and the duplicates are removed as they're
+// streamed, instead of being kept in memory.
+func (d *distinct) Wireup(bldr builder, jt *jointab) error {
+	parts := unionParts(d.input)
+	orderBy := partsOrder(parts, d.colCount)
+	for i, rc := range d.resultColumns[:d.colCount] {
+		typ, known := unionColumnType(parts, i)
+		if !known || !(sqltypes.IsNumber(typ) || sqltypes.IsText(typ)) {
+			// We don't know how mysql sorts this column.
+			orderBy = nil
+		}
+		if known && !sqltypes.IsText(typ) {
+			d.eDistinct.CheckCols = append(d.eDistinct.CheckCols, i)
+			continue
+		}
+		// If a weight string was previously requested, reuse it.
+		if weightcolNumber, ok := d.weightStrings[rc]; ok {
+			d.eDistinct.CheckCols = append(d.eDistinct.CheckCols, weightcolNumber)
+			continue
+		}
+		weightcolNumber, err := d.input.SupplyWeightString(i)
+		if err != nil {
+			return err
+		}
+		d.weightStrings[rc] = weightcolNumber
+		d.eDistinct.CheckCols = append(d.eDistinct.CheckCols, weightcolNumber)
+		d.eDistinct.TruncateColumnCount = len(d.resultColumns)
+	}
+	if orderBy != nil {
+		// The text columns are merged by their weight_string.
+		for i := range orderBy {
+			orderBy[i].Col = d.eDistinct.CheckCols[orderBy[i].Col]
+		}
+		setConcatenateOrder(d.input, orderBy)
+		d.eDistinct.Ordered = true
+	}
+	return d.input.Wireup(bldr, jt)
+}
+
+// unionParts returns the parts of the union that
+// are concatenated under bldr.
+func unionParts(bldr builder) []builder {
+	if c, ok := bldr.(*concatenate); ok {
+		return append(unionParts(c.lhs), unionParts(c.rhs)...)
+	}
+	return []builder{bldr}
+}
+
+// setConcatenateOrder makes the concatenations under bldr
+// merge their inputs, which are all sorted by orderBy.
+func setConcatenateOrder(bldr builder, orderBy []engine.OrderbyParams) {
+	if c, ok := bldr.(*concatenate); ok {
+		c.orderBy = orderBy
+		setConcatenateOrder(c.lhs, orderBy)
+		setConcatenateOrder(c.rhs, orderBy)
+	}
+}
+
+// unionColumnType returns the type of a column of the union.
+// It's not known if it's not the same in all the parts.
+func unionColumnType(parts []builder, colNumber int) (querypb.Type, bool) {
+	typ := parts[0].ResultColumns()[colNumber].column.typ
+	if typ == sqltypes.Null {
+		return typ, false
+	}
+	for _, part := range parts[1:] {
+		if part.ResultColumns()[colNumber].column.typ != typ {
+			return typ, false
+		}
+	}
+	return typ, true
+}
+
+// partsOrder returns the order of the rows of the parts of the
+// union, if they're all sorted the same way by all of the first
+// colCount columns. Otherwise, it returns nil.
+func partsOrder(parts []builder, colCount int) []engine.OrderbyParams {
+	var orderBy []engine.OrderbyParams
+	for i, part := range parts {
+		partOrder := routeOrder(part)
+		if len(partOrder) != colCount {
+			return nil
+		}
+		if i == 0 {
+			orderBy = partOrder
+			continue
+		}
+		for j, order := range partOrder {
+			if order != orderBy[j] {
+				return nil
+			}
+		}
+	}
+	// Every column must be sorted.
+	sorted := make(map[int]bool)
+	for _, order := range orderBy {
+		if order.Col >= colCount {
+			return nil
+		}
+		sorted[order.Col] = true
+	}
+	if len(sorted) != colCount {
+		return nil
+	}
+	return orderBy
+}
+
+// routeOrder returns the ORDER BY of a part of a union that's
+// a route, as the columns it sorts the rows by. It returns nil if
+// the part is not a route, or if the rows are not sorted by columns.
+func routeOrder(bldr builder) []engine.OrderbyParams {
+	if ms, ok := bldr.(*mergeSort); ok {
+		bldr = ms.input
+	}
+	rb, ok := bldr.(*route)
+	if !ok {
+		return nil
+	}
+	sel, ok := rb.innerSelect().(*sqlparser.Select)
+	if !ok {
+		return nil
+	}
+	var orderBy []engine.OrderbyParams
+	for _, order := range sel.OrderBy {
+		colNumber := -1
+		switch expr := order.Expr.(type) {
+		case *sqlparser.SQLVal:
+			var err error
+			if colNumber, err = ResultFromNumber(rb.resultColumns, expr); err != nil {
+				return nil
+			}
+		case *sqlparser.ColName:
+			c, ok := expr.Metadata.(*column)
+			if !ok {
+				return nil
+			}
+			for i, rc := range rb.resultColumns {
+				if rc.column == c {
+					colNumber = i
+					break
+				}
+			}
+		}
+		if colNumber == -1 {
+			return nil
+		}
+		orderBy = append(orderBy, engine.OrderbyParams{
+			Col:  colNumber,
+			Desc: order.Direction == sqlparser.DescScr,
+		})
+	}
+	return orderBy
+}
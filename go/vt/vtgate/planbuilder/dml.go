@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"fmt"
+
 	"vitess.io/vitess/go/sqltypes"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -28,37 +30,64 @@ import (
 
 // getDMLRouting returns the vindex and values for the DML,
 // If it cannot find a unique vindex match, it returns an error.
-func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.SingleColumn, string, vindexes.SingleColumn, []sqltypes.PlanValue, error) {
-	var ksidVindex vindexes.SingleColumn
-	var ksidCol string
+// The ksid columns are the columns of the vindex used to compute
+// the keyspace ids of the rows.
+func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.Vindex, []sqlparser.ColIdent, vindexes.Vindex, []sqltypes.PlanValue, error) {
+	var ksidVindex vindexes.Vindex
+	var ksidCols []sqlparser.ColIdent
 	for _, index := range table.Ordered {
 		if !index.Vindex.IsUnique() {
 			continue
 		}
-		single, ok := index.Vindex.(vindexes.SingleColumn)
-		if !ok {
+		_, multi := index.Vindex.(vindexes.MultiColumn)
+		if _, single := index.Vindex.(vindexes.SingleColumn); !single && !multi {
 			continue
 		}
-		if ksidCol == "" {
-			ksidCol = sqlparser.String(index.Columns[0])
-			ksidVindex = single
+		if ksidCols == nil {
+			ksidCols = index.Columns
+			if !multi {
+				ksidCols = index.Columns[:1]
+			}
+			ksidVindex = index.Vindex
 		}
 		if where == nil {
-			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+			return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
 		}
 
+		if multi {
+			// A multi-column vindex needs an equality
+			// constraint on every one of its columns.
+			if pvs, ok := getMultiColMatch(where.Expr, index.Columns); ok {
+				return engine.Equal, ksidVindex, ksidCols, index.Vindex, pvs, nil
+			}
+			continue
+		}
 		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
 			opcode := engine.Equal
 			if pv.IsList() {
 				opcode = engine.In
 			}
-			return opcode, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
+			return opcode, ksidVindex, ksidCols, index.Vindex, []sqltypes.PlanValue{pv}, nil
 		}
 	}
 	if ksidVindex == nil {
-		return engine.Scatter, nil, "", nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+		return engine.Scatter, nil, nil, nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
 	}
-	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+	return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
+}
+
+// getMultiColMatch returns the matched values of the columns
+// if there is an equality constraint on every one of them.
+func getMultiColMatch(node sqlparser.Expr, cols []sqlparser.ColIdent) ([]sqltypes.PlanValue, bool) {
+	pvs := make([]sqltypes.PlanValue, 0, len(cols))
+	for _, col := range cols {
+		pv, ok := getMatch(node, col)
+		if !ok || pv.IsList() {
+			return nil, false
+		}
+		pvs = append(pvs, pv)
+	}
+	return pvs, true
 }
 
 // getMatch returns the matched value if there is an equality
@@ -100,12 +129,15 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.Vindex, []sqlparser.ColIdent, error) {
 	eupd := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
 	ro, err := pb.processDMLTable(tableExprs)
+	if err == errMultiRouteDML {
+		return buildMultiTableDMLPlan(pb, dmlType, stmt, tableExprs, where, orderBy, limit, comments, err)
+	}
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 	eupd.Keyspace = ro.eroute.Keyspace
 	if !eupd.Keyspace.Sharded {
@@ -114,21 +146,25 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		subqueryArgs = append(subqueryArgs, nodes...)
 		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
 		if !pb.finalizeUnshardedDMLSubqueries(subqueryArgs...) {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		eupd.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		eupd.Query = generateQuery(stmt)
-		return eupd, nil, "", nil
+		return eupd, nil, nil, nil
 	}
 
-	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	if len(pb.st.tables) != 1 {
+		err := vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+		if hasSubquery(stmt) {
+			err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		}
+		return buildMultiTableDMLPlan(pb, dmlType, stmt, tableExprs, where, orderBy, limit, comments, err)
 	}
 
-	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+	if hasSubquery(stmt) {
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -143,38 +179,277 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	eupd.QueryTimeout = queryTimeout(directives)
 	eupd.Table = ro.vschemaTable
 	if eupd.Table == nil {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
 	}
 
 	if ro.eroute.TargetDestination != nil {
 		if ro.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
 		}
 		eupd.Opcode = engine.ByDestination
 		eupd.TargetDestination = ro.eroute.TargetDestination
-		return eupd, nil, "", nil
+		return eupd, nil, nil, nil
 	}
 
-	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, eupd.Table)
+	routingType, ksidVindex, ksidCols, vindex, values, err := getDMLRouting(where, eupd.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, err
 	}
 	eupd.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
 		}
 	} else {
 		eupd.Vindex = vindex
 		eupd.Values = values
 	}
 
-	return eupd, ksidVindex, ksidCol, nil
+	return eupd, ksidVindex, ksidCols, nil
 }
 
-func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
+// buildMultiTableDMLPlan builds a MultiTable plan for a DML that joins
+// tables that can't be sent to the shards as is. The primary keys of
+// the rows to change are selected by the regular select planner, and
+// the DML is then sent to their shards with a where clause on the
+// primary key. This requires the primary key of the target table to
+// be in the vschema. If it's not, unsupportedErr is returned.
+func buildMultiTableDMLPlan(pb *primitiveBuilder, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, unsupportedErr error) (*engine.DML, vindexes.Vindex, []sqlparser.ColIdent, error) {
+	var target sqlparser.TableName
+	switch stmt := stmt.(type) {
+	case *sqlparser.Delete:
+		switch len(stmt.Targets) {
+		case 0:
+			return nil, nil, nil, unsupportedErr
+		case 1:
+			target = stmt.Targets[0]
+		default:
+			return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement in sharded keyspace")
+		}
+	case *sqlparser.Update:
+		var err error
+		if target, err = pb.multiTableUpdateTarget(stmt); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	tableExpr := findAliasedTable(tableExprs, target)
+	if tableExpr == nil {
+		if dmlType == "delete" {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", target.Name.String())
+		}
+		return nil, nil, nil, unsupportedErr
+	}
+	tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, nil, nil, unsupportedErr
+	}
+	vschemaTables, _, _, _, destination, err := pb.vschema.FindTablesOrVindex(tableName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(vschemaTables) == 0 || destination != nil {
+		return nil, nil, nil, unsupportedErr
+	}
+	table := vschemaTables[0]
+	switch len(table.PrimaryKey) {
+	case 0:
+		return nil, nil, nil, unsupportedErr
+	case 1:
+	default:
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement on a table with a multi-column primary key", dmlType)
+	}
+	if len(orderBy) != 0 || limit != nil {
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement with order by or limit", dmlType)
+	}
+
+	edml := &engine.DML{
+		Opcode:   engine.MultiTable,
+		Keyspace: table.Keyspace,
+		Table:    table,
+	}
+	var ksidVindex vindexes.Vindex
+	var ksidCols []sqlparser.ColIdent
+	if table.Keyspace.Sharded {
+		if _, ksidVindex, ksidCols, _, _, err = getDMLRouting(nil, table); err != nil {
+			return nil, nil, nil, err
+		}
+		edml.KsidVindex = ksidVindex
+		edml.KsidLength = len(ksidCols)
+	}
+
+	// The select is built from a copy of the statement because
+	// the analysis of the FROM clause has annotated its columns.
 	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("select %s", ksidCol)
+	buf.Myprintf("select %v", &sqlparser.ColName{Qualifier: target, Name: table.PrimaryKey[0]})
+	for _, ksidCol := range ksidCols {
+		buf.Myprintf(", %v", &sqlparser.ColName{Qualifier: target, Name: ksidCol})
+	}
+	buf.Myprintf(" from %v%v for update", tableExprs, where)
+	sel, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if edml.Input, err = buildSelectPlan(sel, pb.vschema); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// The DML is sent to the shards with the alias of the target table
+	// because its expressions may be qualified with it.
+	alias := tableExpr.As
+	if alias.IsEmpty() && tableName.Name != table.Name {
+		alias = tableName.Name
+	}
+	targetExprs := sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+		Expr: sqlparser.TableName{Name: table.Name},
+		As:   alias,
+	}}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Delete:
+		del := &sqlparser.Delete{
+			Comments:   stmt.Comments,
+			TableExprs: targetExprs,
+			Where:      multiTableWhere(table),
+		}
+		if !alias.IsEmpty() {
+			del.Targets = sqlparser.TableNames{{Name: alias}}
+		}
+		edml.Query = generateQuery(del)
+	case *sqlparser.Update:
+		edml.Query = generateQuery(&sqlparser.Update{
+			Comments:   stmt.Comments,
+			Ignore:     stmt.Ignore,
+			TableExprs: targetExprs,
+			Exprs:      stmt.Exprs,
+			Where:      multiTableWhere(table),
+		})
+	}
+
+	directives := sqlparser.ExtractCommentDirectives(comments)
+	if directives.IsSet(sqlparser.DirectiveMultiShardAutocommit) {
+		edml.MultiShardAutocommit = true
+	}
+	edml.QueryTimeout = queryTimeout(directives)
+	return edml, ksidVindex, ksidCols, nil
+}
+
+// multiTableUpdateTarget returns the alias of the table changed by a
+// multi-table update. All the columns of the set clause, including the
+// ones referenced by the values, must belong to that table.
+func (pb *primitiveBuilder) multiTableUpdateTarget(upd *sqlparser.Update) (sqlparser.TableName, error) {
+	var target sqlparser.TableName
+	for _, expr := range upd.Exprs {
+		alias, err := pb.multiTableColumnTable(expr.Name)
+		if err != nil {
+			return sqlparser.TableName{}, err
+		}
+		if target.IsEmpty() {
+			target = alias
+			continue
+		}
+		if alias != target {
+			return sqlparser.TableName{}, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update statement that changes more than one table")
+		}
+	}
+	for _, expr := range upd.Exprs {
+		err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			switch node := node.(type) {
+			case *sqlparser.Subquery:
+				return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+			case *sqlparser.ColName:
+				alias, err := pb.multiTableColumnTable(node)
+				if err != nil {
+					return false, err
+				}
+				if alias != target {
+					return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update statement with values from another table")
+				}
+			}
+			return true, nil
+		}, expr.Expr)
+		if err != nil {
+			return sqlparser.TableName{}, err
+		}
+	}
+	return target, nil
+}
+
+// multiTableColumnTable returns the alias of the table of the column.
+// Unqualified columns are resolved using the columns known by the vschema.
+func (pb *primitiveBuilder) multiTableColumnTable(col *sqlparser.ColName) (sqlparser.TableName, error) {
+	if !col.Qualifier.IsEmpty() {
+		if _, ok := pb.st.tables[col.Qualifier]; !ok {
+			return sqlparser.TableName{}, fmt.Errorf("symbol %s not found", sqlparser.String(col))
+		}
+		return col.Qualifier, nil
+	}
+	var alias sqlparser.TableName
+	for _, t := range pb.st.AllTables() {
+		if _, ok := t.columns[col.Name.Lowered()]; !ok {
+			continue
+		}
+		if !alias.IsEmpty() {
+			return sqlparser.TableName{}, fmt.Errorf("ambiguous symbol reference: %v", sqlparser.String(col))
+		}
+		alias = t.alias
+	}
+	if alias.IsEmpty() {
+		return sqlparser.TableName{}, fmt.Errorf("symbol %s not found", sqlparser.String(col))
+	}
+	return alias, nil
+}
+
+// findAliasedTable returns the table expression of the FROM clause
+// that has the specified alias.
+func findAliasedTable(tableExprs sqlparser.TableExprs, alias sqlparser.TableName) *sqlparser.AliasedTableExpr {
+	for _, tableExpr := range tableExprs {
+		switch tableExpr := tableExpr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if !tableExpr.As.IsEmpty() {
+				if alias.Qualifier.IsEmpty() && tableExpr.As == alias.Name {
+					return tableExpr
+				}
+				continue
+			}
+			tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+			if !ok {
+				continue
+			}
+			if tableName.Name == alias.Name && (alias.Qualifier.IsEmpty() || tableName.Qualifier == alias.Qualifier) {
+				return tableExpr
+			}
+		case *sqlparser.ParenTableExpr:
+			if t := findAliasedTable(tableExpr.Exprs, alias); t != nil {
+				return t
+			}
+		case *sqlparser.JoinTableExpr:
+			if t := findAliasedTable(sqlparser.TableExprs{tableExpr.LeftExpr, tableExpr.RightExpr}, alias); t != nil {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// multiTableWhere returns the where clause of the queries sent to
+// the shards by a MultiTable plan.
+func multiTableWhere(table *vindexes.Table) *sqlparser.Where {
+	return sqlparser.NewWhere(sqlparser.WhereStr, &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     &sqlparser.ColName{Name: table.PrimaryKey[0]},
+		Right:    sqlparser.ListArg("::" + engine.DMLVals),
+	})
+}
+
+func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCols []sqlparser.ColIdent) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, ksidCol := range ksidCols {
+		if i == 0 {
+			buf.Myprintf("select %v", ksidCol)
+			continue
+		}
+		buf.Myprintf(", %v", ksidCol)
+	}
 	for _, cv := range table.Owned {
 		for _, column := range cv.Columns {
 			buf.Myprintf(", %v", column)
@@ -149,7 +149,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			return nil, nil, nil, errCorrelatedSubquery
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
@@ -277,6 +277,17 @@ func (pb *primitiveBuilder) finalizeUnshardedDMLSubqueries(nodes ...sqlparser.SQ
 
 func valEqual(a, b sqlparser.Expr) bool {
 	switch a := a.(type) {
+	case sqlparser.ValTuple:
+		b, ok := b.(sqlparser.ValTuple)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !valEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
 	case *sqlparser.ColName:
 		if b, ok := b.(*sqlparser.ColName); ok {
 			return a.Metadata == b.Metadata
@@ -28,6 +28,10 @@ import (
 
 // This file has functions to analyze the FROM clause.
 
+// errMultiRouteDML is returned by processDMLTable if the FROM clause
+// can't be sent to the shards by a single route.
+var errMultiRouteDML = errors.New("unsupported: multi-shard or vindex write statement")
+
 // processDMLTable analyzes the FROM clause for DMLs and returns a routeOption.
 func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs) (*routeOption, error) {
 	if err := pb.processTableExprs(tableExprs); err != nil {
@@ -35,7 +39,7 @@ func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs) (*r
 	}
 	rb, ok := pb.bldr.(*route)
 	if !ok {
-		return nil, errors.New("unsupported: multi-shard or vindex write statement")
+		return nil, errMultiRouteDML
 	}
 	ro := rb.routeOptions[0]
 	for _, sub := range ro.substitutions {
@@ -156,7 +160,7 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 				})
 			}
 		}
-		vindexMaps, err := st.AddVSchemaTable(sqlparser.TableName{Name: tableExpr.As}, vschemaTables, rb)
+		vindexMaps, _, _, err := st.AddVSchemaTable(sqlparser.TableName{Name: tableExpr.As}, vschemaTables, rb)
 		if err != nil {
 			return err
 		}
@@ -209,7 +213,7 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 
 	rb, st := newRoute(sel)
 	pb.bldr, pb.st = rb, st
-	vindexMaps, err := st.AddVSchemaTable(alias, vschemaTables, rb)
+	vindexMaps, multiColVindexes, primaryVindexColumns, err := st.AddVSchemaTable(alias, vschemaTables, rb)
 	if err != nil {
 		return err
 	}
@@ -253,13 +257,13 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 			// for keyspace id.
 			eroute = engine.NewSimpleRoute(engine.SelectEqualUnique, vst.Keyspace)
 			vindex, _ = vindexes.NewBinary("binary", nil)
-			eroute.Vindex, _ = vindex.(vindexes.SingleColumn)
+			eroute.Vindex = vindex
 			eroute.Values = []sqltypes.PlanValue{{Value: sqltypes.MakeTrusted(sqltypes.VarBinary, vst.Pinned)}}
 		}
 		// set table name into route
 		eroute.TableName = vst.Name.String()
 
-		rb.routeOptions = append(rb.routeOptions, newRouteOption(rb, vst, sub, vindexMaps[i], eroute))
+		rb.routeOptions = append(rb.routeOptions, newRouteOption(rb, vst, sub, vindexMaps[i], multiColVindexes[i], primaryVindexColumns[i], eroute))
 	}
 	return nil
 }
@@ -268,10 +272,20 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 // If the left and right nodes can be part of the same route,
 // then it's a route. Otherwise, it's a join.
 func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
+	natural := false
 	switch ajoin.Join {
 	case sqlparser.JoinStr, sqlparser.StraightJoinStr, sqlparser.LeftJoinStr:
 	case sqlparser.RightJoinStr:
 		convertToLeftJoin(ajoin)
+	case sqlparser.NaturalJoinStr:
+		natural = true
+		ajoin.Join = sqlparser.JoinStr
+	case sqlparser.NaturalLeftJoinStr:
+		natural = true
+		ajoin.Join = sqlparser.LeftJoinStr
+	case sqlparser.NaturalRightJoinStr:
+		natural = true
+		convertToLeftJoin(ajoin)
 	default:
 		return fmt.Errorf("unsupported: %s", ajoin.Join)
 	}
@@ -282,7 +296,107 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
 	if err := rpb.processTableExpr(ajoin.RightExpr); err != nil {
 		return err
 	}
-	return pb.join(rpb, ajoin)
+	if !natural && ajoin.Condition.Using == nil {
+		return pb.join(rpb, ajoin)
+	}
+	left, right, using, err := expandUsing(pb.st, rpb.st, ajoin, natural)
+	if err != nil {
+		return err
+	}
+	if err := pb.join(rpb, ajoin); err != nil {
+		return err
+	}
+	pb.st.SetUsingColumns(left, right, using)
+	return nil
+}
+
+// expandUsing converts the USING clause of a join, or the implicit
+// one of a natural join, into the equivalent ON clause. This requires
+// the column lists of both sides to be authoritative. It returns the
+// columns of both sides along with the pairs of columns that are merged.
+func expandUsing(lst, rst *symtab, ajoin *sqlparser.JoinTableExpr, natural bool) (left, right []tableColumn, using [][2]tableColumn, err error) {
+	left, lok := lst.allColumns()
+	right, rok := rst.allColumns()
+	if !lok || !rok {
+		if natural {
+			return nil, nil, nil, errors.New("unsupported: natural join on tables without authoritative column lists")
+		}
+		return nil, nil, nil, errors.New("unsupported: join with USING(column_list) clause on tables without authoritative column lists")
+	}
+	names := ajoin.Condition.Using
+	if natural {
+		// The common columns are listed in the order of the left side.
+		names = nil
+		for _, lcol := range left {
+			if containsColIdent(names, lcol.name) || !containsColumn(right, lcol.name) {
+				continue
+			}
+			names = append(names, lcol.name)
+		}
+	}
+	var on sqlparser.Expr
+	for _, name := range names {
+		lcol, err := findUsingColumn(left, name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rcol, err := findUsingColumn(right, name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		using = append(using, [2]tableColumn{lcol, rcol})
+		var cond sqlparser.Expr = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualStr,
+			Left:     &sqlparser.ColName{Name: lcol.name, Qualifier: lcol.table.alias},
+			Right:    &sqlparser.ColName{Name: rcol.name, Qualifier: rcol.table.alias},
+		}
+		if on != nil {
+			cond = &sqlparser.AndExpr{Left: on, Right: cond}
+		}
+		on = cond
+	}
+	if on == nil && ajoin.Join == sqlparser.LeftJoinStr {
+		// A natural join without common columns is a cross join,
+		// but a left join still requires an ON clause.
+		on = sqlparser.BoolVal(true)
+	}
+	ajoin.Condition = sqlparser.JoinCondition{On: on}
+	return left, right, using, nil
+}
+
+// findUsingColumn returns the only column of cols that has the name.
+func findUsingColumn(cols []tableColumn, name sqlparser.ColIdent) (tableColumn, error) {
+	var found []tableColumn
+	for _, col := range cols {
+		if col.name.Equal(name) {
+			found = append(found, col)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return tableColumn{}, fmt.Errorf("unknown column '%s' in 'from clause'", name.String())
+	case 1:
+		return found[0], nil
+	}
+	return tableColumn{}, fmt.Errorf("column '%s' in from clause is ambiguous", name.String())
+}
+
+func containsColumn(cols []tableColumn, name sqlparser.ColIdent) bool {
+	for _, col := range cols {
+		if col.name.Equal(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsColIdent(names sqlparser.Columns, name sqlparser.ColIdent) bool {
+	for _, n := range names {
+		if n.Equal(name) {
+			return true
+		}
+	}
+	return false
 }
 
 // convertToLeftJoin converts a right join into a left join.
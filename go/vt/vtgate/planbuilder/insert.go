@@ -26,6 +26,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // buildInsertPlan builds the route for an INSERT statement.
@@ -48,10 +50,7 @@ func buildInsertPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		}
 		return buildInsertUnshardedPlan(ins, ro.vschemaTable)
 	}
-	if ins.Action == sqlparser.ReplaceStr {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
-	return buildInsertShardedPlan(ins, ro.vschemaTable)
+	return buildInsertShardedPlan(ins, ro.vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -98,7 +97,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -107,11 +106,15 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	if ins.Ignore != "" {
 		eins.Opcode = engine.InsertShardedIgnore
 	}
+	if ins.Action == sqlparser.ReplaceStr {
+		eins.Opcode = engine.InsertShardedReplace
+	}
 	if ins.OnDup != nil {
 		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), eins.Table.ColumnVindexes) {
-			return nil, errors.New("unsupported: DML cannot change vindex column")
+			eins.Opcode = engine.InsertShardedUpsert
+		} else {
+			eins.Opcode = engine.InsertShardedIgnore
 		}
-		eins.Opcode = engine.InsertShardedIgnore
 	}
 	if len(ins.Columns) == 0 {
 		if table.ColumnListAuthoritative {
@@ -130,8 +133,14 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.ParenSelect:
+		switch eins.Opcode {
+		case engine.InsertShardedReplace:
+			return nil, errors.New("unsupported: REPLACE INTO with a SELECT in sharded schema")
+		case engine.InsertShardedUpsert:
+			return nil, errors.New("unsupported: DML cannot change vindex column")
+		}
+		return buildInsertSelectPlan(ins, insertValues.(sqlparser.SelectStatement), eins, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -178,11 +187,284 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 		}
 	}
 	eins.VindexValues = routeValues
+	if eins.Opcode == engine.InsertShardedReplace || eins.Opcode == engine.InsertShardedUpsert {
+		if err := buildConflictQueries(ins, eins, rows); err != nil {
+			return nil, err
+		}
+	}
 	eins.Query = generateQuery(ins)
 	generateInsertShardedQuery(ins, eins, rows)
 	return eins, nil
 }
 
+// buildConflictQueries builds the queries that InsertShardedReplace and
+// InsertShardedUpsert plans use to find and change the existing rows
+// that every row conflicts with. It must be called after the vindex
+// columns of the rows have been replaced by bind variables.
+func buildConflictQueries(ins *sqlparser.Insert, eins *engine.Insert, rows sqlparser.Values) error {
+	var upsertVindexes map[*vindexes.ColumnVindex]sqlparser.UpdateExprs
+	if eins.Opcode == engine.InsertShardedUpsert {
+		var err error
+		if upsertVindexes, err = findUpsertVindexes(ins.OnDup, eins.Table.ColumnVindexes); err != nil {
+			return err
+		}
+		eins.UpsertVindexValues = make([]map[string]engine.VindexValues, len(rows))
+	}
+
+	eins.ConflictQueries = make([]string, len(rows))
+	eins.ConflictDMLs = make([]string, len(rows))
+	for rowNum, row := range rows {
+		rowValue := func(col sqlparser.ColIdent) sqlparser.Expr {
+			for i, column := range ins.Columns {
+				if col.Equal(column) {
+					return row[i]
+				}
+			}
+			return nil
+		}
+		where, err := conflictWhere(eins.Table, rowValue)
+		if err != nil {
+			return err
+		}
+		eins.ConflictQueries[rowNum] = generateDMLSubquery(where, nil, nil, eins.Table, eins.Table.ColumnVindexes[0].Columns)
+
+		if eins.Opcode == engine.InsertShardedReplace {
+			buf := sqlparser.NewTrackedBuffer(dmlFormatter)
+			buf.Myprintf("delete %vfrom %v%v", ins.Comments, eins.Table.Name, where)
+			eins.ConflictDMLs[rowNum] = buf.String()
+			continue
+		}
+
+		// VALUES(col) refers to the value of col in the row.
+		buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+			if node, ok := node.(*sqlparser.ValuesFuncExpr); ok {
+				if expr := rowValue(node.Name.Name); expr != nil {
+					buf.Myprintf("%v", expr)
+				} else {
+					buf.Myprintf("null")
+				}
+				return
+			}
+			dmlFormatter(buf, node)
+		})
+		buf.Myprintf("update %v%v set %v%v", ins.Comments, eins.Table.Name, sqlparser.UpdateExprs(ins.OnDup), where)
+		eins.ConflictDMLs[rowNum] = buf.String()
+
+		changedVindexes := make(map[string]engine.VindexValues, len(upsertVindexes))
+		for colVindex, assignments := range upsertVindexes {
+			vindexValues := make(engine.VindexValues, len(assignments))
+			for _, assignment := range assignments {
+				expr := assignment.Expr
+				if valuesExpr, ok := expr.(*sqlparser.ValuesFuncExpr); ok {
+					if expr = rowValue(valuesExpr.Name.Name); expr == nil {
+						expr = &sqlparser.NullVal{}
+					}
+				}
+				pv, err := extractValueFromUpdate(&sqlparser.UpdateExpr{Name: assignment.Name, Expr: expr})
+				if err != nil {
+					return err
+				}
+				vindexValues[assignment.Name.Name.String()] = pv
+			}
+			changedVindexes[colVindex.Name] = vindexValues
+		}
+		eins.UpsertVindexValues[rowNum] = changedVindexes
+	}
+	return nil
+}
+
+// conflictWhere returns the WHERE clause that matches the existing rows
+// with the same primary key as a row, or the same values for an owned
+// unique vindex. The primary key must be specified by the VSchema, and
+// its columns must be in the row.
+func conflictWhere(table *vindexes.Table, rowValue func(sqlparser.ColIdent) sqlparser.Expr) (*sqlparser.Where, error) {
+	if len(table.PrimaryKey) == 0 {
+		return nil, fmt.Errorf("unsupported: REPLACE or ON DUPLICATE KEY UPDATE on table %s without a primary key in the vschema", table.Name)
+	}
+	conflict := keyMatch(table.PrimaryKey, rowValue)
+	if conflict == nil {
+		return nil, fmt.Errorf("unsupported: REPLACE or ON DUPLICATE KEY UPDATE without a value for the primary key of table %s", table.Name)
+	}
+	for _, colVindex := range table.Owned {
+		if !colVindex.Vindex.IsUnique() || sameColumns(colVindex.Columns, table.PrimaryKey) {
+			continue
+		}
+		if match := keyMatch(colVindex.Columns, rowValue); match != nil {
+			conflict = &sqlparser.OrExpr{Left: conflict, Right: match}
+		}
+	}
+	return sqlparser.NewWhere(sqlparser.WhereStr, conflict), nil
+}
+
+func sameColumns(cols1, cols2 []sqlparser.ColIdent) bool {
+	if len(cols1) != len(cols2) {
+		return false
+	}
+	for i, col := range cols1 {
+		if !col.Equal(cols2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// keyMatch returns the expression that matches the rows with the
+// same values for the columns of a key as a row. It returns nil if
+// a column is absent from the row.
+func keyMatch(cols []sqlparser.ColIdent, rowValue func(sqlparser.ColIdent) sqlparser.Expr) sqlparser.Expr {
+	var match sqlparser.Expr
+	for _, col := range cols {
+		value := rowValue(col)
+		if value == nil {
+			return nil
+		}
+		cond := &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualStr,
+			Left:     &sqlparser.ColName{Name: col},
+			Right:    value,
+		}
+		if match == nil {
+			match = cond
+		} else {
+			match = &sqlparser.AndExpr{Left: match, Right: cond}
+		}
+	}
+	return match
+}
+
+// findUpsertVindexes returns the assignments of an ON DUPLICATE KEY UPDATE
+// clause to the columns of every vindex that it changes. Like with UPDATE
+// statements, only owned lookup vindexes can be changed.
+func findUpsertVindexes(onDup sqlparser.OnDup, colVindexes []*vindexes.ColumnVindex) (map[*vindexes.ColumnVindex]sqlparser.UpdateExprs, error) {
+	changed := make(map[*vindexes.ColumnVindex]sqlparser.UpdateExprs)
+	for i, colVindex := range colVindexes {
+		var assignments sqlparser.UpdateExprs
+		for _, assignment := range onDup {
+			for _, col := range colVindex.Columns {
+				if col.Equal(assignment.Name.Name) {
+					assignments = append(assignments, assignment)
+				}
+			}
+		}
+		if len(assignments) == 0 {
+			continue
+		}
+		_, isLookup := colVindex.Vindex.(vindexes.Lookup)
+		if i > 0 && isLookup && colVindex.Owned {
+			// The existing row may have other values than the row,
+			// so even VALUES(col) changes the vindex.
+			changed[colVindex] = assignments
+			continue
+		}
+		if !isVindexChanging(assignments, []*vindexes.ColumnVindex{colVindex}) {
+			continue
+		}
+		if i == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns. Invalid update on vindex: %v", colVindex.Name)
+		}
+		if !isLookup {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", colVindex.Name)
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update owned vindexes. Invalid update on vindex: %v", colVindex.Name)
+	}
+	return changed, nil
+}
+
+// buildInsertSelectPlan builds the plan for an INSERT...SELECT into a
+// sharded table. The SELECT is executed by vtgate, and the resulting rows
+// are routed to the shards using the vindex columns of the table.
+// Vindex or auto-inc columns that are absent from the insert are added
+// to it, and the SELECT supplies NULL values for them.
+func buildInsertSelectPlan(ins *sqlparser.Insert, sel sqlparser.SelectStatement, eins *engine.Insert, vschema ContextVSchema) (engine.Primitive, error) {
+	if count, ok := selectExprCount(sel); ok && count != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+	eins.Ignore = eins.Opcode == engine.InsertShardedIgnore
+	eins.Opcode = engine.InsertSelect
+
+	eins.VindexValueOffset = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		eins.VindexValueOffset[vIdx] = make([]int, len(colVindex.Columns))
+		for colIdx, col := range colVindex.Columns {
+			eins.VindexValueOffset[vIdx][colIdx] = findOrAddSelectColumn(ins, sel, col)
+		}
+	}
+	if eins.Table.AutoIncrement != nil {
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+			Offset:   findOrAddSelectColumn(ins, sel, eins.Table.AutoIncrement.Column),
+		}
+	}
+
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
+	var err error
+	if s, ok := sel.(*sqlparser.Select); ok {
+		err = pb.processSelect(s, nil)
+	} else {
+		err = pb.processPart(sel, nil, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := pb.bldr.Wireup(pb.bldr, pb.jt); err != nil {
+		return nil, err
+	}
+	eins.Input = pb.bldr.Primitive()
+
+	eins.Query = generateQuery(ins)
+	generateInsertShardedQuery(ins, eins, nil)
+	return eins, nil
+}
+
+// selectExprCount returns the number of columns returned by the select
+// statement. It returns false if that number can't be known at plan
+// time because of a '*' expression.
+func selectExprCount(stmt sqlparser.SelectStatement) (int, bool) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		for _, expr := range stmt.SelectExprs {
+			if _, ok := expr.(*sqlparser.AliasedExpr); !ok {
+				return 0, false
+			}
+		}
+		return len(stmt.SelectExprs), true
+	case *sqlparser.Union:
+		return selectExprCount(stmt.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return selectExprCount(stmt.Select)
+	}
+	return 0, false
+}
+
+// findOrAddSelectColumn is the INSERT...SELECT counterpart of
+// findOrAddColumn. If the column is absent, a NULL value is added
+// to every SELECT of the statement.
+func findOrAddSelectColumn(ins *sqlparser.Insert, sel sqlparser.SelectStatement, col sqlparser.ColIdent) int {
+	for i, column := range ins.Columns {
+		if col.Equal(column) {
+			return i
+		}
+	}
+	ins.Columns = append(ins.Columns, col)
+	addNullSelectExpr(sel)
+	return len(ins.Columns) - 1
+}
+
+func addNullSelectExpr(stmt sqlparser.SelectStatement) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		stmt.SelectExprs = append(stmt.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.NullVal{}})
+	case *sqlparser.Union:
+		addNullSelectExpr(stmt.FirstStatement)
+		for _, us := range stmt.UnionSelects {
+			addNullSelectExpr(us.Statement)
+		}
+	case *sqlparser.ParenSelect:
+		addNullSelectExpr(stmt.Select)
+	}
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -196,8 +478,8 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore,
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		node.Action, node.Comments, node.Ignore,
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
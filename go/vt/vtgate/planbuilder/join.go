@@ -19,8 +19,11 @@ package planbuilder
 import (
 	"errors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 var _ builder = (*join)(nil)
@@ -64,6 +67,40 @@ type join struct {
 	Left, Right builder
 
 	ejoin *engine.Join
+
+	// hashConditions are the equality conditions between a column of
+	// the LHS and a column of the RHS that were pushed into the RHS.
+	// They're used to decide if the join can be performed as a hash join.
+	hashConditions []hashCondition
+	ehashJoin      *engine.HashJoin
+
+	// allowHashJoin is set if the select has the ALLOW_HASH_JOIN
+	// directive. Hash joins are opt-in because they hold the
+	// RHS in memory, which isn't always cheaper.
+	allowHashJoin bool
+
+	// filter and exprs reference the RHS of a cross-shard left join.
+	// They're evaluated by vtgate on the results of the join, where
+	// the RHS columns are NULL for the rows of the LHS that have no
+	// match. exprCols maps the result column number of each expression
+	// to its index in exprs. The columns they reference are supplied
+	// during Wireup, and truncated from the results.
+	filter   sqlparser.Expr
+	exprs    []*sqlparser.AliasedExpr
+	exprCols map[int]int
+	efilter  *engine.Filter
+	// weightStringCols are the columns of the filter and the exprs that
+	// are compared by their weight strings, because they're text.
+	weightStringCols map[*sqlparser.ColName]bool
+	eprojection      *engine.Projection
+	esubquery        *engine.Subquery
+}
+
+// hashCondition is an equality condition between two columns
+// that can be used as a hash join key.
+type hashCondition struct {
+	filter      *sqlparser.ComparisonExpr
+	left, right *sqlparser.ColName
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -94,8 +131,6 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			if err := rpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr); err != nil {
 				return err
 			}
-		case ajoin.Condition.Using != nil:
-			return errors.New("unsupported: join with USING(column_list) clause")
 		}
 	}
 	lpb.bldr = &join{
@@ -129,9 +164,29 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
-	jb.ejoin.Left = jb.Left.Primitive()
-	jb.ejoin.Right = jb.Right.Primitive()
-	return jb.ejoin
+	var input engine.Primitive = jb.ejoin
+	if jb.ehashJoin != nil {
+		jb.ehashJoin.Left = jb.Left.Primitive()
+		jb.ehashJoin.Right = jb.Right.Primitive()
+		jb.ehashJoin.Cols = jb.ejoin.Cols
+		input = jb.ehashJoin
+	} else {
+		jb.ejoin.Left = jb.Left.Primitive()
+		jb.ejoin.Right = jb.Right.Primitive()
+	}
+	if jb.efilter != nil {
+		jb.efilter.Input = input
+		input = jb.efilter
+	}
+	if jb.eprojection != nil {
+		jb.eprojection.Input = input
+		input = jb.eprojection
+	}
+	if jb.esubquery != nil {
+		jb.esubquery.Subquery = input
+		input = jb.esubquery
+	}
+	return input
 }
 
 // PushLock satisfies the builder interface.
@@ -160,7 +215,10 @@ func (jb *join) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereTyp
 		return jb.Left.PushFilter(pb, filter, whereType, origin)
 	}
 	if jb.ejoin.Opcode == engine.LeftJoin {
-		return errors.New("unsupported: cross-shard left join and where clause")
+		return jb.pushPostJoinFilter(filter)
+	}
+	if cond, ok := jb.newHashCondition(pb, filter, whereType); ok {
+		jb.hashConditions = append(jb.hashConditions, cond)
 	}
 	return jb.Right.PushFilter(pb, filter, whereType, origin)
 }
@@ -174,9 +232,10 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 		}
 		jb.ejoin.Cols = append(jb.ejoin.Cols, -colNumber-1)
 	} else {
-		// Pushing of non-trivial expressions not allowed for RHS of left joins.
+		// Non-trivial expressions on the RHS of a left join
+		// are evaluated by vtgate, after the join.
 		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
-			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+			return jb.pushPostJoinExpr(expr)
 		}
 
 		rc, colNumber, err = jb.Right.PushSelect(pb, expr, origin)
@@ -287,17 +346,32 @@ func (jb *join) SetUpperLimit(_ *sqlparser.SQLVal) {
 
 // PushMisc satisfies the builder interface.
 func (jb *join) PushMisc(sel *sqlparser.Select) {
+	if sqlparser.ExtractCommentDirectives(sel.Comments).IsSet(sqlparser.DirectiveAllowHashJoin) {
+		jb.allowHashJoin = true
+	}
 	jb.Left.PushMisc(sel)
 	jb.Right.PushMisc(sel)
 }
 
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	if err := jb.planHashJoin(); err != nil {
+		return err
+	}
+	if err := jb.wireupPostJoin(); err != nil {
+		return err
+	}
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
 	}
-	return jb.Left.Wireup(bldr, jt)
+	if err := jb.Left.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	if jb.ehashJoin != nil && len(jb.ejoin.Vars) != 0 {
+		return errors.New("BUG: hash join requires join variables")
+	}
+	return nil
 }
 
 // SupplyVar satisfies the builder interface.
@@ -355,6 +429,9 @@ func (jb *join) SupplyWeightString(colNumber int) (weightcolNumber int, err erro
 	if weightcolNumber, ok := jb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	if _, ok := jb.exprCols[colNumber]; ok {
+		return 0, errors.New("unsupported: cross-shard left join and weight string of column expressions")
+	}
 	routeNumber := rc.column.Origin().Order()
 	if jb.isOnLeft(routeNumber) {
 		sourceCol, err := jb.Left.SupplyWeightString(-jb.ejoin.Cols[colNumber] - 1)
@@ -374,9 +451,449 @@ func (jb *join) SupplyWeightString(colNumber int) (weightcolNumber int, err erro
 	return len(jb.ejoin.Cols) - 1, nil
 }
 
+// pushPostJoinFilter adds a filter that references the RHS of a
+// left join. Pushing it into the RHS would only discard rows of the
+// RHS instead of rows of the join results.
+func (jb *join) pushPostJoinFilter(filter sqlparser.Expr) error {
+	if _, err := sqlparser.Convert(filter, jb.checkPostJoinColumn); err != nil {
+		if err == sqlparser.ExprNotSupported {
+			return errors.New("unsupported: cross-shard left join and where clause")
+		}
+		return err
+	}
+	if !jb.checkPostJoinComparisons(filter) {
+		return errors.New("unsupported: cross-shard left join and string comparison in where clause")
+	}
+	if jb.filter == nil {
+		jb.filter = filter
+		return nil
+	}
+	jb.filter = &sqlparser.AndExpr{Left: jb.filter, Right: filter}
+	return nil
+}
+
+// pushPostJoinExpr adds a result column for an expression that
+// references the RHS of a left join. Its Cols entry is set by Wireup.
+func (jb *join) pushPostJoinExpr(expr *sqlparser.AliasedExpr) (rc *resultColumn, colNumber int, err error) {
+	if _, err := sqlparser.Convert(expr.Expr, jb.checkPostJoinColumn); err != nil {
+		if err == sqlparser.ExprNotSupported {
+			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+		}
+		return nil, 0, err
+	}
+	if !jb.checkPostJoinComparisons(expr.Expr) {
+		return nil, 0, errors.New("unsupported: cross-shard left join and string comparison in column expressions")
+	}
+	if jb.exprCols == nil {
+		jb.exprCols = make(map[int]int)
+	}
+	jb.exprCols[len(jb.resultColumns)] = len(jb.exprs)
+	jb.exprs = append(jb.exprs, expr)
+	jb.ejoin.Cols = append(jb.ejoin.Cols, 0)
+	rc = newResultColumn(expr, jb)
+	jb.resultColumns = append(jb.resultColumns, rc)
+	return rc, len(jb.resultColumns) - 1, nil
+}
+
+// checkPostJoinColumn verifies that the column is a column of
+// one of the tables of the join, which can be supplied by Wireup.
+func (jb *join) checkPostJoinColumn(col *sqlparser.ColName) (int, error) {
+	c, ok := col.Metadata.(*column)
+	if !ok {
+		return 0, sqlparser.ExprNotSupported
+	}
+	order := c.Origin().Order()
+	if order < jb.Left.First().Order() || order > jb.Right.Order() {
+		return 0, sqlparser.ExprNotSupported
+	}
+	return 0, nil
+}
+
+// checkPostJoinComparisons returns false if the expression compares
+// values that may be strings, which vtgate can't compare like mysql:
+// vtgate compares strings by their bytes, while mysql uses the collation
+// of the columns. A comparison is evaluated as a numeric comparison
+// if one of its operands is a number. Otherwise, it's allowed only
+// between text columns, whose weight strings are compared instead.
+func (jb *join) checkPostJoinComparisons(expr sqlparser.Expr) bool {
+	var weightStringCols []*sqlparser.ColName
+	comparable := func(left, right sqlparser.Expr) bool {
+		if isNumericOperand(left) || isNumericOperand(right) {
+			return true
+		}
+		lcol, lok := left.(*sqlparser.ColName)
+		rcol, rok := right.(*sqlparser.ColName)
+		if !lok || !rok || !sqltypes.IsText(columnType(lcol)) || !sqltypes.IsText(columnType(rcol)) {
+			return false
+		}
+		weightStringCols = append(weightStringCols, lcol, rcol)
+		return true
+	}
+	if !allComparisons(expr, comparable) {
+		return false
+	}
+	for _, col := range weightStringCols {
+		if jb.weightStringCols == nil {
+			jb.weightStringCols = make(map[*sqlparser.ColName]bool)
+		}
+		jb.weightStringCols[col] = true
+	}
+	return true
+}
+
+// allComparisons calls comparable with the operands of every comparison
+// of the expression, and returns false as soon as it returns false.
+func allComparisons(expr sqlparser.Expr, comparable func(left, right sqlparser.Expr) bool) bool {
+	ok := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ComparisonExpr:
+			if tuple, isTuple := node.Right.(sqlparser.ValTuple); isTuple {
+				for _, value := range tuple {
+					ok = ok && comparable(node.Left, value)
+				}
+			} else {
+				ok = ok && comparable(node.Left, node.Right)
+			}
+		case *sqlparser.RangeCond:
+			ok = ok && comparable(node.Left, node.From) && comparable(node.Left, node.To)
+		case *sqlparser.CaseExpr:
+			if node.Expr != nil {
+				for _, when := range node.Whens {
+					ok = ok && comparable(node.Expr, when.Cond)
+				}
+			}
+		}
+		return ok, nil
+	}, expr)
+	return ok
+}
+
+// isNumericOperand returns true if the operand of a comparison
+// is a number, or NULL, which can't compare equal to anything.
+func isNumericOperand(expr sqlparser.Expr) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		return expr.Type == sqlparser.IntVal || expr.Type == sqlparser.FloatVal
+	case *sqlparser.NullVal, sqlparser.BoolVal:
+		return true
+	case *sqlparser.BinaryExpr:
+		// Only arithmetic operators can be evaluated by vtgate.
+		return true
+	case *sqlparser.ColName:
+		return sqltypes.IsNumber(columnType(expr))
+	}
+	return false
+}
+
+// columnType returns the type of the column, if it's known.
+func columnType(col *sqlparser.ColName) querypb.Type {
+	c, ok := col.Metadata.(*column)
+	if !ok {
+		return sqltypes.Null
+	}
+	return c.typ
+}
+
+// supplyPostJoinColumn supplies the column referenced by
+// a filter or an expression evaluated after the join.
+// Like memorySort, text columns are compared by their weight strings.
+func (jb *join) supplyPostJoinColumn(col *sqlparser.ColName) (int, error) {
+	_, colNumber := jb.SupplyCol(col)
+	if jb.weightStringCols[col] {
+		return jb.SupplyWeightString(colNumber)
+	}
+	return colNumber, nil
+}
+
+// wireupPostJoin builds the primitives that evaluate the filter
+// and the expressions on the results of the join. The Filter
+// discards rows, the Projection appends the values of the expressions
+// to the rows, and the Subquery returns the result columns in order,
+// without the columns that were only supplied for the evaluation.
+func (jb *join) wireupPostJoin() error {
+	if jb.filter == nil && len(jb.exprs) == 0 {
+		return nil
+	}
+	count := len(jb.resultColumns)
+	if jb.filter != nil {
+		predicate, err := sqlparser.Convert(jb.filter, jb.supplyPostJoinColumn)
+		if err != nil {
+			return err
+		}
+		jb.efilter = &engine.Filter{Predicate: predicate}
+	}
+	if len(jb.exprs) != 0 {
+		jb.eprojection = &engine.Projection{}
+		exprColNumbers := make([]int, len(jb.exprs))
+		for colNumber, exprNumber := range jb.exprCols {
+			exprColNumbers[exprNumber] = colNumber
+		}
+		for i, expr := range jb.exprs {
+			first := -1
+			evalExpr, err := sqlparser.Convert(expr.Expr, func(col *sqlparser.ColName) (int, error) {
+				colNumber, err := jb.supplyPostJoinColumn(col)
+				if first == -1 {
+					first = colNumber
+				}
+				return colNumber, err
+			})
+			if err != nil {
+				return err
+			}
+			name := expr.As.String()
+			if name == "" {
+				name = sqlparser.String(expr.Expr)
+			}
+			jb.eprojection.Exprs = append(jb.eprojection.Exprs, evalExpr)
+			jb.eprojection.Cols = append(jb.eprojection.Cols, name)
+			// The join returns a copy of a referenced column in
+			// place of the expression, which is not returned.
+			jb.ejoin.Cols[exprColNumbers[i]] = jb.ejoin.Cols[first]
+		}
+	}
+	if len(jb.exprs) == 0 && len(jb.resultColumns) == count {
+		return nil
+	}
+	jb.esubquery = &engine.Subquery{}
+	for colNumber := 0; colNumber < count; colNumber++ {
+		col := colNumber
+		if exprNumber, ok := jb.exprCols[colNumber]; ok {
+			// The Projection appends the values of the expressions.
+			col = len(jb.ejoin.Cols) + exprNumber
+		}
+		jb.esubquery.Cols = append(jb.esubquery.Cols, col)
+	}
+	return nil
+}
+
 // isOnLeft returns true if the specified route number
 // is on the left side of the join. If false, it means
 // the node is on the right.
 func (jb *join) isOnLeft(nodeNum int) bool {
 	return nodeNum <= jb.leftOrder
 }
+
+// newHashCondition returns a hashCondition if the filter is an
+// equality between a column of the LHS and a column of the RHS.
+func (jb *join) newHashCondition(pb *primitiveBuilder, filter sqlparser.Expr, whereType string) (hashCondition, bool) {
+	if whereType != sqlparser.WhereStr {
+		return hashCondition{}, false
+	}
+	cmp, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualStr {
+		return hashCondition{}, false
+	}
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return hashCondition{}, false
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return hashCondition{}, false
+	}
+	if !jb.isLocalColumn(pb, left) || !jb.isLocalColumn(pb, right) {
+		return hashCondition{}, false
+	}
+	leftOnLeft := jb.isOnLeft(left.Metadata.(*column).Origin().Order())
+	rightOnLeft := jb.isOnLeft(right.Metadata.(*column).Origin().Order())
+	switch {
+	case leftOnLeft && !rightOnLeft:
+		return hashCondition{filter: cmp, left: left, right: right}, true
+	case !leftOnLeft && rightOnLeft:
+		return hashCondition{filter: cmp, left: right, right: left}, true
+	}
+	return hashCondition{}, false
+}
+
+// isLocalColumn returns true if col is a column of one of the
+// tables of the join.
+func (jb *join) isLocalColumn(pb *primitiveBuilder, col *sqlparser.ColName) bool {
+	origin, isLocal, err := pb.st.Find(col)
+	if err != nil || !isLocal {
+		return false
+	}
+	return origin.Order() >= jb.Left.First().Order() && origin.Order() <= jb.Right.Order()
+}
+
+// planHashJoin converts the join into a hash join if the select allows
+// it, and if this saves the RHS from being scattered once for every row
+// of a scatter LHS. This is the case if the RHS is a scatter route whose only
+// dependencies on the LHS are equality conditions on columns
+// that are not vindex columns on either side, and whose values
+// can be compared in vtgate. The conditions
+// are then removed from the RHS query, and evaluated by the
+// HashJoin primitive instead.
+func (jb *join) planHashJoin() error {
+	if !jb.canHashJoin() {
+		return nil
+	}
+
+	// Remove the conditions from the RHS query.
+	sel := jb.Right.(*route).Select.(*sqlparser.Select)
+	filters := splitAndExpression(nil, sel.Where.Expr)
+	sel.Where = nil
+	for _, filter := range filters {
+		if !jb.isHashCondition(filter) {
+			sel.AddWhere(filter)
+		}
+	}
+
+	ehashJoin := &engine.HashJoin{}
+	for _, cond := range jb.hashConditions {
+		_, lcol := jb.Left.SupplyCol(cond.left)
+		_, rcol := jb.Right.SupplyCol(cond.right)
+		// Like memorySort, compare the weight strings of text
+		// columns because we can't mimic mysql's collations.
+		// Columns of unknown types may be text too.
+		if !cond.isNumeric() {
+			var err error
+			if lcol, err = jb.Left.SupplyWeightString(lcol); err != nil {
+				return err
+			}
+			if rcol, err = jb.Right.SupplyWeightString(rcol); err != nil {
+				return err
+			}
+		}
+		ehashJoin.LHSKeys = append(ehashJoin.LHSKeys, lcol)
+		ehashJoin.RHSKeys = append(ehashJoin.RHSKeys, rcol)
+	}
+	jb.ehashJoin = ehashJoin
+	return nil
+}
+
+// canHashJoin returns true if the join can be converted into a hash join.
+func (jb *join) canHashJoin() bool {
+	if !jb.allowHashJoin || jb.ejoin.Opcode != engine.NormalJoin || len(jb.hashConditions) == 0 {
+		return false
+	}
+	rb, ok := jb.Right.(*route)
+	if !ok || !isScatter(rb) || !isScatter(jb.Left) {
+		return false
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return false
+	}
+	for _, cond := range jb.hashConditions {
+		if isVindexColumn(cond.left) || isVindexColumn(cond.right) {
+			return false
+		}
+		if !cond.isComparable() {
+			return false
+		}
+	}
+	return !jb.rhsDependsOnLHS(sel)
+}
+
+// isNumeric returns true if both columns of the condition are numbers.
+func (cond hashCondition) isNumeric() bool {
+	return isNumericKey(cond.left.Metadata.(*column).typ, cond.right.Metadata.(*column).typ)
+}
+
+// isComparable returns true if the HashJoin primitive can compare
+// the columns of the condition. Columns of unknown types are refused:
+// they could be an int and a varchar or a decimal, which mysql compares
+// by value, while their weight strings differ.
+func (cond hashCondition) isComparable() bool {
+	ltyp, rtyp := cond.left.Metadata.(*column).typ, cond.right.Metadata.(*column).typ
+	if ltyp == sqltypes.Null || rtyp == sqltypes.Null {
+		return false
+	}
+	return isComparableKey(ltyp, rtyp)
+}
+
+// isNumericKey returns true if the columns of a key compared in vtgate
+// are both numbers, which can be compared by value.
+func isNumericKey(ltyp, rtyp querypb.Type) bool {
+	return sqltypes.IsNumber(ltyp) && sqltypes.IsNumber(rtyp)
+}
+
+// isComparableKey returns true if the columns of a key can be compared
+// in vtgate like mysql does: either both are exact numbers (integers or
+// decimals), both are floats, or both are compared by their weight
+// strings, because they're text or of unknown types. The weight strings
+// of a number and of a string don't compare like their values, and
+// neither do the values of an exact number and of a float.
+func isComparableKey(ltyp, rtyp querypb.Type) bool {
+	switch {
+	case isExactNumber(ltyp) && isExactNumber(rtyp):
+		return true
+	case sqltypes.IsFloat(ltyp) && sqltypes.IsFloat(rtyp):
+		return true
+	case sqltypes.IsText(ltyp) && sqltypes.IsText(rtyp):
+		return true
+	}
+	return ltyp == sqltypes.Null && rtyp == sqltypes.Null
+}
+
+// isExactNumber returns true if typ is an integer or a decimal.
+func isExactNumber(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || typ == sqltypes.Decimal
+}
+
+// rhsDependsOnLHS returns true if the RHS query references
+// columns of the LHS outside of the hash conditions.
+func (jb *join) rhsDependsOnLHS(sel *sqlparser.Select) bool {
+	first := jb.Left.First().Order()
+	depends := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ComparisonExpr:
+			if jb.isHashCondition(node) {
+				return false, nil
+			}
+		case *sqlparser.ColName:
+			c, ok := node.Metadata.(*column)
+			if !ok {
+				return true, nil
+			}
+			if order := c.Origin().Order(); order >= first && jb.isOnLeft(order) {
+				depends = true
+				return false, errors.New("unused error")
+			}
+		}
+		return true, nil
+	}, sel)
+	return depends
+}
+
+func (jb *join) isHashCondition(expr sqlparser.Expr) bool {
+	for _, cond := range jb.hashConditions {
+		if expr == sqlparser.Expr(cond.filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// isScatter returns true if the builder produces
+// the results of at least one scatter route.
+func isScatter(bldr builder) bool {
+	switch bldr := bldr.(type) {
+	case *route:
+		return bldr.bestOption().eroute.Opcode == engine.SelectScatter
+	case *join:
+		return isScatter(bldr.Left) || isScatter(bldr.Right)
+	}
+	return false
+}
+
+// isVindexColumn returns true if col is a vindex column
+// for any of the options of the route it comes from.
+func isVindexColumn(col *sqlparser.ColName) bool {
+	c := col.Metadata.(*column)
+	rb, ok := c.Origin().(*route)
+	if !ok {
+		return true
+	}
+	for _, ro := range rb.routeOptions {
+		if ro.vindexMap[c] != nil {
+			return true
+		}
+		if mcv, _ := ro.multiColVindexFor(c); mcv != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,508 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"math"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// This file orders the inner joins of a SELECT by their estimated cost.
+// If the statistics of all the tables are known, the FROM clause is
+// planned for every join order, and the cheapest one is chosen. The
+// estimates are coarse: they're only meant to avoid the orders that
+// send many more queries, or fetch many more rows, than necessary.
+
+const (
+	// maxPermutedTables is the maximum number of tables for which
+	// all the join orders are evaluated. For more tables, a greedy
+	// order is compared with the original one.
+	maxPermutedTables = 5
+
+	// shardQueryCost is the cost of sending a query to a shard,
+	// expressed in rows.
+	shardQueryCost = 10
+
+	// defaultCardinality is the number of distinct values assumed
+	// for a column that doesn't lead an index.
+	defaultCardinality = 10
+
+	// rangeSelectivity is the fraction of the rows assumed
+	// to match an inequality.
+	rangeSelectivity = 1.0 / 3
+)
+
+// tableSet is a set of tables, identified by their position
+// in the FROM clause.
+type tableSet uint64
+
+// joinOrder contains the tables of a FROM clause that only
+// has inner joins of tables, and the conditions of the joins.
+type joinOrder struct {
+	tables     []*sqlparser.AliasedTableExpr
+	aliases    []sqlparser.TableName
+	stats      []*TableStatistics
+	conditions []sqlparser.Expr
+
+	// deps contains the tables referenced by each condition.
+	deps []tableSet
+}
+
+// orderJoins returns a copy of sel whose tables are joined in the
+// order with the lowest estimated cost. It returns nil if the joins
+// can't be reordered, or if the original order is the cheapest.
+func orderJoins(sel *sqlparser.Select, vschema ContextVSchema) *sqlparser.Select {
+	jo := newJoinOrder(sel, vschema)
+	if jo == nil {
+		return nil
+	}
+	var candidates [][]int
+	if len(jo.tables) <= maxPermutedTables {
+		candidates = permutations(len(jo.tables))
+	} else {
+		original := make([]int, len(jo.tables))
+		for i := range original {
+			original[i] = i
+		}
+		candidates = [][]int{original, jo.greedyOrder()}
+	}
+
+	// The original order comes first, so it wins the ties.
+	best, bestCost := -1, math.Inf(1)
+	for i, order := range candidates {
+		cost, ok := jo.estimate(sel, order, vschema)
+		if ok && cost < bestCost {
+			best, bestCost = i, cost
+		}
+	}
+	if best <= 0 {
+		return nil
+	}
+	return jo.reorder(sel, candidates[best])
+}
+
+// newJoinOrder returns the joinOrder of sel, or nil if its
+// joins can't be reordered.
+func newJoinOrder(sel *sqlparser.Select, vschema ContextVSchema) *joinOrder {
+	if sel.With != nil || sel.StraightJoinHint {
+		return nil
+	}
+	for _, expr := range sel.SelectExprs {
+		// The columns of an unqualified '*' follow the join order.
+		if star, ok := expr.(*sqlparser.StarExpr); ok && star.TableName.IsEmpty() {
+			return nil
+		}
+	}
+	jo := &joinOrder{}
+	if !jo.addTableExprs(sel.From) {
+		return nil
+	}
+	if len(jo.tables) < 2 || len(jo.tables) > 64 {
+		return nil
+	}
+	for _, tableExpr := range jo.tables {
+		tableName := tableExpr.Expr.(sqlparser.TableName)
+		if systemTable(tableName.Qualifier.String()) {
+			return nil
+		}
+		vst, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil || vst == nil {
+			return nil
+		}
+		stats := vschema.TableStatistics(vst.Keyspace.Name, vst.Name.String())
+		if stats == nil {
+			return nil
+		}
+		jo.stats = append(jo.stats, stats)
+	}
+	for _, cond := range jo.conditions {
+		jo.deps = append(jo.deps, jo.dependencies(cond))
+	}
+	return jo
+}
+
+// addTableExprs adds the tables and the join conditions of the
+// table expressions. It returns false if they contain anything
+// other than inner joins of tables.
+func (jo *joinOrder) addTableExprs(tableExprs sqlparser.TableExprs) bool {
+	for _, tableExpr := range tableExprs {
+		if !jo.addTableExpr(tableExpr) {
+			return false
+		}
+	}
+	return true
+}
+
+func (jo *joinOrder) addTableExpr(tableExpr sqlparser.TableExpr) bool {
+	switch tableExpr := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return false
+		}
+		alias := tableName
+		if !tableExpr.As.IsEmpty() {
+			alias = sqlparser.TableName{Name: tableExpr.As}
+		}
+		jo.tables = append(jo.tables, tableExpr)
+		jo.aliases = append(jo.aliases, alias)
+		return true
+	case *sqlparser.ParenTableExpr:
+		return jo.addTableExprs(tableExpr.Exprs)
+	case *sqlparser.JoinTableExpr:
+		if tableExpr.Join != sqlparser.JoinStr || len(tableExpr.Condition.Using) != 0 {
+			return false
+		}
+		if !jo.addTableExpr(tableExpr.LeftExpr) || !jo.addTableExpr(tableExpr.RightExpr) {
+			return false
+		}
+		jo.conditions = splitAndExpression(jo.conditions, tableExpr.Condition.On)
+		return true
+	}
+	return false
+}
+
+// dependencies returns the tables referenced by expr. A column
+// that can't be attributed to a table references all of them.
+func (jo *joinOrder) dependencies(expr sqlparser.Expr) tableSet {
+	var deps tableSet
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			deps |= jo.columnTables(col)
+		}
+		return true, nil
+	}, expr)
+	return deps
+}
+
+func (jo *joinOrder) columnTables(col *sqlparser.ColName) tableSet {
+	if !col.Qualifier.IsEmpty() {
+		for i, alias := range jo.aliases {
+			if col.Qualifier.Name == alias.Name && (col.Qualifier.Qualifier.IsEmpty() || col.Qualifier.Qualifier == alias.Qualifier) {
+				return 1 << uint(i)
+			}
+		}
+	}
+	return 1<<uint(len(jo.tables)) - 1
+}
+
+// reorder returns a copy of sel whose tables are joined in the
+// specified order. Every join condition is attached to the first
+// join that has all the tables it references. The copy is made by
+// parsing sel again, because planning modifies the statement.
+func (jo *joinOrder) reorder(sel *sqlparser.Select, order []int) *sqlparser.Select {
+	stmt, err := sqlparser.Parse(sqlparser.String(sel))
+	if err != nil {
+		return nil
+	}
+	copied, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil
+	}
+	cjo := &joinOrder{}
+	if !cjo.addTableExprs(copied.From) || len(cjo.tables) != len(jo.tables) || len(cjo.conditions) != len(jo.conditions) {
+		return nil
+	}
+
+	var from sqlparser.TableExpr = cjo.tables[order[0]]
+	joined := tableSet(1) << uint(order[0])
+	attached := make([]bool, len(cjo.conditions))
+	for _, i := range order[1:] {
+		joined |= 1 << uint(i)
+		var on sqlparser.Expr
+		for j, cond := range cjo.conditions {
+			if attached[j] || jo.deps[j]&^joined != 0 {
+				continue
+			}
+			attached[j] = true
+			if on == nil {
+				on = cond
+				continue
+			}
+			on = &sqlparser.AndExpr{Left: on, Right: cond}
+		}
+		from = &sqlparser.JoinTableExpr{
+			LeftExpr:  from,
+			Join:      sqlparser.JoinStr,
+			RightExpr: cjo.tables[i],
+			Condition: sqlparser.JoinCondition{On: on},
+		}
+	}
+	copied.From = sqlparser.TableExprs{from}
+	return copied
+}
+
+// estimate returns the estimated cost of the FROM and WHERE
+// clauses of sel if its tables are joined in the specified order.
+// It returns false if that order can't be planned.
+func (jo *joinOrder) estimate(sel *sqlparser.Select, order []int, vschema ContextVSchema) (float64, bool) {
+	candidate := jo.reorder(sel, order)
+	if candidate == nil {
+		return 0, false
+	}
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(candidate)))
+	if err := pb.processTableExprs(candidate.From); err != nil {
+		return 0, false
+	}
+	if candidate.Where != nil {
+		if err := pb.pushFilter(candidate.Where.Expr, sqlparser.WhereStr); err != nil {
+			return 0, false
+		}
+	}
+	ce, ok := newCostEstimator(jo, pb.st)
+	if !ok {
+		return 0, false
+	}
+	est, ok := ce.estimate(pb.bldr)
+	return est.cost, ok
+}
+
+// greedyOrder starts with the smallest table, and then repeatedly
+// joins the smallest of the tables that have a join condition with
+// the tables joined so far. If there are none, it joins the
+// smallest of the remaining tables.
+func (jo *joinOrder) greedyOrder() []int {
+	size := func(i int) float64 {
+		return float64(jo.stats[i].RowCount) * math.Max(1, float64(jo.stats[i].Shards))
+	}
+	var order []int
+	var joined tableSet
+	for len(order) < len(jo.tables) {
+		next, nextConnected := -1, false
+		for i := range jo.tables {
+			if joined&(1<<uint(i)) != 0 {
+				continue
+			}
+			connected := false
+			for _, deps := range jo.deps {
+				if deps&(1<<uint(i)) != 0 && deps&joined != 0 {
+					connected = true
+					break
+				}
+			}
+			switch {
+			case next == -1, connected && !nextConnected:
+			case connected == nextConnected && size(i) < size(next):
+			default:
+				continue
+			}
+			next, nextConnected = i, connected
+		}
+		order = append(order, next)
+		joined |= 1 << uint(next)
+	}
+	return order
+}
+
+// permutations returns all the orders of n tables,
+// starting with the original one.
+func permutations(n int) [][]int {
+	var result [][]int
+	var permute func(order []int, used tableSet)
+	permute = func(order []int, used tableSet) {
+		if len(order) == n {
+			result = append(result, append([]int(nil), order...))
+			return
+		}
+		for i := 0; i < n; i++ {
+			if used&(1<<uint(i)) == 0 {
+				permute(append(order, i), used|1<<uint(i))
+			}
+		}
+	}
+	permute(make([]int, 0, n), 0)
+	return result
+}
+
+// costEstimate is the estimated cost of executing a builder,
+// and the estimated number of rows it returns.
+type costEstimate struct {
+	cost, rows float64
+}
+
+// costEstimator estimates the cost of the builder tree
+// built for a join order.
+type costEstimator struct {
+	jo *joinOrder
+
+	// routes contains the route of every table.
+	routes []*route
+
+	// columns maps the columns of the tables to
+	// the table they belong to, and their name.
+	columns map[*column]tableColumnName
+}
+
+type tableColumnName struct {
+	table int
+	name  string
+}
+
+func newCostEstimator(jo *joinOrder, st *symtab) (*costEstimator, bool) {
+	ce := &costEstimator{
+		jo:      jo,
+		columns: make(map[*column]tableColumnName),
+	}
+	for i, alias := range jo.aliases {
+		t, ok := st.tables[alias]
+		if !ok {
+			return nil, false
+		}
+		rb, ok := t.origin.(*route)
+		if !ok {
+			return nil, false
+		}
+		ce.routes = append(ce.routes, rb.Resolve())
+		for name, c := range t.columns {
+			ce.columns[c] = tableColumnName{table: i, name: name}
+		}
+	}
+	return ce, true
+}
+
+func (ce *costEstimator) estimate(bldr builder) (costEstimate, bool) {
+	switch bldr := bldr.(type) {
+	case *route:
+		return ce.estimateRoute(bldr, nil), true
+	case *join:
+		left, ok := ce.estimate(bldr.Left)
+		if !ok {
+			return costEstimate{}, false
+		}
+		right, ok := ce.estimate(bldr.Right)
+		if !ok {
+			return costEstimate{}, false
+		}
+		rows := left.rows * right.rows
+		if bldr.canHashJoin() {
+			// The RHS is executed only once, without the hash conditions.
+			rhs := ce.estimateRoute(bldr.Right.(*route), bldr.isHashCondition)
+			return costEstimate{cost: left.cost + rhs.cost, rows: rows}, true
+		}
+		// The RHS is executed once for every row of the LHS.
+		return costEstimate{cost: left.cost + left.rows*right.cost, rows: rows}, true
+	case *pulloutSubquery:
+		return ce.estimate(bldr.underlying)
+	case *semiJoin:
+		return ce.estimate(bldr.underlying)
+	}
+	return costEstimate{}, false
+}
+
+// estimateRoute estimates the cost of one execution of a route. The
+// filters for which ignore returns true are not taken into account.
+// The candidate plans are thrown away after costing, so the route
+// options of rb are left untouched.
+func (ce *costEstimator) estimateRoute(rb *route, ignore func(sqlparser.Expr) bool) costEstimate {
+	ro := rb.bestOption()
+
+	rows, shards := 1.0, 1
+	for i, tableRoute := range ce.routes {
+		if tableRoute != rb {
+			continue
+		}
+		rows *= math.Max(1, float64(ce.jo.stats[i].RowCount))
+		if ce.jo.stats[i].Shards > shards {
+			shards = ce.jo.stats[i].Shards
+		}
+	}
+	if sel, ok := rb.Select.(*sqlparser.Select); ok && sel.Where != nil {
+		for _, filter := range splitAndExpression(nil, sel.Where.Expr) {
+			if ignore == nil || !ignore(filter) {
+				rows *= ce.selectivity(rb, filter)
+			}
+		}
+	}
+
+	switch ro.eroute.Opcode {
+	case engine.SelectScatter:
+	case engine.SelectIN:
+		if cmp, ok := ro.condition.(*sqlparser.ComparisonExpr); ok {
+			if values, ok := cmp.Right.(sqlparser.ValTuple); ok && len(values) < shards {
+				shards = len(values)
+			}
+		}
+	case engine.SelectNone:
+		shards = 0
+	default:
+		shards = 1
+	}
+	return costEstimate{
+		cost: float64(shards) * (shardQueryCost + rows),
+		rows: float64(shards) * rows,
+	}
+}
+
+// selectivity estimates the fraction of the rows of
+// the route that match the filter.
+func (ce *costEstimator) selectivity(rb *route, filter sqlparser.Expr) float64 {
+	cmp, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return 1
+	}
+	switch cmp.Operator {
+	case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+		left, leftOK := ce.cardinality(rb, cmp.Left)
+		right, rightOK := ce.cardinality(rb, cmp.Right)
+		switch {
+		case leftOK && rightOK:
+			// This joins two tables of the route.
+			return 1 / math.Max(left, right)
+		case leftOK:
+			return 1 / left
+		case rightOK:
+			return 1 / right
+		}
+	case sqlparser.InStr:
+		card, ok := ce.cardinality(rb, cmp.Left)
+		values, isTuple := cmp.Right.(sqlparser.ValTuple)
+		if ok && isTuple {
+			return math.Min(1, float64(len(values))/card)
+		}
+	case sqlparser.LessThanStr, sqlparser.GreaterThanStr, sqlparser.LessEqualStr, sqlparser.GreaterEqualStr:
+		return rangeSelectivity
+	}
+	return 1
+}
+
+// cardinality returns the estimated number of distinct values per
+// shard of expr, if it's a column of one of the tables of the route.
+func (ce *costEstimator) cardinality(rb *route, expr sqlparser.Expr) (float64, bool) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return 0, false
+	}
+	c, ok := col.Metadata.(*column)
+	if !ok || c.Origin() != rb {
+		return 0, false
+	}
+	tc, ok := ce.columns[c]
+	if !ok {
+		return 0, false
+	}
+	stats := ce.jo.stats[tc.table]
+	rows := math.Max(1, float64(stats.RowCount))
+	for name, card := range stats.ColumnCardinality {
+		if strings.EqualFold(name, tc.name) && card > 0 {
+			return math.Min(rows, float64(card)), true
+		}
+	}
+	return math.Min(rows, defaultCardinality), true
+}
@@ -85,6 +85,18 @@ func (jt *jointab) GenerateSubqueryVars() (sq, hasValues string) {
 	}
 }
 
+// GenerateSemiJoinVar generates a unique name for the list
+// bind variable of a semi-join key.
+func (jt *jointab) GenerateSemiJoinVar() string {
+	for {
+		jt.varIndex++
+		listVar := "__sj" + strconv.Itoa(jt.varIndex)
+		if !jt.containsAny(listVar) {
+			return listVar
+		}
+	}
+}
+
 func (jt *jointab) containsAny(names ...string) bool {
 	for _, name := range names {
 		if _, ok := jt.vars[name]; ok {
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// lockFuncs are the MySQL functions that work on named locks.
+var lockFuncs = map[string]bool{
+	"get_lock":          true,
+	"release_lock":      true,
+	"release_all_locks": true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+}
+
+// lockChangeFuncs are the named lock functions that acquire or
+// release named locks.
+var lockChangeFuncs = map[string]bool{
+	"get_lock":          true,
+	"release_lock":      true,
+	"release_all_locks": true,
+}
+
+// hasLockFunc returns true if the node calls one of the funcs.
+func hasLockFunc(node sqlparser.SQLNode, funcs map[string]bool) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if f, ok := node.(*sqlparser.FuncExpr); ok && f.Qualifier.IsEmpty() && funcs[f.Name.Lowered()] {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+// buildLockPlan builds the plan of a select from dual that calls named
+// lock functions. The named locks of a keyspace are all taken on the
+// shard of keyspace id 0, so that every session contends for the same
+// locks. Other selects are planned as usual, but they can't acquire or
+// release named locks, because those wouldn't be tracked.
+func buildLockPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	keyspace, err := vschema.DefaultKeyspace()
+	if err != nil {
+		return nil, err
+	}
+	lock := &engine.Lock{
+		Keyspace:          keyspace,
+		TargetDestination: key.DestinationKeyspaceID{0},
+		Query:             sqlparser.String(sel),
+		FieldQuery:        sqlparser.NewTrackedBuffer(sqlparser.FormatImpossibleQuery).WriteNode(sel).String(),
+	}
+	for i, expr := range sel.SelectExprs {
+		if lf := newLockFunc(expr); lf != nil {
+			lf.Column = i
+			lock.LockFuncs = append(lock.LockFuncs, lf)
+		}
+	}
+	return lock, nil
+}
+
+// newLockFunc returns the lock function that acquires or releases a
+// named lock if the select expression is a call to one. The locks can
+// only be tracked if their names are values.
+func newLockFunc(expr sqlparser.SelectExpr) *engine.LockFunc {
+	aliased, ok := expr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil
+	}
+	f, ok := aliased.Expr.(*sqlparser.FuncExpr)
+	if !ok || !f.Qualifier.IsEmpty() {
+		return nil
+	}
+	var action engine.LockAction
+	switch f.Name.Lowered() {
+	case "get_lock":
+		action = engine.LockAcquire
+	case "release_lock":
+		action = engine.LockRelease
+	case "release_all_locks":
+		return &engine.LockFunc{Action: engine.LockReleaseAll}
+	default:
+		return nil
+	}
+	if len(f.Exprs) == 0 {
+		return nil
+	}
+	arg, ok := f.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil
+	}
+	name, err := sqlparser.NewPlanValue(arg.Expr)
+	if err != nil {
+		return nil
+	}
+	return &engine.LockFunc{
+		Action: action,
+		Name:   name,
+	}
+}
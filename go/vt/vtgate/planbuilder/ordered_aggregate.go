@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ builder = (*orderedAggregate)(nil)
@@ -53,10 +54,50 @@ var _ builder = (*orderedAggregate)(nil)
 //      Keys: []int{0, 1},
 //      Input: (Scatter Route with the order by request),
 //    }
+//
+// If the query has a HAVING clause that references the results
+// of the aggregation, an engine.Filter is built on top of the
+// engine.OrderedAggregate to evaluate it.
 type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+	having        evalengine.Expr
+
+	// aggrExprs tracks the aggregate expression computed
+	// by each of the result columns originated by oa.
+	aggrExprs map[*resultColumn]sqlparser.Expr
+
+	// countRequests and groupConcat track the expressions that oa
+	// needs from the underlying route in addition to the select list.
+	// They're pushed down only after all the select expressions are
+	// pushed to preserve the column numbering of the result columns.
+	countRequests []countRequest
+	groupConcat   *groupConcatRequest
+}
+
+// countRequest is a request for a count to be supplied along with the
+// aggregated column of the specified aggregate.
+type countRequest struct {
+	aggrIndex int
+	expr      *sqlparser.AliasedExpr
+}
+
+// groupConcatRequest tracks the information needed to perform a
+// non-distinct group_concat in vtgate. The route is requested to
+// group by the concatenated expression and the order by expressions
+// of the group_concat, and to return the number of rows in each group.
+// This allows vtgate to rebuild the concatenated values in the order
+// requested by the group_concat.
+type groupConcatRequest struct {
+	aggrIndex int
+	col       int
+	orderBy   sqlparser.OrderBy
+
+	// extraGroupBy and extraOrderBy are computed when the
+	// expressions of the group_concat get pushed down.
+	extraGroupBy sqlparser.GroupBy
+	extraOrderBy sqlparser.OrderBy
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -134,6 +175,7 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	pb.bldr = &orderedAggregate{
 		resultsBuilder: newResultsBuilder(rb, eaggr),
 		eaggr:          eaggr,
+		aggrExprs:      make(map[*resultColumn]sqlparser.Expr),
 	}
 	pb.bldr.Reorder(0)
 	return nil
@@ -240,6 +282,12 @@ func findAlias(colname *sqlparser.ColName, selects sqlparser.SelectExprs) sqlpar
 // Primitive satisfies the builder interface.
 func (oa *orderedAggregate) Primitive() engine.Primitive {
 	oa.eaggr.Input = oa.input.Primitive()
+	if oa.having != nil {
+		return &engine.Filter{
+			Predicate: oa.having,
+			Input:     oa.eaggr,
+		}
+	}
 	return oa.eaggr
 }
 
@@ -249,8 +297,131 @@ func (oa *orderedAggregate) PushLock(lock string) error {
 }
 
 // PushFilter satisfies the builder interface.
-func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
-	return errors.New("unsupported: filtering on results of aggregates")
+// Only HAVING clauses are expected here. If the expression does not
+// reference any aggregates, it can be pushed down to the underlying
+// route because it only filters on the grouping columns, which yields
+// the same results whether it's applied per shard or after the merge.
+// Otherwise, the expression is evaluated by vtgate on the aggregated
+// rows, which requires every referenced expression to be in the
+// select list.
+func (oa *orderedAggregate) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	if whereType != sqlparser.HavingStr {
+		return errors.New("unsupported: filtering on results of aggregates")
+	}
+	if !oa.referencesAggregates(filter) {
+		return oa.input.PushFilter(pb, filter, whereType, origin)
+	}
+	original := sqlparser.String(filter)
+	filter, err := oa.replaceAggregates(filter)
+	if err != nil {
+		return err
+	}
+	// vtgate compares strings by their bytes, while mysql uses the
+	// collation of the values, and the aggregated values have no
+	// weight strings to compare instead.
+	if !allComparisons(filter, func(left, right sqlparser.Expr) bool {
+		return isNumericOperand(left) || isNumericOperand(right)
+	}) {
+		return fmt.Errorf("unsupported: in scatter query: string comparison in having clause: %s", original)
+	}
+	predicate, err := sqlparser.Convert(filter, oa.lookupColumn)
+	if err != nil {
+		if err == sqlparser.ExprNotSupported {
+			return fmt.Errorf("unsupported: in scatter query: complex having expression: %s", original)
+		}
+		return err
+	}
+	if oa.having == nil {
+		oa.having = predicate
+		return nil
+	}
+	oa.having = &evalengine.BinaryOp{
+		Expr:  &evalengine.And{},
+		Left:  oa.having,
+		Right: predicate,
+	}
+	return nil
+}
+
+// referencesAggregates returns true if the expression contains
+// aggregate functions, or references aggregates computed by oa.
+func (oa *orderedAggregate) referencesAggregates(expr sqlparser.Expr) bool {
+	if nodeHasAggregates(expr) {
+		return true
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if c, ok := col.Metadata.(*column); ok && c.Origin() == oa {
+				found = true
+				return false, nil
+			}
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
+// replaceAggregates replaces the aggregate functions in the expression
+// with references to the matching aggregates of the select list.
+// For example, in 'select a, count(*) from t group by a having count(*) > 1',
+// count(*) of the HAVING clause gets replaced by a reference to the
+// second result column.
+func (oa *orderedAggregate) replaceAggregates(expr sqlparser.Expr) (sqlparser.Expr, error) {
+	var aggrs []sqlparser.Expr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsAggregate() {
+				aggrs = append(aggrs, node)
+				return false, nil
+			}
+		case *sqlparser.GroupConcatExpr:
+			aggrs = append(aggrs, node)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	for _, aggr := range aggrs {
+		rc := oa.findAggregate(aggr)
+		if rc == nil {
+			return nil, fmt.Errorf("unsupported: in scatter query: aggregate in having clause must be in the select list: %s", sqlparser.String(aggr))
+		}
+		col := &sqlparser.ColName{
+			Metadata: rc.column,
+			Name:     rc.alias,
+		}
+		expr = sqlparser.ReplaceExpr(expr, aggr, col)
+	}
+	return expr, nil
+}
+
+// findAggregate returns the result column of oa that
+// computes the specified aggregate expression.
+func (oa *orderedAggregate) findAggregate(aggr sqlparser.Expr) *resultColumn {
+	want := sqlparser.String(aggr)
+	for _, rc := range oa.resultColumns {
+		expr, ok := oa.aggrExprs[rc]
+		if ok && sqlparser.String(expr) == want {
+			return rc
+		}
+	}
+	return nil
+}
+
+// lookupColumn returns the result column number of the
+// referenced column. It's used for converting HAVING
+// expressions to be evaluated by vtgate.
+func (oa *orderedAggregate) lookupColumn(col *sqlparser.ColName) (int, error) {
+	c, ok := col.Metadata.(*column)
+	if ok {
+		for i, rc := range oa.resultColumns {
+			if rc.column == c {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unsupported: in scatter query: having clause must reference columns in the select list: %s", sqlparser.String(col))
 }
 
 // PushSelect satisfies the builder interface.
@@ -266,10 +437,13 @@ func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, wh
 // others. This functionality depends on the PushOrderBy to request that
 // the rows be correctly ordered.
 func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
-	if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok {
+	switch inner := expr.Expr.(type) {
+	case *sqlparser.FuncExpr:
 		if _, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
 			return oa.pushAggr(pb, expr, origin)
 		}
+	case *sqlparser.GroupConcatExpr:
+		return oa.pushGroupConcat(pb, expr, origin)
 	}
 
 	// Ensure that there are no aggregates in the expression.
@@ -294,7 +468,7 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 		return nil, 0, err
 	}
 	if handleDistinct {
-		if oa.extraDistinct != nil {
+		if oa.extraDistinct != nil || oa.groupConcat != nil {
 			return nil, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
 		}
 		// Push the expression that's inside the aggregate.
@@ -306,12 +480,7 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 		}
 		oa.extraDistinct = col
 		oa.eaggr.HasDistinct = true
-		var alias string
-		if expr.As.IsEmpty() {
-			alias = sqlparser.String(expr.Expr)
-		} else {
-			alias = expr.As.String()
-		}
+		alias := aggregateAlias(expr)
 		switch opcode {
 		case engine.AggregateCount:
 			opcode = engine.AggregateCountDistinct
@@ -323,6 +492,32 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 			Col:    innerCol,
 			Alias:  alias,
 		})
+	} else if opcode == engine.AggregateAvg {
+		if funcExpr.Distinct {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: distinct in avg: %s", sqlparser.String(funcExpr))
+		}
+		// avg is computed by vtgate as sum/count.
+		sumExpr := &sqlparser.AliasedExpr{
+			Expr: &sqlparser.FuncExpr{
+				Name:  sqlparser.NewColIdent("sum"),
+				Exprs: funcExpr.Exprs,
+			},
+		}
+		_, innerCol, _ = oa.input.PushSelect(pb, sumExpr, origin)
+		oa.countRequests = append(oa.countRequests, countRequest{
+			aggrIndex: len(oa.eaggr.Aggregates),
+			expr: &sqlparser.AliasedExpr{
+				Expr: &sqlparser.FuncExpr{
+					Name:  sqlparser.NewColIdent("count"),
+					Exprs: funcExpr.Exprs,
+				},
+			},
+		})
+		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+			Opcode: opcode,
+			Col:    innerCol,
+			Alias:  aggregateAlias(expr),
+		})
 	} else {
 		_, innerCol, _ = oa.input.PushSelect(pb, expr, origin)
 		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
@@ -333,11 +528,131 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 
 	// Build a new rc with oa as origin because it's semantically different
 	// from the expression we pushed down.
+	rc = newResultColumn(expr, oa)
+	switch opcode {
+	case engine.AggregateCount, engine.AggregateCountDistinct:
+		rc.column.typ = sqltypes.Int64
+	case engine.AggregateSum, engine.AggregateSumDistinct, engine.AggregateAvg:
+		rc.column.typ = sqltypes.Decimal
+	}
+	oa.resultColumns = append(oa.resultColumns, rc)
+	oa.aggrExprs[rc] = funcExpr
+	return rc, len(oa.resultColumns) - 1, nil
+}
+
+// pushGroupConcat pushes a group_concat expression. The route is asked
+// to supply the individual values to be concatenated, which are then
+// concatenated by vtgate:
+// If the group_concat is distinct, it's handled like the other distinct
+// aggregates: the value is added to the group by and order by clauses
+// of the route, and vtgate skips the duplicates.
+// Otherwise, the route also groups by the order by expressions of the
+// group_concat and orders by them, and supplies the number of rows that
+// each value represents. See groupConcatRequest.
+func (oa *orderedAggregate) pushGroupConcat(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	gcExpr := expr.Expr.(*sqlparser.GroupConcatExpr)
+	if len(gcExpr.Exprs) != 1 {
+		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(gcExpr))
+	}
+	if gcExpr.Limit != nil {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: limit in group_concat: %s", sqlparser.String(gcExpr))
+	}
+	if oa.extraDistinct != nil || oa.groupConcat != nil {
+		return nil, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(gcExpr))
+	}
+	innerAliased, ok := gcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(gcExpr))
+	}
+	for _, order := range gcExpr.OrderBy {
+		if nodeHasAggregates(order.Expr) {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: complex aggregate expression: %s", sqlparser.String(gcExpr))
+		}
+	}
+	_, innerCol, _ := oa.input.PushSelect(pb, &sqlparser.AliasedExpr{Expr: innerAliased.Expr}, origin)
+	aggr := engine.AggregateParams{
+		Opcode:    engine.AggregateGroupConcat,
+		Col:       innerCol,
+		Separator: gcExpr.Separator,
+		Alias:     aggregateAlias(expr),
+	}
+	if gcExpr.Distinct != "" {
+		for _, order := range gcExpr.OrderBy {
+			if order.Direction != sqlparser.AscScr || sqlparser.String(order.Expr) != sqlparser.String(innerAliased.Expr) {
+				return nil, 0, fmt.Errorf("unsupported: in scatter query: group_concat with distinct can only be ordered by its own expression: %s", sqlparser.String(gcExpr))
+			}
+		}
+		col, err := BuildColName(oa.input.ResultColumns(), innerCol)
+		if err != nil {
+			return nil, 0, err
+		}
+		oa.extraDistinct = col
+		oa.eaggr.HasDistinct = true
+		aggr.Opcode = engine.AggregateGroupConcatDistinct
+	} else {
+		oa.groupConcat = &groupConcatRequest{
+			aggrIndex: len(oa.eaggr.Aggregates),
+			col:       innerCol,
+			orderBy:   gcExpr.OrderBy,
+		}
+		oa.countRequests = append(oa.countRequests, countRequest{
+			aggrIndex: len(oa.eaggr.Aggregates),
+			expr: &sqlparser.AliasedExpr{
+				Expr: &sqlparser.FuncExpr{
+					Name:  sqlparser.NewColIdent("count"),
+					Exprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+				},
+			},
+		})
+	}
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, aggr)
+
 	rc = newResultColumn(expr, oa)
 	oa.resultColumns = append(oa.resultColumns, rc)
+	oa.aggrExprs[rc] = gcExpr
 	return rc, len(oa.resultColumns) - 1, nil
 }
 
+// pushExtraColumns pushes the expressions requested by countRequests
+// and groupConcat to the underlying route. It must be called after
+// all the select expressions have been pushed.
+func (oa *orderedAggregate) pushExtraColumns() {
+	if len(oa.countRequests) == 0 {
+		return
+	}
+	// It's ok to pass nil for pb and builder because the
+	// underlying route doesn't use them.
+	for _, req := range oa.countRequests {
+		_, colNumber, _ := oa.input.PushSelect(nil, req.expr, nil)
+		oa.eaggr.Aggregates[req.aggrIndex].CountCol = colNumber
+	}
+	if gc := oa.groupConcat; gc != nil {
+		for _, order := range gc.orderBy {
+			_, colNumber, _ := oa.input.PushSelect(nil, &sqlparser.AliasedExpr{Expr: order.Expr}, nil)
+			gc.extraGroupBy = append(gc.extraGroupBy, columnNumberExpr(colNumber))
+			gc.extraOrderBy = append(gc.extraOrderBy, &sqlparser.Order{Expr: columnNumberExpr(colNumber), Direction: order.Direction})
+		}
+		gc.extraGroupBy = append(gc.extraGroupBy, columnNumberExpr(gc.col))
+		gc.extraOrderBy = append(gc.extraOrderBy, &sqlparser.Order{Expr: columnNumberExpr(gc.col), Direction: sqlparser.AscScr})
+	}
+	oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+}
+
+// columnNumberExpr returns the expression that references
+// the specified column of the select list by its position.
+func columnNumberExpr(colNumber int) sqlparser.Expr {
+	return sqlparser.NewIntVal([]byte(strconv.Itoa(colNumber + 1)))
+}
+
+// aggregateAlias returns the name of the column
+// produced by the aggregate expression.
+func aggregateAlias(expr *sqlparser.AliasedExpr) string {
+	if expr.As.IsEmpty() {
+		return sqlparser.String(expr.Expr)
+	}
+	return expr.As.String()
+}
+
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
 // If true, it will also return the aliased expression that needs to be pushed
 // down into the underlying route.
@@ -416,6 +731,12 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 		groupBy = append(groupBy, oa.extraDistinct)
 	}
 
+	// All the select expressions have been pushed by now.
+	oa.pushExtraColumns()
+	if oa.groupConcat != nil {
+		groupBy = append(groupBy, oa.groupConcat.extraGroupBy...)
+	}
+
 	_ = oa.input.PushGroupBy(groupBy)
 
 	return nil
@@ -496,6 +817,11 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: oa.extraDistinct, Direction: sqlparser.AscScr})
 	}
 
+	// Append the ordering requested by a group_concat if any.
+	if oa.groupConcat != nil {
+		selOrderBy = append(selOrderBy, oa.groupConcat.extraOrderBy...)
+	}
+
 	// Push down the order by.
 	// It's ok to push the original AST down because all references
 	// should point to the route. Only aggregate functions are originated
@@ -175,6 +175,7 @@ func TestPlan(t *testing.T) {
 	testFile(t, "set_sysvar_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "show_cases.txt", testOutputTempDir, vschemaWrapper)
 }
 
 func TestOne(t *testing.T) {
@@ -237,6 +238,41 @@ func TestOtherPlanningFromFile(t *testing.T) {
 	testFile(t, "other_admin_cases.txt", testOutputTempDir, vschema)
 }
 
+func TestJoinOrderPlanning(t *testing.T) {
+	testOutputTempDir, err := ioutil.TempDir("", "plan_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(testOutputTempDir)
+	vschema := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json"),
+		tableStats: map[string]*TableStatistics{
+			"user.user": {
+				Shards:            4,
+				RowCount:          1000,
+				ColumnCardinality: map[string]uint64{"id": 1000, "name": 500},
+			},
+			"user.user_extra": {
+				Shards:            4,
+				RowCount:          100000,
+				ColumnCardinality: map[string]uint64{"user_id": 1000},
+			},
+			"user.music": {
+				Shards:   4,
+				RowCount: 10000,
+			},
+			"main.unsharded": {
+				Shards:   1,
+				RowCount: 10,
+			},
+			"main.unsharded_a": {
+				Shards:   1,
+				RowCount: 50000,
+			},
+		},
+	}
+
+	testFile(t, "join_order_cases.txt", testOutputTempDir, vschema)
+}
+
 func loadSchema(t *testing.T, filename string) *vindexes.VSchema {
 	formal, err := vindexes.LoadFormal(locateFile(filename))
 	if err != nil {
@@ -261,6 +297,9 @@ type vschemaWrapper struct {
 	keyspace   *vindexes.Keyspace
 	tabletType topodatapb.TabletType
 	dest       key.Destination
+
+	// tableStats is keyed by keyspace and table name, like "main.user".
+	tableStats map[string]*TableStatistics
 }
 
 func (vw *vschemaWrapper) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
@@ -326,6 +365,10 @@ func (vw *vschemaWrapper) TargetString() string {
 	return "targetString"
 }
 
+func (vw *vschemaWrapper) TableStatistics(keyspace, tableName string) *TableStatistics {
+	return vw.tableStats[keyspace+"."+tableName]
+}
+
 func testFile(t *testing.T, filename, tempDir string, vschema *vschemaWrapper) {
 	t.Run(filename, func(t *testing.T) {
 		expected := &strings.Builder{}
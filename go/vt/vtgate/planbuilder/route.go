@@ -130,7 +130,7 @@ func (rb *route) UpdatePlans(pb *primitiveBuilder, filter sqlparser.Expr) {
 
 // PushSelect satisfies the builder interface.
 func (rb *route) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, _ builder) (rc *resultColumn, colNumber int, err error) {
-	sel := rb.Select.(*sqlparser.Select)
+	sel := rb.innerSelect().(*sqlparser.Select)
 	sel.SelectExprs = append(sel.SelectExprs, expr)
 
 	rc = newResultColumn(expr, rb)
@@ -249,6 +249,7 @@ func (rb *route) SetUpperLimit(count *sqlparser.SQLVal) {
 func (rb *route) PushMisc(sel *sqlparser.Select) {
 	rb.Select.(*sqlparser.Select).Comments = sel.Comments
 	rb.Select.(*sqlparser.Select).Lock = sel.Lock
+	rb.Select.(*sqlparser.Select).Windows = sel.Windows
 }
 
 // Wireup satisfies the builder interface.
@@ -267,6 +268,15 @@ func (rb *route) Wireup(bldr builder, jt *jointab) error {
 			}
 			ro.eroute.Values = []sqltypes.PlanValue{pv}
 			vals.Right = sqlparser.ListArg("::" + engine.ListVarName)
+		case sqlparser.ValTuple:
+			// These are the values of the columns of a multi-column vindex.
+			for _, val := range vals {
+				pv, err := rb.procureValues(bldr, jt, val)
+				if err != nil {
+					return err
+				}
+				ro.eroute.Values = append(ro.eroute.Values, pv)
+			}
 		case nil:
 			// no-op.
 		default:
@@ -338,13 +348,19 @@ func systemTable(qualifier string) bool {
 }
 
 func (rb *route) finalizeOptions() {
+	rb.routeOptions = []*routeOption{rb.bestOption()}
+}
+
+// bestOption returns the route option that finalizeOptions would
+// keep, without changing the route.
+func (rb *route) bestOption() *routeOption {
 	bestOption := rb.routeOptions[0]
 	for i := 1; i < len(rb.routeOptions); i++ {
 		if cur := rb.routeOptions[i]; cur.isBetterThan(bestOption) {
 			bestOption = cur
 		}
 	}
-	rb.routeOptions = []*routeOption{bestOption}
+	return bestOption
 }
 
 // procureValues procures and converts the input into
@@ -432,11 +448,15 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if weightcolNumber, ok := rb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	sel, ok := rb.innerSelect().(*sqlparser.Select)
+	if !ok {
+		return 0, fmt.Errorf("unsupported: weight_string of a column of %s", sqlparser.String(rb.Select))
+	}
 	expr := &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
 			Name: sqlparser.NewColIdent("weight_string"),
 			Exprs: []sqlparser.SelectExpr{
-				rb.Select.(*sqlparser.Select).SelectExprs[colNumber],
+				sel.SelectExprs[colNumber],
 			},
 		},
 	}
@@ -446,6 +466,19 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	return weightcolNumber, nil
 }
 
+// innerSelect returns the statement of the route
+// without the parenthesis of a UNION part.
+func (rb *route) innerSelect() sqlparser.SelectStatement {
+	stmt := rb.Select
+	for {
+		paren, ok := stmt.(*sqlparser.ParenSelect)
+		if !ok {
+			return stmt
+		}
+		stmt = paren.Select
+	}
+}
+
 // MergeSubquery returns true if the subquery route could successfully be merged
 // with the outer route.
 func (rb *route) MergeSubquery(pb *primitiveBuilder, inner *route) bool {
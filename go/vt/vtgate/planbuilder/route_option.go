@@ -39,6 +39,15 @@ type routeOption struct {
 	// for the routeOption.
 	vindexMap map[*column]vindexes.SingleColumn
 
+	// multiColVindexes contains the multi-column vindexes
+	// that can be used for the routeOption.
+	multiColVindexes []*multiColVindex
+
+	// primaryVindexColumns contains the columns of the primary
+	// vindex of every table of the routeOption. The rows that have
+	// the same values for them are in the same shard.
+	primaryVindexColumns [][]*column
+
 	// condition stores the AST condition that will be used
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
@@ -51,6 +60,15 @@ type tableSubstitution struct {
 	newExpr, oldExpr *sqlparser.AliasedTableExpr
 }
 
+// multiColVindex is a multi-column vindex of a routeOption.
+// values contains the values of the equality constraints
+// found so far for each of its columns.
+type multiColVindex struct {
+	vindex  vindexes.MultiColumn
+	columns []*column
+	values  []sqlparser.Expr
+}
+
 func newSimpleRouteOption(rb *route, eroute *engine.Route) *routeOption {
 	return &routeOption{
 		rb:     rb,
@@ -58,18 +76,23 @@ func newSimpleRouteOption(rb *route, eroute *engine.Route) *routeOption {
 	}
 }
 
-func newRouteOption(rb *route, vst *vindexes.Table, sub *tableSubstitution, vindexMap map[*column]vindexes.SingleColumn, eroute *engine.Route) *routeOption {
+func newRouteOption(rb *route, vst *vindexes.Table, sub *tableSubstitution, vindexMap map[*column]vindexes.SingleColumn, multiColVindexes []*multiColVindex, primaryVindexColumns []*column, eroute *engine.Route) *routeOption {
 	var subs []*tableSubstitution
 	if sub != nil && sub.newExpr != nil {
 		subs = []*tableSubstitution{sub}
 	}
-	return &routeOption{
-		rb:            rb,
-		vschemaTable:  vst,
-		substitutions: subs,
-		vindexMap:     vindexMap,
-		eroute:        eroute,
+	ro := &routeOption{
+		rb:               rb,
+		vschemaTable:     vst,
+		substitutions:    subs,
+		vindexMap:        vindexMap,
+		multiColVindexes: multiColVindexes,
+		eroute:           eroute,
+	}
+	if primaryVindexColumns != nil {
+		ro.primaryVindexColumns = [][]*column{primaryVindexColumns}
 	}
+	return ro
 }
 
 func (ro *routeOption) JoinCanMerge(pb *primitiveBuilder, rro *routeOption, ajoin *sqlparser.JoinTableExpr) bool {
@@ -77,12 +100,13 @@ func (ro *routeOption) JoinCanMerge(pb *primitiveBuilder, rro *routeOption, ajoi
 		if ajoin == nil {
 			return false
 		}
-		for _, filter := range splitAndExpression(nil, ajoin.Condition.On) {
+		filters := splitAndExpression(nil, ajoin.Condition.On)
+		for _, filter := range filters {
 			if ro.canMergeOnFilter(pb, rro, filter) {
 				return true
 			}
 		}
-		return false
+		return ro.canMergeOnMultiColFilters(pb, rro, filters)
 	})
 }
 
@@ -95,6 +119,12 @@ func (ro *routeOption) MergeJoin(rro *routeOption, isLeftJoin bool) {
 		}
 		ro.vindexMap[c] = v
 	}
+	ro.multiColVindexes = append(ro.multiColVindexes, rro.multiColVindexes...)
+	if !isLeftJoin {
+		// The primary vindex columns of the right side of a left join
+		// are NULL for the rows that have no match, in any shard.
+		ro.primaryVindexColumns = append(ro.primaryVindexColumns, rro.primaryVindexColumns...)
+	}
 }
 
 // merge merges two routeOptions. If the LHS (ro) is a SelectReference,
@@ -136,6 +166,8 @@ func (ro *routeOption) SubqueryToTable(rb *route, vindexMap map[*column]vindexes
 	ro.rb = rb
 	ro.vschemaTable = nil
 	ro.vindexMap = vindexMap
+	ro.multiColVindexes = nil
+	ro.primaryVindexColumns = nil
 }
 
 func (ro *routeOption) canMerge(rro *routeOption, customCheck func() bool) bool {
@@ -193,6 +225,50 @@ func (ro *routeOption) canMergeOnFilter(pb *primitiveBuilder, rro *routeOption,
 	return rVindex == lVindex
 }
 
+// canMergeOnMultiColFilters returns true if the join constraints make
+// the routes mergeable by a multi-column vindex. There has to be an
+// equality like a.col = b.col for every column of the vindex, where
+// both tables have the same multi-column vindex.
+func (ro *routeOption) canMergeOnMultiColFilters(pb *primitiveBuilder, rro *routeOption, filters []sqlparser.Expr) bool {
+	for _, lmcv := range ro.multiColVindexes {
+		for _, rmcv := range rro.multiColVindexes {
+			if lmcv.vindex != rmcv.vindex {
+				continue
+			}
+			matched := 0
+			for i := range lmcv.columns {
+				if !hasColumnEquality(pb, filters, lmcv.columns[i], rmcv.columns[i]) {
+					break
+				}
+				matched++
+			}
+			if matched == len(lmcv.columns) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasColumnEquality returns true if one of the filters is
+// an equality between the two columns.
+func hasColumnEquality(pb *primitiveBuilder, filters []sqlparser.Expr, lcol, rcol *column) bool {
+	for _, filter := range filters {
+		comparison, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || comparison.Operator != sqlparser.EqualStr {
+			continue
+		}
+		left, right := findColumn(pb, comparison.Left), findColumn(pb, comparison.Right)
+		if left == nil || right == nil {
+			continue
+		}
+		if (left == lcol && right == rcol) || (left == rcol && right == lcol) {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdatePlan evaluates the primitive against the specified
 // filter. If it's an improvement, the primitive is updated.
 // We assume that the filter has already been pushed into
@@ -222,7 +298,10 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		case engine.SelectEqualUnique:
 			ro.updateRoute(opcode, vindex, values)
 		case engine.SelectEqual:
-			if vindex.Cost() < ro.eroute.Vindex.Cost() {
+			// The values of a multi-column vindex can only narrow
+			// down its keyrange as more of its columns are known.
+			_, multi := vindex.(vindexes.MultiColumn)
+			if vindex.Cost() < ro.eroute.Vindex.Cost() || (multi && vindex == ro.eroute.Vindex) {
 				ro.updateRoute(opcode, vindex, values)
 			}
 		}
@@ -243,14 +322,14 @@ func (ro *routeOption) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 }
 
-func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	ro.eroute.Opcode = opcode
 	ro.eroute.Vindex = vindex
 	ro.condition = condition
 }
 
 // computePlan computes the plan for the specified filter.
-func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	switch node := filter.(type) {
 	case *sqlparser.ComparisonExpr:
 		switch node.Operator {
@@ -268,7 +347,7 @@ func (ro *routeOption) computePlan(pb *primitiveBuilder, filter sqlparser.Expr)
 }
 
 // computeEqualPlan computes the plan for an equality constraint.
-func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	left := comparison.Left
 	right := comparison.Right
 
@@ -276,47 +355,87 @@ func (ro *routeOption) computeEqualPlan(pb *primitiveBuilder, comparison *sqlpar
 		return engine.SelectNone, nil, nil
 	}
 
-	vindex = ro.FindVindex(pb, left)
-	if vindex == nil {
+	single := ro.FindVindex(pb, left)
+	if single == nil {
+		left, right = right, left
+		single = ro.FindVindex(pb, left)
+		if single == nil {
+			return ro.computeMultiColEqualPlan(pb, comparison)
+		}
+	}
+	if !ro.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+	if single.IsUnique() {
+		return engine.SelectEqualUnique, single, right
+	}
+	return engine.SelectEqual, single, right
+}
+
+// computeMultiColEqualPlan records the value of an equality constraint
+// on a column of a multi-column vindex. If the values of all its columns
+// are known, the plan is for a single shard. If only the values of its
+// leading columns are known, the plan is for the keyrange they map to.
+// The condition is the tuple of the known values.
+func (ro *routeOption) computeMultiColEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	left := comparison.Left
+	right := comparison.Right
+
+	mcv, colIndex := ro.findMultiColVindex(pb, left)
+	if mcv == nil {
 		left, right = right, left
-		vindex = ro.FindVindex(pb, left)
-		if vindex == nil {
+		mcv, colIndex = ro.findMultiColVindex(pb, left)
+		if mcv == nil {
 			return engine.SelectScatter, nil, nil
 		}
 	}
 	if !ro.exprIsValue(right) {
 		return engine.SelectScatter, nil, nil
 	}
-	if vindex.IsUnique() {
-		return engine.SelectEqualUnique, vindex, right
+	if mcv.values[colIndex] == nil {
+		mcv.values[colIndex] = right
+	}
+	var values sqlparser.ValTuple
+	for _, value := range mcv.values {
+		if value == nil {
+			break
+		}
+		values = append(values, value)
+	}
+	switch len(values) {
+	case 0:
+		return engine.SelectScatter, nil, nil
+	case len(mcv.values):
+		return engine.SelectEqualUnique, mcv.vindex, values
 	}
-	return engine.SelectEqual, vindex, right
+	return engine.SelectEqual, mcv.vindex, values
 }
 
 // computeEqualPlan computes the plan for an equality constraint.
-func (ro *routeOption) computeISPlan(pb *primitiveBuilder, comparison *sqlparser.IsExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (ro *routeOption) computeISPlan(pb *primitiveBuilder, comparison *sqlparser.IsExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	// we only handle IS NULL correct. IsExpr can contain other expressions as well
 	if comparison.Operator != sqlparser.IsNullStr {
 		return engine.SelectScatter, nil, nil
 	}
 
-	vindex = ro.FindVindex(pb, comparison.Expr)
+	single := ro.FindVindex(pb, comparison.Expr)
 	// fallback to scatter gather if there is no vindex
-	if vindex == nil {
+	if single == nil {
 		return engine.SelectScatter, nil, nil
 	}
-	if vindex.IsUnique() {
-		return engine.SelectEqualUnique, vindex, &sqlparser.NullVal{}
+	if single.IsUnique() {
+		return engine.SelectEqualUnique, single, &sqlparser.NullVal{}
 	}
-	return engine.SelectEqual, vindex, &sqlparser.NullVal{}
+	return engine.SelectEqual, single, &sqlparser.NullVal{}
 }
 
 // computeINPlan computes the plan for an IN constraint.
-func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
-	vindex = ro.FindVindex(pb, comparison.Left)
-	if vindex == nil {
+func (ro *routeOption) computeINPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	single := ro.FindVindex(pb, comparison.Left)
+	if single == nil {
 		return engine.SelectScatter, nil, nil
 	}
+	vindex = single
 	switch node := comparison.Right.(type) {
 	case sqlparser.ValTuple:
 		if len(node) == 1 && sqlparser.IsNull(node[0]) {
@@ -393,6 +512,45 @@ func (ro *routeOption) FindVindex(pb *primitiveBuilder, expr sqlparser.Expr) vin
 	return ro.vindexMap[c]
 }
 
+// findMultiColVindex returns the multi-column vindex that has
+// the expression as a column, and the index of the column.
+func (ro *routeOption) findMultiColVindex(pb *primitiveBuilder, expr sqlparser.Expr) (*multiColVindex, int) {
+	c := findColumn(pb, expr)
+	if c == nil || c.Origin() != ro.rb {
+		return nil, 0
+	}
+	return ro.multiColVindexFor(c)
+}
+
+// findColumn returns the column the expression refers to,
+// or nil if it's not a column.
+func findColumn(pb *primitiveBuilder, expr sqlparser.Expr) *column {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if col.Metadata == nil {
+		// Find will set the Metadata.
+		if _, _, err := pb.st.Find(col); err != nil {
+			return nil
+		}
+	}
+	return col.Metadata.(*column)
+}
+
+// multiColVindexFor returns the multi-column vindex that
+// has c as a column, and the index of the column.
+func (ro *routeOption) multiColVindexFor(c *column) (*multiColVindex, int) {
+	for _, mcv := range ro.multiColVindexes {
+		for i, mcvCol := range mcv.columns {
+			if mcvCol == c {
+				return mcv, i
+			}
+		}
+	}
+	return nil, 0
+}
+
 // exprIsValue returns true if the expression can be treated as a value
 // for the routeOption. External references are treated as value.
 func (ro *routeOption) exprIsValue(expr sqlparser.Expr) bool {
@@ -185,3 +185,18 @@ func TestIsBetterThan(t *testing.T) {
 		}
 	}
 }
+
+func TestBestOption(t *testing.T) {
+	scatter := &routeOption{eroute: &engine.Route{Opcode: engine.SelectScatter}}
+	unique := &routeOption{eroute: &engine.Route{Opcode: engine.SelectEqualUnique}}
+	rb := &route{routeOptions: []*routeOption{scatter, unique}}
+
+	if got := rb.bestOption(); got != unique {
+		t.Errorf("bestOption: %v, want %v", got.eroute.Opcode, unique.eroute.Opcode)
+	}
+	// bestOption is used to cost candidate plans, and must not
+	// discard the other options.
+	if len(rb.routeOptions) != 2 {
+		t.Errorf("bestOption changed the route options to %d, want 2", len(rb.routeOptions))
+	}
+}
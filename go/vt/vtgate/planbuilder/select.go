@@ -33,10 +33,24 @@ import (
 func buildSelectPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	sel := stmt.(*sqlparser.Select)
 
+	if hasLockFunc(sel, lockFuncs) && isOnlyDual(sel.From) {
+		return buildLockPlan(sel, vschema)
+	}
+	if hasLockFunc(sel, lockChangeFuncs) {
+		return nil, errors.New("unsupported: lock functions in a select with a FROM clause other than dual")
+	}
+
+	if sel.SQLCalcFoundRows && sel.Limit != nil {
+		return buildSQLCalcFoundRowsPlan(sel, vschema)
+	}
+
 	p := tryAtVtgate(sel)
 	if p != nil {
 		return p, nil
 	}
+	if ordered := orderJoins(sel, vschema); ordered != nil {
+		sel = ordered
+	}
 
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 	if err := pb.processSelect(sel, nil); err != nil {
@@ -84,6 +98,13 @@ func buildSelectPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 // pushed into a route, then a primitive is created on top of any
 // of the above trees to make it discard unwanted rows.
 func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab) error {
+	if with := sel.With; with != nil {
+		sel.With = nil
+		if err := inlineCTEs(sel, with); err != nil {
+			return err
+		}
+	}
+
 	if sel.SQLCalcFoundRows && sel.Limit != nil {
 		return vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "sql_calc_found_rows not yet fully supported")
 	}
@@ -118,6 +139,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel); err != nil {
 		return err
 	}
@@ -149,7 +173,7 @@ func tryAtVtgate(sel *sqlparser.Select) engine.Primitive {
 			return nil
 		}
 		var err error
-		exprs[i], err = sqlparser.Convert(expr.Expr)
+		exprs[i], err = sqlparser.Convert(expr.Expr, nil)
 		if err != nil {
 			return nil
 		}
@@ -185,7 +209,19 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	filters := splitAndExpression(nil, in)
 	reorderBySubquery(filters)
 	for _, filter := range filters {
+		// findOrigin rewrites the subqueries it pulls out. Keep a
+		// copy of the filter in case it's pushed as a semi-join.
+		var saved sqlparser.Expr
+		if pb.canSemiJoin(whereType) && hasSubquery(filter) {
+			saved = sqlparser.CloneSQLNode(filter).(sqlparser.Expr)
+		}
 		pullouts, origin, expr, err := pb.findOrigin(filter)
+		if err == errCorrelatedSubquery && saved != nil {
+			if err := pb.pushSemiJoin(saved); err != nil {
+				return err
+			}
+			continue
+		}
 		if err != nil {
 			return err
 		}
@@ -313,47 +349,46 @@ func (pb *primitiveBuilder) expandStar(inrcs []*resultColumn, expr *sqlparser.St
 		return inrcs, false, nil
 	}
 	if expr.TableName.IsEmpty() {
-		for _, t := range tables {
-			// All tables must have authoritative column lists.
-			if !t.isAuthoritative {
-				return inrcs, false, nil
-			}
+		// All tables must have authoritative column lists.
+		// The columns merged by a USING clause are listed once.
+		cols, ok := pb.st.allColumns()
+		if !ok {
+			return inrcs, false, nil
 		}
 		singleTable := false
 		if len(tables) == 1 {
 			singleTable = true
 		}
-		for _, t := range tables {
-			for _, col := range t.columnNames {
-				var expr *sqlparser.AliasedExpr
-				if singleTable {
-					// If there's only one table, we use unqualified column names.
-					expr = &sqlparser.AliasedExpr{
-						Expr: &sqlparser.ColName{
-							Metadata: t.columns[col.Lowered()],
-							Name:     col,
-						},
-					}
-				} else {
-					// If a and b have id as their column, then
-					// select * from a join b should result in
-					// select a.id as id, b.id as id from a join b.
-					expr = &sqlparser.AliasedExpr{
-						Expr: &sqlparser.ColName{
-							Metadata:  t.columns[col.Lowered()],
-							Name:      col,
-							Qualifier: t.alias,
-						},
-						As: col,
-					}
+		for _, tc := range cols {
+			t, col := tc.table, tc.name
+			var expr *sqlparser.AliasedExpr
+			if singleTable {
+				// If there's only one table, we use unqualified column names.
+				expr = &sqlparser.AliasedExpr{
+					Expr: &sqlparser.ColName{
+						Metadata: t.columns[col.Lowered()],
+						Name:     col,
+					},
 				}
-				rc, _, err := pb.bldr.PushSelect(pb, expr, t.Origin())
-				if err != nil {
-					// Unreachable because PushSelect won't fail on ColName.
-					return inrcs, false, err
+			} else {
+				// If a and b have id as their column, then
+				// select * from a join b should result in
+				// select a.id as id, b.id as id from a join b.
+				expr = &sqlparser.AliasedExpr{
+					Expr: &sqlparser.ColName{
+						Metadata:  t.columns[col.Lowered()],
+						Name:      col,
+						Qualifier: t.alias,
+					},
+					As: col,
 				}
-				inrcs = append(inrcs, rc)
 			}
+			rc, _, err := pb.bldr.PushSelect(pb, expr, t.Origin())
+			if err != nil {
+				// Unreachable because PushSelect won't fail on ColName.
+				return inrcs, false, err
+			}
+			inrcs = append(inrcs, rc)
 		}
 		return inrcs, true, nil
 	}
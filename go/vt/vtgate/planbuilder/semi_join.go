@@ -0,0 +1,398 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*semiJoin)(nil)
+
+// semiJoin is the builder for engine.SemiJoin.
+// This gets built if a subquery is correlated and cannot
+// be merged with the route of the outer query.
+type semiJoin struct {
+	order      int
+	underlying builder
+	subquery   builder
+
+	// lhsCols are the columns of the outer query that are
+	// compared with the columns of the subquery results.
+	// listVars are the names of the corresponding list
+	// bind variables used by the subquery.
+	lhsCols  []*sqlparser.ColName
+	listVars []string
+
+	eSemiJoin *engine.SemiJoin
+}
+
+// newSemiJoin builds a new semiJoin.
+func newSemiJoin(opcode engine.SemiJoinOpcode, underlying, subquery builder, lhsCols []*sqlparser.ColName, listVars []string) *semiJoin {
+	sj := &semiJoin{
+		underlying: underlying,
+		subquery:   subquery,
+		lhsCols:    lhsCols,
+		listVars:   listVars,
+		eSemiJoin: &engine.SemiJoin{
+			Opcode:    opcode,
+			ListVars:  make(map[string]int),
+			BatchSize: engine.DefaultSemiJoinBatchSize,
+		},
+	}
+	sj.Reorder(0)
+	return sj
+}
+
+// Order satisfies the builder interface.
+func (sj *semiJoin) Order() int {
+	return sj.order
+}
+
+// Reorder satisfies the builder interface.
+func (sj *semiJoin) Reorder(order int) {
+	sj.underlying.Reorder(order)
+	sj.subquery.Reorder(sj.underlying.Order())
+	sj.order = sj.subquery.Order() + 1
+}
+
+// Primitive satisfies the builder interface.
+func (sj *semiJoin) Primitive() engine.Primitive {
+	sj.eSemiJoin.Left = sj.underlying.Primitive()
+	sj.eSemiJoin.Right = sj.subquery.Primitive()
+	return sj.eSemiJoin
+}
+
+// PushLock satisfies the builder interface.
+func (sj *semiJoin) PushLock(lock string) error {
+	err := sj.subquery.PushLock(lock)
+	if err != nil {
+		return err
+	}
+
+	return sj.underlying.PushLock(lock)
+}
+
+// First satisfies the builder interface.
+func (sj *semiJoin) First() builder {
+	return sj.underlying.First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	return sj.underlying.ResultColumns()
+}
+
+// PushFilter satisfies the builder interface.
+func (sj *semiJoin) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return sj.underlying.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (sj *semiJoin) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return sj.underlying.PushSelect(pb, expr, origin)
+}
+
+// MakeDistinct satisfies the builder interface.
+func (sj *semiJoin) MakeDistinct() error {
+	return sj.underlying.MakeDistinct()
+}
+
+// PushGroupBy satisfies the builder interface.
+func (sj *semiJoin) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return sj.underlying.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// The SemiJoin preserves the order of the outer query,
+// which can therefore be pushed down.
+func (sj *semiJoin) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := sj.underlying.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	sj.underlying = bldr
+	return sj, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because the SemiJoin can discard rows
+// of the outer query. The limit primitive is applied on top.
+func (sj *semiJoin) SetUpperLimit(count *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+// The subquery already received its own misc parts when it was planned.
+func (sj *semiJoin) PushMisc(sel *sqlparser.Select) {
+	sj.underlying.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+// The outer query supplies the key columns, which get truncated
+// from the final results if they weren't already selected.
+func (sj *semiJoin) Wireup(bldr builder, jt *jointab) error {
+	count := len(sj.underlying.ResultColumns())
+	for i, col := range sj.lhsCols {
+		_, lcol := sj.underlying.SupplyCol(col)
+		sj.eSemiJoin.ListVars[sj.listVars[i]] = lcol
+		rcol := i
+		// Like join, compare the weight strings of text
+		// columns because we can't mimic mysql's collations.
+		// Columns of unknown types may be text too.
+		if !isNumericKey(col.Metadata.(*column).typ, sj.subquery.ResultColumns()[i].column.typ) {
+			var err error
+			if lcol, err = sj.underlying.SupplyWeightString(lcol); err != nil {
+				return err
+			}
+			if rcol, err = sj.subquery.SupplyWeightString(rcol); err != nil {
+				return err
+			}
+		}
+		sj.eSemiJoin.LHSKeys = append(sj.eSemiJoin.LHSKeys, lcol)
+		sj.eSemiJoin.RHSKeys = append(sj.eSemiJoin.RHSKeys, rcol)
+	}
+	if len(sj.underlying.ResultColumns()) > count {
+		sj.eSemiJoin.TruncateColumnCount = count
+	}
+	if err := sj.underlying.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	return sj.subquery.Wireup(sj.subquery, jt)
+}
+
+// SupplyVar satisfies the builder interface.
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if from <= sj.underlying.Order() {
+		sj.underlying.SupplyVar(from, to, col, varname)
+		return
+	}
+	sj.subquery.SupplyVar(from, to, col, varname)
+}
+
+// SupplyCol satisfies the builder interface.
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	return sj.underlying.SupplyCol(col)
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (sj *semiJoin) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	return sj.underlying.SupplyWeightString(colNumber)
+}
+
+// errCorrelatedSubquery is returned for correlated subqueries
+// that can neither be merged with the outer query nor be
+// executed as a semi-join.
+var errCorrelatedSubquery = errors.New("unsupported: cross-shard correlated subquery")
+
+// canSemiJoin returns true if the correlated subqueries of
+// a filter of the specified type can be pushed as semi-joins.
+// This is only done for the top level query. Otherwise, the
+// semi-join could end up on the RHS of a left join, or inside
+// another subquery.
+func (pb *primitiveBuilder) canSemiJoin(whereType string) bool {
+	return whereType == sqlparser.WhereStr && pb.st.Outer == nil
+}
+
+// pushSemiJoin pushes a filter containing a correlated subquery that
+// could not be merged with the outer query. The filter must be an
+// EXISTS, NOT EXISTS or IN condition. The subquery can only be correlated
+// through conditions of its WHERE clause like inner_col = outer_col,
+// which are rewritten as inner_col IN ::__sj. Its results are then
+// matched with the rows of the outer query by an engine.SemiJoin.
+// If any of these requirements is not met, errCorrelatedSubquery
+// is returned.
+//
+// The filter must not have been analyzed yet, because findOrigin
+// rewrites the subqueries it could pull out.
+func (pb *primitiveBuilder) pushSemiJoin(filter sqlparser.Expr) error {
+	opcode := engine.Semi
+	var subquery *sqlparser.Subquery
+	var inCol *sqlparser.ColName
+	switch filter := filter.(type) {
+	case *sqlparser.ExistsExpr:
+		subquery = filter.Subquery
+	case *sqlparser.NotExpr:
+		exists, ok := filter.Expr.(*sqlparser.ExistsExpr)
+		if !ok {
+			return errCorrelatedSubquery
+		}
+		opcode = engine.Anti
+		subquery = exists.Subquery
+	case *sqlparser.ComparisonExpr:
+		// NOT IN is not supported because of its NULL semantics.
+		if filter.Operator != sqlparser.InStr {
+			return errCorrelatedSubquery
+		}
+		var ok bool
+		if subquery, ok = filter.Right.(*sqlparser.Subquery); !ok {
+			return errCorrelatedSubquery
+		}
+		if inCol, ok = filter.Left.(*sqlparser.ColName); !ok {
+			return errCorrelatedSubquery
+		}
+		if _, isLocal, err := pb.st.Find(inCol); err != nil {
+			return err
+		} else if !isLocal {
+			return errCorrelatedSubquery
+		}
+	default:
+		return errCorrelatedSubquery
+	}
+	sel, ok := subquery.Select.(*sqlparser.Select)
+	if !ok || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || nodeHasAggregates(sel.SelectExprs) {
+		return errCorrelatedSubquery
+	}
+	var inInnerCol *sqlparser.ColName
+	if inCol != nil {
+		if len(sel.SelectExprs) != 1 {
+			return errCorrelatedSubquery
+		}
+		expr, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+		if !ok {
+			return errCorrelatedSubquery
+		}
+		if inInnerCol, ok = expr.Expr.(*sqlparser.ColName); !ok {
+			return errCorrelatedSubquery
+		}
+	}
+
+	// The subquery is analyzed to find its external references,
+	// and a fresh copy of it is rewritten and planned separately.
+	stmt, err := sqlparser.Parse(sqlparser.String(sel))
+	if err != nil {
+		return err
+	}
+	rsel := stmt.(*sqlparser.Select)
+	var conds, rconds []sqlparser.Expr
+	if sel.Where != nil {
+		conds = splitAndExpression(nil, sel.Where.Expr)
+		rconds = splitAndExpression(nil, rsel.Where.Expr)
+	}
+	spb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	if err := spb.processSelect(sel, pb.st); err != nil {
+		return err
+	}
+	externs := make(map[*sqlparser.ColName]bool)
+	for _, extern := range spb.st.Externs {
+		externs[extern] = true
+	}
+	if inInnerCol != nil && externs[inInnerCol] {
+		return errCorrelatedSubquery
+	}
+
+	var lhsCols []*sqlparser.ColName
+	var innerCols []*sqlparser.ColName
+	var listVars []string
+	consumed := 0
+	rsel.Where = nil
+	for i, cond := range conds {
+		outer, inner, correlated := correlationColumns(cond, externs)
+		if !correlated {
+			rsel.AddWhere(rconds[i])
+			continue
+		}
+		if outer == nil {
+			return errCorrelatedSubquery
+		}
+		// The inner column is taken from the fresh copy.
+		rcond := rconds[i].(*sqlparser.ComparisonExpr)
+		rinner := rcond.Left.(*sqlparser.ColName)
+		if inner == cond.(*sqlparser.ComparisonExpr).Right {
+			rinner = rcond.Right.(*sqlparser.ColName)
+		}
+		lhsCols = append(lhsCols, outer)
+		innerCols = append(innerCols, rinner)
+		consumed++
+	}
+	// Every external reference must be a correlation condition.
+	if consumed == 0 || consumed != len(externs) {
+		return errCorrelatedSubquery
+	}
+	if inInnerCol != nil {
+		lhsCols = append(lhsCols, inCol)
+		innerCols = append(innerCols, rsel.SelectExprs[0].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName))
+	}
+	for _, col := range lhsCols {
+		if _, isLocal, _ := pb.st.Find(col); !isLocal {
+			return errCorrelatedSubquery
+		}
+	}
+
+	// The subquery selects the inner columns, and restricts
+	// them to the values of the outer columns.
+	rsel.SelectExprs = nil
+	rsel.OrderBy = nil
+	for _, col := range innerCols {
+		listVar := pb.jt.GenerateSemiJoinVar()
+		listVars = append(listVars, listVar)
+		rsel.SelectExprs = append(rsel.SelectExprs, &sqlparser.AliasedExpr{Expr: col})
+		copied := *col
+		rsel.AddWhere(&sqlparser.ComparisonExpr{
+			Operator: sqlparser.InStr,
+			Left:     &copied,
+			Right:    sqlparser.ListArg([]byte("::" + listVar)),
+		})
+	}
+	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	if err := rpb.processSelect(rsel, nil); err != nil {
+		return err
+	}
+	// The SemiJoin compares the keys in vtgate.
+	for i, col := range lhsCols {
+		if !isComparableKey(col.Metadata.(*column).typ, rpb.bldr.ResultColumns()[i].column.typ) {
+			return errCorrelatedSubquery
+		}
+	}
+	pb.bldr = newSemiJoin(opcode, pb.bldr, rpb.bldr, lhsCols, listVars)
+	return nil
+}
+
+// correlationColumns returns the outer and inner columns of
+// a condition like outer_col = inner_col, where outer_col is
+// an external reference of the subquery and inner_col isn't.
+// correlated is false if the condition has no external references.
+// If it has some, but isn't of that form, nil columns are returned.
+func correlationColumns(cond sqlparser.Expr, externs map[*sqlparser.ColName]bool) (outer, inner *sqlparser.ColName, correlated bool) {
+	hasExterns := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok && externs[col] {
+			hasExterns = true
+		}
+		return true, nil
+	}, cond)
+	if !hasExterns {
+		return nil, nil, false
+	}
+	comparison, ok := cond.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualStr {
+		return nil, nil, true
+	}
+	left, lok := comparison.Left.(*sqlparser.ColName)
+	right, rok := comparison.Right.(*sqlparser.ColName)
+	if !lok || !rok {
+		return nil, nil, true
+	}
+	switch {
+	case externs[left] && !externs[right]:
+		return left, right, true
+	case externs[right] && !externs[left]:
+		return right, left, true
+	}
+	return nil, nil, true
+}
@@ -33,10 +33,47 @@ import (
 
 var sysVarPlanningFunc = map[string]func(expr *sqlparser.SetExpr, vschema ContextVSchema) (engine.SetOp, error){}
 
+// sessionSysVars are the system variables that change the semantics of the
+// queries of a session. They are kept in the session, and applied to the
+// connections of the tablets when they differ from the values of the tablets.
+var sessionSysVars = []string{
+	"big_tables",
+	"block_encryption_mode",
+	"collation_connection",
+	"default_tmp_storage_engine",
+	"default_week_format",
+	"div_precision_increment",
+	"explicit_defaults_for_timestamp",
+	"foreign_key_checks",
+	"group_concat_max_len",
+	"innodb_lock_wait_timeout",
+	"lc_messages",
+	"lc_time_names",
+	"max_execution_time",
+	"max_heap_table_size",
+	"max_join_size",
+	"max_sort_length",
+	"optimizer_switch",
+	"sort_buffer_size",
+	"sql_big_selects",
+	"sql_buffer_result",
+	"sql_mode",
+	"sql_notes",
+	"sql_quote_show_create",
+	"sql_safe_updates",
+	"sql_warnings",
+	"time_zone",
+	"tmp_table_size",
+	"unique_checks",
+	"updatable_views_with_limit",
+}
+
 func init() {
 	sysVarPlanningFunc["default_storage_engine"] = buildSetOpIgnore
-	sysVarPlanningFunc["sql_mode"] = buildSetOpCheckAndIgnore
-	sysVarPlanningFunc["sql_safe_updates"] = buildSetOpVarSet
+	sysVarPlanningFunc["sql_log_bin"] = buildSetOpCheckAndIgnore
+	for _, name := range sessionSysVars {
+		sysVarPlanningFunc[name] = buildSetOpVarSet
+	}
 }
 
 func buildSetPlan(stmt *sqlparser.Set, vschema ContextVSchema) (engine.Primitive, error) {
@@ -50,7 +87,7 @@ func buildSetPlan(stmt *sqlparser.Set, vschema ContextVSchema) (engine.Primitive
 		case sqlparser.GlobalStr:
 			return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported in set: global")
 		case "":
-			exp, err := sqlparser.Convert(expr.Expr)
+			exp, err := sqlparser.Convert(expr.Expr, nil)
 			if err == nil {
 				setOp = &engine.UserDefinedVariable{
 					Name: expr.Name.Lowered(),
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// buildShowPlan builds the plan of the SHOW statements about the tables
+// of a keyspace. The other SHOW statements are answered by vtgate itself,
+// and aren't planned.
+//
+// The keyspace is removed from the statement, so that every tablet
+// answers it for its own MySQL database, whatever its name is.
+func buildShowPlan(stmt *sqlparser.Show, vschema ContextVSchema) (engine.Primitive, error) {
+	showType := strings.ToLower(stmt.Type)
+	var table *sqlparser.TableName
+	switch showType {
+	case "columns", "fields", "index", "indexes", "keys":
+		table = &stmt.OnTable
+	case "create table":
+		table = &stmt.Table
+	case "tables", engine.ShowTableStatus:
+	default:
+		return nil, ErrPlanNotSupported
+	}
+
+	var tableName sqlparser.TableName
+	if table != nil {
+		tableName = *table
+		table.Qualifier = sqlparser.NewTableIdent("")
+	}
+	if stmt.ShowTablesOpt != nil && stmt.ShowTablesOpt.DbName != "" {
+		tableName.Qualifier = sqlparser.NewTableIdent(stmt.ShowTablesOpt.DbName)
+		stmt.ShowTablesOpt.DbName = ""
+	}
+	query := sqlparser.String(stmt)
+
+	if vschema.Destination() != nil {
+		// The statement is sent to the shard targeted by the session.
+		return buildOtherReadAndAdmin(query, vschema)
+	}
+
+	var keyspace *vindexes.Keyspace
+	var err error
+	if table != nil {
+		keyspace, err = showTableKeyspace(tableName, vschema)
+	} else {
+		keyspace, err = showKeyspace(tableName.Qualifier.String(), vschema)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &engine.Show{
+		Keyspace: keyspace,
+		Type:     showType,
+		Query:    query,
+	}, nil
+}
+
+// showTableKeyspace returns the keyspace of a table. Tables that aren't
+// in the VSchema can only be found in an explicitly named keyspace.
+func showTableKeyspace(tableName sqlparser.TableName, vschema ContextVSchema) (*vindexes.Keyspace, error) {
+	table, _, _, _, err := vschema.FindTable(tableName)
+	if err == nil {
+		return table.Keyspace, nil
+	}
+	if tableName.Qualifier.IsEmpty() {
+		return nil, err
+	}
+	return showKeyspace(tableName.Qualifier.String(), vschema)
+}
+
+// showKeyspace returns the keyspace named by a SHOW statement,
+// or the default keyspace if it doesn't name one.
+func showKeyspace(name string, vschema ContextVSchema) (*vindexes.Keyspace, error) {
+	if name == "" {
+		return vschema.DefaultKeyspace()
+	}
+	_, keyspace, _, err := vschema.TargetDestination(name)
+	return keyspace, err
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// buildSQLCalcFoundRowsPlan builds the plan of a SELECT SQL_CALC_FOUND_ROWS
+// with a LIMIT. The rows are returned by the select without SQL_CALC_FOUND_ROWS,
+// and the rows it would return without the LIMIT are counted by another select,
+// since the shards can't count them for the whole keyspace. If that select can't
+// be planned, vtgate counts the rows of the select without the LIMIT.
+func buildSQLCalcFoundRowsPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	limitSel := sqlparser.CloneSQLNode(sel).(*sqlparser.Select)
+	limitSel.SQLCalcFoundRows = false
+	limitPlan, err := buildSelectPlan(limitSel, vschema)
+	if err != nil {
+		return nil, err
+	}
+
+	countSel := countSelectFor(sel)
+	countPlan, err := buildSelectPlan(countSel, vschema)
+	if err == nil {
+		return &engine.SQLCalcFoundRows{
+			LimitPrimitive: limitPlan,
+			CountPrimitive: countPlan,
+		}, nil
+	}
+
+	// The count can't be computed by the shards, like for joins across
+	// shards or a DISTINCT on columns that aren't a vindex. The rows of
+	// the select without the LIMIT are counted by vtgate instead.
+	rowsSel := sqlparser.CloneSQLNode(sel).(*sqlparser.Select)
+	rowsSel.SQLCalcFoundRows = false
+	rowsSel.OrderBy = nil
+	rowsSel.Limit = nil
+	rowsPlan, err := buildSelectPlan(rowsSel, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.SQLCalcFoundRows{
+		LimitPrimitive: limitPlan,
+		CountPrimitive: rowsPlan,
+		CountRows:      true,
+	}, nil
+}
+
+// countSelectFor returns the select that counts the rows that
+// sel returns without its LIMIT. If sel returns a row per row of its
+// tables, its select expressions are replaced by count(*). Otherwise,
+// the rows of sel are counted in a derived table.
+func countSelectFor(sel *sqlparser.Select) *sqlparser.Select {
+	countSel := sqlparser.CloneSQLNode(sel).(*sqlparser.Select)
+	countSel.SQLCalcFoundRows = false
+	countSel.OrderBy = nil
+	countSel.Limit = nil
+	countExprs := sqlparser.SelectExprs{&sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name:  sqlparser.NewColIdent("count"),
+			Exprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+		},
+	}}
+	if !countSel.Distinct && countSel.GroupBy == nil && countSel.Having == nil && !nodeHasAggregates(countSel.SelectExprs) {
+		countSel.SelectExprs = countExprs
+		return countSel
+	}
+	return &sqlparser.Select{
+		SelectExprs: countExprs,
+		From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.Subquery{Select: countSel},
+			As:   sqlparser.NewTableIdent("t"),
+		}},
+	}
+}
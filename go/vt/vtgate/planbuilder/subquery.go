@@ -22,6 +22,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ builder = (*subquery)(nil)
@@ -35,10 +36,23 @@ var _ builder = (*subquery)(nil)
 // a new route that keeps the subquery in the FROM
 // clause, because a route is more versatile than
 // a subquery.
+// Filters and expressions on the results of the
+// subquery are evaluated by vtgate.
 type subquery struct {
 	builderCommon
 	resultColumns []*resultColumn
 	esubquery     *engine.Subquery
+
+	// filter is the predicate that the rows of the
+	// subquery must satisfy. It's nil if there's no filter.
+	filter evalengine.Expr
+
+	// eprojection evaluates the expressions pushed into
+	// the select list, which are appended to the rows of the
+	// subquery. exprCols maps the index of each of those
+	// columns in esubquery.Cols to its expression.
+	eprojection *engine.Projection
+	exprCols    map[int]int
 }
 
 // newSubquery builds a new subquery.
@@ -70,7 +84,24 @@ func newSubquery(alias sqlparser.TableIdent, bldr builder) (*subquery, *symtab,
 
 // Primitive satisfies the builder interface.
 func (sq *subquery) Primitive() engine.Primitive {
-	sq.esubquery.Subquery = sq.input.Primitive()
+	input := sq.input.Primitive()
+	if sq.filter != nil {
+		input = &engine.Filter{
+			Predicate: sq.filter,
+			Input:     input,
+		}
+	}
+	if sq.eprojection != nil {
+		// The evaluated columns come after the
+		// columns returned by the input.
+		width := len(sq.input.ResultColumns())
+		for i, exprNumber := range sq.exprCols {
+			sq.esubquery.Cols[i] = width + exprNumber
+		}
+		sq.eprojection.Input = input
+		input = sq.eprojection
+	}
+	sq.esubquery.Subquery = input
 	return sq.esubquery
 }
 
@@ -90,15 +121,36 @@ func (sq *subquery) ResultColumns() []*resultColumn {
 }
 
 // PushFilter satisfies the builder interface.
-func (sq *subquery) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
-	return errors.New("unsupported: filtering on results of cross-shard subquery")
+func (sq *subquery) PushFilter(_ *primitiveBuilder, filter sqlparser.Expr, whereType string, _ builder) error {
+	predicate, err := sqlparser.Convert(filter, sq.lookupColumn)
+	if err != nil {
+		if err == sqlparser.ExprNotSupported {
+			return errors.New("unsupported: filtering on results of cross-shard subquery")
+		}
+		return err
+	}
+	if sq.filter == nil {
+		sq.filter = predicate
+		return nil
+	}
+	sq.filter = &evalengine.BinaryOp{
+		Expr:  &evalengine.And{},
+		Left:  sq.filter,
+		Right: predicate,
+	}
+	return nil
 }
 
 // PushSelect satisfies the builder interface.
 func (sq *subquery) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, _ builder) (rc *resultColumn, colNumber int, err error) {
 	col, ok := expr.Expr.(*sqlparser.ColName)
 	if !ok {
-		return nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+		if err := sq.pushExpr(expr); err != nil {
+			return nil, 0, err
+		}
+		rc = newResultColumn(expr, sq)
+		sq.resultColumns = append(sq.resultColumns, rc)
+		return rc, len(sq.resultColumns) - 1, nil
 	}
 
 	// colNumber should already be set for subquery columns.
@@ -112,6 +164,44 @@ func (sq *subquery) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr,
 	return rc, len(sq.resultColumns) - 1, nil
 }
 
+// pushExpr adds an expression to be evaluated by
+// vtgate to the columns returned by sq.
+func (sq *subquery) pushExpr(expr *sqlparser.AliasedExpr) error {
+	evalExpr, err := sqlparser.Convert(expr.Expr, sq.lookupColumn)
+	if err != nil {
+		if err == sqlparser.ExprNotSupported {
+			return errors.New("unsupported: expression on results of a cross-shard subquery")
+		}
+		return err
+	}
+	if sq.eprojection == nil {
+		sq.eprojection = &engine.Projection{}
+		sq.exprCols = make(map[int]int)
+	}
+	name := expr.As.String()
+	if name == "" {
+		name = sqlparser.String(expr.Expr)
+	}
+	sq.exprCols[len(sq.esubquery.Cols)] = len(sq.eprojection.Exprs)
+	sq.eprojection.Exprs = append(sq.eprojection.Exprs, evalExpr)
+	sq.eprojection.Cols = append(sq.eprojection.Cols, name)
+	// The column number is set by Primitive, once the number
+	// of columns returned by the input is final.
+	sq.esubquery.Cols = append(sq.esubquery.Cols, -1)
+	return nil
+}
+
+// lookupColumn returns the number of the column of the input
+// that is referenced. It's used for converting expressions on
+// the results of the subquery to be evaluated by vtgate.
+func (sq *subquery) lookupColumn(col *sqlparser.ColName) (int, error) {
+	c, ok := col.Metadata.(*column)
+	if !ok || c.origin != sq {
+		return 0, sqlparser.ExprNotSupported
+	}
+	return c.colNumber, nil
+}
+
 // MakeDistinct satisfies the builder interface.
 func (sq *subquery) MakeDistinct() error {
 	return errors.New("unsupported: distinct on cross-shard subquery")
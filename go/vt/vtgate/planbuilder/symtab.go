@@ -61,6 +61,19 @@ type symtab struct {
 	// the symbol table are part of the same route.
 	singleRoute *route
 
+	// columnOrder is set if the tables are joined with a
+	// USING clause. It lists the columns of a star expansion,
+	// without the duplicates that the USING clause merges.
+	// hasUsing remains set if the order can't be tracked
+	// because a table doesn't have an authoritative column list.
+	columnOrder []tableColumn
+	hasUsing    bool
+
+	// usingColumns contains the columns merged by USING clauses.
+	// They can be referenced without a qualifier, even though
+	// both sides of the join have a column of the same name.
+	usingColumns map[string]tableColumn
+
 	ResultColumns []*resultColumn
 	Outer         *symtab
 	Externs       []*sqlparser.ColName
@@ -86,19 +99,23 @@ func newSymtabWithRoute(rb *route) *symtab {
 
 // AddVSchemaTable takes a list of vschema tables as input and
 // creates a table with multiple route options. It returns a
-// list of vindex maps, one for each input.
-func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vindexes.Table, rb *route) (vindexMaps []map[*column]vindexes.SingleColumn, err error) {
+// list of vindex maps, one for each input, the list of
+// multi-column vindexes of each input, and the columns of
+// the primary vindex of each input.
+func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vindexes.Table, rb *route) (vindexMaps []map[*column]vindexes.SingleColumn, multiColVindexes [][]*multiColVindex, primaryVindexColumns [][]*column, err error) {
 	t := &table{
 		alias:  alias,
 		origin: rb,
 	}
 
 	vindexMaps = make([]map[*column]vindexes.SingleColumn, len(vschemaTables))
+	multiColVindexes = make([][]*multiColVindex, len(vschemaTables))
+	primaryVindexColumns = make([][]*column, len(vschemaTables))
 	for i, vst := range vschemaTables {
 		// The following logic allows the first table to be authoritative while the rest
 		// are not. But there's no need to reveal this flexibility to the user.
 		if i != 0 && vst.ColumnListAuthoritative && !t.isAuthoritative {
-			return nil, fmt.Errorf("intermixing of authoritative and non-authoritative tables not allowed: %v", vst.Name)
+			return nil, nil, nil, fmt.Errorf("intermixing of authoritative and non-authoritative tables not allowed: %v", vst.Name)
 		}
 
 		for _, col := range vst.Columns {
@@ -107,7 +124,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 				st:     st,
 				typ:    col.Type,
 			}); err != nil {
-				return nil, err
+				return nil, nil, nil, err
 			}
 		}
 		if i == 0 && vst.ColumnListAuthoritative {
@@ -117,6 +134,24 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 
 		var vindexMap map[*column]vindexes.SingleColumn
 		for _, cv := range vst.ColumnVindexes {
+			if multi, ok := cv.Vindex.(vindexes.MultiColumn); ok {
+				mcv := &multiColVindex{
+					vindex: multi,
+					values: make([]sqlparser.Expr, len(cv.Columns)),
+				}
+				for _, cvcol := range cv.Columns {
+					col, err := t.mergeColumn(cvcol, &column{
+						origin: rb,
+						st:     st,
+					})
+					if err != nil {
+						return nil, nil, nil, err
+					}
+					mcv.columns = append(mcv.columns, col)
+				}
+				multiColVindexes[i] = append(multiColVindexes[i], mcv)
+				continue
+			}
 			single, ok := cv.Vindex.(vindexes.SingleColumn)
 			if !ok {
 				continue
@@ -127,7 +162,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 					st:     st,
 				})
 				if err != nil {
-					return nil, err
+					return nil, nil, nil, err
 				}
 				if j == 0 {
 					// For now, only the first column is used for vindex Map functions.
@@ -141,6 +176,11 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 			}
 		}
 		vindexMaps[i] = vindexMap
+		if len(vst.ColumnVindexes) != 0 {
+			for _, cvcol := range vst.ColumnVindexes[0].Columns {
+				primaryVindexColumns[i] = append(primaryVindexColumns[i], t.columns[cvcol.Lowered()])
+			}
+		}
 
 		if ai := vst.AutoIncrement; ai != nil {
 			if _, ok := t.columns[ai.Column.Lowered()]; !ok {
@@ -148,15 +188,15 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 					origin: rb,
 					st:     st,
 				}); err != nil {
-					return nil, err
+					return nil, nil, nil, err
 				}
 			}
 		}
 	}
 	if err := st.AddTable(t); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	return vindexMaps, nil
+	return vindexMaps, multiColVindexes, primaryVindexColumns, nil
 }
 
 // Merge merges the new symtab into the current one.
@@ -171,14 +211,98 @@ func (st *symtab) Merge(newsyms *symtab) error {
 		// we treat the merged symtab as having anonymous tables.
 		return nil
 	}
+	var columnOrder []tableColumn
+	if st.hasUsing || newsyms.hasUsing {
+		left, lok := st.allColumns()
+		right, rok := newsyms.allColumns()
+		if lok && rok {
+			columnOrder = append(append(columnOrder, left...), right...)
+		}
+	}
+	// The merged columns of a USING clause remain unique
+	// unless the other side has a column of the same name.
+	var usingColumns map[string]tableColumn
+	for name, tc := range newsyms.usingColumns {
+		if _, ok := st.uniqueColumns[name]; !ok && newsyms.uniqueColumns[name] == tc.column() {
+			if usingColumns == nil {
+				usingColumns = make(map[string]tableColumn)
+			}
+			usingColumns[name] = tc
+		}
+	}
 	for _, t := range newsyms.tables {
 		if err := st.AddTable(t); err != nil {
 			return err
 		}
 	}
+	for name, tc := range st.usingColumns {
+		if st.uniqueColumns[name] != tc.column() {
+			delete(st.usingColumns, name)
+		}
+	}
+	for name, tc := range usingColumns {
+		st.setUsingColumn(name, tc)
+	}
+	if st.hasUsing || newsyms.hasUsing {
+		st.columnOrder = columnOrder
+		st.hasUsing = true
+	}
 	return nil
 }
 
+// allColumns returns the columns of all the tables in the order
+// of a star expansion. It returns false if the column lists
+// are not authoritative.
+func (st *symtab) allColumns() ([]tableColumn, bool) {
+	if st.columnOrder != nil {
+		return st.columnOrder, true
+	}
+	if st.hasUsing || len(st.tableNames) == 0 {
+		return nil, false
+	}
+	var cols []tableColumn
+	for _, t := range st.AllTables() {
+		if !t.isAuthoritative {
+			return nil, false
+		}
+		for _, name := range t.columnNames {
+			cols = append(cols, tableColumn{table: t, name: name})
+		}
+	}
+	return cols, true
+}
+
+// SetUsingColumns records the columns merged by the USING clause of a
+// join, after the symtab of its right side was merged. left and right
+// are the columns of each side, and using contains the matched pairs.
+// The merged columns come first in a star expansion, and unqualified
+// references to them resolve to the column of the left side.
+func (st *symtab) SetUsingColumns(left, right []tableColumn, using [][2]tableColumn) {
+	merged := make(map[*column]bool)
+	order := make([]tableColumn, 0, len(left)+len(right)-len(using))
+	for _, pair := range using {
+		order = append(order, pair[0])
+		merged[pair[0].column()] = true
+		merged[pair[1].column()] = true
+		st.setUsingColumn(pair[0].name.Lowered(), pair[0])
+	}
+	for _, tc := range append(append([]tableColumn(nil), left...), right...) {
+		if !merged[tc.column()] {
+			order = append(order, tc)
+		}
+	}
+	st.columnOrder = order
+	st.hasUsing = true
+}
+
+func (st *symtab) setUsingColumn(name string, tc tableColumn) {
+	if st.usingColumns == nil {
+		st.usingColumns = make(map[string]tableColumn)
+	}
+	st.usingColumns[name] = tc
+	st.uniqueColumns[name] = tc.column()
+}
+
 // AddTable adds a table to symtab.
 func (st *symtab) AddTable(t *table) error {
 	if rb, ok := t.origin.(*route); !ok || rb.Resolve() != st.singleRoute {
@@ -344,6 +468,13 @@ func (st *symtab) searchTables(col *sqlparser.ColName) (*column, error) {
 	// @@ syntax is only allowed for dual tables, in which case there should be
 	// only one in the symtab. So, such expressions will be implicitly matched.
 	if col.Qualifier.IsEmpty() || strings.HasPrefix(col.Qualifier.Name.String(), "@@") {
+		// The columns merged by a USING clause get qualified, because
+		// the clause is rewritten as an ON clause for the underlying
+		// routes, which makes the unqualified name ambiguous.
+		if tc, ok := st.usingColumns[col.Name.Lowered()]; ok && col.Qualifier.IsEmpty() {
+			col.Qualifier = tc.table.alias
+			return tc.column(), nil
+		}
 		// Search uniqueColumns first. If found, our job is done.
 		// Check for nil because there can be nil entries if there
 		// are duplicate columns across multiple tables.
@@ -446,6 +577,16 @@ func (st *symtab) ResolveSymbols(node sqlparser.SQLNode) error {
 	}, node)
 }
 
+// tableColumn is a column of a table of the symtab.
+type tableColumn struct {
+	table *table
+	name  sqlparser.ColIdent
+}
+
+func (tc tableColumn) column() *column {
+	return tc.table.columns[tc.name.Lowered()]
+}
+
 // table is part of symtab.
 // It represents a table alias in a FROM clause. It points
 // to the builder that represents it.
@@ -278,7 +278,7 @@ func TestSymtabAddVSchemaTable(t *testing.T) {
 	out := []string{"c1", "c2"}
 	for _, tcase := range tcases {
 		st := newSymtab()
-		vindexMaps, err := st.AddVSchemaTable(tname, tcase.in, rb)
+		vindexMaps, _, _, err := st.AddVSchemaTable(tname, tcase.in, rb)
 		tcasein, _ := json.Marshal(tcase.in)
 		if err != nil {
 			if err.Error() != tcase.err {
@@ -40,6 +40,13 @@ func buildUnionPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Pr
 }
 
 func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab) error {
+	if with := union.With; with != nil {
+		union.With = nil
+		if err := inlineCTEs(union, with); err != nil {
+			return err
+		}
+	}
+
 	if err := pb.processPart(union.FirstStatement, outer, false); err != nil {
 		return err
 	}
@@ -50,10 +57,6 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 		}
 		err := unionRouteMerge(pb.bldr, rpb.bldr, us)
 		if err != nil {
-			if us.Type != sqlparser.UnionAllStr {
-				return err
-			}
-
 			// we are merging between two routes - let's check if we can see so that we have the same amount of columns on both sides of the union
 			lhsCols := len(pb.bldr.ResultColumns())
 			rhsCols := len(rpb.bldr.ResultColumns())
@@ -66,10 +69,25 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 				}
 			}
 
+			lhs := pb.bldr
+			if us.Type != sqlparser.UnionAllStr {
+				// A UNION DISTINCT also removes the duplicates of the previous
+				// parts. So, if they're already under a distinct, it's reused.
+				if d, ok := lhs.(*distinct); ok {
+					lhs = d.input
+				}
+			}
 			pb.bldr = &concatenate{
-				lhs: pb.bldr,
+				lhs: lhs,
 				rhs: rpb.bldr,
 			}
+			if us.Type != sqlparser.UnionAllStr {
+				// The columns of a '*' expression are unknown.
+				if hasStar(pb.bldr) {
+					return errors.New("unsupported: '*' expression in cross-shard query")
+				}
+				pb.bldr = newDistinct(pb.bldr)
+			}
 		}
 		pb.st.Outer = outer
 	}
@@ -148,3 +166,27 @@ func unionRouteMerge(left, right builder, us *sqlparser.UnionSelect) error {
 
 	return nil
 }
+
+// hasStar returns true if a route of the union
+// has a '*' expression in its select list.
+func hasStar(bldr builder) bool {
+	switch bldr := bldr.(type) {
+	case *route:
+		found := false
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			switch node.(type) {
+			case *sqlparser.StarExpr:
+				found = true
+			case *sqlparser.Subquery:
+				return false, nil
+			}
+			return !found, nil
+		}, bldr.Select)
+		return found
+	case *concatenate:
+		return hasStar(bldr.lhs) || hasStar(bldr.rhs)
+	case *distinct:
+		return hasStar(bldr.input)
+	}
+	return false
+}
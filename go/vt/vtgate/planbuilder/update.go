@@ -29,7 +29,7 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	dml, ksidVindex, ksidCols, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -42,12 +42,30 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		return eupd, nil
 	}
 
+	if isVindexChanging(upd.Exprs, eupd.Table.ColumnVindexes[:1]) {
+		where := upd.Where
+		if dml.Opcode == engine.MultiTable {
+			where = multiTableWhere(eupd.Table)
+		}
+		if err := buildMovePlan(upd, eupd, where); err != nil {
+			return nil, err
+		}
+		eupd.KsidVindex = ksidVindex
+		eupd.KsidLength = len(ksidCols)
+		return eupd, nil
+	}
+
 	if eupd.ChangedVindexValues, err = buildChangedVindexesValues(upd, eupd.Table.ColumnVindexes); err != nil {
 		return nil, err
 	}
 	if len(eupd.ChangedVindexValues) != 0 {
-		eupd.OwnedVindexQuery = generateDMLSubquery(upd.Where, upd.OrderBy, upd.Limit, eupd.Table, ksidCol)
+		where := upd.Where
+		if dml.Opcode == engine.MultiTable {
+			where = multiTableWhere(eupd.Table)
+		}
+		eupd.OwnedVindexQuery = generateDMLSubquery(where, upd.OrderBy, upd.Limit, eupd.Table, ksidCols)
 		eupd.KsidVindex = ksidVindex
+		eupd.KsidLength = len(ksidCols)
 	}
 	return eupd, nil
 }
@@ -100,6 +118,70 @@ func buildChangedVindexesValues(update *sqlparser.Update, colVindexes []*vindexe
 	return changedVindexes, nil
 }
 
+// buildMovePlan builds the queries of an update that changes the columns of
+// the primary vindex. Such an update moves the rows to other shards, so it
+// deletes them and inserts them again. MySQL computes the new values of the
+// changed columns, so they aren't limited to values. The other vindexes that
+// the update changes must be owned lookup vindexes, like for other updates.
+func buildMovePlan(upd *sqlparser.Update, eupd *engine.Update, where *sqlparser.Where) error {
+	table := eupd.Table
+	if upd.Limit != nil && len(upd.OrderBy) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", table.ColumnVindexes[0].Name)
+	}
+	for _, vindex := range table.ColumnVindexes[1:] {
+		if !isVindexChanging(upd.Exprs, []*vindexes.ColumnVindex{vindex}) {
+			continue
+		}
+		if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
+		}
+		if !vindex.Owned {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update owned vindexes. Invalid update on vindex: %v", vindex.Name)
+		}
+	}
+
+	if err := checkMoveAssignments(upd.Exprs); err != nil {
+		return err
+	}
+
+	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	buf.Myprintf("select ")
+	for _, assignment := range upd.Exprs {
+		buf.Myprintf("%v, ", assignment.Expr)
+		eupd.MoveColumns = append(eupd.MoveColumns, assignment.Name.Name.String())
+	}
+	buf.Myprintf("%v.* from %v%v%v%v for update", table.Name, table.Name, where, upd.OrderBy, upd.Limit)
+	eupd.MoveQuery = buf.String()
+
+	buf = sqlparser.NewTrackedBuffer(dmlFormatter)
+	buf.Myprintf("delete %vfrom %v%v%v%v", upd.Comments, table.Name, where, upd.OrderBy, upd.Limit)
+	eupd.MoveDeleteQuery = buf.String()
+	return nil
+}
+
+// checkMoveAssignments rejects assignments that read a column assigned
+// before them. The move query computes all the new values from the old
+// row, but MySQL applies the assignments of a single-table update from
+// left to right, so such an assignment would see the new value.
+func checkMoveAssignments(exprs sqlparser.UpdateExprs) error {
+	for i, assignment := range exprs {
+		for _, earlier := range exprs[:i] {
+			reads := false
+			_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+				if col, ok := node.(*sqlparser.ColName); ok && col.Name.Equal(earlier.Name.Name) {
+					reads = true
+					return false, nil
+				}
+				return true, nil
+			}, assignment.Expr)
+			if reads {
+				return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update of primary vindex with an assignment that reads a column assigned before it: %v", assignment.Name.Name)
+			}
+		}
+	}
+	return nil
+}
+
 // extractValueFromUpdate given an UpdateExpr attempts to extracts the Value
 // it's holding. At the moment it only supports: StrVal, HexVal, IntVal, ValArg.
 // If a complex expression is provided (e.g set name = name + 1), the update will be rejected.
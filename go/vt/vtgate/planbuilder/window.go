@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// This file has functions to analyze window functions.
+
+// checkWindowFunctions verifies that the window functions of the select
+// can be pushed down to the underlying route. This is possible if the
+// route targets a single shard, or if every window is partitioned by
+// all the columns of the primary vindex of a table, because all the rows
+// of a partition are then guaranteed to be in the same shard. Secondary
+// vindexes don't give that guarantee, since they don't determine where
+// the rows are stored.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	partitions, err := windowPartitions(sel)
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	switch bldr := pb.bldr.(type) {
+	case *route:
+		if bldr.removeMultishardOptions() {
+			return nil
+		}
+		success := bldr.removeOptions(func(ro *routeOption) bool {
+			for _, partitionBy := range partitions {
+				if !pb.exprsHavePrimaryVindex(ro, partitionBy) {
+					return false
+				}
+			}
+			return true
+		})
+		if !success {
+			return errors.New("unsupported: in scatter query: window function must be partitioned by the primary vindex columns")
+		}
+		return nil
+	case *orderedAggregate:
+		return errors.New("unsupported: in scatter query: window function with aggregates")
+	}
+	return errors.New("unsupported: cross-shard query with window functions")
+}
+
+// windowPartitions returns the effective PARTITION BY list of
+// every window used by the window functions of the select.
+// References to named windows are resolved.
+func windowPartitions(sel *sqlparser.Select) ([]sqlparser.Exprs, error) {
+	named := make(map[string]*sqlparser.WindowSpecification, len(sel.Windows))
+	for _, window := range sel.Windows {
+		name := window.Name.Lowered()
+		if _, ok := named[name]; ok {
+			return nil, fmt.Errorf("window '%s' is defined twice", window.Name.String())
+		}
+		named[name] = window.WindowSpec
+	}
+
+	var partitions []sqlparser.Exprs
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.Over == nil {
+				return true, nil
+			}
+			spec := node.Over.WindowSpec
+			if spec == nil {
+				spec = &sqlparser.WindowSpecification{Name: node.Over.WindowName}
+			}
+			partitionBy, err := resolvePartitionBy(spec, named)
+			if err != nil {
+				return false, err
+			}
+			partitions = append(partitions, partitionBy)
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, sel.SelectExprs, sel.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	return partitions, nil
+}
+
+// resolvePartitionBy returns the PARTITION BY list of the window
+// specification. A window that's based on another one inherits
+// its PARTITION BY.
+func resolvePartitionBy(spec *sqlparser.WindowSpecification, named map[string]*sqlparser.WindowSpecification) (sqlparser.Exprs, error) {
+	// A window can't be based on itself, directly or indirectly,
+	// which bounds the length of the chain.
+	for i := 0; i <= len(named); i++ {
+		if spec.Name.IsEmpty() || len(spec.PartitionBy) != 0 {
+			return spec.PartitionBy, nil
+		}
+		base, ok := named[spec.Name.Lowered()]
+		if !ok {
+			return nil, fmt.Errorf("window name '%s' is not defined", spec.Name.String())
+		}
+		spec = base
+	}
+	return nil, errors.New("there is a circularity in the window dependency graph")
+}
+
+// exprsHavePrimaryVindex returns true if the expressions contain
+// all the columns of the primary vindex of a table of the route option.
+func (pb *primitiveBuilder) exprsHavePrimaryVindex(ro *routeOption, exprs sqlparser.Exprs) bool {
+	cols := make(map[*column]bool, len(exprs))
+	for _, expr := range exprs {
+		if c := findColumn(pb, expr); c != nil && c.Origin() == ro.rb {
+			cols[c] = true
+		}
+	}
+outer:
+	for _, primaryCols := range ro.primaryVindexColumns {
+		for _, c := range primaryCols {
+			if !cols[c] {
+				continue outer
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -113,6 +113,12 @@ func (vh *vtgateHandler) ComResetConnection(c *mysql.Conn) {
 	if err != nil {
 		log.Errorf("Error happened in transaction rollback: %v", err)
 	}
+
+	// The rest of the session state is reset too, like MySQL does.
+	// The prepared statements, and the plans bound to them, are
+	// dropped by the connection. The current database is kept.
+	c.ClientData = nil
+	vh.session(c).TargetString = session.TargetString
 }
 
 func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
@@ -298,7 +304,13 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
-	_, qr, err := vh.vtg.Execute(ctx, session, prepare.PrepareStmt, prepare.BindVars)
+	// The plan of the statement is bound to it on its first execution.
+	ps, _ := prepare.ClientData.(*PreparedStatement)
+	if ps == nil {
+		ps = NewPreparedStatement(prepare.PrepareStmt)
+		prepare.ClientData = ps
+	}
+	_, qr, err := vh.vtg.ExecutePrepared(ctx, session, ps, prepare.BindVars)
 	if err != nil {
 		err = mysql.NewSQLErrorFromError(err)
 		return err
@@ -228,6 +228,24 @@ func TestDefaultWorkloadOLAP(t *testing.T) {
 	}
 }
 
+func TestComResetConnection(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	vh := newVtgateHandler(&VTGate{executor: executor})
+	c := &mysql.Conn{}
+	session := vh.session(c)
+	session.TargetString = "TestExecutor"
+	session.Autocommit = false
+	session.LastInsertId = 1
+
+	// The session is reset, except for the current database.
+	vh.ComResetConnection(c)
+	newSession := vh.session(c)
+	assert.False(t, session == newSession)
+	assert.Equal(t, "TestExecutor", newSession.TargetString)
+	assert.True(t, newSession.Autocommit)
+	assert.EqualValues(t, 0, newSession.LastInsertId)
+}
+
 func TestInitTLSConfig(t *testing.T) {
 	// Create the certs.
 	root, err := ioutil.TempDir("", "TestInitTLSConfig")
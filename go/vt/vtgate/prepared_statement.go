@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// PreparedStatement is a statement that's executed multiple times
+// with different bind variables. The plan built on its first execution
+// is bound to it, so that the next executions don't need to parse and
+// normalize the query, or to look it up in the plan cache.
+//
+// The bound plan is only valid for the VSchema and the target it was
+// built for. It's rebuilt when any of them changes.
+//
+// A PreparedStatement must not be executed concurrently.
+type PreparedStatement struct {
+	// Query is the SQL of the statement.
+	Query string
+
+	plan      *engine.Plan
+	vschema   *vindexes.VSchema
+	prefixKey string
+	// bindVars contains the bind variables that were
+	// extracted from the literals of the query when it
+	// was normalized.
+	bindVars map[string]*querypb.BindVariable
+}
+
+// NewPreparedStatement creates a PreparedStatement for the query.
+func NewPreparedStatement(query string) *PreparedStatement {
+	return &PreparedStatement{Query: query}
+}
+
+// boundPlan returns the plan bound to the statement, if it's still valid
+// for the VSchema and the target of the vcursor.
+func (ps *PreparedStatement) boundPlan(vcursor *vcursorImpl) *engine.Plan {
+	if ps.plan == nil || ps.vschema != vcursor.vschema || ps.prefixKey != vcursor.planPrefixKey() {
+		return nil
+	}
+	return ps.plan
+}
+
+// bind binds the plan to the statement.
+func (ps *PreparedStatement) bind(vcursor *vcursorImpl, plan *engine.Plan, bindVars map[string]*querypb.BindVariable) {
+	ps.plan = plan
+	ps.vschema = vcursor.vschema
+	ps.prefixKey = vcursor.planPrefixKey()
+	ps.bindVars = bindVars
+}
+
+// getPreparedPlan returns the plan for a prepared statement, and adds the
+// bind variables of the normalized literals to bindVars. If the statement
+// has no valid bound plan, the plan is looked up as usual and bound to it.
+func (e *Executor) getPreparedPlan(vcursor *vcursorImpl, ps *PreparedStatement, query string, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, skipQueryPlanCache bool, logStats *LogStats) (*engine.Plan, error) {
+	if plan := ps.boundPlan(vcursor); plan != nil {
+		for k, v := range ps.bindVars {
+			bindVars[k] = v
+		}
+		if logStats != nil {
+			logStats.SQL = comments.Leading + plan.Original + comments.Trailing
+			logStats.BindVariables = bindVars
+		}
+		return plan, nil
+	}
+
+	// getPlan adds the normalized literals to the bind variables
+	// it receives. Planning with a copy lets us tell them apart
+	// from the ones of this execution.
+	planBindVars := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		planBindVars[k] = v
+	}
+	plan, err := e.getPlan(vcursor, query, comments, planBindVars, skipQueryPlanCache, logStats)
+	if err != nil {
+		return nil, err
+	}
+	literals := make(map[string]*querypb.BindVariable)
+	for k, v := range planBindVars {
+		if _, ok := bindVars[k]; !ok {
+			literals[k] = v
+			bindVars[k] = v
+		}
+	}
+	if logStats != nil {
+		logStats.BindVariables = bindVars
+	}
+	if plan.Instructions == nil || skipQueryPlanCache {
+		return plan, nil
+	}
+	// Like the plan cache, a statement with the SKIP_QUERY_PLAN_CACHE
+	// directive isn't bound to its plan, so it's planned on every execution.
+	if stmt, err := sqlparser.Parse(query); err != nil || sqlparser.SkipQueryPlanCacheDirective(stmt) {
+		return plan, nil
+	}
+	ps.bind(vcursor, plan, literals)
+	return plan, nil
+}
@@ -124,19 +124,16 @@ func queryzHandler(e *Executor, w http.ResponseWriter, r *http.Request) {
 	defer logz.EndHTMLTable(w)
 	w.Write(queryzHeader)
 
-	keys := e.plans.Keys()
+	// Items doesn't count as a lookup of the plans.
+	items := e.plans.Items()
 	sorter := queryzSorter{
-		rows: make([]*queryzRow, 0, len(keys)),
+		rows: make([]*queryzRow, 0, len(items)),
 		less: func(row1, row2 *queryzRow) bool {
 			return row1.timePQ() > row2.timePQ()
 		},
 	}
-	for _, v := range e.plans.Keys() {
-		result, ok := e.plans.Get(v)
-		if !ok {
-			continue
-		}
-		plan := result.(*engine.Plan)
+	for _, item := range items {
+		plan := item.Value.(*engine.Plan)
 		Value := &queryzRow{
 			Query: logz.Wrappable(sqlparser.TruncateForUI(plan.Original)),
 		}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// positionQuery is executed on the masters after a commit, for the
+// tablets to return their replication position.
+const positionQuery = "select 1 from dual"
+
+// positionOptions returns the options of a write outside of a
+// transaction, which ask the master to return its replication position
+// after the write if the session needs it.
+func positionOptions(session *SafeSession, target *querypb.Target, opts *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	if target.TabletType != topodatapb.TabletType_MASTER || !session.ReadAfterWrite() {
+		return opts
+	}
+	opts = cloneOptions(opts)
+	opts.IncludeExecutedPosition = true
+	return opts
+}
+
+// executeAfterWrites executes a query outside of a transaction, so that
+// it sees the writes of the session. If the session wrote to the shard
+// of a replica target, the replicas are asked to wait for the replication
+// position after the writes, for up to read_after_write_timeout, and the
+// gateway tries another replica if one doesn't reach it. If no replica
+// reaches it, the query is executed on the master, unless execute says
+// it can't fall back to it.
+func executeAfterWrites(session *SafeSession, target *querypb.Target, opts *querypb.ExecuteOptions, execute func(target *querypb.Target, opts *querypb.ExecuteOptions) (canFallBack bool, err error)) error {
+	position := session.PositionFor(target)
+	if position == "" || target.TabletType == topodatapb.TabletType_MASTER {
+		_, err := execute(target, opts)
+		return err
+	}
+
+	waitOpts := cloneOptions(opts)
+	waitOpts.WaitForPosition = position
+	waitOpts.WaitForPositionTimeoutMs = readAfterWriteTimeout.Milliseconds()
+	canFallBack, err := execute(target, waitOpts)
+	if !canFallBack || vterrors.Code(err) != vtrpcpb.Code_FAILED_PRECONDITION {
+		return err
+	}
+	master := proto.Clone(target).(*querypb.Target)
+	master.TabletType = topodatapb.TabletType_MASTER
+	_, err = execute(master, opts)
+	return err
+}
+
+func cloneOptions(opts *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	if opts == nil {
+		return &querypb.ExecuteOptions{}
+	}
+	return proto.Clone(opts).(*querypb.ExecuteOptions)
+}
@@ -17,9 +17,13 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -37,6 +41,15 @@ type SafeSession struct {
 	mustRollback    bool
 	autocommitState autocommitState
 	commitOrder     vtgatepb.CommitOrder
+
+	// foundRowsHandled is set when the statement sets FoundRows
+	// itself, instead of it being the number of rows returned.
+	foundRowsHandled bool
+
+	// temporaryTableQuery is set while a query that uses the temporary
+	// tables of the session is executed. It runs on the reserved
+	// connections of the session, which the temporary tables are on.
+	temporaryTableQuery bool
 	*vtgatepb.Session
 }
 
@@ -73,13 +86,15 @@ func NewSafeSession(sessn *vtgatepb.Session) *SafeSession {
 }
 
 // NewAutocommitSession returns a SafeSession based on the original
-// session, but with autocommit enabled.
+// session, but with autocommit enabled. It doesn't reserve connections,
+// since it wouldn't release them.
 func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession := proto.Clone(sessn).(*vtgatepb.Session)
 	newSession.InTransaction = false
 	newSession.ShardSessions = nil
 	newSession.PreSessions = nil
 	newSession.PostSessions = nil
+	newSession.InReservedConn = false
 	newSession.Autocommit = true
 	newSession.Warnings = nil
 	return NewSafeSession(newSession)
@@ -95,10 +110,22 @@ func (session *SafeSession) ResetTx() {
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
 	if !session.Session.InReservedConn {
-		session.ShardSessions = nil
-		session.PreSessions = nil
-		session.PostSessions = nil
+		// The reserved connections of the temporary tables are kept.
+		session.ShardSessions = reservedSessions(session.ShardSessions)
+		session.PreSessions = reservedSessions(session.PreSessions)
+		session.PostSessions = reservedSessions(session.PostSessions)
+	}
+}
+
+// reservedSessions returns the shard sessions that are on reserved connections.
+func reservedSessions(sessions []*vtgatepb.Session_ShardSession) []*vtgatepb.Session_ShardSession {
+	var reserved []*vtgatepb.Session_ShardSession
+	for _, shardSession := range sessions {
+		if shardSession.ReservedId != 0 {
+			reserved = append(reserved, shardSession)
+		}
 	}
+	return reserved
 }
 
 // Reset clears the session
@@ -113,7 +140,11 @@ func (session *SafeSession) Reset() {
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
-	session.Session.InReservedConn = false
+	// The system variables of the session are applied to
+	// the next connections that are reserved.
+	session.Session.InReservedConn = len(session.SystemVariables) > 0
+	// The temporary tables were on the released connections.
+	session.TemporaryTables = nil
 }
 
 // SetAutocommittable sets the state to autocommitable if true.
@@ -218,7 +249,7 @@ func (session *SafeSession) AppendOrUpdate(shardSession *vtgatepb.Session_ShardS
 		// Should be unreachable
 		return vterrors.New(vtrpcpb.Code_INTERNAL, "BUG: SafeSession.AppendOrUpdate: unexpected autocommit state")
 	}
-	if !(session.Session.InTransaction || session.Session.InReservedConn) {
+	if !(session.Session.InTransaction || session.Session.InReservedConn || session.temporaryTableQuery) {
 		// Should be unreachable
 		return vterrors.New(vtrpcpb.Code_INTERNAL, "BUG: SafeSession.AppendOrUpdate: not in transaction and not in reserved connection")
 	}
@@ -320,6 +351,53 @@ func (session *SafeSession) SetSystemVariable(name string, expr string) {
 	session.SystemVariables[name] = expr
 }
 
+// UnsetSystemVariable removes the system variable from the session.
+// The session doesn't need reserved connections anymore once it sets
+// no system variables.
+func (session *SafeSession) UnsetSystemVariable(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	delete(session.SystemVariables, name)
+	session.Session.InReservedConn = len(session.SystemVariables) > 0
+}
+
+// SystemVariable returns the expression the system variable is set
+// to in the session, if it is.
+func (session *SafeSession) SystemVariable(name string) (string, bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	expr, ok := session.SystemVariables[name]
+	return expr, ok
+}
+
+// SetFoundRows sets the number of rows found by the statement.
+func (session *SafeSession) SetFoundRows(foundRows uint64) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.FoundRows = foundRows
+	session.foundRowsHandled = true
+}
+
+// SetPreQueries returns the queries that apply the system variables
+// of the session to a connection, when it is reserved.
+func (session *SafeSession) SetPreQueries() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(session.SystemVariables) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(session.SystemVariables))
+	for name := range session.SystemVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sets := make([]string, len(names))
+	for i, name := range names {
+		sets[i] = fmt.Sprintf("@@%s = %s", name, session.SystemVariables[name])
+	}
+	return []string{"set " + strings.Join(sets, ", ")}
+}
+
 //SetOptions sets the options
 func (session *SafeSession) SetOptions(options *querypb.ExecuteOptions) {
 	session.mu.Lock()
@@ -338,5 +416,191 @@ func (session *SafeSession) StoreSavepoint(sql string) {
 func (session *SafeSession) InReservedConn() bool {
 	session.mu.Lock()
 	defer session.mu.Unlock()
-	return session.Session.InReservedConn
+	return session.Session.InReservedConn || session.temporaryTableQuery
+}
+
+// SetReservedConn sets whether the session needs to execute on dedicated connections.
+func (session *SafeSession) SetReservedConn(reservedConn bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.InReservedConn = reservedConn
+}
+
+// HasReservedConns returns true if the session holds reserved connections.
+func (session *SafeSession) HasReservedConns() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.hasReservedConns()
+}
+
+func (session *SafeSession) hasReservedConns() bool {
+	for _, sessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		if len(reservedSessions(sessions)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ReservedConnsUnneeded returns true if the session holds reserved
+// connections that it doesn't need anymore, because it has no temporary
+// tables left, isn't in a transaction and doesn't need them otherwise.
+func (session *SafeSession) ReservedConnsUnneeded() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.Session.InTransaction || session.Session.InReservedConn || len(session.TemporaryTables) > 0 {
+		return false
+	}
+	return session.hasReservedConns()
+}
+
+// SetTemporaryTableQuery sets whether the current query uses the
+// temporary tables of the session, so that it runs on the reserved
+// connections of the session.
+func (session *SafeSession) SetTemporaryTableQuery(temporaryTableQuery bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.temporaryTableQuery = temporaryTableQuery
+}
+
+// HasTemporaryTables returns true if the session has temporary tables.
+func (session *SafeSession) HasTemporaryTables() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return len(session.TemporaryTables) > 0
+}
+
+// IsTemporaryTable returns true if the session has a temporary table of the name.
+func (session *SafeSession) IsTemporaryTable(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return contains(session.TemporaryTables, name)
+}
+
+// AddTemporaryTables records the temporary tables that the session created.
+func (session *SafeSession) AddTemporaryTables(names []string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, name := range names {
+		if !contains(session.TemporaryTables, name) {
+			session.TemporaryTables = append(session.TemporaryTables, name)
+		}
+	}
+}
+
+// RemoveTemporaryTables forgets the temporary tables that the session dropped.
+func (session *SafeSession) RemoveTemporaryTables(names []string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	var tables []string
+	for _, table := range session.TemporaryTables {
+		if !contains(names, table) {
+			tables = append(tables, table)
+		}
+	}
+	session.TemporaryTables = tables
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FindLockSession returns the reserved connection and the tablet alias
+// of the lock session to the target, if any.
+func (session *SafeSession) FindLockSession(target *querypb.Target) (reservedID int64, alias *topodatapb.TabletAlias) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, lockSession := range session.LockSessions {
+		if target.Keyspace == lockSession.Target.Keyspace && target.TabletType == lockSession.Target.TabletType && target.Shard == lockSession.Target.Shard {
+			return lockSession.ReservedId, lockSession.TabletAlias
+		}
+	}
+	return 0, nil
+}
+
+// AppendLockSession adds the lock session to a new target.
+func (session *SafeSession) AppendLockSession(lockSession *vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.LockSessions = append(session.LockSessions, lockSession)
+}
+
+// SetReadAfterWriteConsistency sets whether the reads of the session from
+// the replicas have to see its writes. The positions of the writes are
+// forgotten when it's turned off.
+func (session *SafeSession) SetReadAfterWriteConsistency(readAfterWrite bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.ReadAfterWriteConsistency = readAfterWrite
+	if !readAfterWrite {
+		session.ReadAfterWritePositions = nil
+	}
+}
+
+// ReadAfterWrite returns true if the reads of the session from the
+// replicas have to see its writes.
+func (session *SafeSession) ReadAfterWrite() bool {
+	if session == nil {
+		return false
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.GetReadAfterWriteConsistency()
+}
+
+// RecordPosition records the replication position of the master of the
+// target after a write of the session.
+func (session *SafeSession) RecordPosition(target *querypb.Target, position string) {
+	if position == "" {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.Session.ReadAfterWriteConsistency {
+		return
+	}
+	if session.ReadAfterWritePositions == nil {
+		session.ReadAfterWritePositions = make(map[string]string)
+	}
+	session.ReadAfterWritePositions[topoproto.KeyspaceShardString(target.Keyspace, target.Shard)] = position
+}
+
+// PositionFor returns the replication position that a replica of the
+// target has to reach to see the writes of the session, if any.
+func (session *SafeSession) PositionFor(target *querypb.Target) string {
+	if session == nil {
+		return ""
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.Session.GetReadAfterWriteConsistency() {
+		return ""
+	}
+	return session.ReadAfterWritePositions[topoproto.KeyspaceShardString(target.Keyspace, target.Shard)]
+}
+
+// RemoveLockSession removes the lock session to the target,
+// and returns it. It returns nil if there is none.
+func (session *SafeSession) RemoveLockSession(target *querypb.Target) *vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for i, lockSession := range session.LockSessions {
+		if target.Keyspace == lockSession.Target.Keyspace && target.TabletType == lockSession.Target.TabletType && target.Shard == lockSession.Target.Shard {
+			session.LockSessions = append(session.LockSessions[:i], session.LockSessions[i+1:]...)
+			return lockSession
+		}
+	}
+	return nil
+}
+
+// ResetLock clears the lock sessions.
+func (session *SafeSession) ResetLock() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.LockSessions = nil
 }
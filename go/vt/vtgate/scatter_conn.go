@@ -185,16 +185,28 @@ func (stc *ScatterConn) ExecuteMultiShard(
 				if info.transactionID != int64(0) {
 					return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "in autocommit mode, transactionID should be zero but was: %d", info.transactionID)
 				}
-				innerqr, err = rs.Gateway.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, 0, 0, opts)
+				innerqr, err = rs.Gateway.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, 0, 0, positionOptions(session, rs.Target, opts))
 				if err != nil {
 					return nil, err
 				}
+				session.RecordPosition(rs.Target, innerqr.ExecutedPosition)
+				// The query didn't run on the reserved connection that the
+				// session may keep for its temporary tables.
+				reservedID = 0
 			case nothing == info.actionNeeded:
 				qs, err := getQueryService(rs, info)
 				if err != nil {
 					return nil, err
 				}
-				innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, info.transactionID, info.reserveID, opts)
+				if info.transactionID == 0 && info.reserveID == 0 {
+					err = executeAfterWrites(session, rs.Target, opts, func(target *querypb.Target, opts *querypb.ExecuteOptions) (bool, error) {
+						var err error
+						innerqr, err = qs.Execute(ctx, target, queries[i].Sql, queries[i].BindVariables, 0, 0, opts)
+						return true, err
+					})
+				} else {
+					innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, info.transactionID, info.reserveID, opts)
+				}
 				if err != nil {
 					return nil, err
 				}
@@ -212,12 +224,12 @@ func (stc *ScatterConn) ExecuteMultiShard(
 				if err != nil {
 					return nil, err
 				}
-				innerqr, reservedID, alias, err = qs.ReserveExecute(ctx, rs.Target, nil, queries[i].Sql, queries[i].BindVariables, info.transactionID, opts)
+				innerqr, reservedID, alias, err = qs.ReserveExecute(ctx, rs.Target, session.SetPreQueries(), queries[i].Sql, queries[i].BindVariables, info.transactionID, opts)
 				if err != nil {
 					return info.updateReservedID(reservedID, alias), err
 				}
 			case reserveBegin == info.actionNeeded:
-				innerqr, transactionID, reservedID, alias, err = rs.Gateway.ReserveBeginExecute(ctx, rs.Target, nil, queries[i].Sql, queries[i].BindVariables, opts)
+				innerqr, transactionID, reservedID, alias, err = rs.Gateway.ReserveBeginExecute(ctx, rs.Target, session.SetPreQueries(), queries[i].Sql, queries[i].BindVariables, opts)
 				if err != nil {
 					return info.updateTransactionAndReservedID(transactionID, reservedID, alias), err
 				}
@@ -241,6 +253,43 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	return qr, allErrors.GetErrors()
 }
 
+// ExecuteLock executes a query that calls named lock functions on the
+// lock session of the shard. The first query reserves the connection,
+// which is kept until the session is closed: the named locks are
+// released with it.
+func (stc *ScatterConn) ExecuteLock(
+	ctx context.Context,
+	rs *srvtopo.ResolvedShard,
+	query *querypb.BoundQuery,
+	session *SafeSession,
+) (qr *sqltypes.Result, err error) {
+	allErrors := new(concurrency.AllErrorRecorder)
+	startTime, statsKey := stc.startAction("ExecuteLock", rs.Target)
+	defer stc.endAction(startTime, allErrors, statsKey, &err, session)
+
+	opts := session.GetOptions()
+	reservedID, alias := session.FindLockSession(rs.Target)
+	if reservedID == 0 {
+		qr, reservedID, alias, err = rs.Gateway.ReserveExecute(ctx, rs.Target, nil, query.Sql, query.BindVariables, 0, opts)
+		if reservedID != 0 {
+			session.AppendLockSession(&vtgatepb.Session_ShardSession{
+				Target:      rs.Target,
+				ReservedId:  reservedID,
+				TabletAlias: alias,
+			})
+		}
+		return qr, err
+	}
+
+	var qs queryservice.QueryService
+	qs, err = getQueryService(rs, &shardActionInfo{reserveID: reservedID, alias: alias})
+	if err != nil {
+		return nil, err
+	}
+	qr, err = qs.Execute(ctx, rs.Target, query.Sql, query.BindVariables, 0, reservedID, opts)
+	return qr, err
+}
+
 func getQueryService(rs *srvtopo.ResolvedShard, info *shardActionInfo) (queryservice.QueryService, error) {
 	_, usingLegacyGw := rs.Gateway.(*DiscoveryGateway)
 	//if usingLegacyGw {
@@ -300,7 +349,8 @@ func (stc *ScatterConn) StreamExecute(
 
 // StreamExecuteMulti is like StreamExecute,
 // but each shard gets its own bindVars. If len(shards) is not equal to
-// len(bindVars), the function panics.
+// len(bindVars), the function panics. The queries see the writes of the
+// session if it has read after write consistency.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines, through processOneStreamingResult.
 func (stc *ScatterConn) StreamExecuteMulti(
@@ -308,16 +358,23 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	query string,
 	rss []*srvtopo.ResolvedShard,
 	bindVars []map[string]*querypb.BindVariable,
-	options *querypb.ExecuteOptions,
+	session *SafeSession,
 	callback func(reply *sqltypes.Result) error,
 ) error {
 	// mu protects fieldSent, callback and replyErr
 	var mu sync.Mutex
 	fieldSent := false
 
+	options := session.GetOptions()
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
-		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
-			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+		return executeAfterWrites(session, rs.Target, options, func(target *querypb.Target, opts *querypb.ExecuteOptions) (bool, error) {
+			// The query can't be executed again once results are sent.
+			streamed := false
+			err := rs.Gateway.StreamExecute(ctx, target, query, bindVars[i], 0, opts, func(qr *sqltypes.Result) error {
+				streamed = true
+				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+			})
+			return !streamed, err
 		})
 	})
 	return allErrors.AggrError(vterrors.Aggregate)
@@ -152,7 +152,7 @@ func TestScatterConnStreamExecuteMulti(t *testing.T) {
 		}
 		bvs := make([]map[string]*querypb.BindVariable, len(rss))
 		qr := new(sqltypes.Result)
-		err = sc.StreamExecuteMulti(ctx, "query", rss, bvs, nil, func(r *sqltypes.Result) error {
+		err = sc.StreamExecuteMulti(ctx, "query", rss, bvs, NewSafeSession(nil), func(r *sqltypes.Result) error {
 			qr.AppendResult(r)
 			return nil
 		})
@@ -600,7 +600,7 @@ func TestMultiExecs(t *testing.T) {
 			"bv1": sqltypes.Int64BindVariable(1),
 		},
 	}
-	_ = sc.StreamExecuteMulti(ctx, "query", rss, bvs, nil, func(*sqltypes.Result) error {
+	_ = sc.StreamExecuteMulti(ctx, "query", rss, bvs, NewSafeSession(nil), func(*sqltypes.Result) error {
 		return nil
 	})
 	if !reflect.DeepEqual(sbc0.Queries[0].BindVariables, wantVars0) {
@@ -675,6 +675,77 @@ func TestScatterConnSingleDB(t *testing.T) {
 	require.Empty(t, errors)
 }
 
+func TestScatterConnReadAfterWrite(t *testing.T) {
+	keyspace := "TestScatterConnReadAfterWrite"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeLegacyHealthCheck()
+	sc := newTestLegacyScatterConn(hc, new(sandboxTopo), "aa")
+	master := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	replica := hc.AddTestTablet("aa", "1", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	masterRss, err := res.ResolveDestination(ctx, keyspace, topodatapb.TabletType_MASTER, key.DestinationShard("0"))
+	require.NoError(t, err)
+	replicaRss, err := res.ResolveDestination(ctx, keyspace, topodatapb.TabletType_REPLICA, key.DestinationShard("0"))
+	require.NoError(t, err)
+	queries := []*querypb.BoundQuery{{Sql: "query"}}
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true, ReadAfterWriteConsistency: true})
+
+	// An autocommitted write records the position of the master.
+	position := "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	master.SetResults([]*sqltypes.Result{{RowsAffected: 1, ExecutedPosition: position}})
+	_, errs := sc.ExecuteMultiShard(ctx, masterRss, queries, session, true)
+	require.Empty(t, errs)
+	assert.True(t, master.Options[0].IncludeExecutedPosition)
+	assert.Equal(t, map[string]string{keyspace + "/0": position}, session.ReadAfterWritePositions)
+
+	// The reads from the replicas wait for it.
+	_, errs = sc.ExecuteMultiShard(ctx, replicaRss, queries, session, false)
+	require.Empty(t, errs)
+	assert.Equal(t, position, replica.Options[0].WaitForPosition)
+	assert.Equal(t, readAfterWriteTimeout.Milliseconds(), replica.Options[0].WaitForPositionTimeoutMs)
+
+	// If no replica reaches it, the read is executed on the master.
+	replica.MustFailCodes[vtrpcpb.Code_FAILED_PRECONDITION] = 1
+	_, errs = sc.ExecuteMultiShard(ctx, replicaRss, queries, session, false)
+	require.Empty(t, errs)
+	assert.Len(t, replica.Queries, 2)
+	require.Len(t, master.Queries, 2)
+	assert.Empty(t, master.Options[1].GetWaitForPosition())
+
+	replica.MustFailCodes[vtrpcpb.Code_FAILED_PRECONDITION] = 1
+	err = sc.StreamExecuteMulti(ctx, "query", replicaRss, []map[string]*querypb.BindVariable{nil}, session, func(*sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, position, replica.Options[2].WaitForPosition)
+	assert.Len(t, master.Queries, 3)
+
+	// A commit records the positions of the masters after it.
+	session.Session.InTransaction = true
+	_, errs = sc.ExecuteMultiShard(ctx, masterRss, queries, session, false)
+	require.Empty(t, errs)
+	position = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8"
+	master.SetResults([]*sqltypes.Result{{ExecutedPosition: position}})
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Equal(t, positionQuery, master.Queries[len(master.Queries)-1].Sql)
+	assert.Equal(t, map[string]string{keyspace + "/0": position}, session.ReadAfterWritePositions)
+
+	// The positions are forgotten when the consistency is turned off.
+	session.SetReadAfterWriteConsistency(false)
+	assert.Empty(t, session.ReadAfterWritePositions)
+	_, errs = sc.ExecuteMultiShard(ctx, replicaRss, queries, session, false)
+	require.Empty(t, errs)
+	assert.Empty(t, replica.Options[len(replica.Options)-1].GetWaitForPosition())
+
+	// And a commit doesn't ask the masters for them anymore.
+	session.Session.InTransaction = true
+	_, errs = sc.ExecuteMultiShard(ctx, masterRss, queries, session, false)
+	require.Empty(t, errs)
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.NotEqual(t, positionQuery, master.Queries[len(master.Queries)-1].Sql)
+}
+
 func TestAppendResult(t *testing.T) {
 	qr := new(sqltypes.Result)
 	innerqr1 := &sqltypes.Result{
@@ -173,6 +173,11 @@ func (gw *TabletGateway) WaitForTablets(ctx context.Context, tabletTypesToWait [
 	return gw.hc.WaitForAllServingTablets(ctx, targets)
 }
 
+// WaitForTargets is part of the Gateway interface.
+func (gw *TabletGateway) WaitForTargets(ctx context.Context, targets []*querypb.Target) error {
+	return gw.hc.WaitForAllServingTablets(ctx, targets)
+}
+
 // Close shuts down underlying connections.
 // This function hides the inner implementation.
 func (gw *TabletGateway) Close(_ context.Context) error {
@@ -193,6 +198,16 @@ func (gw *TabletGateway) CacheStatus() TabletCacheStatusList {
 	return res
 }
 
+// TableStatistics is part of the Gateway interface.
+func (gw *TabletGateway) TableStatistics(target *querypb.Target) []*querypb.TableStatistics {
+	for _, th := range gw.hc.GetHealthyTabletStats(target) {
+		if stats := th.Stats.GetTableStatistics(); stats != nil {
+			return stats
+		}
+	}
+	return nil
+}
+
 // withRetry gets available connections and executes the action. If there are retryable errors,
 // it retries retryCount times before failing. It does not retry if the connection is in
 // the middle of a transaction. While returning the error check if it maybe a result of
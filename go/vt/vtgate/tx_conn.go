@@ -64,7 +64,7 @@ func (txc *TxConn) Begin(ctx context.Context, session *SafeSession) error {
 // Commit commits the current transaction. The type of commit can be
 // best effort or 2pc depending on the session setting.
 func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
-	defer session.ResetTx()
+	defer txc.resetTx(ctx, session)
 	if !session.InTransaction() {
 		return nil
 	}
@@ -76,10 +76,57 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	case vtgatepb.TransactionMode_UNSPECIFIED:
 		twopc = txc.mode == vtgatepb.TransactionMode_TWOPC
 	}
+	// The commit forgets the transactions, so the masters that
+	// ran one are found before it.
+	masterSessions := positionSessions(session)
+	var err error
 	if twopc {
-		return txc.commit2PC(ctx, session)
+		err = txc.commit2PC(ctx, session)
+	} else {
+		err = txc.commitNormal(ctx, session)
+	}
+	if err != nil {
+		return err
+	}
+	txc.recordPositions(ctx, session, masterSessions)
+	return nil
+}
+
+// positionSessions returns the shard sessions of the masters that ran
+// a transaction of the session, if the reads of the session from the
+// replicas have to see its writes.
+func positionSessions(session *SafeSession) []*vtgatepb.Session_ShardSession {
+	if !session.ReadAfterWrite() {
+		return nil
+	}
+	var masterSessions []*vtgatepb.Session_ShardSession
+	for _, sessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		for _, s := range sessions {
+			if s.TransactionId != 0 && s.Target.TabletType == topodatapb.TabletType_MASTER {
+				masterSessions = append(masterSessions, s)
+			}
+		}
+	}
+	return masterSessions
+}
+
+// recordPositions records the replication positions of the masters
+// after a commit.
+func (txc *TxConn) recordPositions(ctx context.Context, session *SafeSession, masterSessions []*vtgatepb.Session_ShardSession) {
+	if len(masterSessions) == 0 {
+		return
+	}
+	err := txc.runSessions(ctx, masterSessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+		qr, err := txc.gateway.Execute(ctx, s.Target, positionQuery, nil, 0, 0, &querypb.ExecuteOptions{IncludeExecutedPosition: true})
+		if err != nil {
+			return err
+		}
+		session.RecordPosition(s.Target, qr.ExecutedPosition)
+		return nil
+	})
+	if err != nil {
+		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("replication positions after commit could not be read: %v", err)})
 	}
-	return txc.commitNormal(ctx, session)
 }
 
 func (txc *TxConn) queryService(alias *topodatapb.TabletAlias) (queryservice.QueryService, error) {
@@ -201,7 +248,7 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	if !session.InTransaction() {
 		return nil
 	}
-	defer session.ResetTx()
+	defer txc.resetTx(ctx, session)
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -231,9 +278,19 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	return err
 }
 
+// resetTx clears the transaction of the session. The reserved
+// connections that the session kept for its temporary tables are
+// released if it dropped all of them in the transaction.
+func (txc *TxConn) resetTx(ctx context.Context, session *SafeSession) {
+	session.ResetTx()
+	if session.ReservedConnsUnneeded() {
+		_ = txc.Release(ctx, session)
+	}
+}
+
 //Release releases the reserved connection and/or rollbacks the transaction
 func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
-	if !session.InTransaction() && !session.InReservedConn() {
+	if !session.InTransaction() && !session.InReservedConn() && !session.HasReservedConns() {
 		return nil
 	}
 	defer session.Reset()
@@ -259,6 +316,23 @@ func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// ReleaseLock releases the reserved connections of the lock sessions,
+// which releases the named locks that are held on them.
+func (txc *TxConn) ReleaseLock(ctx context.Context, session *SafeSession) error {
+	if len(session.LockSessions) == 0 {
+		return nil
+	}
+	defer session.ResetLock()
+
+	return txc.runSessions(ctx, session.LockSessions, func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+		qs, err := txc.queryService(s.TabletAlias)
+		if err != nil {
+			return err
+		}
+		return qs.Release(ctx, s.Target, 0, s.ReservedId)
+	})
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
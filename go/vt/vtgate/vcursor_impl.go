@@ -59,7 +59,14 @@ var _ iExecute = (*Executor)(nil)
 type iExecute interface {
 	Execute(ctx context.Context, method string, session *SafeSession, s string, vars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
 	ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, autocommit bool) (qr *sqltypes.Result, errs []error)
-	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
+	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) error
+
+	TableStatistics(target *querypb.Target) []*querypb.TableStatistics
+	DBDDLPlugin() DBDDLPlugin
+	TransactionMode() vtgatepb.TransactionMode
+	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
+	RecordLockAction(ctx context.Context, session *SafeSession, target *querypb.Target, action engine.LockAction, name string)
+	ReleaseUnneededConns(ctx context.Context, session *SafeSession) error
 
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
@@ -129,6 +136,18 @@ func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.DDL
 
 }
 
+// ExecuteDBDDL creates or drops a database through the DB DDL plugin.
+func (vc *vcursorImpl) ExecuteDBDDL(dbddl *sqlparser.DBDDL) error {
+	plugin := vc.executor.DBDDLPlugin()
+	switch dbddl.Action {
+	case sqlparser.CreateStr:
+		return plugin.CreateDatabase(vc.ctx, dbddl.DBName, dbddl.IfNotExists)
+	case sqlparser.DropStr:
+		return plugin.DropDatabase(vc.ctx, dbddl.DBName, dbddl.IfExists)
+	}
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Database DDL %v", sqlparser.String(dbddl))
+}
+
 // newVcursorImpl creates a vcursorImpl. Before creating this object, you have to separate out any marginComments that came with
 // the query and supply it here. Trailing comments are typically sent by the application for various reasons,
 // including as identifying markers. So, they have to be added back to all queries that are executed
@@ -263,6 +282,42 @@ func (vc *vcursorImpl) TargetString() string {
 	return vc.safeSession.TargetString
 }
 
+// TableStatistics is part of the ContextVSchema interface.
+// The statistics are averaged over the shards that report them.
+func (vc *vcursorImpl) TableStatistics(keyspace, tableName string) *planbuilder.TableStatistics {
+	rss, _, err := vc.resolver.GetAllShards(vc.ctx, keyspace, vc.tabletType)
+	if err != nil || len(rss) == 0 {
+		return nil
+	}
+	var rowCount uint64
+	cardinality := make(map[string]uint64)
+	reported := uint64(0)
+	for _, rs := range rss {
+		for _, ts := range vc.executor.TableStatistics(rs.Target) {
+			if ts.Name != tableName {
+				continue
+			}
+			rowCount += ts.RowCount
+			for col, card := range ts.ColumnCardinality {
+				cardinality[col] += card
+			}
+			reported++
+			break
+		}
+	}
+	if reported == 0 {
+		return nil
+	}
+	for col := range cardinality {
+		cardinality[col] /= reported
+	}
+	return &planbuilder.TableStatistics{
+		Shards:            len(rss),
+		RowCount:          rowCount / reported,
+		ColumnCardinality: cardinality,
+	}
+}
+
 // Execute is part of the engine.VCursor interface.
 func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
 	session := vc.safeSession
@@ -312,10 +367,20 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 	return qr, vterrors.Aggregate(errs)
 }
 
+// ExecuteLock is part of the engine.VCursor interface.
+func (vc *vcursorImpl) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	atomic.AddUint32(&vc.logStats.ShardQueries, 1)
+	query = &querypb.BoundQuery{
+		Sql:           vc.marginComments.Leading + query.Sql + vc.marginComments.Trailing,
+		BindVariables: query.BindVariables,
+	}
+	return vc.executor.ExecuteLock(vc.ctx, rs, query, vc.safeSession)
+}
+
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
-	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession.Options, callback)
+	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession, callback)
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -377,6 +442,68 @@ func (vc *vcursorImpl) SetSysVar(name string, expr string) {
 	vc.safeSession.SetSystemVariable(name, expr)
 }
 
+// SysVar implements the SessionActions interface.
+func (vc *vcursorImpl) SysVar(name string) (string, bool) {
+	return vc.safeSession.SystemVariable(name)
+}
+
+// UnsetSysVar implements the SessionActions interface.
+func (vc *vcursorImpl) UnsetSysVar(name string) error {
+	vc.safeSession.UnsetSystemVariable(name)
+	return vc.executor.ReleaseUnneededConns(vc.ctx, vc.safeSession)
+}
+
+// SetFoundRows implements the SessionActions interface.
+func (vc *vcursorImpl) SetFoundRows(foundRows uint64) {
+	vc.safeSession.SetFoundRows(foundRows)
+}
+
+// TransactionMode implements the SessionActions interface.
+func (vc *vcursorImpl) TransactionMode() vtgatepb.TransactionMode {
+	if vc.safeSession.TransactionMode != vtgatepb.TransactionMode_UNSPECIFIED {
+		return vc.safeSession.TransactionMode
+	}
+	return vc.executor.TransactionMode()
+}
+
+// RecordLockAction implements the SessionActions interface.
+func (vc *vcursorImpl) RecordLockAction(target *querypb.Target, action engine.LockAction, name string) {
+	vc.executor.RecordLockAction(vc.ctx, vc.safeSession, target, action, name)
+}
+
+// NeedsReservedConn implements the SessionActions interface.
+func (vc *vcursorImpl) NeedsReservedConn() {
+	vc.safeSession.SetReservedConn(true)
+}
+
+// UseTemporaryTables implements the SessionActions interface.
+func (vc *vcursorImpl) UseTemporaryTables() {
+	vc.safeSession.SetTemporaryTableQuery(true)
+}
+
+// AddTemporaryTables implements the SessionActions interface.
+func (vc *vcursorImpl) AddTemporaryTables(names []string) {
+	vc.safeSession.AddTemporaryTables(names)
+}
+
+// DropTemporaryTables implements the SessionActions interface.
+func (vc *vcursorImpl) DropTemporaryTables(names []string) error {
+	vc.safeSession.RemoveTemporaryTables(names)
+	return vc.executor.ReleaseUnneededConns(vc.ctx, vc.safeSession)
+}
+
+// ShardSession implements the SessionActions interface.
+func (vc *vcursorImpl) ShardSession() []*srvtopo.ResolvedShard {
+	var rss []*srvtopo.ResolvedShard
+	for _, shardSession := range vc.safeSession.ShardSessions {
+		rss = append(rss, &srvtopo.ResolvedShard{
+			Target:  shardSession.Target,
+			Gateway: vc.resolver.GetGateway(),
+		})
+	}
+	return rss
+}
+
 // Destination implements the ContextVSchema interface
 func (vc *vcursorImpl) Destination() key.Destination {
 	return vc.destination
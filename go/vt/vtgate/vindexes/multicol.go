@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
+
+// multiColKeyspaceIDLength is the length of the keyspace ids
+// produced by a MultiCol vindex.
+const multiColKeyspaceIDLength = 8
+
+// MultiCol is a multi-column unique vindex. Every column is mapped
+// by its own sub-vindex, and the keyspace id is the concatenation of
+// the leading bytes of their keyspace ids. All the rows that share a
+// value for the first column are therefore stored in the same keyrange.
+// If only the leading columns are supplied, the values map to the
+// keyrange that contains all their rows.
+type MultiCol struct {
+	name        string
+	cost        int
+	columnVdx   []SingleColumn
+	columnBytes []int
+}
+
+// NewMultiCol creates a MultiCol vindex.
+// The supplied map requires a column_count argument, which is the
+// number of columns of the vindex. The optional column_vindex
+// argument is a comma separated list of the vindex types used for
+// every column. It defaults to hash. The optional column_bytes argument
+// is a comma separated list of the number of bytes that every column
+// contributes to the keyspace id. Their total can't exceed 8. By default,
+// the bytes are split evenly, and the first column gets the remainder.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	colCount, err := strconv.Atoi(m["column_count"])
+	if err != nil {
+		return nil, fmt.Errorf("multicol: invalid column_count: %q", m["column_count"])
+	}
+	if colCount < 1 || colCount > multiColKeyspaceIDLength {
+		return nil, fmt.Errorf("multicol: column_count must be between 1 and %d: %d", multiColKeyspaceIDLength, colCount)
+	}
+	columnVdx, cost, err := multiColVindexes(name, m["column_vindex"], colCount)
+	if err != nil {
+		return nil, err
+	}
+	columnBytes, err := multiColBytes(m["column_bytes"], colCount)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiCol{
+		name:        name,
+		cost:        cost,
+		columnVdx:   columnVdx,
+		columnBytes: columnBytes,
+	}, nil
+}
+
+func multiColVindexes(name, param string, colCount int) ([]SingleColumn, int, error) {
+	vindexTypes := make([]string, colCount)
+	if param != "" {
+		types := strings.Split(param, ",")
+		if len(types) != colCount {
+			return nil, 0, fmt.Errorf("multicol: column_vindex must have %d values: %q", colCount, param)
+		}
+		copy(vindexTypes, types)
+	}
+	cost := 0
+	columnVdx := make([]SingleColumn, colCount)
+	for i, vindexType := range vindexTypes {
+		vindexType = strings.TrimSpace(vindexType)
+		if vindexType == "" {
+			vindexType = "hash"
+		}
+		vindex, err := CreateVindex(vindexType, fmt.Sprintf("%s_%d", name, i), nil)
+		if err != nil {
+			return nil, 0, fmt.Errorf("multicol: %v", err)
+		}
+		single, ok := vindex.(SingleColumn)
+		if !ok || !vindex.IsUnique() || vindex.NeedsVCursor() || vindex.Cost() > 1 {
+			return nil, 0, fmt.Errorf("multicol: %s is not a functional unique single column vindex", vindexType)
+		}
+		if vindex.Cost() > cost {
+			cost = vindex.Cost()
+		}
+		columnVdx[i] = single
+	}
+	return columnVdx, cost, nil
+}
+
+func multiColBytes(param string, colCount int) ([]int, error) {
+	columnBytes := make([]int, colCount)
+	if param == "" {
+		for i := range columnBytes {
+			columnBytes[i] = multiColKeyspaceIDLength / colCount
+		}
+		columnBytes[0] += multiColKeyspaceIDLength % colCount
+		return columnBytes, nil
+	}
+	values := strings.Split(param, ",")
+	if len(values) != colCount {
+		return nil, fmt.Errorf("multicol: column_bytes must have %d values: %q", colCount, param)
+	}
+	total := 0
+	for i, value := range values {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("multicol: invalid column_bytes: %q", param)
+		}
+		columnBytes[i] = n
+		total += n
+	}
+	if total > multiColKeyspaceIDLength {
+		return nil, fmt.Errorf("multicol: column_bytes cannot exceed a total of %d: %q", multiColKeyspaceIDLength, param)
+	}
+	return columnBytes, nil
+}
+
+// String returns the name of the vindex.
+func (m *MultiCol) String() string {
+	return m.name
+}
+
+// Cost returns the highest cost of the column vindexes.
+func (m *MultiCol) Cost() int {
+	return m.cost
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (m *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (m *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// Map satisfies MultiColumn. A row that contains only the leading
+// columns maps to a keyrange.
+func (m *MultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	destinations := make([]key.Destination, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		dest, err := m.mapRow(vcursor, row)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, dest)
+	}
+	return destinations, nil
+}
+
+func (m *MultiCol) mapRow(vcursor VCursor, row []sqltypes.Value) (key.Destination, error) {
+	if len(row) > len(m.columnVdx) {
+		return key.DestinationNone{}, nil
+	}
+	var prefix []byte
+	for i, value := range row {
+		destinations, err := m.columnVdx[i].Map(vcursor, []sqltypes.Value{value})
+		if err != nil {
+			return nil, err
+		}
+		ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+		if !ok {
+			return key.DestinationNone{}, nil
+		}
+		// Short keyspace ids are padded with zeroes.
+		colBytes := make([]byte, m.columnBytes[i])
+		copy(colBytes, ksid)
+		prefix = append(prefix, colBytes...)
+	}
+	if len(row) == len(m.columnVdx) {
+		return key.DestinationKeyspaceID(prefix), nil
+	}
+	return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+		Start: prefix,
+		End:   prefixEnd(prefix),
+	}}, nil
+}
+
+// prefixEnd returns the smallest keyspace id that is greater
+// than all the keyspace ids that start with the prefix. It
+// returns nil if there is none, which is the end of the keyspace.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// Verify satisfies MultiColumn.
+func (m *MultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	result := make([]bool, len(rowsColValues))
+	destinations, err := m.Map(vcursor, rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	for i, dest := range destinations {
+		destksid, ok := dest.(key.DestinationKeyspaceID)
+		if !ok {
+			continue
+		}
+		result[i] = bytes.Equal([]byte(destksid), ksids[i])
+	}
+	return result, nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestMultiColMisc(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, "multicol", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+	assert.Equal(t, []int{4, 4}, vindex.(*MultiCol).columnBytes)
+
+	vindex, err = CreateVindex("multicol", "multicol", map[string]string{
+		"column_count":  "3",
+		"column_vindex": "binary,hash,numeric",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, vindex.Cost())
+	assert.Equal(t, []int{4, 2, 2}, vindex.(*MultiCol).columnBytes)
+
+	vindex, err = CreateVindex("multicol", "multicol", map[string]string{
+		"column_count":  "2",
+		"column_vindex": "numeric,numeric",
+		"column_bytes":  "1,2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, vindex.Cost())
+	assert.Equal(t, []int{1, 2}, vindex.(*MultiCol).columnBytes)
+}
+
+func TestMultiColMap(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+		"column_bytes": "1,7",
+	})
+	require.NoError(t, err)
+	got, err := vindex.(MultiColumn).Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		// Only the first column.
+		sqltypes.NewInt64(1),
+	}, {
+		// No columns.
+	}, {
+		// Too many columns.
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		// Invalid value.
+		sqltypes.NewInt64(1), sqltypes.NewVarBinary("abcd"),
+	}})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID("\x16\x16k@\xb4J\xbaK"),
+		key.DestinationKeyspaceID("\x16\x06\xe7\xea\"\xce\x92p"),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x16"), End: []byte("\x17")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestMultiColMapShortKeyspaceID(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count":  "2",
+		"column_vindex": "binary,binary",
+		"column_bytes":  "2,2",
+	})
+	require.NoError(t, err)
+	got, err := vindex.(MultiColumn).Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("bcd"),
+	}, {
+		sqltypes.NewVarBinary("\xff\xff"),
+	}, {
+		sqltypes.NewVarBinary("\x01\xff"),
+	}})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID("a\x00bc"),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\xff\xff")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01\xff"), End: []byte("\x02")}},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestMultiColVerify(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+		"column_bytes": "1,7",
+	})
+	require.NoError(t, err)
+	got, err := vindex.(MultiColumn).Verify(nil, [][]sqltypes.Value{{
+		// One for match
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		// One for mismatch
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		// One partial value
+		sqltypes.NewInt64(1),
+	}}, [][]byte{
+		[]byte("\x16\x16k@\xb4J\xbaK"),
+		[]byte("no match"),
+		[]byte("\x16"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestMultiColCreateErrors(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: nil,
+		err:    `multicol: invalid column_count: ""`,
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    "multicol: column_count must be between 1 and 8: 9",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash"},
+		err:    `multicol: column_vindex must have 2 values: "hash"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,foo"},
+		err:    `multicol: vindexType "foo" not found`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,lookup_hash"},
+		err:    "multicol: lookup_hash is not a functional unique single column vindex",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4"},
+		err:    `multicol: column_bytes must have 2 values: "4"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,0"},
+		err:    `multicol: invalid column_bytes: "4,0"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,5"},
+		err:    `multicol: column_bytes cannot exceed a total of 8: "4,5"`,
+	}}
+	for _, tcase := range testcases {
+		_, err := CreateVindex("multicol", "multicol", tcase.params)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
@@ -91,6 +91,7 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	PrimaryKey              []sqlparser.ColIdent `json:"primary_key,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -277,6 +278,11 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Columns = append(t.Columns, Column{Name: name, Type: col.Type})
 		}
 
+		// Initialize PrimaryKey.
+		for _, col := range table.PrimaryKey {
+			t.PrimaryKey = append(t.PrimaryKey, sqlparser.NewColIdent(col))
+		}
+
 		// Initialize ColumnVindexes.
 		for i, ind := range table.ColumnVindexes {
 			vindexInfo, ok := ks.Vindexes[ind.Name]
@@ -372,6 +372,58 @@ func TestVSchemaColumnListAuthoritative(t *testing.T) {
 	}
 }
 
+func TestVSchemaPrimaryKey(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						PrimaryKey: []string{"c1", "C2"},
+					},
+				},
+			},
+		},
+	}
+	got, err := BuildVSchema(&good)
+	require.NoError(t, err)
+	ks := &Keyspace{
+		Name: "unsharded",
+	}
+	t1 := &Table{
+		Name:       sqlparser.NewTableIdent("t1"),
+		Keyspace:   ks,
+		PrimaryKey: []sqlparser.ColIdent{sqlparser.NewColIdent("c1"), sqlparser.NewColIdent("C2")},
+	}
+	dual := &Table{
+		Name:     sqlparser.NewTableIdent("dual"),
+		Keyspace: ks,
+		Type:     TypeReference,
+	}
+	want := &VSchema{
+		RoutingRules: map[string]*RoutingRule{},
+		uniqueTables: map[string]*Table{
+			"t1":   t1,
+			"dual": dual,
+		},
+		uniqueVindexes: map[string]Vindex{},
+		Keyspaces: map[string]*KeyspaceSchema{
+			"unsharded": {
+				Keyspace: ks,
+				Tables: map[string]*Table{
+					"t1":   t1,
+					"dual": dual,
+				},
+				Vindexes: map[string]Vindex{},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		gotb, _ := json.Marshal(got)
+		wantb, _ := json.Marshal(want)
+		t.Errorf("BuildVSchema:\n%s, want\n%s", gotb, wantb)
+	}
+}
+
 func TestVSchemaColumnsFail(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
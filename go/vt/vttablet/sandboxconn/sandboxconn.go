@@ -51,6 +51,9 @@ type SandboxConn struct {
 	MustFailSetRollback         int
 	MustFailConcludeTransaction int
 
+	// ExecuteErr is returned by the next Execute, if set.
+	ExecuteErr error
+
 	// These Count vars report how often the corresponding
 	// functions were called.
 	ExecCount                sync2.AtomicInt64
@@ -150,6 +153,10 @@ func (sbc *SandboxConn) Execute(ctx context.Context, target *querypb.Target, que
 	if err := sbc.getError(); err != nil {
 		return nil, err
 	}
+	if err := sbc.ExecuteErr; err != nil {
+		sbc.ExecuteErr = nil
+		return nil, err
+	}
 	return sbc.getNextResult(), nil
 }
 
@@ -278,6 +278,11 @@ func (dbc *DBConn) IsClosed() bool {
 	return dbc.conn.IsClosed()
 }
 
+// ResetConnection resets the session state of the DBConn.
+func (dbc *DBConn) ResetConnection() error {
+	return dbc.conn.ResetConnection()
+}
+
 // Recycle returns the DBConn to the pool.
 func (dbc *DBConn) Recycle() {
 	switch {
@@ -330,6 +335,17 @@ func (dbc *DBConn) ID() int64 {
 	return dbc.conn.ID()
 }
 
+// MasterPosition returns the executed replication position of MySQL.
+func (dbc *DBConn) MasterPosition() (mysql.Position, error) {
+	return dbc.conn.MasterPosition()
+}
+
+// WaitUntilPositionCommand returns the query that waits for MySQL to reach
+// the replication position, for the flavor of the connection.
+func (dbc *DBConn) WaitUntilPositionCommand(ctx context.Context, pos mysql.Position) (string, error) {
+	return dbc.conn.WaitUntilPositionCommand(ctx, pos)
+}
+
 func (dbc *DBConn) reconnect(ctx context.Context) error {
 	dbc.conn.Close()
 	// Reuse MySQLTimings from dbc.conn.
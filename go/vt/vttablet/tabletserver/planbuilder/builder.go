@@ -35,6 +35,15 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		plan.PlanID = PlanSelectLock
 	}
 
+	// A reserved connection keeps the named locks until it's released.
+	// The field query would run outside of it.
+	if checkForPoolingUnsafeConstructs(sel) != nil {
+		plan.PlanID = PlanSelectLockFunc
+		plan.FieldQuery = nil
+		plan.FullQuery = GenerateFullQuery(sel)
+		return plan, nil
+	}
+
 	if sel.Where != nil {
 		comp, ok := sel.Where.Expr.(*sqlparser.ComparisonExpr)
 		if ok && comp.IsImpossible() {
@@ -64,6 +64,9 @@ const (
 	PlanSavepoint
 	PlanRelease
 	PlanSRollback
+	// PlanSelectLockFunc is for selects that call GET_LOCK(),
+	// which can only run on a reserved connection.
+	PlanSelectLockFunc
 	NumPlans
 )
 
@@ -88,6 +91,7 @@ var planName = [NumPlans]string{
 	"Savepoint",
 	"Release",
 	"RollbackSavepoint",
+	"SelectLockFunc",
 }
 
 func (pt PlanType) String() string {
@@ -154,11 +158,15 @@ func (plan *Plan) TableName() sqlparser.TableIdent {
 func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Plan, error) {
 	var plan *Plan
 
-	err := checkForPoolingUnsafeConstructs(statement)
-	if err != nil {
-		return nil, err
+	// Selects are allowed to call GET_LOCK() on a reserved connection.
+	if _, ok := statement.(*sqlparser.Select); !ok {
+		if err := checkForPoolingUnsafeConstructs(statement); err != nil {
+			return nil, err
+		}
 	}
 
+	var err error
+
 	switch stmt := statement.(type) {
 	case *sqlparser.Union:
 		plan, err = &Plan{
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// maxWaitForPositionTimeout bounds the time a query waits for the
+// replication position, since it holds a pool connection meanwhile.
+const maxWaitForPositionTimeout = 500 * time.Millisecond
+
+// waitForPosition waits for MySQL to reach the replication position
+// requested by the options, if any. If the position isn't reached
+// within the timeout of the options, which is at most
+// maxWaitForPositionTimeout, a FAILED_PRECONDITION error is
+// returned, for vtgate to try another tablet.
+func (qe *QueryEngine) waitForPosition(ctx context.Context, options *querypb.ExecuteOptions) error {
+	if options.GetWaitForPosition() == "" {
+		return nil
+	}
+	pos, err := mysql.DecodePosition(options.WaitForPosition)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid position to wait for: %v", err)
+	}
+
+	conn, err := qe.conns.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	current, err := conn.MasterPosition()
+	if err != nil {
+		return err
+	}
+	if current.AtLeast(pos) {
+		return nil
+	}
+	timeout := time.Duration(options.WaitForPositionTimeoutMs) * time.Millisecond
+	if timeout <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "position %v not reached", options.WaitForPosition)
+	}
+	if timeout > maxWaitForPositionTimeout {
+		timeout = maxWaitForPositionTimeout
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	query, err := conn.WaitUntilPositionCommand(waitCtx, pos)
+	if err != nil {
+		return err
+	}
+	qr, err := conn.Exec(ctx, query, 1, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", query, qr.Rows)
+	}
+	// The result is -1 if the wait timed out, and NULL
+	// if the tablet doesn't replicate.
+	if result := qr.Rows[0][0]; result.IsNull() || result.ToString() == "-1" {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "position %v not reached within %v", options.WaitForPosition, timeout)
+	}
+	return nil
+}
+
+// executedPosition returns the encoded replication position of MySQL.
+func (qe *QueryEngine) executedPosition(ctx context.Context) (string, error) {
+	conn, err := qe.conns.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+
+	pos, err := conn.MasterPosition()
+	if err != nil {
+		return "", err
+	}
+	return mysql.EncodePosition(pos), nil
+}
@@ -134,6 +134,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		return qr, nil
 	case planbuilder.PlanSelectLock:
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
+	case planbuilder.PlanSelectLockFunc:
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside reserved connection", qre.plan.PlanID.String())
 	case planbuilder.PlanSet, planbuilder.PlanOtherRead, planbuilder.PlanOtherAdmin:
 		return qre.execOther()
 	case planbuilder.PlanSavepoint, planbuilder.PlanRelease, planbuilder.PlanSRollback:
@@ -214,6 +216,13 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 			return nil, err
 		}
 		return qr, nil
+	case planbuilder.PlanSelectLockFunc:
+		// The named locks would outlive a transaction on its pooled connection.
+		if !conn.IsTainted() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside reserved connection", qre.plan.PlanID.String())
+		}
+		conn.MarkNamedLocks()
+		return qre.txFetch(conn, false)
 	case planbuilder.PlanDDL:
 		return qre.execDDL(conn)
 	}
@@ -385,7 +394,7 @@ func (qre *QueryExecutor) execDDL(conn *StatefulConnection) (*sqltypes.Result, e
 
 // BeginAgain commits the existing transaction and begins a new one
 func (*QueryExecutor) BeginAgain(ctx context.Context, dc *StatefulConnection) error {
-	if dc.IsClosed() || dc.TxProperties().Autocommit {
+	if dc.IsClosed() || !dc.IsInTransaction() || dc.TxProperties().Autocommit {
 		return nil
 	}
 	if _, err := dc.Exec(ctx, "commit", 1, false); err != nil {
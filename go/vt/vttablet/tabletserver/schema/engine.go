@@ -40,6 +40,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -61,8 +62,11 @@ type Engine struct {
 	reloadTime time.Duration
 	//the position at which the schema was last loaded. it is only used in conjunction with ReloadAt
 	reloadAtPos mysql.Position
-	notifierMu  sync.Mutex
-	notifiers   map[string]notifier
+	// tableStats are the statistics loaded by the last reload, if
+	// PublishTableStatistics is enabled.
+	tableStats []*querypb.TableStatistics
+	notifierMu sync.Mutex
+	notifiers  map[string]notifier
 
 	historian *historian
 
@@ -171,6 +175,7 @@ func (se *Engine) Close() {
 	se.conns.Close()
 
 	se.tables = make(map[string]*Table)
+	se.tableStats = nil
 	se.lastChange = 0
 	se.notifiers = make(map[string]notifier)
 	se.isOpen = false
@@ -304,9 +309,61 @@ func (se *Engine) reload(ctx context.Context) error {
 	}
 	se.lastChange = curTime
 	se.broadcast(created, altered, dropped)
+
+	if se.env.Config().PublishTableStatistics {
+		// The statistics are only hints for the query planner.
+		// Failing to load them doesn't fail the reload.
+		tableStats, err := se.loadTableStatistics(ctx, conn)
+		if err != nil {
+			log.Warningf("Could not load table statistics: %v", err)
+		} else {
+			se.tableStats = tableStats
+		}
+	}
 	return nil
 }
 
+// loadTableStatistics loads the estimated row counts of the tables,
+// and the estimated cardinality of the columns that lead an index.
+func (se *Engine) loadTableStatistics(ctx context.Context, conn *connpool.DBConn) ([]*querypb.TableStatistics, error) {
+	rowData, err := conn.Exec(ctx, mysql.BaseShowTableRows, maxTableCount, false)
+	if err != nil {
+		return nil, err
+	}
+	tableStats := make([]*querypb.TableStatistics, 0, len(rowData.Rows))
+	byName := make(map[string]*querypb.TableStatistics, len(rowData.Rows))
+	for _, row := range rowData.Rows {
+		// table_rows is NULL for some engines.
+		rowCount, _ := evalengine.ToUint64(row[1])
+		ts := &querypb.TableStatistics{
+			Name:     row[0].ToString(),
+			RowCount: rowCount,
+		}
+		tableStats = append(tableStats, ts)
+		byName[ts.Name] = ts
+	}
+
+	cardinalityData, err := conn.Exec(ctx, mysql.BaseShowIndexCardinality, maxTableCount, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range cardinalityData.Rows {
+		ts, ok := byName[row[0].ToString()]
+		if !ok {
+			continue
+		}
+		cardinality, err := evalengine.ToUint64(row[2])
+		if err != nil {
+			continue
+		}
+		if ts.ColumnCardinality == nil {
+			ts.ColumnCardinality = make(map[string]uint64)
+		}
+		ts.ColumnCardinality[row[1].ToString()] = cardinality
+	}
+	return tableStats, nil
+}
+
 func (se *Engine) mysqlTime(ctx context.Context, conn *connpool.DBConn) (int64, error) {
 	tm, err := conn.Exec(ctx, "select unix_timestamp()", 1, false)
 	if err != nil {
@@ -436,6 +493,15 @@ func (se *Engine) GetSchema() map[string]*Table {
 	return tables
 }
 
+// TableStatistics returns the table statistics loaded by the
+// last reload. It returns nil if PublishTableStatistics is disabled.
+// The statistics are shared and must be treated as read-only.
+func (se *Engine) TableStatistics() []*querypb.TableStatistics {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	return se.tableStats
+}
+
 // GetConnection returns a connection from the pool
 func (se *Engine) GetConnection(ctx context.Context) (*connpool.DBConn, error) {
 	return se.conns.Get(ctx)
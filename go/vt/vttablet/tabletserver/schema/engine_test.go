@@ -341,6 +341,44 @@ func TestStatsURL(t *testing.T) {
 	se.handleDebugSchema(response, request)
 }
 
+func TestTableStatistics(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery(mysql.BaseShowTableRows, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|table_rows", "varchar|uint64"),
+		"test_table_01|100",
+		"test_table_02|null",
+	))
+	db.AddQuery(mysql.BaseShowIndexCardinality, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name|max(cardinality)", "varchar|varchar|int64"),
+		"test_table_01|pk|100",
+		"test_table_02|pk|null",
+		"unknown_table|pk|10",
+	))
+	se := newEngine(10, 1*time.Second, 1*time.Second, true, db)
+	se.env.Config().PublishTableStatistics = true
+	require.NoError(t, se.Open())
+	defer se.Close()
+
+	want := []*querypb.TableStatistics{{
+		Name:              "test_table_01",
+		RowCount:          100,
+		ColumnCardinality: map[string]uint64{"pk": 100},
+	}, {
+		Name: "test_table_02",
+	}}
+	assert.Equal(t, want, se.TableStatistics())
+
+	// Statistics aren't loaded unless they're published.
+	se.Close()
+	se.env.Config().PublishTableStatistics = false
+	require.NoError(t, se.Open())
+	assert.Nil(t, se.TableStatistics())
+}
+
 func newEngine(queryCacheSize int, reloadTime time.Duration, idleTimeout time.Duration, strict bool, db *fakesqldb.DB) *Engine {
 	config := tabletenv.NewDefaultConfig()
 	config.QueryCacheSize = queryCacheSize
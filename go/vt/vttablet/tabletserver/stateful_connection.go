@@ -51,6 +51,17 @@ type StatefulConnection struct {
 	reservedProps  *Properties
 	tainted        bool
 	enforceTimeout bool
+
+	// settings are the system variable settings the reserved connection
+	// was set up with. Once it's released, the connection is reset and
+	// reused by another session with the same settings.
+	settings string
+
+	// namedLocks is set once named lock functions ran on the reserved
+	// connection. The locks are held until the connection is released,
+	// so outside of a transaction the transaction killer only kills it
+	// once it exceeds the named lock timeout.
+	namedLocks bool
 }
 
 //Properties contains meta information about the connection
@@ -138,7 +149,9 @@ func (sc *StatefulConnection) Releasef(reasonFormat string, a ...interface{}) {
 		return
 	}
 	sc.pool.unregister(sc.ConnID, fmt.Sprintf(reasonFormat, a...))
-	sc.dbConn.Recycle()
+	if !sc.pool.putSettingsConn(sc) {
+		sc.dbConn.Recycle()
+	}
 	sc.dbConn = nil
 	sc.logReservedConn()
 }
@@ -210,6 +223,24 @@ func (sc *StatefulConnection) Taint(ctx context.Context, stats *servenv.TimingsW
 	return nil
 }
 
+//MarkNamedLocks records that named locks may be held on the connection.
+func (sc *StatefulConnection) MarkNamedLocks() {
+	sc.namedLocks = true
+}
+
+//HoldsNamedLocks returns true if named locks may be held on the connection.
+func (sc *StatefulConnection) HoldsNamedLocks() bool {
+	return sc.namedLocks
+}
+
+//ReservedTime returns how long the connection has been reserved.
+func (sc *StatefulConnection) ReservedTime() time.Duration {
+	if sc.reservedProps == nil {
+		return 0
+	}
+	return time.Since(sc.reservedProps.StartTime)
+}
+
 //IsTainted tells us whether this connection is tainted
 func (sc *StatefulConnection) IsTainted() bool {
 	return sc.tainted
@@ -17,6 +17,8 @@ limitations under the License.
 package tabletserver
 
 import (
+	"strings"
+	"sync"
 	"time"
 
 	"vitess.io/vitess/go/pools"
@@ -26,6 +28,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -48,6 +51,22 @@ type StatefulConnectionPool struct {
 	active        *pools.Numbered
 	lastID        sync2.AtomicInt64
 	env           tabletenv.Env
+
+	// settingsConns keeps the released reserved connections that
+	// only have the system variable settings they were set up with,
+	// by their settings, so that they can be reserved again by the
+	// sessions with the same settings. It's nil when the pool is closed.
+	settingsMu          sync.Mutex
+	settingsConns       map[string][]*settingsConn
+	settingsCount       int
+	settingsCapacity    int
+	settingsIdleTimeout time.Duration
+}
+
+// settingsConn is a connection in the settings pool.
+type settingsConn struct {
+	dbConn   *connpool.DBConn
+	released time.Time
 }
 
 //NewStatefulConnPool creates an ActivePool
@@ -60,6 +79,9 @@ func NewStatefulConnPool(env tabletenv.Env) *StatefulConnectionPool {
 		foundRowsPool: connpool.NewPool(env, "FoundRowsPool", config.TxPool),
 		active:        pools.NewNumbered(),
 		lastID:        sync2.NewAtomicInt64(time.Now().UnixNano()),
+
+		settingsCapacity:    config.SettingsPoolSize,
+		settingsIdleTimeout: time.Duration(config.TxPool.IdleTimeoutSeconds * 1e9),
 	}
 }
 
@@ -72,6 +94,10 @@ func (sf *StatefulConnectionPool) Open(appParams, dbaParams, appDebugParams dbco
 	foundRowsParam.EnableClientFoundRows()
 	appParams = dbconfigs.New(foundRowsParam)
 	sf.foundRowsPool.Open(appParams, dbaParams, appDebugParams)
+
+	sf.settingsMu.Lock()
+	sf.settingsConns = make(map[string][]*settingsConn)
+	sf.settingsMu.Unlock()
 }
 
 // Close closes the TxPool. A closed pool can be reopened.
@@ -87,6 +113,15 @@ func (sf *StatefulConnectionPool) Close() {
 		conn.Close()
 		conn.Releasef("pool closed")
 	}
+	sf.settingsMu.Lock()
+	for _, conns := range sf.settingsConns {
+		for _, conn := range conns {
+			conn.dbConn.Close()
+		}
+	}
+	sf.settingsConns = nil
+	sf.settingsCount = 0
+	sf.settingsMu.Unlock()
 	sf.conns.Close()
 	sf.foundRowsPool.Close()
 }
@@ -146,7 +181,77 @@ func (sf *StatefulConnectionPool) NewConn(ctx context.Context, options *querypb.
 	if err != nil {
 		return nil, err
 	}
+	return sf.register(conn, options)
+}
+
+// GetWithSettings returns a StatefulConnection from the settings pool, that was
+// released by a session with the same settings. The connection is reset, so
+// the settings have to be set up again. It returns nil if the pool has no such
+// connection.
+func (sf *StatefulConnectionPool) GetWithSettings(options *querypb.ExecuteOptions, settings string) (*StatefulConnection, error) {
+	for {
+		sf.settingsMu.Lock()
+		conns := sf.settingsConns[settings]
+		if len(conns) == 0 {
+			sf.settingsMu.Unlock()
+			return nil, nil
+		}
+		last := conns[len(conns)-1]
+		if len(conns) == 1 {
+			delete(sf.settingsConns, settings)
+		} else {
+			sf.settingsConns[settings] = conns[:len(conns)-1]
+		}
+		sf.settingsCount--
+		sf.settingsMu.Unlock()
+
+		if sf.isIdle(last) {
+			last.dbConn.Close()
+			continue
+		}
+		if err := last.dbConn.ResetConnection(); err != nil {
+			log.Warningf("could not reset connection for reuse: %v", err)
+			last.dbConn.Close()
+			continue
+		}
+		return sf.register(last.dbConn, options)
+	}
+}
 
+// CloseIdleSettingsConns closes the connections in the settings pool that
+// are closed or were idle for longer than the idle timeout.
+func (sf *StatefulConnectionPool) CloseIdleSettingsConns() {
+	var idle []*connpool.DBConn
+	sf.settingsMu.Lock()
+	for settings, conns := range sf.settingsConns {
+		kept := conns[:0]
+		for _, conn := range conns {
+			if sf.isIdle(conn) {
+				idle = append(idle, conn.dbConn)
+				continue
+			}
+			kept = append(kept, conn)
+		}
+		sf.settingsCount -= len(conns) - len(kept)
+		if len(kept) == 0 {
+			delete(sf.settingsConns, settings)
+		} else {
+			sf.settingsConns[settings] = kept
+		}
+	}
+	sf.settingsMu.Unlock()
+
+	for _, dbConn := range idle {
+		dbConn.Close()
+	}
+}
+
+func (sf *StatefulConnectionPool) isIdle(conn *settingsConn) bool {
+	return conn.dbConn.IsClosed() || (sf.settingsIdleTimeout > 0 && time.Since(conn.released) > sf.settingsIdleTimeout)
+}
+
+// register registers the connection as active, and returns it locked.
+func (sf *StatefulConnectionPool) register(conn *connpool.DBConn, options *querypb.ExecuteOptions) (*StatefulConnection, error) {
 	connID := sf.lastID.Add(1)
 	sfConn := &StatefulConnection{
 		dbConn:         conn,
@@ -156,7 +261,7 @@ func (sf *StatefulConnectionPool) NewConn(ctx context.Context, options *querypb.
 		enforceTimeout: options.GetWorkload() != querypb.ExecuteOptions_DBA,
 	}
 
-	err = sf.active.Register(
+	err := sf.active.Register(
 		sfConn.ConnID,
 		sfConn,
 		sfConn.enforceTimeout,
@@ -169,6 +274,44 @@ func (sf *StatefulConnectionPool) NewConn(ctx context.Context, options *querypb.
 	return sf.GetAndLock(sfConn.ConnID, "new connection")
 }
 
+// putSettingsConn keeps the released connection in the settings pool,
+// if it was set up with settings. It returns false if the connection
+// was not kept.
+func (sf *StatefulConnectionPool) putSettingsConn(sc *StatefulConnection) bool {
+	if sc.settings == "" || sc.IsInTransaction() || sc.dbConn.IsClosed() {
+		return false
+	}
+	sf.settingsMu.Lock()
+	defer sf.settingsMu.Unlock()
+	if sf.settingsConns == nil || sf.settingsCount >= sf.settingsCapacity {
+		return false
+	}
+	sf.settingsConns[sc.settings] = append(sf.settingsConns[sc.settings], &settingsConn{
+		dbConn:   sc.dbConn,
+		released: time.Now(),
+	})
+	sf.settingsCount++
+	return true
+}
+
+// settingsKey returns the key by which the reserved connections that are set up
+// with the pre-queries for the caller are kept in the settings pool. It's empty
+// if there are no pre-queries.
+func settingsKey(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string) string {
+	if len(preQueries) == 0 {
+		return ""
+	}
+	key := strings.Join(preQueries, ";")
+	if options.GetClientFoundRows() {
+		key = "found_rows:" + key
+	}
+	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx))
+	if username == "" {
+		username = callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+	}
+	return username + "@" + key
+}
+
 //ForAllTxProperties executes a function an every connection that has a not-nil TxProperties
 func (sf *StatefulConnectionPool) ForAllTxProperties(f func(*tx.Properties)) {
 	for _, connection := range mapToTxConn(sf.active.GetAll()) {
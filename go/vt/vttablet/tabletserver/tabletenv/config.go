@@ -86,6 +86,7 @@ func init() {
 	flag.IntVar(&currentConfig.MessagePostponeParallelism, "queryserver-config-message-postpone-cap", defaultConfig.MessagePostponeParallelism, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&deprecatedFoundRowsPoolSize, "client-found-rows-pool-size", 0, "DEPRECATED: queryserver-config-transaction-cap will be used instead.")
 	flag.Float64Var(&currentConfig.Oltp.TxTimeoutSeconds, "queryserver-config-transaction-timeout", defaultConfig.Oltp.TxTimeoutSeconds, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
+	flag.Float64Var(&currentConfig.Oltp.NamedLockTimeoutSeconds, "queryserver-config-named-lock-timeout", defaultConfig.Oltp.NamedLockTimeoutSeconds, "query server named lock timeout (in seconds), a reserved connection that holds named locks outside of a transaction will be killed if it's reserved for longer than this value")
 	flag.Float64Var(&currentConfig.ShutdownGracePeriodSeconds, "transaction_shutdown_grace_period", defaultConfig.ShutdownGracePeriodSeconds, "how long to wait (in seconds) for transactions to complete during graceful shutdown.")
 	flag.IntVar(&currentConfig.Oltp.MaxRows, "queryserver-config-max-result-size", defaultConfig.Oltp.MaxRows, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
 	flag.IntVar(&currentConfig.Oltp.WarnRows, "queryserver-config-warn-result-size", defaultConfig.Oltp.WarnRows, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
@@ -103,6 +104,7 @@ func init() {
 	flag.Float64Var(&currentConfig.OltpReadPool.IdleTimeoutSeconds, "queryserver-config-idle-timeout", defaultConfig.OltpReadPool.IdleTimeoutSeconds, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
 	flag.IntVar(&currentConfig.OltpReadPool.MaxWaiters, "queryserver-config-query-pool-waiter-cap", defaultConfig.OltpReadPool.MaxWaiters, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
 	flag.IntVar(&currentConfig.TxPool.MaxWaiters, "queryserver-config-txpool-waiter-cap", defaultConfig.TxPool.MaxWaiters, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
+	flag.IntVar(&currentConfig.SettingsPoolSize, "queryserver-config-settings-pool-size", defaultConfig.SettingsPoolSize, "query server settings pool size, the maximum number of released reserved connections that are kept with the system variable settings of their sessions, to be reserved again by sessions with the same settings. Idle connections are closed after the idle timeout.")
 	// tableacl related configurations.
 	flag.BoolVar(&currentConfig.StrictTableACL, "queryserver-config-strict-table-acl", defaultConfig.StrictTableACL, "only allow queries that pass table acl checks")
 	flag.BoolVar(&currentConfig.EnableTableACLDryRun, "queryserver-config-enable-table-acl-dry-run", defaultConfig.EnableTableACLDryRun, "If this flag is enabled, tabletserver will emit monitoring metrics and let the request pass regardless of table acl check results")
@@ -111,6 +113,7 @@ func init() {
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", true, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
+	flag.BoolVar(&currentConfig.PublishTableStatistics, "publish_table_statistics", false, "When enabled, vttablet will load the estimated row counts and index cardinalities of its tables from MySQL with every schema reload, and publish them in its health stream. vtgate uses them to order the joins of a query.")
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
@@ -196,6 +199,10 @@ type TabletConfig struct {
 	OlapReadPool ConnPoolConfig `json:"olapReadPool,omitempty"`
 	TxPool       ConnPoolConfig `json:"txPool,omitempty"`
 
+	// SettingsPoolSize is the number of released reserved connections that
+	// are kept by the system variable settings they were set up with.
+	SettingsPoolSize int `json:"settingsPoolSize,omitempty"`
+
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
 
@@ -208,6 +215,7 @@ type TabletConfig struct {
 	SchemaReloadIntervalSeconds float64 `json:"schemaReloadIntervalSeconds,omitempty"`
 	WatchReplication            bool    `json:"watchReplication,omitempty"`
 	TrackSchemaVersions         bool    `json:"trackSchemaVersions,omitempty"`
+	PublishTableStatistics      bool    `json:"publishTableStatistics,omitempty"`
 	TerseErrors                 bool    `json:"terseErrors,omitempty"`
 	MessagePostponeParallelism  int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields           bool    `json:"cacheResultFields,omitempty"`
@@ -241,10 +249,11 @@ type ConnPoolConfig struct {
 
 // OltpConfig contains the config for oltp settings.
 type OltpConfig struct {
-	QueryTimeoutSeconds float64 `json:"queryTimeoutSeconds,omitempty"`
-	TxTimeoutSeconds    float64 `json:"txTimeoutSeconds,omitempty"`
-	MaxRows             int     `json:"maxRpws,omitempty"`
-	WarnRows            int     `json:"warnRows,omitempty"`
+	QueryTimeoutSeconds     float64 `json:"queryTimeoutSeconds,omitempty"`
+	TxTimeoutSeconds        float64 `json:"txTimeoutSeconds,omitempty"`
+	NamedLockTimeoutSeconds float64 `json:"namedLockTimeoutSeconds,omitempty"`
+	MaxRows                 int     `json:"maxRpws,omitempty"`
+	WarnRows                int     `json:"warnRows,omitempty"`
 }
 
 // HotRowProtectionConfig contains the config for hot row protection.
@@ -352,10 +361,12 @@ var defaultConfig = TabletConfig{
 		IdleTimeoutSeconds: 30 * 60,
 		MaxWaiters:         5000,
 	},
+	SettingsPoolSize: 20,
 	Oltp: OltpConfig{
-		QueryTimeoutSeconds: 30,
-		TxTimeoutSeconds:    30,
-		MaxRows:             10000,
+		QueryTimeoutSeconds:     30,
+		TxTimeoutSeconds:        30,
+		NamedLockTimeoutSeconds: 30 * 60,
+		MaxRows:                 10000,
 	},
 	HotRowProtection: HotRowProtectionConfig{
 		Mode: Disable,
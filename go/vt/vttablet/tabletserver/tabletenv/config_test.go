@@ -115,6 +115,7 @@ olapReadPool:
   size: 200
 oltp:
   maxRpws: 10000
+  namedLockTimeoutSeconds: 1800
   queryTimeoutSeconds: 30
   txTimeoutSeconds: 30
 oltpReadPool:
@@ -123,6 +124,7 @@ oltpReadPool:
   size: 16
 queryCacheSize: 5000
 schemaReloadIntervalSeconds: 1800
+settingsPoolSize: 20
 streamBufferSize: 32768
 txPool:
   idleTimeoutSeconds: 1800
@@ -167,10 +169,12 @@ func TestFlags(t *testing.T) {
 			TimeoutSeconds: 1,
 			MaxWaiters:     5000,
 		},
+		SettingsPoolSize: 20,
 		Oltp: OltpConfig{
-			QueryTimeoutSeconds: 30,
-			TxTimeoutSeconds:    30,
-			MaxRows:             10000,
+			QueryTimeoutSeconds:     30,
+			TxTimeoutSeconds:        30,
+			NamedLockTimeoutSeconds: 1800,
+			MaxRows:                 10000,
 		},
 		HotRowProtection: HotRowProtectionConfig{
 			MaxQueueSize:       20,
@@ -652,11 +652,22 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				tsv:            tsv,
 				tabletType:     target.GetTabletType(),
 			}
+			if connID == 0 {
+				if err := tsv.qe.waitForPosition(ctx, options); err != nil {
+					return err
+				}
+			}
 			result, err = qre.Execute()
 			if err != nil {
 				return err
 			}
 			result = result.StripMetadata(sqltypes.IncludeFieldsOrDefault(options))
+			if options.GetIncludeExecutedPosition() {
+				result.ExecutedPosition, err = tsv.qe.executedPosition(ctx)
+				if err != nil {
+					return err
+				}
+			}
 			return nil
 		},
 	)
@@ -691,6 +702,11 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 				logStats:       logStats,
 				tsv:            tsv,
 			}
+			if transactionID == 0 {
+				if err := tsv.qe.waitForPosition(ctx, options); err != nil {
+					return err
+				}
+			}
 			return qre.Stream(callback)
 		},
 	)
@@ -1410,7 +1426,11 @@ func (tsv *TabletServer) streamHealthUnregister(id int) {
 }
 
 // BroadcastHealth will broadcast the current health to all listeners
+// If table statistics are published, they're added to the stats.
 func (tsv *TabletServer) BroadcastHealth(terTimestamp int64, stats *querypb.RealtimeStats, maxCache time.Duration) {
+	if stats != nil && tsv.config.PublishTableStatistics {
+		stats.TableStatistics = tsv.se.TableStatistics()
+	}
 	target := tsv.sm.Target()
 	shr := &querypb.StreamHealthResponse{
 		Target:      &target,
@@ -672,6 +672,79 @@ func TestTabletServerStreamExecuteComments(t *testing.T) {
 		t.Fatal("stats are empty")
 	}
 }
+
+func TestTabletServerExecuteReadAfterWrite(t *testing.T) {
+	db, tsv := setupTabletServerTest(t)
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	executeSQL := "select 42 from dual"
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+	db.AddQuery(executeSQL+" limit 10001", &sqltypes.Result{})
+	db.AddQuery("SELECT @@GLOBAL.gtid_executed", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"@@global.gtid_executed",
+			"varchar",
+		),
+		"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+	))
+	waitSQL := "SELECT WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS('3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8', 1)"
+	waitResult := func(result string) {
+		db.AddQuery(waitSQL, sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"result",
+				"int64",
+			),
+			result,
+		))
+	}
+
+	// The executed position is returned if it's requested.
+	qr, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, &querypb.ExecuteOptions{IncludeExecutedPosition: true})
+	require.NoError(t, err)
+	assert.Equal(t, "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", qr.ExecutedPosition)
+	qr, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.ExecutedPosition)
+
+	// A position that is reached doesn't need a wait.
+	options := &querypb.ExecuteOptions{
+		WaitForPosition:          "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3",
+		WaitForPositionTimeoutMs: 100,
+	}
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.NoError(t, err)
+	assert.Equal(t, 0, db.GetQueryCalledNum(waitSQL))
+
+	// Otherwise, the tablet waits for it.
+	options.WaitForPosition = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8"
+	waitResult("3")
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum(waitSQL))
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, options, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, 2, db.GetQueryCalledNum(waitSQL))
+
+	waitResult("-1")
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.EqualError(t, err, "position MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8 not reached within 100ms")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	// The wait is bounded, whatever the timeout of the options.
+	options.WaitForPositionTimeoutMs = 5000
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.EqualError(t, err, "position MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8 not reached within 500ms")
+	assert.Equal(t, 2, db.GetQueryCalledNum(waitSQL))
+
+	// Without a timeout, the tablet doesn't wait.
+	options.WaitForPositionTimeoutMs = 0
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, options)
+	require.EqualError(t, err, "position MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8 not reached")
+	assert.Equal(t, 2, db.GetQueryCalledNum(waitSQL))
+}
+
 func TestTabletServerExecuteBatch(t *testing.T) {
 	db, tsv := setupTabletServerTest(t)
 	defer tsv.StopService()
@@ -2031,6 +2104,146 @@ func TestReserveExecute_WithTx(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReserveExecute_GetLock(t *testing.T) {
+	db, tsv := setupTabletServerTest(t)
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	query := "select get_lock('foo', 10) from dual"
+	db.AddQuery(query, sqltypes.MakeTestResult(sqltypes.MakeTestFields("get_lock('foo', 10)", "int64"), "1"))
+
+	_, err := tsv.Execute(ctx, &target, query, nil, 0, 0, nil)
+	require.EqualError(t, err, "SelectLockFunc disallowed outside reserved connection")
+
+	transactionID, _, err := tsv.Begin(ctx, &target, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, &target, query, nil, transactionID, 0, nil)
+	require.EqualError(t, err, "SelectLockFunc disallowed outside reserved connection")
+	_, err = tsv.Rollback(ctx, &target, transactionID)
+	require.NoError(t, err)
+
+	qr, reservedID, _, err := tsv.ReserveExecute(ctx, &target, nil, query, nil, 0, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "[[INT64(1)]]", fmt.Sprintf("%v", qr.Rows))
+	err = tsv.Release(ctx, &target, 0, reservedID)
+	require.NoError(t, err)
+}
+
+func TestReserveExecute_GetLockTimeout(t *testing.T) {
+	db, tsv := setupTabletServerTest(t)
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	lockQuery := "select get_lock('foo', 10) from dual"
+	db.AddQuery(lockQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("get_lock('foo', 10)", "int64"), "1"))
+	query := "select 42 from dual"
+	db.AddQuery(query, &sqltypes.Result{})
+
+	_, lockID, _, err := tsv.ReserveExecute(ctx, &target, nil, lockQuery, nil, 0, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	_, reservedID, _, err := tsv.ReserveExecute(ctx, &target, nil, query, nil, 0, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+
+	tsv.te.txPool.SetTimeout(1 * time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	tsv.te.txPool.transactionKiller()
+
+	// The reserved connection that holds named locks outlives the transaction timeout.
+	_, err = tsv.Execute(ctx, &target, lockQuery, nil, 0, lockID, nil)
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, &target, query, nil, 0, reservedID, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded timeout: 1ms")
+
+	// It's killed once it exceeds the named lock timeout.
+	tsv.te.txPool.SetNamedLockTimeout(2 * time.Millisecond)
+	tsv.te.txPool.transactionKiller()
+	_, err = tsv.Execute(ctx, &target, lockQuery, nil, 0, lockID, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded timeout: 2ms")
+}
+
+func TestReserveExecute_TemporaryTable(t *testing.T) {
+	db, tsv := setupTabletServerTest(t)
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	query := "create temporary table temp(id bigint)"
+	db.AddQuery(query, &sqltypes.Result{})
+	db.AddQuery("select id from temp where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("select id from temp limit 10001", sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1"))
+
+	panics := tsv.stats.InternalErrors.Counts()["Panic"]
+	_, reservedID, _, err := tsv.ReserveExecute(ctx, &target, nil, query, nil, 0, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	// The DDL must not try to restart a transaction on the reserved connection.
+	assert.Equal(t, panics, tsv.stats.InternalErrors.Counts()["Panic"])
+	qr, err := tsv.Execute(ctx, &target, "select id from temp", nil, 0, reservedID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[[INT64(1)]]", fmt.Sprintf("%v", qr.Rows))
+	err = tsv.Release(ctx, &target, 0, reservedID)
+	require.NoError(t, err)
+}
+
+func TestReserveExecute_SettingsPool(t *testing.T) {
+	db, tsv := setupTabletServerTest(t)
+	defer tsv.StopService()
+	defer db.Close()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	setSQLMode := "set @@sql_mode = ''"
+	setTimeZone := "set @@time_zone = '+00:00'"
+	db.AddQuery(setSQLMode, &sqltypes.Result{})
+	db.AddQuery(setTimeZone, &sqltypes.Result{})
+	db.AddQuery("select 42 from dual limit 10001", &sqltypes.Result{})
+	db.AddQuery("set @@sql_safe_updates = 1", &sqltypes.Result{})
+
+	reserve := func(ctx context.Context, preQueries []string) int64 {
+		t.Helper()
+		_, reservedID, _, err := tsv.ReserveExecute(ctx, &target, preQueries, "select 42 from dual", nil, 0, &querypb.ExecuteOptions{})
+		require.NoError(t, err)
+		return reservedID
+	}
+	release := func(reservedID int64) {
+		t.Helper()
+		require.NoError(t, tsv.Release(ctx, &target, 0, reservedID))
+	}
+	pooled := func() int {
+		tsv.te.txPool.scp.settingsMu.Lock()
+		defer tsv.te.txPool.scp.settingsMu.Unlock()
+		return tsv.te.txPool.scp.settingsCount
+	}
+
+	// A connection that is released is reset and reused by a session with the
+	// same settings, which are set up again.
+	release(reserve(ctx, []string{setSQLMode}))
+	assert.Equal(t, 1, pooled())
+	reservedID := reserve(ctx, []string{setSQLMode})
+	assert.Equal(t, 0, pooled())
+	assert.Equal(t, 2, db.GetQueryCalledNum(setSQLMode))
+
+	// Also if the session left other state on it.
+	_, err := tsv.Execute(ctx, &target, "set @@sql_safe_updates = 1", nil, 0, reservedID, nil)
+	require.NoError(t, err)
+	release(reservedID)
+	reservedID = reserve(ctx, []string{setSQLMode})
+	assert.Equal(t, 0, pooled())
+	assert.Equal(t, 3, db.GetQueryCalledNum(setSQLMode))
+
+	// But not by a session with other settings.
+	release(reservedID)
+	release(reserve(ctx, []string{setTimeZone}))
+	assert.Equal(t, 2, pooled())
+
+	// Nor by another caller.
+	otherCtx := callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: "other"})
+	release(reserve(otherCtx, []string{setSQLMode}))
+	assert.Equal(t, 3, pooled())
+}
+
 func TestRelease(t *testing.T) {
 	type testcase struct {
 		begin, reserve  bool
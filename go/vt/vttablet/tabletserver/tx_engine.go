@@ -596,7 +596,7 @@ func (te *TxEngine) Reserve(ctx context.Context, options *querypb.ExecuteOptions
 	}
 	defer conn.Unlock()
 
-	err = te.taintConn(ctx, conn, preQueries)
+	err = te.taintConn(ctx, conn, options, preQueries)
 	if err != nil {
 		return 0, vterrors.Wrap(err, "TxEngine.Reserve")
 	}
@@ -615,12 +615,24 @@ func (te *TxEngine) reserve(ctx context.Context, options *querypb.ExecuteOptions
 	}
 	te.stateLock.Unlock()
 
-	conn, err := te.txPool.scp.NewConn(ctx, options)
-	if err != nil {
-		return nil, err
+	// A connection that was released by a session with the same
+	// settings is reused, once it's reset.
+	var conn *StatefulConnection
+	var err error
+	if settings := settingsKey(ctx, options, preQueries); settings != "" {
+		conn, err = te.txPool.scp.GetWithSettings(options, settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if conn == nil {
+		conn, err = te.txPool.scp.NewConn(ctx, options)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = te.taintConn(ctx, conn, preQueries)
+	err = te.taintConn(ctx, conn, options, preQueries)
 	if err != nil {
 		return nil, err
 	}
@@ -628,7 +640,7 @@ func (te *TxEngine) reserve(ctx context.Context, options *querypb.ExecuteOptions
 	return conn, err
 }
 
-func (te *TxEngine) taintConn(ctx context.Context, conn *StatefulConnection, preQueries []string) error {
+func (te *TxEngine) taintConn(ctx context.Context, conn *StatefulConnection, options *querypb.ExecuteOptions, preQueries []string) error {
 	err := conn.Taint(ctx, te.reservedConnStats)
 	if err != nil {
 		return err
@@ -640,6 +652,7 @@ func (te *TxEngine) taintConn(ctx context.Context, conn *StatefulConnection, pre
 			return err
 		}
 	}
+	conn.settings = settingsKey(ctx, options, preQueries)
 	return nil
 }
 
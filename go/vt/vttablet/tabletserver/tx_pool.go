@@ -61,6 +61,7 @@ type (
 		env                tabletenv.Env
 		scp                *StatefulConnectionPool
 		transactionTimeout sync2.AtomicDuration
+		namedLockTimeout   sync2.AtomicDuration
 		ticks              *timer.Timer
 		limiter            txlimiter.TxLimiter
 
@@ -82,6 +83,7 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 		env:                env,
 		scp:                NewStatefulConnPool(env),
 		transactionTimeout: sync2.NewAtomicDuration(transactionTimeout),
+		namedLockTimeout:   sync2.NewAtomicDuration(time.Duration(config.Oltp.NamedLockTimeoutSeconds * 1e9)),
 		ticks:              timer.NewTimer(transactionTimeout / 10),
 		limiter:            limiter,
 		txStats:            env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
@@ -89,6 +91,7 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 	// Careful: conns also exports name+"xxx" vars,
 	// but we know it doesn't export Timeout.
 	env.Exporter().NewGaugeDurationFunc("TransactionTimeout", "Transaction timeout", axp.transactionTimeout.Get)
+	env.Exporter().NewGaugeDurationFunc("NamedLockTimeout", "Timeout of reserved connections that hold named locks", axp.namedLockTimeout.Get)
 	return axp
 }
 
@@ -124,7 +127,17 @@ func (tp *TxPool) RollbackNonBusy(ctx context.Context) {
 func (tp *TxPool) transactionKiller() {
 	defer tp.env.LogError()
 	for _, conn := range tp.scp.GetOutdated(tp.Timeout(), "for tx killer rollback") {
-		log.Warningf("killing transaction (exceeded timeout: %v): %s", tp.Timeout(), conn.String())
+		timeout := tp.Timeout()
+		if conn.HoldsNamedLocks() && !conn.IsInTransaction() {
+			// The named locks are held until the session releases the
+			// reserved connection, so it gets the longer named lock timeout.
+			timeout = tp.NamedLockTimeout()
+			if conn.ReservedTime() < timeout {
+				conn.Unlock()
+				continue
+			}
+		}
+		log.Warningf("killing transaction (exceeded timeout: %v): %s", timeout, conn.String())
 		if conn.IsTainted() {
 			tp.env.Stats().KillCounters.Add("ReservedConnection", 1)
 		}
@@ -132,8 +145,9 @@ func (tp *TxPool) transactionKiller() {
 			tp.env.Stats().KillCounters.Add("Transactions", 1)
 		}
 		conn.Close()
-		conn.Releasef("exceeded timeout: %v", tp.Timeout())
+		conn.Releasef("exceeded timeout: %v", timeout)
 	}
+	tp.scp.CloseIdleSettingsConns()
 }
 
 // WaitForEmpty waits until all active transactions are completed.
@@ -335,3 +349,14 @@ func (tp *TxPool) txComplete(conn *StatefulConnection, reason tx.ReleaseReason)
 	tp.limiter.Release(conn.TxProperties().ImmediateCaller, conn.TxProperties().EffectiveCaller)
 	conn.CleanTxState()
 }
+
+// NamedLockTimeout returns the timeout of reserved connections
+// that hold named locks outside of a transaction.
+func (tp *TxPool) NamedLockTimeout() time.Duration {
+	return tp.namedLockTimeout.Get()
+}
+
+// SetNamedLockTimeout sets the named lock timeout.
+func (tp *TxPool) SetNamedLockTimeout(timeout time.Duration) {
+	tp.namedLockTimeout.Set(timeout)
+}